@@ -0,0 +1,157 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// storageInfoCache keeps the last cluster wide storage info computed by
+// this node, collecting it on large clusters can take longer than the
+// deadline of diagnostics which reuse it.
+type storageInfoCache struct {
+	mu      sync.RWMutex
+	info    StorageInfo
+	updated time.Time
+}
+
+var globalStorageInfoCache storageInfoCache
+
+func (c *storageInfoCache) set(info StorageInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.info = info
+	c.updated = UTCNow()
+}
+
+func (c *storageInfoCache) get() (StorageInfo, time.Time) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.info, c.updated
+}
+
+// Sources of assembled cluster info.
+const (
+	clusterInfoFromCache       = "cache"
+	clusterInfoFromLive        = "live"
+	clusterInfoFromUnavailable = "unavailable"
+)
+
+// clusterInfoSource tells where a piece of the assembled cluster info
+// came from, and how old it is when it was not collected live.
+type clusterInfoSource struct {
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	Age       string    `json:"age,omitempty"`
+}
+
+func cachedClusterInfoSource(updated time.Time) clusterInfoSource {
+	src := clusterInfoSource{Source: clusterInfoFromCache, UpdatedAt: updated}
+	if !updated.IsZero() {
+		src.Age = UTCNow().Sub(updated).Round(time.Second).String()
+	}
+	return src
+}
+
+// clusterInfo is cluster wide information assembled from the sources
+// already cached on this node, falling back to live collection within
+// the deadline of the context only for the missing pieces.
+type clusterInfo struct {
+	storage   StorageInfo
+	storageOK bool
+	usage     DataUsageInfo
+	usageErr  error
+
+	sources map[string]clusterInfoSource
+}
+
+// assembleClusterInfo assembles the cluster info, the storage info is
+// only assembled when withStorage is set.
+func assembleClusterInfo(ctx context.Context, objAPI ObjectLayer, withStorage bool) clusterInfo {
+	ci := clusterInfo{sources: make(map[string]clusterInfoSource, 2)}
+
+	var wg sync.WaitGroup
+	if withStorage {
+		if info, updated := globalStorageInfoCache.get(); !updated.IsZero() {
+			ci.storage, ci.storageOK = info, true
+			ci.sources["storage"] = cachedClusterInfoSource(updated)
+		} else {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ci.storage, ci.storageOK = liveStorageInfo(ctx, objAPI)
+				if ci.storageOK {
+					ci.sources["storage"] = clusterInfoSource{Source: clusterInfoFromLive}
+				} else {
+					ci.sources["storage"] = clusterInfoSource{Source: clusterInfoFromUnavailable}
+				}
+			}()
+		}
+	}
+
+	// The data usage is always the snapshot persisted by the scanner.
+	usage, err := loadDataUsageSnapshot(ctx, objAPI)
+	wg.Wait()
+
+	ci.usage, ci.usageErr = usage, err
+	if err != nil {
+		ci.sources["usage"] = clusterInfoSource{Source: clusterInfoFromUnavailable}
+	} else {
+		ci.sources["usage"] = cachedClusterInfoSource(usage.LastUpdate)
+	}
+	return ci
+}
+
+// liveStorageInfo collects the cluster wide storage info, giving up
+// when the context is done.
+func liveStorageInfo(ctx context.Context, objAPI ObjectLayer) (StorageInfo, bool) {
+	infoCh := make(chan StorageInfo, 1)
+	go func() {
+		info := objAPI.StorageInfo(ctx)
+		globalStorageInfoCache.set(info)
+		infoCh <- info
+	}()
+	select {
+	case info := <-infoCh:
+		return info, true
+	case <-ctx.Done():
+		return StorageInfo{}, false
+	}
+}
+
+// loadDataUsageSnapshot loads the data usage persisted by the scanner,
+// giving up when the context is done.
+func loadDataUsageSnapshot(ctx context.Context, objAPI ObjectLayer) (DataUsageInfo, error) {
+	type result struct {
+		info DataUsageInfo
+		err  error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		info, err := loadDataUsageFromBackend(ctx, objAPI)
+		resultCh <- result{info, err}
+	}()
+	select {
+	case r := <-resultCh:
+		return r.info, r.err
+	case <-ctx.Done():
+		return DataUsageInfo{}, ctx.Err()
+	}
+}
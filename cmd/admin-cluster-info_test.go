@@ -0,0 +1,71 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/minio/madmin-go/v2"
+)
+
+func TestAssembleClusterInfo(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	defer func() { globalStorageInfoCache = storageInfoCache{} }()
+	globalStorageInfoCache = storageInfoCache{}
+
+	// Nothing cached yet, the storage info is collected live and cached.
+	ci := assembleClusterInfo(ctx, obj, true)
+	if !ci.storageOK || len(ci.storage.Disks) != 16 {
+		t.Fatalf("expected the live storage info of 16 drives, got %v", ci.storage.Disks)
+	}
+	if src := ci.sources["storage"].Source; src != clusterInfoFromLive {
+		t.Fatalf("expected storage info from %s, got %s", clusterInfoFromLive, src)
+	}
+	if _, updated := globalStorageInfoCache.get(); updated.IsZero() {
+		t.Fatal("expected the live storage info to be cached")
+	}
+
+	globalStorageInfoCache.set(StorageInfo{Disks: []madmin.Disk{{TotalSpace: 10}}})
+	ci = assembleClusterInfo(ctx, obj, true)
+	if !ci.storageOK || len(ci.storage.Disks) != 1 {
+		t.Fatalf("expected the cached storage info, got %v", ci.storage.Disks)
+	}
+	if src := ci.sources["storage"]; src.Source != clusterInfoFromCache || src.UpdatedAt.IsZero() || src.Age == "" {
+		t.Fatalf("expected storage info from the cache with its age, got %v", src)
+	}
+	if src := ci.sources["usage"].Source; src != clusterInfoFromCache {
+		t.Fatalf("expected usage from %s, got %s", clusterInfoFromCache, src)
+	}
+
+	if ci = assembleClusterInfo(ctx, obj, false); ci.storageOK {
+		t.Fatal("expected no storage info when not requested")
+	}
+	if _, ok := ci.sources["storage"]; ok {
+		t.Fatal("expected no storage info source when not requested")
+	}
+}
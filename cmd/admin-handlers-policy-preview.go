@@ -0,0 +1,235 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/bucket/policy"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// Types of policies which can be previewed.
+const (
+	policyPreviewIAM    = "iam"
+	policyPreviewBucket = "bucket"
+)
+
+// maxPolicyPreviewSamples is the maximum number of sample requests of a
+// policy preview.
+const maxPolicyPreviewSamples = 1000
+
+// PolicyPreviewRequest - a proposed policy and the sample requests to
+// evaluate against it.
+type PolicyPreviewRequest struct {
+	// Type is either "iam" or "bucket".
+	Type string `json:"type"`
+	// Name of the IAM policy the proposed policy replaces, a new policy
+	// attached to every principal if empty. For a bucket policy the
+	// bucket.
+	Name     string               `json:"name"`
+	Policy   json.RawMessage      `json:"policy"`
+	Requests []PolicyPreviewTuple `json:"requests"`
+}
+
+// PolicyPreviewTuple - a sample request.
+type PolicyPreviewTuple struct {
+	// Principal is an IAM user, for a bucket policy any access key and
+	// empty for anonymous requests.
+	Principal string `json:"principal"`
+	Action    string `json:"action"`
+	// Resource is "bucket" or "bucket/object", optionally as an
+	// "arn:aws:s3:::" ARN.
+	Resource string `json:"resource"`
+}
+
+// PolicyPreviewResult - the outcome of a sample request with the policies
+// in effect and with the proposed policy.
+type PolicyPreviewResult struct {
+	PolicyPreviewTuple
+	Current  bool   `json:"current"`
+	Proposed bool   `json:"proposed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// splitPolicyPreviewResource splits a sample request resource into its
+// bucket and object.
+func splitPolicyPreviewResource(resource string) (bucket, object string) {
+	resource = strings.TrimPrefix(resource, "arn:aws:s3:::")
+	bucket, object, _ = strings.Cut(resource, SlashSeparator)
+	return bucket, object
+}
+
+// previewIAMPolicy evaluates a sample request with the policies attached
+// to the principal, directly or through groups, and with the proposed
+// policy substituted for the policy name.
+func previewIAMPolicy(name string, proposed iampolicy.Policy, t PolicyPreviewTuple) (current, allowed bool, err error) {
+	if t.Principal == globalActiveCred.AccessKey {
+		// Policies don't apply to the owner.
+		return true, true, nil
+	}
+	policies, err := globalIAMSys.PolicyDBGet(t.Principal, false)
+	if err != nil {
+		return false, false, err
+	}
+
+	bucket, object := splitPolicyPreviewResource(t.Resource)
+	args := iampolicy.Args{
+		AccountName:     t.Principal,
+		Action:          iampolicy.Action(t.Action),
+		BucketName:      bucket,
+		ObjectName:      object,
+		ConditionValues: map[string][]string{},
+	}
+	if len(policies) > 0 {
+		current = globalIAMSys.GetCombinedPolicy(policies...).IsAllowed(args)
+	}
+
+	attached := name == ""
+	others := make([]string, 0, len(policies))
+	for _, p := range policies {
+		if p == name {
+			attached = true
+			continue
+		}
+		others = append(others, p)
+	}
+	if !attached {
+		// The proposed policy does not apply to the principal.
+		return current, current, nil
+	}
+	combined := proposed
+	if len(others) > 0 {
+		combined = globalIAMSys.GetCombinedPolicy(others...).Merge(proposed)
+	}
+	return current, combined.IsAllowed(args), nil
+}
+
+// previewBucketPolicy evaluates a sample request with the policy of the
+// bucket in effect and with the proposed policy.
+func previewBucketPolicy(bucket string, proposed *policy.Policy, t PolicyPreviewTuple) (current, allowed bool, err error) {
+	reqBucket, object := splitPolicyPreviewResource(t.Resource)
+	if reqBucket != bucket {
+		return false, false, fmt.Errorf("resource '%s' is not in bucket '%s'", t.Resource, bucket)
+	}
+	args := policy.Args{
+		AccountName:     t.Principal,
+		Action:          policy.Action(t.Action),
+		BucketName:      bucket,
+		ObjectName:      object,
+		ConditionValues: map[string][]string{},
+		IsOwner:         t.Principal == globalActiveCred.AccessKey,
+	}
+	return globalPolicySys.IsAllowed(args), args.IsOwner || proposed.IsAllowed(args), nil
+}
+
+// PolicyPreviewHandler - POST /minio/admin/v3/policy/preview
+// ----------
+// Evaluates sample requests against a proposed IAM or bucket policy and
+// against the policies in effect, without saving the proposed policy.
+func (a adminAPIHandlers) PolicyPreviewHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PolicyPreview")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.GetPolicyAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	var req PolicyPreviewRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, 2*maxBucketPolicySize)).Decode(&req); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+		return
+	}
+	if len(req.Requests) == 0 || len(req.Requests) > maxPolicyPreviewSamples {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest,
+			fmt.Errorf("between 1 and %d sample requests are required", maxPolicyPreviewSamples)), r.URL)
+		return
+	}
+	for _, t := range req.Requests {
+		if !strings.Contains(t.Action, ":") || t.Resource == "" {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest,
+				fmt.Errorf("invalid sample request %+v", t)), r.URL)
+			return
+		}
+	}
+
+	var preview func(t PolicyPreviewTuple) (bool, bool, error)
+	switch req.Type {
+	case policyPreviewIAM:
+		iamPolicy, err := iampolicy.ParseConfig(bytes.NewReader(req.Policy))
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		if iamPolicy.Version == "" {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrPolicyInvalidVersion), r.URL)
+			return
+		}
+		preview = func(t PolicyPreviewTuple) (bool, bool, error) {
+			return previewIAMPolicy(req.Name, *iamPolicy, t)
+		}
+	case policyPreviewBucket:
+		// Check if bucket exists.
+		if _, err := objectAPI.GetBucketInfo(ctx, req.Name, BucketOptions{}); err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		bucketPolicy, err := policy.ParseConfig(bytes.NewReader(req.Policy), req.Name)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest, err), r.URL)
+			return
+		}
+		if bucketPolicy.Version == "" {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrPolicyInvalidVersion), r.URL)
+			return
+		}
+		preview = func(t PolicyPreviewTuple) (bool, bool, error) {
+			return previewBucketPolicy(req.Name, bucketPolicy, t)
+		}
+	default:
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest,
+			errors.New("policy type must be 'iam' or 'bucket'")), r.URL)
+		return
+	}
+
+	results := make([]PolicyPreviewResult, 0, len(req.Requests))
+	for _, t := range req.Requests {
+		res := PolicyPreviewResult{PolicyPreviewTuple: t}
+		var err error
+		if res.Current, res.Proposed, err = preview(t); err != nil {
+			res.Error = err.Error()
+		}
+		results = append(results, res)
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, data)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import "testing"
+
+func TestSplitPolicyPreviewResource(t *testing.T) {
+	testCases := []struct {
+		resource, bucket, object string
+	}{
+		{"bucket", "bucket", ""},
+		{"bucket/", "bucket", ""},
+		{"bucket/dir/object", "bucket", "dir/object"},
+		{"arn:aws:s3:::bucket/object", "bucket", "object"},
+	}
+	for _, tc := range testCases {
+		bucket, object := splitPolicyPreviewResource(tc.resource)
+		if bucket != tc.bucket || object != tc.object {
+			t.Errorf("%s: expected %s and %s, got %s and %s", tc.resource, tc.bucket, tc.object, bucket, object)
+		}
+	}
+}
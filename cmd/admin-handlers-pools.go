@@ -22,8 +22,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/google/uuid"
+	"github.com/minio/minio/internal/config/storageclass"
+	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/mux"
 	iampolicy "github.com/minio/pkg/iam/policy"
@@ -238,6 +242,91 @@ func (a adminAPIHandlers) ListPools(w http.ResponseWriter, r *http.Request) {
 	logger.LogIf(r.Context(), json.NewEncoder(w).Encode(poolsStatus))
 }
 
+// SimulateUpload - GET /minio/admin/v3/pools/simulate-upload?bucket={bucket}&object={object}&size={size}&storage-class={sc}
+// ----------
+// Returns the pool, erasure set and parity an upload would be written
+// with, without writing anything.
+func (a adminAPIHandlers) SimulateUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "SimulateUpload")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	pools, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	size, err := strconv.ParseInt(vars["size"], 10, 64)
+	if err != nil || size < 0 {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errInvalidArgument), r.URL)
+		return
+	}
+
+	sc := r.Form.Get("storage-class")
+	if sc != "" && !storageclass.IsValid(sc) {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidStorageClass), r.URL)
+		return
+	}
+
+	placement, err := pools.SimulatePutObject(ctx, vars["bucket"], vars["object"], size, sc)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(r.Context(), json.NewEncoder(w).Encode(&placement))
+}
+
+// LocateObject - GET /minio/admin/v3/locate?bucket=mybucket&object=myobject&versionId=
+// ----------
+// Reports how an object is looked up across pools, the answer of
+// every pool and the pool the object is served from.
+func (a adminAPIHandlers) LocateObject(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "LocateObject")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	pools, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket, object := vars["bucket"], vars["object"]
+	vid := strings.TrimSpace(r.Form.Get(xhttp.VersionID))
+	if vid != "" && vid != nullVersionID {
+		if _, err := uuid.Parse(vid); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, InvalidVersionID{
+				Bucket:    bucket,
+				Object:    object,
+				VersionID: vid,
+			}), r.URL)
+			return
+		}
+	}
+
+	location, err := pools.LocateObject(ctx, bucket, object, ObjectOptions{VersionID: vid})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(r.Context(), json.NewEncoder(w).Encode(&location))
+}
+
 func (a adminAPIHandlers) RebalanceStart(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "RebalanceStart")
 	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +39,100 @@ import (
 	iampolicy "github.com/minio/pkg/iam/policy"
 )
 
+// userInfoWithLastUsed - user listing entry, along with the last
+// time the user authenticated, if known, and its policy elevation.
+type userInfoWithLastUsed struct {
+	madmin.UserInfo
+	LastUsed  *time.Time `json:"lastUsed,omitempty"`
+	Elevation *Elevation `json:"elevation,omitempty"`
+}
+
+// listServiceAccountsWithLastUsed - service account listing, along
+// with the last time each service account authenticated, if known.
+type listServiceAccountsWithLastUsed struct {
+	madmin.ListServiceAccountsResp
+	LastUsed map[string]time.Time `json:"lastUsed,omitempty"`
+}
+
+// StaleCredential - a user or service account which did not authenticate
+// for the requested duration.
+type StaleCredential struct {
+	AccessKey  string     `json:"accessKey"`
+	Type       string     `json:"type"` // "user" or "service-account"
+	ParentUser string     `json:"parentUser,omitempty"`
+	Status     string     `json:"status"`
+	LastUsed   *time.Time `json:"lastUsed,omitempty"` // unset if not used since trackingSince
+}
+
+// StaleCredentialsReport - response of the stale credentials admin API.
+type StaleCredentialsReport struct {
+	UnusedFor     string            `json:"unusedFor"`
+	TrackingSince time.Time         `json:"trackingSince"`
+	Accuracy      string            `json:"accuracy"`
+	Credentials   []StaleCredential `json:"credentials"`
+}
+
+// parseUnusedFor parses a duration, also accepting a number of days such as "90d".
+func parseUnusedFor(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		n, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return d, nil
+}
+
+// StaleCredentials - GET /minio/admin/v3/stale-credentials?unused-for=90d
+// Returns the users and service accounts which did not authenticate
+// for the given duration, 90 days by default, as candidates for cleanup.
+func (a adminAPIHandlers) StaleCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "StaleCredentials")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ListUsersAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	unusedForStr := r.Form.Get("unused-for")
+	if unusedForStr == "" {
+		unusedForStr = "90d"
+	}
+	unusedFor, err := parseUnusedFor(unusedForStr)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest, err), r.URL)
+		return
+	}
+
+	stale, state, err := globalIAMSys.StaleCredentials(ctx, UTCNow().Add(-unusedFor))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	report := StaleCredentialsReport{
+		UnusedFor:     unusedForStr,
+		TrackingSince: state.Since,
+		Accuracy: fmt.Sprintf("last used times are recorded with a resolution of %s and shared across nodes every %s",
+			lastUsedGranularity, lastUsedFlushInterval),
+		Credentials: stale,
+	}
+	data, err := json.Marshal(report)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
 // RemoveUser - DELETE /minio/admin/v3/remove-user?accessKey=<access_key>
 func (a adminAPIHandlers) RemoveUser(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "RemoveUser")
@@ -143,8 +238,21 @@ func (a adminAPIHandlers) ListUsers(w http.ResponseWriter, r *http.Request) {
 		allCredentials[k] = v
 	}
 
+	lastUsed, err := globalIAMSys.AccessKeysLastUsed(ctx)
+	logger.LogIf(ctx, err)
+
+	users := make(map[string]userInfoWithLastUsed, len(allCredentials))
+	for k, v := range allCredentials {
+		u := userInfoWithLastUsed{UserInfo: v}
+		if t, ok := lastUsed.LastUsed[k]; ok {
+			u.LastUsed = &t
+		}
+		u.Elevation = globalIAMSys.GetElevation(k)
+		users[k] = u
+	}
+
 	// Marshal the response
-	data, err := json.Marshal(allCredentials)
+	data, err := json.Marshal(users)
 	if err != nil {
 		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
 		return
@@ -207,7 +315,10 @@ func (a adminAPIHandlers) GetUserInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	data, err := json.Marshal(userInfo)
+	data, err := json.Marshal(userInfoWithLastUsed{
+		UserInfo:  userInfo,
+		Elevation: globalIAMSys.GetElevation(name),
+	})
 	if err != nil {
 		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
 		return
@@ -1079,8 +1190,21 @@ func (a adminAPIHandlers) ListServiceAccounts(w http.ResponseWriter, r *http.Req
 		serviceAccountsNames = append(serviceAccountsNames, svc.AccessKey)
 	}
 
-	listResp := madmin.ListServiceAccountsResp{
-		Accounts: serviceAccountsNames,
+	lastUsed, err := globalIAMSys.AccessKeysLastUsed(ctx)
+	logger.LogIf(ctx, err)
+
+	listResp := listServiceAccountsWithLastUsed{
+		ListServiceAccountsResp: madmin.ListServiceAccountsResp{
+			Accounts: serviceAccountsNames,
+		},
+	}
+	for _, accessKey := range serviceAccountsNames {
+		if t, ok := lastUsed.LastUsed[accessKey]; ok {
+			if listResp.LastUsed == nil {
+				listResp.LastUsed = make(map[string]time.Time)
+			}
+			listResp.LastUsed[accessKey] = t
+		}
 	}
 
 	data, err := json.Marshal(listResp)
@@ -2626,3 +2750,161 @@ func (a adminAPIHandlers) ImportIAM(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 }
+
+// ElevateUser - POST /minio/admin/v3/iam/elevate
+//
+// Attaches a policy to a user for a limited duration, a reason is
+// mandatory. The elevation replaces any existing elevation of the user.
+func (a adminAPIHandlers) ElevateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ElevateUser")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, cred := validateAdminReq(ctx, w, r, iampolicy.AttachPolicyAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	var req ElevationRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxEConfigJSONSize)).Decode(&req); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+
+	mp, err := parseElevationRequest(req, cred.AccessKey, UTCNow())
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, AdminError{
+			Code:       "XMinioAdminInvalidRequest",
+			Message:    err.Error(),
+			StatusCode: http.StatusBadRequest,
+		}), r.URL)
+		return
+	}
+
+	ok, _, err := globalIAMSys.IsTempUser(req.User)
+	if err != nil && err != errNoSuchUser {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if ok {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errIAMActionNotAllowed), r.URL)
+		return
+	}
+
+	// Validate that user exists.
+	if globalIAMSys.GetUsersSysType() == MinIOUsersSysType {
+		if _, ok := globalIAMSys.GetUser(ctx, req.User); !ok {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errNoSuchUser), r.URL)
+			return
+		}
+	}
+
+	updatedAt, err := globalIAMSys.ElevatePolicy(ctx, req.User, mp, false)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	mpData, err := json.Marshal(mp)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	logger.LogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
+		Type:      srIAMItemElevatedPolicy,
+		Name:      req.User,
+		Policy:    mpData,
+		UpdatedAt: updatedAt,
+	}))
+
+	data, err := json.Marshal(newElevation(req.User, mp))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, data)
+}
+
+// RevokeElevation - DELETE /minio/admin/v3/iam/elevate?user=<user>
+func (a adminAPIHandlers) RevokeElevation(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RevokeElevation")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, cred := validateAdminReq(ctx, w, r, iampolicy.AttachPolicyAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	user := r.Form.Get("user")
+	if user == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+
+	if _, err := globalIAMSys.RevokeElevatedPolicy(ctx, user, cred.AccessKey, false); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
+		Type:      srIAMItemElevatedPolicy,
+		Name:      user,
+		UpdatedAt: UTCNow(),
+	}))
+}
+
+// ListElevations - GET /minio/admin/v3/iam/elevate[?user=<user>]
+//
+// Lists the policy elevations that have not expired, with their expiry.
+func (a adminAPIHandlers) ListElevations(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListElevations")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ListUserPoliciesAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	elevations, err := globalIAMSys.ListElevations(r.Form.Get("user"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(elevations)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, data)
+}
+
+// ListElevationAudit - GET /minio/admin/v3/iam/elevate/audit[?user=<user>]
+//
+// Lists the most recent elevation, revocation and expiry records of
+// policy elevations.
+func (a adminAPIHandlers) ListElevationAudit(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListElevationAudit")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ListUserPoliciesAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	records, err := listElevationAuditRecords(ctx, objectAPI, r.Form.Get("user"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, data)
+}
@@ -31,23 +31,23 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
-	"math"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"regexp"
-	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/google/uuid"
 	"github.com/klauspost/compress/zip"
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/madmin-go/v2/estream"
 	"github.com/minio/minio-go/v7/pkg/set"
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/dsync"
 	"github.com/minio/minio/internal/handlers"
 	xhttp "github.com/minio/minio/internal/http"
@@ -74,9 +74,10 @@ const (
 	mgmtForceStop   = "forceStop"
 )
 
-// ServerUpdateHandler - POST /minio/admin/v3/update?updateURL={updateURL}
+// ServerUpdateHandler - POST /minio/admin/v3/update?updateURL={updateURL}&dry-run={bool}
 // ----------
-// updates all minio servers and restarts them gracefully.
+// updates all minio servers and restarts them gracefully. A dry run only
+// reports the version the servers would be updated to.
 func (a adminAPIHandlers) ServerUpdateHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "ServerUpdate")
 
@@ -96,11 +97,32 @@ func (a adminAPIHandlers) ServerUpdateHandler(w http.ResponseWriter, r *http.Req
 	vars := mux.Vars(r)
 	updateURL := vars["updateURL"]
 	mode := getMinioMode()
-	if updateURL == "" {
-		updateURL = minioReleaseInfoURL
-		if runtime.GOOS == globalWindowsOSName {
-			updateURL = minioReleaseWindowsInfoURL
+	dryRun := r.Form.Get("dry-run") == "true"
+
+	// A dry run without an explicit URL reports the result of the
+	// last background check instead of reaching the release info.
+	if result := globalUpdateChecker.Result(); dryRun && updateURL == "" && result.CheckedAt != nil {
+		updateStatus := madmin.ServerUpdateStatus{
+			CurrentVersion: Version,
+			UpdatedVersion: Version,
+		}
+		if result.UpdateAvailable {
+			updateStatus.UpdatedVersion = result.LatestVersion
+		}
+
+		// Marshal API response
+		jsonBytes, err := json.Marshal(updateStatus)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
 		}
+
+		writeSuccessResponseJSON(w, jsonBytes)
+		return
+	}
+
+	if updateURL == "" {
+		updateURL = getUpdateInfoURL()
 	}
 
 	u, err := url.Parse(updateURL)
@@ -128,11 +150,14 @@ func (a adminAPIHandlers) ServerUpdateHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if lrTime.Sub(crTime) <= 0 {
+	if lrTime.Sub(crTime) <= 0 || dryRun {
 		updateStatus := madmin.ServerUpdateStatus{
 			CurrentVersion: Version,
 			UpdatedVersion: Version,
 		}
+		if lrTime.Sub(crTime) > 0 {
+			updateStatus.UpdatedVersion = lrTime.Format(minioReleaseTagTimeLayout)
+		}
 
 		// Marshal API response
 		jsonBytes, err := json.Marshal(updateStatus)
@@ -325,10 +350,13 @@ type ServerHTTPStats struct {
 	TotalS35xxErrors       ServerHTTPAPIStats `json:"totalS35xxErrors"`
 	TotalS34xxErrors       ServerHTTPAPIStats `json:"totalS34xxErrors"`
 	TotalS3Canceled        ServerHTTPAPIStats `json:"totalS3Canceled"`
+	TotalS3SlowDown        ServerHTTPAPIStats `json:"totalS3SlowDown"`
 	TotalS3RejectedAuth    uint64             `json:"totalS3RejectedAuth"`
 	TotalS3RejectedTime    uint64             `json:"totalS3RejectedTime"`
 	TotalS3RejectedHeader  uint64             `json:"totalS3RejectedHeader"`
 	TotalS3RejectedInvalid uint64             `json:"totalS3RejectedInvalid"`
+	TotalS3RejectedLimit   uint64             `json:"totalS3RejectedLimit"`
+	TotalS3AuthFailures    map[string]uint64  `json:"totalS3AuthFailures,omitempty"`
 }
 
 // StorageInfoHandler - GET /minio/admin/v3/storageinfo
@@ -359,6 +387,7 @@ func (a adminAPIHandlers) StorageInfoHandler(w http.ResponseWriter, r *http.Requ
 			storageInfo.Disks[i].Healing = true
 		}
 	}
+	globalStorageInfoCache.set(storageInfo)
 
 	// Marshal API response
 	jsonBytes, err := json.Marshal(storageInfo)
@@ -384,81 +413,16 @@ func (a adminAPIHandlers) MetricsHandler(w http.ResponseWriter, r *http.Request)
 	if objectAPI == nil {
 		return
 	}
-	const defaultMetricsInterval = time.Second
-
-	interval, err := time.ParseDuration(r.Form.Get("interval"))
-	if err != nil || interval < time.Second {
-		interval = defaultMetricsInterval
-	}
-
-	n, err := strconv.Atoi(r.Form.Get("n"))
-	if err != nil || n <= 0 {
-		n = math.MaxInt32
-	}
-
-	var types madmin.MetricType
-	if t, _ := strconv.ParseUint(r.Form.Get("types"), 10, 64); t != 0 {
-		types = madmin.MetricType(t)
-	} else {
-		types = madmin.MetricsAll
-	}
-
-	disks := strings.Split(r.Form.Get("disks"), ",")
-	byDisk := strings.EqualFold(r.Form.Get("by-disk"), "true")
-	var diskMap map[string]struct{}
-	if len(disks) > 0 && disks[0] != "" {
-		diskMap = make(map[string]struct{}, len(disks))
-		for _, k := range disks {
-			if k != "" {
-				diskMap[k] = struct{}{}
-			}
-		}
-	}
-	jobID := r.Form.Get("by-jobID")
-
-	hosts := strings.Split(r.Form.Get("hosts"), ",")
-	byHost := strings.EqualFold(r.Form.Get("by-host"), "true")
-	var hostMap map[string]struct{}
-	if len(hosts) > 0 && hosts[0] != "" {
-		hostMap = make(map[string]struct{}, len(hosts))
-		for _, k := range hosts {
-			if k != "" {
-				hostMap[k] = struct{}{}
-			}
-		}
-	}
-	dID := r.Form.Get("by-depID")
+	opts := parseRealtimeMetricsOpts(r)
 	done := ctx.Done()
-	ticker := time.NewTicker(interval)
+	ticker := time.NewTicker(opts.interval)
 	defer ticker.Stop()
 	w.Header().Set(xhttp.ContentType, string(mimeJSON))
 
 	enc := json.NewEncoder(w)
-	for n > 0 {
-		var m madmin.RealtimeMetrics
-		mLocal := collectLocalMetrics(types, collectMetricsOpts{
-			hosts: hostMap,
-			disks: diskMap,
-			jobID: jobID,
-			depID: dID,
-		})
-		m.Merge(&mLocal)
-		// Allow half the interval for collecting remote...
-		cctx, cancel := context.WithTimeout(ctx, interval/2)
-		mRemote := collectRemoteMetrics(cctx, types, collectMetricsOpts{
-			hosts: hostMap,
-			disks: diskMap,
-			jobID: jobID,
-			depID: dID,
-		})
-		cancel()
-		m.Merge(&mRemote)
-		if !byHost {
-			m.ByHost = nil
-		}
-		if !byDisk {
-			m.ByDisk = nil
-		}
+	for n := opts.n; n > 0; {
+		m := collectRealtimeMetrics(ctx, opts)
+		opts.filter(&m)
 
 		m.Final = n <= 1
 
@@ -483,6 +447,61 @@ func (a adminAPIHandlers) MetricsHandler(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// MetricsWSHandler - GET /minio/admin/v3/metrics/ws
+// ----------
+// Pushes realtime server metrics over a WebSocket, takes the same query
+// parameters as MetricsHandler. The metrics are collected once per
+// interval for all the subscribers with the same options.
+func (a adminAPIHandlers) MetricsWSHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "MetricsWS")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	opts := parseRealtimeMetricsOpts(r)
+	snapshots, unsubscribe, err := globalMetricsHub.subscribe(opts)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrBusy, err), r.URL)
+		return
+	}
+	defer unsubscribe()
+
+	// Upgrade replies with an error to invalid requests.
+	conn, err := metricsWSUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	serveMetricsWS(ctx, conn, snapshots, opts)
+}
+
+// MetricsJSONHandler - GET /minio/admin/v3/metrics/json
+// ----------
+// Returns the cluster Prometheus metrics as a JSON array.
+func (a adminAPIHandlers) MetricsJSONHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "MetricsJSON")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.PrometheusAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	metrics := clusterCollector.collectMetrics(ctx)
+	if metrics == nil {
+		metrics = []Metric{}
+	}
+
+	w.Header().Set(xhttp.ContentType, string(mimeJSON))
+	logger.LogIf(ctx, json.NewEncoder(w).Encode(metrics))
+}
+
 // DataUsageInfoHandler - GET /minio/admin/v3/datausage
 // ----------
 // Get server/cluster data usage info
@@ -511,6 +530,265 @@ func (a adminAPIHandlers) DataUsageInfoHandler(w http.ResponseWriter, r *http.Re
 	writeSuccessResponseJSON(w, dataUsageInfoJSON)
 }
 
+// DataUsageRebuildHandler - POST /minio/admin/v3/datausage/rebuild
+// ----------
+// Discards the data usage cache and restarts the data scanner
+// to rebuild it from scratch.
+func (a adminAPIHandlers) DataUsageRebuildHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DataUsageRebuild")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Only the node running the data scanner acts on the request.
+	globalDataUsageRebuild.request()
+	for _, nerr := range globalNotificationSys.RebuildDataUsage(ctx) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// ObjectLockReport - retention summary of the object versions in a bucket
+// with object lock enabled, as of the last data usage scan.
+type ObjectLockReport struct {
+	Bucket      string            `json:"bucket"`
+	LastUpdate  time.Time         `json:"lastUpdate"`
+	NoRetention uint64            `json:"noRetention"`
+	Governance  uint64            `json:"governance"`
+	Compliance  uint64            `json:"compliance"`
+	LegalHold   uint64            `json:"legalHold"`
+	RetainUntil map[string]uint64 `json:"retainUntil"` // versions under retention by retain-until month
+}
+
+// ObjectLockReportEntry - a version without an active retention, streamed
+// by the object lock report drill-down. The last entry of a response has
+// Final set and carries the markers to resume from when truncated.
+type ObjectLockReportEntry struct {
+	Object            string `json:"object,omitempty"`
+	VersionID         string `json:"versionId,omitempty"`
+	Final             bool   `json:"final,omitempty"`
+	IsTruncated       bool   `json:"isTruncated,omitempty"`
+	NextMarker        string `json:"nextMarker,omitempty"`
+	NextVersionMarker string `json:"nextVersionMarker,omitempty"`
+}
+
+// objectLockReportMaxScan is the maximum number of versions listed by a
+// single drill-down request before it is truncated.
+const objectLockReportMaxScan = 100 * maxObjectList
+
+// ObjectLockReportHandler - GET /minio/admin/v3/object-lock-report?bucket={bucket}
+// ----------
+// Returns the retention summary of a bucket with object lock enabled from
+// the data usage scanner. With missing-retention=true, streams the versions
+// without an active retention instead, listing at most max-keys of them and
+// resuming from marker and version-marker.
+func (a adminAPIHandlers) ObjectLockReportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ObjectLockReport")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.DataUsageInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	bucket := r.Form.Get("bucket")
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if rcfg, _ := globalBucketObjectLockSys.Get(bucket); !rcfg.LockEnabled {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrObjectLockConfigurationNotFound), r.URL)
+		return
+	}
+
+	if r.Form.Get("missing-retention") == "true" {
+		maxKeys := maxObjectList
+		if s := r.Form.Get("max-keys"); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil || n <= 0 {
+				writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidMaxKeys), r.URL)
+				return
+			}
+			if n < maxKeys {
+				maxKeys = n
+			}
+		}
+		streamObjectLockMissingRetention(ctx, w, objectAPI, bucket, r.Form.Get("marker"), r.Form.Get("version-marker"), maxKeys)
+		return
+	}
+
+	stats, lastUpdate, err := loadObjectLockStatsFromBackend(ctx, objectAPI, bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	report := ObjectLockReport{
+		Bucket:      bucket,
+		LastUpdate:  lastUpdate,
+		NoRetention: stats.NoRetention,
+		Governance:  stats.Governance,
+		Compliance:  stats.Compliance,
+		LegalHold:   stats.LegalHold,
+		RetainUntil: stats.RetainUntil,
+	}
+	jsonBytes, err := json.Marshal(report)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ObjectLockTestHandler - POST /minio/admin/v3/object-lock-test?bucket={bucket}&age={duration}&legal-hold={bool}
+// ----------
+// Reports whether deleting or overwriting a sample object version written
+// age ago to a bucket with object lock enabled would be blocked, running
+// the object lock enforcement without touching the bucket contents. The
+// default retention of the bucket is used unless a hypothetical object
+// lock configuration is sent in the body.
+func (a adminAPIHandlers) ObjectLockTestHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ObjectLockTest")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI := newObjectLayerFn()
+	if objectAPI == nil || globalNotificationSys == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	cred, owner, s3Err := validateAdminSignature(ctx, r, "")
+	if s3Err != ErrNone {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
+	// The test runs the object lock enforcement of the bucket, require
+	// the permission to read its object lock configuration.
+	bucket := r.Form.Get("bucket")
+	if !globalIAMSys.IsAllowed(iampolicy.Args{
+		AccountName:     cred.AccessKey,
+		Groups:          cred.Groups,
+		Action:          iampolicy.GetBucketObjectLockConfigurationAction,
+		BucketName:      bucket,
+		ConditionValues: getConditionValues(r, "", cred),
+		IsOwner:         owner,
+		Claims:          cred.Claims,
+	}) {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
+		return
+	}
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	rcfg, err := globalBucketObjectLockSys.Get(bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if !rcfg.LockEnabled {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrObjectLockConfigurationNotFound), r.URL)
+		return
+	}
+
+	var age time.Duration
+	if s := r.Form.Get("age"); s != "" {
+		if age, err = time.ParseDuration(s); err != nil || age < 0 {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, AdminError{
+				Code:       "XMinioAdminInvalidRequest",
+				Message:    fmt.Sprintf("invalid age %q", s),
+				StatusCode: http.StatusBadRequest,
+			}), r.URL)
+			return
+		}
+	}
+	legalHold := r.Form.Get("legal-hold") == "true"
+
+	hypothetical := r.ContentLength != 0
+	if hypothetical {
+		config, err := objectlock.ParseObjectLockConfig(r.Body)
+		if err != nil {
+			apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
+			apiErr.Description = err.Error()
+			writeErrorResponseJSON(ctx, w, apiErr, r.URL)
+			return
+		}
+		rcfg = config.ToRetention()
+	}
+
+	res, err := testObjectLockEnforcement(ctx, bucket, rcfg, legalHold, age)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	res.Hypothetical = hypothetical
+
+	jsonBytes, err := json.Marshal(res)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// streamObjectLockMissingRetention writes the versions of bucket without an
+// active retention as a stream of ObjectLockReportEntry, listing versions
+// after marker and versionMarker.
+func streamObjectLockMissingRetention(ctx context.Context, w http.ResponseWriter, objectAPI ObjectLayer, bucket, marker, versionMarker string, maxKeys int) {
+	w.Header().Set(xhttp.ContentType, string(mimeJSON))
+	enc := json.NewEncoder(w)
+
+	final := ObjectLockReportEntry{Final: true}
+	now := UTCNow()
+	found, scanned := 0, 0
+	for {
+		loi, err := objectAPI.ListObjectVersions(ctx, bucket, "", marker, versionMarker, "", maxObjectList)
+		if err != nil {
+			// Headers are already sent, report where to resume from.
+			logger.LogIf(ctx, err)
+			final.IsTruncated = true
+			final.NextMarker, final.NextVersionMarker = marker, versionMarker
+			break
+		}
+		for _, oi := range loi.Objects {
+			if found == maxKeys || scanned == objectLockReportMaxScan {
+				final.IsTruncated = true
+				final.NextMarker, final.NextVersionMarker = marker, versionMarker
+				break
+			}
+			scanned++
+			marker, versionMarker = oi.Name, oi.VersionID
+			if oi.DeleteMarker {
+				continue
+			}
+			if _, ok := objectLockActiveRetention(oi.UserDefined, now); ok {
+				continue
+			}
+			if err := enc.Encode(ObjectLockReportEntry{Object: oi.Name, VersionID: oi.VersionID}); err != nil {
+				return
+			}
+			found++
+		}
+		if final.IsTruncated || !loi.IsTruncated {
+			break
+		}
+		w.(http.Flusher).Flush()
+		marker, versionMarker = loi.NextMarker, loi.NextVersionIDMarker
+	}
+	logger.LogIf(ctx, enc.Encode(final))
+}
+
 func lriToLockEntry(l lockRequesterInfo, now time.Time, resource, server string) *madmin.LockEntry {
 	entry := &madmin.LockEntry{
 		Timestamp:  l.Timestamp,
@@ -649,6 +927,91 @@ func (a adminAPIHandlers) TopLocksHandler(w http.ResponseWriter, r *http.Request
 	writeSuccessResponseJSON(w, jsonBytes)
 }
 
+// LockHoldersHandler - GET /minio/admin/v3/top/locks/holders?resource={bucket/object}
+// ----------
+// Returns the request context and the current goroutine stack of the
+// holders of the lock on the resource, on all nodes.
+func (a adminAPIHandlers) LockHoldersHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "LockHolders")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.TopLocksAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	resource := strings.TrimPrefix(path.Clean(r.Form.Get("resource")), SlashSeparator)
+	if resource == "" || resource == "." {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest, errors.New("resource is required")), r.URL)
+		return
+	}
+
+	holders := globalNotificationSys.GetLockHolders(ctx, resource)
+	if holders == nil {
+		holders = []LockHolder{}
+	}
+
+	jsonBytes, err := json.Marshal(holders)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ObjectReadStreamsHandler - GET /minio/admin/v3/object-streams
+// ----------
+// Returns the GetObject responses being streamed by all nodes, oldest
+// first.
+func (a adminAPIHandlers) ObjectReadStreamsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ObjectReadStreams")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.TopLocksAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetObjectReadStreams(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// CancelObjectReadStreamHandler - POST /minio/admin/v3/object-streams/cancel?id={id}
+// ----------
+// Aborts the GetObject response being streamed with the ID, releasing
+// the read lock it holds on the object.
+func (a adminAPIHandlers) CancelObjectReadStreamHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "CancelObjectReadStream")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ForceUnlockAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	id := r.Form.Get("id")
+	if id == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest, errors.New("id is required")), r.URL)
+		return
+	}
+
+	if !globalNotificationSys.CancelObjectReadStream(ctx, id) {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errNoSuchObjectStream), r.URL)
+		return
+	}
+
+	writeSuccessNoContent(w)
+}
+
 // StartProfilingResult contains the status of the starting
 // profiling action in a given server - deprecated API
 type StartProfilingResult struct {
@@ -872,6 +1235,7 @@ type healInitParams struct {
 	hs                    madmin.HealOpts
 	clientToken           string
 	forceStart, forceStop bool
+	writeResults          bool
 }
 
 // extractHealInitParams - Validates params for heal init API.
@@ -920,12 +1284,20 @@ func extractHealInitParams(vars map[string]string, qParms url.Values, r io.Reade
 
 	// ignore body if clientToken is provided
 	if hip.clientToken == "" {
-		jerr := json.NewDecoder(r).Decode(&hip.hs)
+		var opts struct {
+			madmin.HealOpts
+			// WriteResults writes the heal results to result
+			// objects instead of the heal status.
+			WriteResults bool `json:"writeResults"`
+		}
+		jerr := json.NewDecoder(r).Decode(&opts)
 		if jerr != nil {
 			logger.LogIf(GlobalContext, jerr, logger.Application)
 			err = ErrRequestBodyParse
 			return
 		}
+		hip.hs = opts.HealOpts
+		hip.writeResults = opts.WriteResults
 	}
 
 	err = ErrNone
@@ -1090,6 +1462,9 @@ func (a adminAPIHandlers) HealHandler(w http.ResponseWriter, r *http.Request) {
 		}()
 	case hip.clientToken == "":
 		nh := newHealSequence(GlobalContext, hip.bucket, hip.objPrefix, handlers.GetSourceIP(r), hip.hs, hip.forceStart)
+		if hip.writeResults {
+			nh.results = newHealResultsWriter(objectAPI, nh.clientToken)
+		}
 		go func() {
 			respBytes, apiErr, errMsg := globalAllHealState.LaunchNewHealSequence(nh, objectAPI)
 			hr := healResp{respBytes, apiErr, errMsg}
@@ -1103,7 +1478,62 @@ func (a adminAPIHandlers) HealHandler(w http.ResponseWriter, r *http.Request) {
 	keepConnLive(w, r, respCh)
 }
 
-// getAggregatedBackgroundHealState returns the heal state of disks.
+// HealResultsHandler - GET /minio/admin/v3/heal-results?token={token}&name={name}
+// -----------
+// Lists the result objects written by the heal sequence with the given
+// token, or returns the result object with the given name as newline
+// delimited JSON.
+func (a adminAPIHandlers) HealResultsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealResults")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Drop the node index of tokens of distributed setups, the
+	// result objects are readable from all nodes.
+	token := strings.SplitN(r.Form.Get("token"), "@", 2)[0]
+	if _, err := uuid.Parse(token); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealInvalidClientToken), r.URL)
+		return
+	}
+
+	name := r.Form.Get("name")
+	if name == "" {
+		objects, err := getHealResults(ctx, objectAPI, token)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		jsonBytes, err := json.Marshal(objects)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		writeSuccessResponseJSON(w, jsonBytes)
+		return
+	}
+
+	if strings.Contains(name, SlashSeparator) || !strings.HasSuffix(name, ".ndjson") {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+	data, err := readConfig(ctx, objectAPI, pathJoin(healResultsPath(token), name))
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNoSuchKey), r.URL)
+			return
+		}
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeResponse(w, http.StatusOK, data, "application/x-ndjson")
+}
+
+// getAggregatedBackgroundHealState returns the heal state of disks.
 // If no ObjectLayer is provided no set status is returned.
 func getAggregatedBackgroundHealState(ctx context.Context, o ObjectLayer) (madmin.BgHealState, error) {
 	// Get local heal status first
@@ -1153,6 +1583,223 @@ func (a adminAPIHandlers) BackgroundHealStatusHandler(w http.ResponseWriter, r *
 	}
 }
 
+// HealStatusAllHandler - GET /minio/admin/v3/heal/status/all
+// ----------
+// Returns a summary of every client initiated heal sequence currently
+// running across all nodes, the returned client tokens can be used
+// with HealHandler to fetch the detailed status of a sequence.
+func (a adminAPIHandlers) HealStatusAllHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealStatusAll")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetHealSequences(ctx, false))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// HealSequencesHandler - GET /minio/admin/v3/heal/sequences
+// ----------
+// Returns a summary of every client initiated heal sequence registered
+// across all nodes, including ended sequences not cleaned up yet, to
+// find stuck sequences.
+func (a adminAPIHandlers) HealSequencesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealSequences")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetHealSequences(ctx, true))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// StopHealSequenceHandler - DELETE /minio/admin/v3/heal/sequences?token={token}
+// ----------
+// Stops and removes the heal sequence with the client token on whichever
+// node runs it, unlike stopping a heal by path it does not need to know
+// the path the sequence was started on.
+func (a adminAPIHandlers) StopHealSequenceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "StopHealSequence")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	token := r.Form.Get("token")
+	if token == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest, errors.New("token is required")), r.URL)
+		return
+	}
+
+	if !globalNotificationSys.StopHealSequence(ctx, token) {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNoSuchProcess), r.URL)
+		return
+	}
+
+	writeSuccessNoContent(w)
+}
+
+// quarantineTarget returns the object version addressed by the bucket,
+// object and versionId query parameters of a quarantine request.
+func quarantineTarget(ctx context.Context, objectAPI ObjectLayer, r *http.Request) (bucket, object, versionID string, err error) {
+	bucket = r.Form.Get("bucket")
+	object = r.Form.Get("object")
+	versionID = r.Form.Get("versionId")
+	if versionID == nullVersionID {
+		versionID = ""
+	}
+	if object == "" {
+		return bucket, object, versionID, ObjectNameInvalid{Bucket: bucket, Object: object}
+	}
+	if _, err = objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		return bucket, object, versionID, err
+	}
+	return bucket, object, versionID, nil
+}
+
+// QuarantineHandler - POST /minio/admin/v3/quarantine?bucket=&object=&versionId=
+// ----------
+// Quarantines an object version, or all the versions of the object when
+// no versionId is given: healing, dangling object removal and lifecycle
+// leave it untouched until it is released, reads keep working. The body
+// holds the mandatory reason of the quarantine.
+func (a adminAPIHandlers) QuarantineHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "Quarantine")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, cred := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	bucket, object, versionID, err := quarantineTarget(ctx, objectAPI, r)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	var req QuarantineRequest
+	if err = json.NewDecoder(io.LimitReader(r.Body, maxEConfigJSONSize)).Decode(&req); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+
+	entry := QuarantineEntry{
+		Bucket:        bucket,
+		Object:        object,
+		VersionID:     versionID,
+		Reason:        req.Reason,
+		QuarantinedBy: cred.AccessKey,
+		QuarantinedAt: UTCNow(),
+	}
+	if err = globalQuarantineSys.Add(ctx, objectAPI, entry); err != nil {
+		switch err {
+		case errQuarantineReasonRequired, errQuarantineFull:
+			err = AdminError{
+				Code:       "XMinioAdminInvalidRequest",
+				Message:    err.Error(),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	jsonBytes, err := json.Marshal(entry)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ReleaseQuarantineHandler - POST /minio/admin/v3/quarantine/release?bucket=&object=&versionId=&heal=true
+// ----------
+// Releases an object version from quarantine, returning the released
+// entry. With heal=true the object is queued for healing right away.
+func (a adminAPIHandlers) ReleaseQuarantineHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ReleaseQuarantine")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	bucket, object, versionID, err := quarantineTarget(ctx, objectAPI, r)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	released, err := globalQuarantineSys.Remove(ctx, objectAPI, bucket, object, versionID)
+	if err != nil {
+		if err == errQuarantineNotFound {
+			err = AdminError{
+				Code:       "XMinioAdminNoSuchQuarantine",
+				Message:    err.Error(),
+				StatusCode: http.StatusNotFound,
+			}
+		}
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	if r.Form.Get("heal") == "true" {
+		healObject(bucket, object, versionID, madmin.HealNormalScan)
+	}
+
+	jsonBytes, err := json.Marshal(released)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ListQuarantineHandler - GET /minio/admin/v3/quarantine
+// ----------
+// Returns the quarantined object versions, who quarantined them, when
+// and why, along with the background operations skipped by this node.
+func (a adminAPIHandlers) ListQuarantineHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListQuarantine")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalQuarantineSys.Status())
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
 // NetperfHandler - perform mesh style network throughput test
 func (a adminAPIHandlers) NetperfHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "NetperfHandler")
@@ -1344,6 +1991,35 @@ func deleteObjectPerfBucket(objectAPI ObjectLayer) {
 	})
 }
 
+// S3SelfTestHandler - POST /minio/admin/v3/selftest/s3
+// ----------
+// Runs a battery of S3 API conformance checks against the local cluster
+// in a temporary bucket and streams the result of each check.
+func (a adminAPIHandlers) S3SelfTestHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "S3SelfTest")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealthInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	resultsCh, err := runS3SelfTests(ctx)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	enc := json.NewEncoder(w)
+	for result := range resultsCh {
+		if err := enc.Encode(result); err != nil {
+			return
+		}
+		w.(http.Flusher).Flush()
+	}
+}
+
 func validateObjPerfOptions(ctx context.Context, storageInfo madmin.StorageInfo, concurrent int, size int, autotune bool) (bool, bool, string) {
 	capacityNeeded := uint64(concurrent * size)
 	capacity := GetTotalUsableCapacityFree(storageInfo.Disks, storageInfo)
@@ -1712,7 +2388,16 @@ func (a adminAPIHandlers) KMSStatusHandler(w http.ResponseWriter, r *http.Reques
 	writeSuccessResponseJSON(w, resp)
 }
 
-// KMSKeyStatusHandler - GET /minio/admin/v3/kms/key/status?key-id=<master-key-id>
+// Object name used in the encryption context of a key status test for a
+// bucket, when none is requested.
+const kmsKeyStatusObject = "minio-kms-key-status"
+
+// KMSKeyStatusHandler - GET /minio/admin/v3/kms/key/status?key-id=<master-key-id>&bucket=<bucket>&object=<object>
+// ----------
+// Tests that the key can generate and decrypt a data key. With a bucket,
+// the test uses the key of the SSE-KMS configuration of the bucket, unless
+// key-id is set, and the encryption context of the objects of the bucket,
+// to catch KMS policies restricting the key by encryption context.
 func (a adminAPIHandlers) KMSKeyStatusHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "KMSKeyStatus")
 
@@ -1735,6 +2420,27 @@ func (a adminAPIHandlers) KMSKeyStatusHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	keyID := r.Form.Get("key-id")
+	kmsContext := kms.Context{"MinIO admin API": "KMSKeyStatusHandler"} // Context for a test key operation
+	if bucket := r.Form.Get("bucket"); bucket != "" {
+		if _, err = objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		sseConfig, _, err := globalBucketMetadataSys.GetSSEConfig(bucket)
+		if err != nil && !errors.Is(err, BucketSSEConfigNotFound{Bucket: bucket}) {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		if keyID == "" && sseConfig != nil {
+			keyID = sseConfig.KeyID()
+		}
+		object := r.Form.Get("object")
+		if object == "" {
+			object = kmsKeyStatusObject
+		}
+		// Same context as the data keys of the objects of the bucket.
+		kmsContext = kms.Context{bucket: path.Join(bucket, object)}
+	}
 	if keyID == "" {
 		keyID = stat.DefaultKey
 	}
@@ -1742,7 +2448,6 @@ func (a adminAPIHandlers) KMSKeyStatusHandler(w http.ResponseWriter, r *http.Req
 		KeyID: keyID,
 	}
 
-	kmsContext := kms.Context{"MinIO admin API": "KMSKeyStatusHandler"} // Context for a test key operation
 	// 1. Generate a new key using the KMS.
 	key, err := GlobalKMS.GenerateKey(ctx, keyID, kmsContext)
 	if err != nil {
@@ -1806,9 +2511,7 @@ func getPoolsInfo(ctx context.Context, allDisks []madmin.Disk) (map[int]map[int]
 		erasureSet, ok := poolInfo[d.SetIndex]
 		if !ok {
 			erasureSet.ID = d.SetIndex
-			cache := dataUsageCache{}
-			if err := cache.load(ctx, z.serverPools[d.PoolIndex].sets[d.SetIndex], dataUsageCacheName); err == nil {
-				dataUsageInfo := cache.dui(dataUsageRoot, nil)
+			if dataUsageInfo, err := loadErasureSetUsage(ctx, z.serverPools[d.PoolIndex].sets[d.SetIndex]); err == nil {
 				erasureSet.ObjectsCount = dataUsageInfo.ObjectsTotalCount
 				erasureSet.VersionsCount = dataUsageInfo.VersionsTotalCount
 				erasureSet.Usage = dataUsageInfo.ObjectsTotalSize
@@ -1868,8 +2571,10 @@ func getServerInfo(ctx context.Context, poolsInfoEnabled bool, r *http.Request)
 	if objectAPI != nil {
 		mode = madmin.ItemOnline
 
-		// Load data usage
-		dataUsageInfo, err := loadDataUsageFromBackend(ctx, objectAPI)
+		// Load data usage, the health diagnostics share the deadline
+		// aware assembly of cluster info with the inspect data.
+		info := assembleClusterInfo(ctx, objectAPI, false)
+		dataUsageInfo, err := info.usage, info.usageErr
 		if err == nil {
 			buckets = madmin.Buckets{Count: dataUsageInfo.BucketsCount}
 			objects = madmin.Objects{Count: dataUsageInfo.ObjectsTotalCount}
@@ -2548,6 +3253,13 @@ func embedFileInZip(zipWriter *zip.Writer, name string, data []byte) error {
 // returns it.
 // This is not a critical function, and it is allowed
 // to fail with a ten seconds timeout, returning nil.
+// clusterMetaInfo is the cluster registration info shipped with the
+// inspect data, along with where its storage and usage fields came from.
+type clusterMetaInfo struct {
+	madmin.ClusterRegistrationInfo
+	Sources map[string]clusterInfoSource `json:"sources,omitempty"`
+}
+
 func getClusterMetaInfo(ctx context.Context) []byte {
 	objectAPI := newObjectLayerFn()
 	if objectAPI == nil {
@@ -2555,55 +3267,41 @@ func getClusterMetaInfo(ctx context.Context) []byte {
 	}
 
 	// Add a ten seconds timeout because getting profiling data
-	// is critical for debugging, in contrary to getting cluster info
+	// is critical for debugging, in contrary to getting cluster info,
+	// the cluster info is assembled from cached sources so that it is
+	// available even on clusters too large to collect it in time.
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	resultCh := make(chan madmin.ClusterRegistrationInfo)
+	info := assembleClusterInfo(ctx, objectAPI, true)
 
-	go func() {
-		defer close(resultCh)
-
-		ci := madmin.ClusterRegistrationInfo{}
-		ci.Info.NoOfServerPools = len(globalEndpoints)
-		ci.Info.NoOfServers = len(globalEndpoints.Hostnames())
-		ci.Info.MinioVersion = Version
+	ci := clusterMetaInfo{Sources: info.sources}
+	ci.Info.NoOfServerPools = len(globalEndpoints)
+	ci.Info.NoOfServers = len(globalEndpoints.Hostnames())
+	ci.Info.MinioVersion = Version
 
-		si := objectAPI.StorageInfo(ctx)
-
-		ci.Info.NoOfDrives = len(si.Disks)
-		for _, disk := range si.Disks {
+	ci.Info.NoOfDrives = globalEndpoints.NEndpoints()
+	if info.storageOK {
+		ci.Info.NoOfDrives = len(info.storage.Disks)
+		for _, disk := range info.storage.Disks {
 			ci.Info.TotalDriveSpace += disk.TotalSpace
 			ci.Info.UsedDriveSpace += disk.UsedSpace
 		}
+	}
 
-		dataUsageInfo, _ := loadDataUsageFromBackend(ctx, objectAPI)
-
-		ci.UsedCapacity = dataUsageInfo.ObjectsTotalSize
-		ci.Info.NoOfBuckets = dataUsageInfo.BucketsCount
-		ci.Info.NoOfObjects = dataUsageInfo.ObjectsTotalCount
-
-		ci.DeploymentID = globalDeploymentID
-		ci.ClusterName = fmt.Sprintf("%d-servers-%d-disks-%s", ci.Info.NoOfServers, ci.Info.NoOfDrives, ci.Info.MinioVersion)
+	ci.UsedCapacity = info.usage.ObjectsTotalSize
+	ci.Info.NoOfBuckets = info.usage.BucketsCount
+	ci.Info.NoOfObjects = info.usage.ObjectsTotalCount
 
-		select {
-		case resultCh <- ci:
-		case <-ctx.Done():
-			return
-		}
-	}()
+	ci.DeploymentID = globalDeploymentID
+	ci.ClusterName = fmt.Sprintf("%d-servers-%d-disks-%s", ci.Info.NoOfServers, ci.Info.NoOfDrives, ci.Info.MinioVersion)
 
-	select {
-	case <-ctx.Done():
+	out, err := json.MarshalIndent(ci, "", "  ")
+	if err != nil {
+		logger.LogIf(ctx, err)
 		return nil
-	case ci := <-resultCh:
-		out, err := json.MarshalIndent(ci, "", "  ")
-		if err != nil {
-			logger.LogIf(ctx, err)
-			return nil
-		}
-		return out
 	}
+	return out
 }
 
 func bytesToPublicKey(pub []byte) (*rsa.PublicKey, error) {
@@ -2667,6 +3365,17 @@ func (a adminAPIHandlers) InspectDataHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// Raw data of buckets with object lock enabled may only be
+	// inspected when explicitly requested, such requests are audited.
+	if rcfg, _ := globalBucketObjectLockSys.Get(volume); rcfg.LockEnabled {
+		if r.Form.Get("object-lock-override") != "true" {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAccessDenied,
+				fmt.Errorf("bucket '%s' has object lock enabled, 'object-lock-override' is required to inspect it", volume)), r.URL)
+			return
+		}
+		logger.GetReqInfo(ctx).SetTags("objectLockOverride", volume)
+	}
+
 	var publicKey *rsa.PublicKey
 
 	publicKeyB64 := r.Form.Get("public-key")
@@ -2826,6 +3535,645 @@ func (a adminAPIHandlers) InspectDataHandler(w http.ResponseWriter, r *http.Requ
 	logger.LogIf(ctx, embedFileInZip(inspectZipW, "inspect-input.txt", sb.Bytes()))
 }
 
+// ListClusterJobsHandler - GET /minio/admin/v3/jobs?history={bool}
+// ----------
+// Lists long-running cluster activities such as decommission, rebalance,
+// site replication resync, batch jobs and heal sequences in a common
+// format. Recently finished jobs are included when history is true.
+func (a adminAPIHandlers) ListClusterJobsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListClusterJobs")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	history := r.Form.Get("history") == "true"
+	jobs := globalNotificationSys.GetClusterJobs(ctx, history)
+
+	jsonBytes, err := json.Marshal(jobs)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// MRFQueueHandler - GET /minio/admin/v3/mrf
+// ----------
+// Lists the objects written while drives were offline which are
+// queued on every node to be healed once those drives reconnect.
+func (a adminAPIHandlers) MRFQueueHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "MRFQueue")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	entries := globalNotificationSys.GetMRFEntries(ctx)
+
+	jsonBytes, err := json.Marshal(entries)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// RequestTimingsHandler - GET /minio/admin/v3/request-timings?count=N
+// ----------
+// Returns, for the recent sampled S3 requests of every node, most recent
+// first, the time spent authenticating, acquiring namespace locks,
+// reading metadata and transferring data. One request in 100 is sampled.
+func (a adminAPIHandlers) RequestTimingsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RequestTimings")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.TraceAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	count := 100
+	if s := r.Form.Get("count"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+			return
+		}
+		count = n
+	}
+
+	timings := globalNotificationSys.GetRequestTimings(ctx)
+	if len(timings) > count {
+		timings = timings[:count]
+	}
+
+	jsonBytes, err := json.Marshal(timings)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ClockHandler - GET /minio/admin/v3/clock
+// ----------
+// Returns the current time of every node and its skew relative to the
+// node serving the request, to check for clock drift at runtime.
+func (a adminAPIHandlers) ClockHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "Clock")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetClocks(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// NodeDrainHandler - POST /minio/admin/v3/node/drain?node={node}&cancel={bool}
+// ----------
+// Drains the given node for maintenance, the node rejects new S3 requests
+// and fails its readiness check while the requests in flight complete, the
+// other nodes keep serving. With cancel=true the node serves requests again.
+func (a adminAPIHandlers) NodeDrainHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "NodeDrain")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServiceFreezeAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	node := r.Form.Get("node")
+	drain := r.Form.Get("cancel") != "true"
+	status, err := globalNotificationSys.DrainNode(ctx, node, drain)
+	if err != nil {
+		if errors.Is(err, errNodeNotFound) {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	jsonBytes, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// GuardrailStatusHandler - GET /minio/admin/v3/guardrail/status
+// ----------
+// Returns the load shedding state of every node, along with the
+// resources used at the last sample.
+func (a adminAPIHandlers) GuardrailStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GuardrailStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetGuardrailStatus(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ServerFeaturesHandler - GET /minio/admin/v3/features
+// ----------
+// Returns for every node the features enabled, the deprecated features
+// still in use and the warnings logged at startup.
+func (a adminAPIHandlers) ServerFeaturesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ServerFeatures")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetServerFeatures(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// BootstrapTraceHandler - GET /minio/admin/v3/bootstrap-trace?clear={bool}
+// ----------
+// Returns the startup events recorded by every node, with the time spent
+// until the next event of the node. With clear=true the recorded events
+// are dropped once returned.
+func (a adminAPIHandlers) BootstrapTraceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "BootstrapTrace")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.TraceAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	clear := r.Form.Get("clear") == "true"
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetBootstrapTrace(ctx, clear))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ScannerStatusHandler - GET /minio/admin/v3/scanner/status
+// ----------
+// Returns the scanner drive concurrency and the scanner state
+// (scanning, backed-off or idle) of the drives of every node.
+func (a adminAPIHandlers) ScannerStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ScannerStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetScannerStatus(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ExpiryStatusHandler - GET /minio/admin/v3/ilm/expiry/status
+// ----------
+// Returns, for every node, the number of ILM expiry workers, the pending
+// expiry tasks per bucket, the configured limits and whether the scanner
+// is currently throttled from enqueuing more expiry work.
+func (a adminAPIHandlers) ExpiryStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ExpiryStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jsonBytes, err := json.Marshal(globalNotificationSys.GetExpiryStatus(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// FormatCheckHandler - GET /minio/admin/v3/format-check
+// ----------
+// Returns, for the local drives of every node, whether the format.json
+// found on the drive matches the position the drive is mounted into,
+// with the sets operating with misplaced drives.
+func (a adminAPIHandlers) FormatCheckHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "FormatCheck")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+	if _, ok := objectAPI.(*erasureServerPools); !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	info := newFormatCheckInfo(globalDeploymentID, globalNotificationSys.FormatCheck(ctx, objectAPI))
+	jsonBytes, err := json.Marshal(info)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// rawVersionLister provides an interface for listing all versions of
+// an object directly from xl.meta.
+type rawVersionLister interface {
+	ListObjectVersionsRaw(ctx context.Context, bucket, object string) ([]FileInfo, error)
+}
+
+// ObjectVersionDebugInfo - a single version of an object as stored in xl.meta.
+type ObjectVersionDebugInfo struct {
+	VersionID    string    `json:"versionId"`
+	Size         int64     `json:"size"`
+	ModTime      time.Time `json:"modTime"`
+	DeleteMarker bool      `json:"deleteMarker,omitempty"`
+	FreeVersion  bool      `json:"freeVersion,omitempty"`
+	IsLatest     bool      `json:"isLatest,omitempty"`
+	DataDir      string    `json:"dataDir,omitempty"`
+}
+
+// ObjectVersionsDebugInfo - a page of versions of a single object.
+type ObjectVersionsDebugInfo struct {
+	Bucket        string                   `json:"bucket"`
+	Object        string                   `json:"object"`
+	TotalVersions int                      `json:"totalVersions"`
+	Versions      []ObjectVersionDebugInfo `json:"versions"`
+	IsTruncated   bool                     `json:"isTruncated"`
+	NextMarker    string                   `json:"nextMarker,omitempty"`
+}
+
+// ObjectVersionsDebugHandler - GET /minio/admin/v3/debug/object-versions?bucket={bucket}&object={object}&marker={versionId}&max-keys={n}
+// ----------
+// Lists all versions of a single object, including free versions, newest
+// first. Versions are read directly from xl.meta and paginated using the
+// version ID of the last returned entry as marker.
+func (a adminAPIHandlers) ObjectVersionsDebugHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ObjectVersionsDebug")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.InspectDataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	o, ok := objectAPI.(rawVersionLister)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	bucket := r.Form.Get("bucket")
+	object := r.Form.Get("object")
+	marker := r.Form.Get("marker")
+
+	maxKeys := maxObjectList
+	if s := r.Form.Get("max-keys"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidMaxKeys), r.URL)
+			return
+		}
+		if n < maxKeys {
+			maxKeys = n
+		}
+	}
+
+	fivs, err := o.ListObjectVersionsRaw(ctx, bucket, object)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	start := 0
+	if marker != "" {
+		start = -1
+		for i := range fivs {
+			if fivs[i].VersionID == marker || (marker == nullVersionID && fivs[i].VersionID == "") {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidVersionID), r.URL)
+			return
+		}
+	}
+
+	result := ObjectVersionsDebugInfo{
+		Bucket:        bucket,
+		Object:        object,
+		TotalVersions: len(fivs),
+		Versions:      []ObjectVersionDebugInfo{},
+	}
+	for i := start; i < len(fivs); i++ {
+		if len(result.Versions) == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		fi := fivs[i]
+		versionID := fi.VersionID
+		if versionID == "" {
+			versionID = nullVersionID
+		}
+		result.Versions = append(result.Versions, ObjectVersionDebugInfo{
+			VersionID:    versionID,
+			Size:         fi.Size,
+			ModTime:      fi.ModTime,
+			DeleteMarker: fi.Deleted,
+			FreeVersion:  fi.TierFreeVersion(),
+			IsLatest:     fi.IsLatest,
+			DataDir:      fi.DataDir,
+		})
+		result.NextMarker = versionID
+	}
+	if !result.IsTruncated {
+		result.NextMarker = ""
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ExpireNoncurrentVersionsResult - the outcome of expiring the noncurrent
+// versions of an object.
+type ExpireNoncurrentVersionsResult struct {
+	Bucket  string `json:"bucket"`
+	Object  string `json:"object"`
+	Expired int    `json:"expired"`
+	// Skipped counts the versions kept because they are locked,
+	// quarantined or could not be deleted.
+	Skipped int `json:"skipped"`
+}
+
+// ExpireNoncurrentVersionsHandler - POST /minio/admin/v3/object/expire-noncurrent?bucket={bucket}&object={object}
+// ----------
+// Deletes all the noncurrent versions of a single object and keeps its
+// current version, to trim the history of an object without a bucket
+// wide lifecycle rule.
+func (a adminAPIHandlers) ExpireNoncurrentVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ExpireNoncurrentVersions")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	o, ok := objectAPI.(rawVersionLister)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	bucket := r.Form.Get("bucket")
+	object := r.Form.Get("object")
+	fivs, err := o.ListObjectVersionsRaw(ctx, bucket, object)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	res := ExpireNoncurrentVersionsResult{
+		Bucket: bucket,
+		Object: object,
+	}
+	res.Expired, res.Skipped = expireNoncurrentVersions(ctx, objectAPI, bucket, object, fivs)
+
+	jsonBytes, err := json.Marshal(res)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ObjectMetadataScrubInfo - how the metadata of an object version agrees
+// across the drives of its erasure set.
+type ObjectMetadataScrubInfo struct {
+	Bucket       string `json:"bucket"`
+	Object       string `json:"object"`
+	DeleteMarker bool   `json:"deleteMarker,omitempty"`
+	*ObjectMetadataScrub
+}
+
+// ObjectMetadataScrubHandler - GET /minio/admin/v3/debug/object-metadata?bucket=mybucket&object=myobject&versionId=
+// ----------
+// Reports which drives agree on the metadata of an object version, and
+// which have stale or divergent metadata, without inspecting xl.meta on
+// each drive.
+func (a adminAPIHandlers) ObjectMetadataScrubHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ObjectMetadataScrub")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.InspectDataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	bucket := r.Form.Get("bucket")
+	object := r.Form.Get("object")
+	if bucket == "" || object == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	objInfo, err := objectAPI.GetObjectInfo(ctx, bucket, object, ObjectOptions{
+		VersionID:     r.Form.Get("versionId"),
+		Versioned:     globalBucketVersioningSys.PrefixEnabled(bucket, object),
+		MetadataScrub: true,
+	})
+	// Delete markers are reported along with an error.
+	if err != nil && objInfo.MetadataScrub == nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if objInfo.MetadataScrub == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	jsonBytes, err := json.Marshal(ObjectMetadataScrubInfo{
+		Bucket:              bucket,
+		Object:              object,
+		DeleteMarker:        objInfo.DeleteMarker,
+		ObjectMetadataScrub: objInfo.MetadataScrub,
+	})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ObjectMetadataDiffHandler - GET /minio/admin/v3/debug/object-metadata-diff?bucket=mybucket&object=myobject&versionId=
+// ----------
+// Compares the xl.meta of an object read from every drive of its erasure
+// set, and reports for each version the drives which miss it or disagree
+// on its metadata along with the fields they disagree on.
+func (a adminAPIHandlers) ObjectMetadataDiffHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ObjectMetadataDiff")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.InspectDataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	diff, err := z.ObjectMetadataDiff(ctx, r.Form.Get("bucket"), r.Form.Get("object"), r.Form.Get("versionId"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	jsonBytes, err := json.Marshal(diff)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// ObjectLayoutHandler - GET /minio/admin/v3/object-layout?bucket=mybucket&object=myobject&versionId=
+// ----------
+// Reports the data and parity blocks of an object version, its
+// distribution across the drives of its erasure set and which drives
+// hold valid shards, are offline or miss them.
+func (a adminAPIHandlers) ObjectLayoutHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ObjectLayout")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.InspectDataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	layout, err := z.ObjectLayout(ctx, r.Form.Get("bucket"), r.Form.Get("object"), r.Form.Get("versionId"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	jsonBytes, err := json.Marshal(layout)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// BitrotScanHandler - POST /minio/admin/v3/scan/bitrot?pool=0&set=0
+// ----------
+// Verifies the data of all the objects of an erasure set against their
+// bitrot checksums and reports the corrupt ones, without healing them.
+func (a adminAPIHandlers) BitrotScanHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "BitrotScan")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	poolIdx, err := strconv.Atoi(vars["pool"])
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errInvalidArgument), r.URL)
+		return
+	}
+	setIdx, err := strconv.Atoi(vars["set"])
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errInvalidArgument), r.URL)
+		return
+	}
+
+	report, err := z.BitrotScanSet(ctx, poolIdx, setIdx)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	jsonBytes, err := json.Marshal(report)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
 func getSubnetAdminPublicKey() []byte {
 	if globalIsCICD {
 		return subnetAdminPublicKeyDev
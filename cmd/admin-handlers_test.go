@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/kms"
 	"github.com/minio/mux"
 )
 
@@ -525,3 +527,219 @@ func TestTopLockEntries(t *testing.T) {
 		}
 	}
 }
+
+func TestObjectVersionsDebugHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	adminTestBed, err := prepareAdminErasureTestBed(ctx)
+	if err != nil {
+		t.Fatal("Failed to initialize a single node Erasure backend for admin handler tests.", err)
+	}
+	defer adminTestBed.TearDown()
+
+	bucket, object := "bucket", "object"
+	objLayer := adminTestBed.objLayer
+	if err = objLayer.MakeBucket(ctx, bucket, MakeBucketOptions{VersioningEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+	var versionIDs []string
+	for i := 0; i < 3; i++ {
+		data := []byte(fmt.Sprintf("version-%d", i))
+		oi, err := objLayer.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{Versioned: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Versions are listed newest first.
+		versionIDs = append([]string{oi.VersionID}, versionIDs...)
+	}
+
+	listVersions := func(marker, maxKeys string) (ObjectVersionsDebugInfo, int) {
+		queryVal := url.Values{}
+		queryVal.Set("bucket", bucket)
+		queryVal.Set("object", object)
+		if marker != "" {
+			queryVal.Set("marker", marker)
+		}
+		if maxKeys != "" {
+			queryVal.Set("max-keys", maxKeys)
+		}
+		req, err := buildAdminRequest(queryVal, http.MethodGet, "/debug/object-versions", 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec := httptest.NewRecorder()
+		adminTestBed.router.ServeHTTP(rec, req)
+
+		var result ObjectVersionsDebugInfo
+		if rec.Code == http.StatusOK {
+			if err = json.NewDecoder(rec.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return result, rec.Code
+	}
+
+	page, code := listVersions("", "2")
+	if code != http.StatusOK {
+		t.Fatalf("Expected to succeed but failed with %d", code)
+	}
+	if page.TotalVersions != 3 || len(page.Versions) != 2 || !page.IsTruncated || page.NextMarker != versionIDs[1] {
+		t.Fatalf("unexpected first page %+v", page)
+	}
+	for i, v := range page.Versions {
+		if v.VersionID != versionIDs[i] {
+			t.Errorf("expected version %s at %d, got %s", versionIDs[i], i, v.VersionID)
+		}
+	}
+	if !page.Versions[0].IsLatest {
+		t.Error("expected the first version to be the latest")
+	}
+
+	page, code = listVersions(page.NextMarker, "2")
+	if code != http.StatusOK {
+		t.Fatalf("Expected to succeed but failed with %d", code)
+	}
+	if len(page.Versions) != 1 || page.IsTruncated || page.NextMarker != "" || page.Versions[0].VersionID != versionIDs[2] {
+		t.Fatalf("unexpected last page %+v", page)
+	}
+
+	if _, code = listVersions("", "0"); code != http.StatusBadRequest {
+		t.Errorf("expected an invalid max-keys to fail with %d, got %d", http.StatusBadRequest, code)
+	}
+	if _, code = listVersions(mustGetUUID(), ""); code != http.StatusBadRequest {
+		t.Errorf("expected an unknown marker to fail with %d, got %d", http.StatusBadRequest, code)
+	}
+}
+
+func TestMetricsJSONHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	adminTestBed, err := prepareAdminErasureTestBed(ctx)
+	if err != nil {
+		t.Fatal("Failed to initialize a single node Erasure backend for admin handler tests.", err)
+	}
+	defer adminTestBed.TearDown()
+
+	want := Metric{
+		Description: MetricDescription{
+			Namespace: clusterMetricNamespace,
+			Subsystem: "test",
+			Name:      total,
+			Help:      "Test metric",
+			Type:      gaugeMetric,
+		},
+		VariableLabels: map[string]string{"label": "value"},
+		Value:          42,
+	}
+	mg := &MetricsGroup{}
+	mg.RegisterRead(func(_ context.Context) []Metric {
+		return []Metric{want}
+	})
+
+	defer func(c *minioClusterCollector) { clusterCollector = c }(clusterCollector)
+	clusterCollector = newMinioClusterCollector([]*MetricsGroup{mg})
+
+	req, err := buildAdminRequest(url.Values{}, http.MethodGet, "/metrics/json", 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	adminTestBed.router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected to succeed but failed with %d", rec.Code)
+	}
+
+	var metrics []Metric
+	if err = json.NewDecoder(rec.Body).Decode(&metrics); err != nil {
+		t.Fatal(err)
+	}
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+	got := metrics[0]
+	if got.Description != want.Description || got.Value != want.Value || got.VariableLabels["label"] != "value" {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+// bucketContextKMS is a KMS which only generates data keys for the
+// encryption context of the objects of a bucket.
+type bucketContextKMS struct {
+	kms.KMS
+	bucket string
+}
+
+func (k bucketContextKMS) GenerateKey(ctx context.Context, keyID string, kmsCtx kms.Context) (kms.DEK, error) {
+	if _, ok := kmsCtx[k.bucket]; !ok {
+		return kms.DEK{}, errors.New("access denied by the key policy")
+	}
+	return k.KMS.GenerateKey(ctx, keyID, kmsCtx)
+}
+
+func TestKMSKeyStatusHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	adminTestBed, err := prepareAdminErasureTestBed(ctx)
+	if err != nil {
+		t.Fatal("Failed to initialize a single node Erasure backend for admin handler tests.", err)
+	}
+	defer adminTestBed.TearDown()
+
+	bucket := "bucket"
+	if err = adminTestBed.objLayer.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	builtin, err := kms.Parse("my-minio-key:5lF+0pJM0OWwlQrvK2S/I7W9mO4a6rJJI7wzj7v09cw=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	GlobalKMS = bucketContextKMS{KMS: builtin, bucket: bucket}
+	defer func() { GlobalKMS = nil }()
+
+	keyStatus := func(bucket string) (madmin.KMSKeyStatus, int) {
+		queryVal := url.Values{}
+		if bucket != "" {
+			queryVal.Set("bucket", bucket)
+		}
+		req, err := buildAdminRequest(queryVal, http.MethodGet, "/kms/key/status", 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec := httptest.NewRecorder()
+		adminTestBed.router.ServeHTTP(rec, req)
+
+		var status madmin.KMSKeyStatus
+		if rec.Code == http.StatusOK {
+			if err = json.NewDecoder(rec.Body).Decode(&status); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return status, rec.Code
+	}
+
+	// The test context of the admin API is denied by the key policy.
+	status, code := keyStatus("")
+	if code != http.StatusOK {
+		t.Fatalf("Expected to succeed but failed with %d", code)
+	}
+	if status.KeyID != "my-minio-key" || status.EncryptionErr == "" {
+		t.Fatalf("expected the key test to fail to encrypt, got %+v", status)
+	}
+
+	// The context of the objects of the bucket is allowed.
+	status, code = keyStatus(bucket)
+	if code != http.StatusOK {
+		t.Fatalf("Expected to succeed but failed with %d", code)
+	}
+	if status.KeyID != "my-minio-key" || status.EncryptionErr != "" || status.DecryptionErr != "" {
+		t.Fatalf("expected the key test to succeed, got %+v", status)
+	}
+
+	if _, code = keyStatus("missing-bucket"); code != http.StatusNotFound {
+		t.Errorf("expected a missing bucket to fail with %d, got %d", http.StatusNotFound, code)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -84,6 +85,12 @@ type healSequenceStatus struct {
 
 	// slice of available heal result records
 	Items []madmin.HealResultItem `json:"Items"`
+
+	// prefix of the result objects in the meta bucket and number of
+	// results by outcome, set when results are written to objects
+	// instead of Items.
+	ResultsPrefix string           `json:"ResultsPrefix,omitempty"`
+	ResultCounts  map[string]int64 `json:"ResultCounts,omitempty"`
 }
 
 // structure to hold state of all heal sequences in server memory
@@ -199,13 +206,19 @@ func (ahs *allHealState) periodicHealSeqsClean(ctx context.Context) {
 	periodicTimer := time.NewTimer(time.Minute * 5)
 	defer periodicTimer.Stop()
 
+	var lastResultsCleanup time.Time
 	for {
 		select {
 		case <-periodicTimer.C:
 			now := UTCNow()
+			if objAPI := newObjectLayerFn(); objAPI != nil && now.Sub(lastResultsCleanup) >= healResultsCleanupInterval {
+				logger.LogIf(ctx, cleanupHealResults(ctx, objAPI))
+				lastResultsCleanup = now
+			}
 			ahs.Lock()
 			for path, h := range ahs.healSeqMap {
 				if h.hasEnded() && h.endTime.Add(keepHealSeqStateDuration).Before(now) {
+					go globalClusterJobsHistory.record(h.clusterJob())
 					delete(ahs.healSeqMap, path)
 				}
 			}
@@ -379,6 +392,10 @@ func (ahs *allHealState) PopHealStatusJSON(hpath string,
 
 	h.lastSentResultIndex = lastResultIndex
 
+	if h.results != nil {
+		h.currentStatus.ResultsPrefix, h.currentStatus.ResultCounts = h.results.status()
+	}
+
 	jbytes, err := json.Marshal(h.currentStatus)
 	if err != nil {
 		h.currentStatus.Items = nil
@@ -425,6 +442,10 @@ type healSequence struct {
 	// heal settings applied to this heal sequence
 	settings madmin.HealOpts
 
+	// writes the heal results to result objects when set, instead
+	// of reporting them in the heal status
+	results *healResultsWriter
+
 	// current accumulated status of the heal sequence
 	currentStatus healSequenceStatus
 
@@ -567,6 +588,98 @@ func (h *healSequence) hasEnded() bool {
 	return !h.endTime.IsZero()
 }
 
+// HealSequenceSummary - summary of a client initiated heal sequence
+// registered on a node, as reported by the heal status/all and heal
+// sequences admin APIs.
+type HealSequenceSummary struct {
+	ClientToken   string              `json:"clientToken"`
+	ClientAddress string              `json:"clientAddress"`
+	Path          string              `json:"path"`
+	Status        string              `json:"status"`
+	ScanMode      madmin.HealScanMode `json:"scanMode"`
+	Recursive     bool                `json:"recursive"`
+	DryRun        bool                `json:"dryRun"`
+	StartTime     time.Time           `json:"startTime"`
+	LastActivity  time.Time           `json:"lastActivity,omitempty"`
+	ItemsScanned  int64               `json:"itemsScanned"`
+	ItemsHealed   int64               `json:"itemsHealed"`
+	ItemsFailed   int64               `json:"itemsFailed"`
+	Ended         bool                `json:"ended"`
+	Node          string              `json:"node"`
+}
+
+// summary - returns the summary of the heal sequence, the client token
+// is suffixed with the node index in a distributed setup so that it can
+// be used as is with the heal status API.
+func (h *healSequence) summary() HealSequenceSummary {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	clientToken := h.clientToken
+	if globalIsDistErasure {
+		clientToken = fmt.Sprintf("%s@%d", h.clientToken, GetProxyEndpointLocalIndex(globalProxyEndpoints))
+	}
+	s := HealSequenceSummary{
+		ClientToken:   clientToken,
+		ClientAddress: h.clientAddress,
+		Path:          pathJoin(h.bucket, h.object),
+		Status:        string(h.currentStatus.Summary),
+		ScanMode:      h.settings.ScanMode,
+		Recursive:     h.settings.Recursive,
+		DryRun:        h.settings.DryRun,
+		StartTime:     h.startTime,
+		LastActivity:  h.lastHealActivity,
+		Node:          globalLocalNodeName,
+	}
+	for _, v := range h.scannedItemsMap {
+		s.ItemsScanned += v
+	}
+	for _, v := range h.healedItemsMap {
+		s.ItemsHealed += v
+	}
+	for _, v := range h.healFailedItemsMap {
+		s.ItemsFailed += v
+	}
+	s.Ended = h.hasEnded()
+	return s
+}
+
+// getHealSequences - returns the summary of all client initiated heal
+// sequences registered on this node, ended sequences are included if
+// inclEnded is set until they are cleaned up.
+func (ahs *allHealState) getHealSequences(inclEnded bool) []HealSequenceSummary {
+	ahs.RLock()
+	defer ahs.RUnlock()
+
+	seqs := make([]HealSequenceSummary, 0, len(ahs.healSeqMap))
+	for _, h := range ahs.healSeqMap {
+		if h.clientToken == bgHealingUUID || (!inclEnded && h.hasEnded()) {
+			continue
+		}
+		seqs = append(seqs, h.summary())
+	}
+	return seqs
+}
+
+// stopHealSequenceByToken - stops the client initiated heal sequence with
+// the client token and removes it, the node index suffix of tokens in a
+// distributed setup is ignored. It returns false if no such sequence is
+// registered on this node.
+func (ahs *allHealState) stopHealSequenceByToken(token string) bool {
+	if i := strings.LastIndex(token, "@"); i >= 0 {
+		token = token[:i]
+	}
+	if token == "" || token == bgHealingUUID {
+		return false
+	}
+	h, exists := ahs.getHealSequenceByToken(token)
+	if !exists {
+		return false
+	}
+	ahs.stopHealSequence(pathJoin(h.bucket, h.object))
+	return true
+}
+
 // stops the heal sequence - safe to call multiple times.
 func (h *healSequence) stop() {
 	h.cancelCtx()
@@ -772,6 +885,10 @@ func (h *healSequence) queueHealTask(source healSource, healType madmin.HealItem
 			}
 			res.result.Detail = res.err.Error()
 		}
+		if h.results != nil {
+			h.results.add(h.ctx, res.result)
+			return nil
+		}
 		return h.pushHealResultItem(res.result)
 	case <-h.ctx.Done():
 		return nil
@@ -801,7 +918,12 @@ func (h *healSequence) healItems(objAPI ObjectLayer, bucketsOnly bool) error {
 // two objects.
 func (h *healSequence) traverseAndHeal(objAPI ObjectLayer) {
 	bucketsOnly := false // Heals buckets and objects also.
-	h.traverseAndHealDoneCh <- h.healItems(objAPI, bucketsOnly)
+	err := h.healItems(objAPI, bucketsOnly)
+	if h.results != nil {
+		// Write the remaining results, also when the heal was stopped.
+		logger.LogIf(GlobalContext, h.results.close(GlobalContext))
+	}
+	h.traverseAndHealDoneCh <- err
 	close(h.traverseAndHealDoneCh)
 }
 
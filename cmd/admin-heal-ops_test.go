@@ -0,0 +1,95 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/minio/madmin-go/v2"
+)
+
+func TestHealSequencesByToken(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ahs := newHealState(ctx, false)
+	running := newHealSequence(ctx, "bucket", "running", "127.0.0.1", madmin.HealOpts{}, false)
+	ended := newHealSequence(ctx, "bucket", "ended", "127.0.0.1", madmin.HealOpts{}, false)
+	ended.endTime = UTCNow()
+	ahs.healSeqMap[pathJoin(running.bucket, running.object)] = running
+	ahs.healSeqMap[pathJoin(ended.bucket, ended.object)] = ended
+
+	if seqs := ahs.getHealSequences(false); len(seqs) != 1 || seqs[0].Ended {
+		t.Fatalf("expected the running sequence only, got %+v", seqs)
+	}
+	seqs := ahs.getHealSequences(true)
+	if len(seqs) != 2 {
+		t.Fatalf("expected 2 sequences, got %+v", seqs)
+	}
+	for _, s := range seqs {
+		if s.Ended != (s.Path == "bucket/ended") {
+			t.Fatalf("unexpected ended state of %+v", s)
+		}
+	}
+
+	if ahs.stopHealSequenceByToken("unknown") || ahs.stopHealSequenceByToken(bgHealingUUID) {
+		t.Fatal("stopped an unknown heal sequence")
+	}
+	// Tokens reported in a distributed setup carry the node index.
+	if !ahs.stopHealSequenceByToken(ended.clientToken + "@1") {
+		t.Fatal("expected the heal sequence to be stopped")
+	}
+	if _, exists := ahs.getHealSequenceByToken(ended.clientToken); exists {
+		t.Fatal("stopped heal sequence was not removed")
+	}
+}
+
+func TestActiveHealSequences(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ahs := newHealState(ctx, false)
+	running := newHealSequence(ctx, "bucket", "running", "127.0.0.1", madmin.HealOpts{Recursive: true, DryRun: true, ScanMode: madmin.HealDeepScan}, false)
+	running.currentStatus.Summary = healRunningStatus
+	running.scannedItemsMap[madmin.HealItemObject] = 3
+	running.scannedItemsMap[madmin.HealItemBucket] = 1
+	running.healedItemsMap[madmin.HealItemObject] = 2
+	running.healFailedItemsMap["drive-offline"] = 1
+	ended := newHealSequence(ctx, "bucket", "ended", "127.0.0.1", madmin.HealOpts{}, false)
+	ended.endTime = UTCNow()
+	background := newBgHealSequence()
+	ahs.healSeqMap[pathJoin(running.bucket, running.object)] = running
+	ahs.healSeqMap[pathJoin(ended.bucket, ended.object)] = ended
+	ahs.healSeqMap[SlashSeparator] = background
+
+	seqs := ahs.getActiveHealSequences()
+	if len(seqs) != 1 {
+		t.Fatalf("expected the running sequence only, got %+v", seqs)
+	}
+	s := seqs[0]
+	if s.ClientToken != running.clientToken || s.ClientAddress != "127.0.0.1" || s.Path != "bucket/running" || s.Status != string(healRunningStatus) {
+		t.Fatalf("unexpected summary %+v", s)
+	}
+	if !s.Recursive || !s.DryRun || s.ScanMode != madmin.HealDeepScan || !s.StartTime.Equal(running.startTime) {
+		t.Fatalf("unexpected heal settings in %+v", s)
+	}
+	if s.ItemsScanned != 4 || s.ItemsHealed != 2 || s.ItemsFailed != 1 {
+		t.Fatalf("expected 4 items scanned, 2 healed and 1 failed, got %+v", s)
+	}
+}
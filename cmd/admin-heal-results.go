@@ -0,0 +1,256 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio/internal/logger"
+)
+
+const (
+	// Result objects of a heal sequence are stored under
+	// heal-results/<token>/ in the meta bucket.
+	healResultsPrefix = "heal-results"
+
+	// Maximum number of results in a result object.
+	healResultsBatchSize = 10000
+
+	// Interval at which partial batches are written.
+	healResultsFlushInterval = time.Minute
+
+	// Result objects are removed once the heal sequence didn't
+	// write any for this long.
+	healResultsRetention = 7 * 24 * time.Hour
+
+	// Interval at which result objects past their retention are removed.
+	healResultsCleanupInterval = time.Hour
+)
+
+// Outcomes of healing an item.
+const (
+	healOutcomeOK     = "ok"
+	healOutcomeHealed = "healed"
+	healOutcomeFailed = "failed"
+)
+
+// HealResultRecord is the outcome of healing an item, the result
+// objects of a heal sequence hold one JSON record per line.
+type HealResultRecord struct {
+	Type      madmin.HealItemType    `json:"type"`
+	Bucket    string                 `json:"bucket"`
+	Object    string                 `json:"object,omitempty"`
+	VersionID string                 `json:"versionId,omitempty"`
+	Before    []madmin.HealDriveInfo `json:"before,omitempty"`
+	After     []madmin.HealDriveInfo `json:"after,omitempty"`
+	Outcome   string                 `json:"outcome"`
+	Detail    string                 `json:"detail,omitempty"`
+}
+
+// HealResultObject is a result object of a heal sequence.
+type HealResultObject struct {
+	Name    string    `json:"name"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+}
+
+func newHealResultRecord(r madmin.HealResultItem) HealResultRecord {
+	rec := HealResultRecord{
+		Type:      r.Type,
+		Bucket:    r.Bucket,
+		Object:    r.Object,
+		VersionID: r.VersionID,
+		Before:    r.Before.Drives,
+		After:     r.After.Drives,
+		Outcome:   healOutcomeOK,
+		Detail:    r.Detail,
+	}
+	if r.Detail != "" {
+		rec.Outcome = healOutcomeFailed
+		return rec
+	}
+	for i, d := range r.After.Drives {
+		if i < len(r.Before.Drives) && r.Before.Drives[i].State != d.State && d.State == madmin.DriveStateOk {
+			rec.Outcome = healOutcomeHealed
+			break
+		}
+	}
+	return rec
+}
+
+// healResultsPath returns the prefix of the result objects of the
+// heal sequence with the given token.
+func healResultsPath(token string) string {
+	return pathJoin(healResultsPrefix, token) + SlashSeparator
+}
+
+// healResultsWriter writes the results of a heal sequence as newline
+// delimited JSON to result objects in the meta bucket, so that they
+// outlive the connection of the client which started the heal.
+type healResultsWriter struct {
+	objAPI ObjectLayer
+	prefix string
+
+	mu sync.Mutex
+	// Results not written yet.
+	buf bytes.Buffer
+	n   int
+	// Number of result objects written.
+	objects   int
+	lastFlush time.Time
+	counts    map[string]int64
+}
+
+func newHealResultsWriter(objAPI ObjectLayer, token string) *healResultsWriter {
+	return &healResultsWriter{
+		objAPI:    objAPI,
+		prefix:    healResultsPath(token),
+		lastFlush: UTCNow(),
+		counts:    make(map[string]int64),
+	}
+}
+
+// add records the result of healing an item.
+func (w *healResultsWriter) add(ctx context.Context, r madmin.HealResultItem) {
+	rec := newHealResultRecord(r)
+	data, err := json.Marshal(rec)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.counts[rec.Outcome]++
+	w.buf.Write(data)
+	w.buf.WriteByte('\n')
+	w.n++
+	if w.n >= healResultsBatchSize || UTCNow().Sub(w.lastFlush) >= healResultsFlushInterval {
+		// Results are kept to be written with the next batch on
+		// errors, the heal goes on.
+		logger.LogIf(ctx, w.flush(ctx))
+	}
+}
+
+// close writes the remaining results.
+func (w *healResultsWriter) close(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.flush(ctx)
+}
+
+func (w *healResultsWriter) flush(ctx context.Context) error {
+	w.lastFlush = UTCNow()
+	if w.n == 0 {
+		return nil
+	}
+	name := pathJoin(w.prefix, fmt.Sprintf("%08d.ndjson", w.objects))
+	if err := saveConfig(ctx, w.objAPI, name, w.buf.Bytes()); err != nil {
+		return err
+	}
+	w.objects++
+	w.buf.Reset()
+	w.n = 0
+	return nil
+}
+
+// status returns the prefix of the result objects in the meta bucket
+// and the number of results by outcome.
+func (w *healResultsWriter) status() (string, map[string]int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	counts := make(map[string]int64, len(w.counts))
+	for k, v := range w.counts {
+		counts[k] = v
+	}
+	return pathJoin(minioMetaBucket, w.prefix), counts
+}
+
+// listHealResults calls fn with the result objects under the prefix.
+func listHealResults(ctx context.Context, objAPI ObjectLayer, prefix string, fn func(ObjectInfo) error) error {
+	marker := ""
+	for {
+		res, err := objAPI.ListObjects(ctx, minioMetaBucket, prefix, marker, "", maxObjectList)
+		if err != nil {
+			return err
+		}
+		for _, obj := range res.Objects {
+			if err = fn(obj); err != nil {
+				return err
+			}
+		}
+		if !res.IsTruncated {
+			return nil
+		}
+		marker = res.NextMarker
+	}
+}
+
+// getHealResults returns the result objects of the heal sequence with
+// the given token.
+func getHealResults(ctx context.Context, objAPI ObjectLayer, token string) ([]HealResultObject, error) {
+	prefix := healResultsPath(token)
+	objects := []HealResultObject{}
+	err := listHealResults(ctx, objAPI, prefix, func(obj ObjectInfo) error {
+		objects = append(objects, HealResultObject{
+			Name:    strings.TrimPrefix(obj.Name, prefix),
+			Size:    obj.Size,
+			ModTime: obj.ModTime,
+		})
+		return nil
+	})
+	return objects, err
+}
+
+// cleanupHealResults removes the result objects of the heal sequences
+// which didn't write any within the retention.
+func cleanupHealResults(ctx context.Context, objAPI ObjectLayer) error {
+	latest := make(map[string]time.Time)
+	var names []string
+	err := listHealResults(ctx, objAPI, healResultsPrefix+SlashSeparator, func(obj ObjectInfo) error {
+		token := strings.SplitN(strings.TrimPrefix(obj.Name, healResultsPrefix+SlashSeparator), SlashSeparator, 2)[0]
+		if obj.ModTime.After(latest[token]) {
+			latest[token] = obj.ModTime
+		}
+		names = append(names, obj.Name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		token := strings.SplitN(strings.TrimPrefix(name, healResultsPrefix+SlashSeparator), SlashSeparator, 2)[0]
+		if UTCNow().Sub(latest[token]) < healResultsRetention {
+			continue
+		}
+		if err = deleteConfig(ctx, objAPI, name); err != nil && !errors.Is(err, errConfigNotFound) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/minio/madmin-go/v2"
+)
+
+func TestNewHealResultRecord(t *testing.T) {
+	drives := func(states ...string) []madmin.HealDriveInfo {
+		d := make([]madmin.HealDriveInfo, len(states))
+		for i, s := range states {
+			d[i].State = s
+		}
+		return d
+	}
+	testCases := []struct {
+		before, after []madmin.HealDriveInfo
+		detail        string
+		outcome       string
+	}{
+		{drives("ok", "ok"), drives("ok", "ok"), "", healOutcomeOK},
+		{drives("ok", "missing"), drives("ok", "ok"), "", healOutcomeHealed},
+		{drives("ok", "offline"), drives("ok", "offline"), "", healOutcomeOK},
+		{drives("ok", "missing"), drives("ok", "missing"), "read quorum not met", healOutcomeFailed},
+	}
+	for i, tc := range testCases {
+		var r madmin.HealResultItem
+		r.Before.Drives, r.After.Drives, r.Detail = tc.before, tc.after, tc.detail
+		if got := newHealResultRecord(r).Outcome; got != tc.outcome {
+			t.Errorf("case %d: expected outcome %s, got %s", i+1, tc.outcome, got)
+		}
+	}
+}
+
+func TestHealResultsWriter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	token := mustGetUUID()
+	w := newHealResultsWriter(obj, token)
+	for i := 0; i < healResultsBatchSize+1; i++ {
+		w.add(ctx, madmin.HealResultItem{Type: madmin.HealItemObject, Bucket: "bucket", Object: "object"})
+	}
+	if err = w.close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	objects, err := getHealResults(ctx, obj, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 2 || objects[0].Name != "00000000.ndjson" {
+		t.Fatalf("expected 2 result objects, got %+v", objects)
+	}
+	data, err := readConfig(ctx, obj, pathJoin(healResultsPath(token), objects[1].Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rec HealResultRecord
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		if err = json.Unmarshal(s.Bytes(), &rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if rec.Object != "object" || rec.Outcome != healOutcomeOK {
+		t.Fatalf("unexpected result %+v", rec)
+	}
+	if prefix, counts := w.status(); counts[healOutcomeOK] != healResultsBatchSize+1 || prefix != pathJoin(minioMetaBucket, healResultsPath(token)) {
+		t.Fatalf("unexpected status %s %v", prefix, counts)
+	}
+
+	// Results within the retention are kept.
+	if err = cleanupHealResults(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if objects, _ = getHealResults(ctx, obj, token); len(objects) != 2 {
+		t.Fatalf("expected the results to be kept, got %d objects", len(objects))
+	}
+}
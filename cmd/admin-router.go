@@ -61,13 +61,37 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Info operations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/info").HandlerFunc(gz(httpTraceAll(adminAPI.ServerInfoHandler)))
 		adminRouter.Methods(http.MethodGet, http.MethodPost).Path(adminVersion + "/inspect-data").HandlerFunc(httpTraceAll(adminAPI.InspectDataHandler))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/debug/object-versions").HandlerFunc(gz(httpTraceAll(adminAPI.ObjectVersionsDebugHandler))).Queries("bucket", "{bucket:.*}", "object", "{object:.*}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/debug/object-metadata").HandlerFunc(gz(httpTraceAll(adminAPI.ObjectMetadataScrubHandler))).Queries("bucket", "{bucket:.*}", "object", "{object:.*}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/debug/object-metadata-diff").HandlerFunc(gz(httpTraceAll(adminAPI.ObjectMetadataDiffHandler))).Queries("bucket", "{bucket:.*}", "object", "{object:.*}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/object-layout").HandlerFunc(gz(httpTraceAll(adminAPI.ObjectLayoutHandler))).Queries("bucket", "{bucket:.*}", "object", "{object:.*}")
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/object/expire-noncurrent").HandlerFunc(gz(httpTraceHdrs(adminAPI.ExpireNoncurrentVersionsHandler))).Queries("bucket", "{bucket:.*}", "object", "{object:.*}")
 
 		// StorageInfo operations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/storageinfo").HandlerFunc(gz(httpTraceAll(adminAPI.StorageInfoHandler)))
 		// DataUsageInfo operations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/datausageinfo").HandlerFunc(gz(httpTraceAll(adminAPI.DataUsageInfoHandler)))
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/datausage/rebuild").HandlerFunc(gz(httpTraceAll(adminAPI.DataUsageRebuildHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/scanner/status").HandlerFunc(gz(httpTraceAll(adminAPI.ScannerStatusHandler)))
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/scan/bitrot").HandlerFunc(gz(httpTraceAll(adminAPI.BitrotScanHandler))).Queries("pool", "{pool:.*}", "set", "{set:.*}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/ilm/expiry/status").HandlerFunc(gz(httpTraceAll(adminAPI.ExpiryStatusHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/request-timings").HandlerFunc(gz(httpTraceAll(adminAPI.RequestTimingsHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/clock").HandlerFunc(gz(httpTraceAll(adminAPI.ClockHandler)))
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/node/drain").HandlerFunc(gz(httpTraceAll(adminAPI.NodeDrainHandler))).Queries("node", "{node:.*}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/guardrail/status").HandlerFunc(gz(httpTraceAll(adminAPI.GuardrailStatusHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/features").HandlerFunc(gz(httpTraceAll(adminAPI.ServerFeaturesHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/bootstrap-trace").HandlerFunc(gz(httpTraceAll(adminAPI.BootstrapTraceHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/format-check").HandlerFunc(gz(httpTraceAll(adminAPI.FormatCheckHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/object-lock-report").HandlerFunc(gz(httpTraceAll(adminAPI.ObjectLockReportHandler))).Queries("bucket", "{bucket:.*}")
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/object-lock-test").HandlerFunc(gz(httpTraceAll(adminAPI.ObjectLockTestHandler))).Queries("bucket", "{bucket:.*}")
+
+		// Cluster jobs operations
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/jobs").HandlerFunc(gz(httpTraceAll(adminAPI.ListClusterJobsHandler)))
+
 		// Metrics operation
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/metrics").HandlerFunc(gz(httpTraceAll(adminAPI.MetricsHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/metrics/json").HandlerFunc(gz(httpTraceAll(adminAPI.MetricsJSONHandler)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/metrics/ws").HandlerFunc(httpTraceHdrs(adminAPI.MetricsWSHandler))
 
 		if globalIsDistErasure || globalIsErasure {
 			// Heal operations
@@ -77,6 +101,14 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/heal/{bucket}").HandlerFunc(gz(httpTraceAll(adminAPI.HealHandler)))
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/heal/{bucket}/{prefix:.*}").HandlerFunc(gz(httpTraceAll(adminAPI.HealHandler)))
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/background-heal/status").HandlerFunc(gz(httpTraceAll(adminAPI.BackgroundHealStatusHandler)))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/heal/status/all").HandlerFunc(gz(httpTraceAll(adminAPI.HealStatusAllHandler)))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/heal/sequences").HandlerFunc(gz(httpTraceAll(adminAPI.HealSequencesHandler)))
+			adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/heal/sequences").HandlerFunc(gz(httpTraceAll(adminAPI.StopHealSequenceHandler))).Queries("token", "{token:.*}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/heal-results").HandlerFunc(gz(httpTraceHdrs(adminAPI.HealResultsHandler))).Queries("token", "{token:.*}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/mrf").HandlerFunc(gz(httpTraceAll(adminAPI.MRFQueueHandler)))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/quarantine").HandlerFunc(gz(httpTraceHdrs(adminAPI.QuarantineHandler)))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/quarantine/release").HandlerFunc(gz(httpTraceHdrs(adminAPI.ReleaseQuarantineHandler)))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/quarantine").HandlerFunc(gz(httpTraceAll(adminAPI.ListQuarantineHandler)))
 
 			// Pool operations
 			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/pools/list").HandlerFunc(gz(httpTraceAll(adminAPI.ListPools)))
@@ -84,6 +116,10 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 
 			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/pools/decommission").HandlerFunc(gz(httpTraceAll(adminAPI.StartDecommission))).Queries("pool", "{pool:.*}")
 			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/pools/cancel").HandlerFunc(gz(httpTraceAll(adminAPI.CancelDecommission))).Queries("pool", "{pool:.*}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/pools/simulate-upload").HandlerFunc(gz(httpTraceAll(adminAPI.SimulateUpload))).
+				Queries("bucket", "{bucket:.*}", "object", "{object:.*}", "size", "{size:[0-9]+}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/locate").HandlerFunc(gz(httpTraceAll(adminAPI.LocateObject))).
+				Queries("bucket", "{bucket:.*}", "object", "{object:.*}")
 
 			// Rebalance operations
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/start").HandlerFunc(gz(httpTraceAll(adminAPI.RebalanceStart)))
@@ -128,6 +164,9 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Add policy IAM
 		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/add-canned-policy").HandlerFunc(gz(httpTraceAll(adminAPI.AddCannedPolicy))).Queries("name", "{name:.*}")
 
+		// Preview the effect of a proposed IAM or bucket policy
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/policy/preview").HandlerFunc(gz(httpTraceHdrs(adminAPI.PolicyPreviewHandler)))
+
 		// Add user IAM
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/accountinfo").HandlerFunc(gz(httpTraceAll(adminAPI.AccountInfoHandler)))
 
@@ -140,6 +179,7 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/update-service-account").HandlerFunc(gz(httpTraceHdrs(adminAPI.UpdateServiceAccount))).Queries("accessKey", "{accessKey:.*}")
 		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/info-service-account").HandlerFunc(gz(httpTraceHdrs(adminAPI.InfoServiceAccount))).Queries("accessKey", "{accessKey:.*}")
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/list-service-accounts").HandlerFunc(gz(httpTraceHdrs(adminAPI.ListServiceAccounts)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/stale-credentials").HandlerFunc(gz(httpTraceHdrs(adminAPI.StaleCredentials)))
 		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/delete-service-account").HandlerFunc(gz(httpTraceHdrs(adminAPI.DeleteServiceAccount))).Queries("accessKey", "{accessKey:.*}")
 
 		// STS accounts ops
@@ -168,6 +208,12 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Detach policies from user or group
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/idp/builtin/policy/detach").HandlerFunc(gz(httpTraceHdrs(adminAPI.DetachPolicyBuiltin)))
 
+		// Time-bound policy elevations
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/iam/elevate").HandlerFunc(gz(httpTraceHdrs(adminAPI.ElevateUser)))
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/iam/elevate").HandlerFunc(gz(httpTraceHdrs(adminAPI.RevokeElevation))).Queries("user", "{user:.*}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/iam/elevate").HandlerFunc(gz(httpTraceHdrs(adminAPI.ListElevations)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/iam/elevate/audit").HandlerFunc(gz(httpTraceHdrs(adminAPI.ListElevationAudit)))
+
 		// Remove user IAM
 		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/remove-user").HandlerFunc(gz(httpTraceHdrs(adminAPI.RemoveUser))).Queries("accessKey", "{accessKey:.*}")
 
@@ -214,6 +260,64 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-quota").HandlerFunc(
 			gz(httpTraceHdrs(adminAPI.PutBucketQuotaConfigHandler))).Queries("bucket", "{bucket:.*}")
 
+		// GetBucketOverwriteConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-overwrite").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.GetBucketOverwriteConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// PutBucketOverwriteConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-overwrite").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.PutBucketOverwriteConfigHandler))).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketAppendConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-append").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.GetBucketAppendConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// PutBucketAppendConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-append").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.PutBucketAppendConfigHandler))).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketEphemeralConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-ephemeral").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.GetBucketEphemeralConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// PutBucketEphemeralConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-ephemeral").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.PutBucketEphemeralConfigHandler))).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketActiveRetentionConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-active-retention").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.GetBucketActiveRetentionConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// PutBucketActiveRetentionConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-active-retention").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.PutBucketActiveRetentionConfigHandler))).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketState
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/bucket-state").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.GetBucketStateHandler))).Queries("bucket", "{bucket:.*}")
+		// PutBucketState
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/bucket-state").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.PutBucketStateHandler))).Queries("bucket", "{bucket:.*}", "state", "{state:.*}")
+
+		// GetBucketReadThroughConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-readthrough").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.GetBucketReadThroughConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// PutBucketReadThroughConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-readthrough").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.PutBucketReadThroughConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// RemoveBucketReadThroughConfig
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/remove-bucket-readthrough").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.RemoveBucketReadThroughConfigHandler))).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketEventJournalConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-event-journal").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.GetBucketEventJournalConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// PutBucketEventJournalConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-event-journal").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.PutBucketEventJournalConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// RemoveBucketEventJournalConfig
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/remove-bucket-event-journal").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.RemoveBucketEventJournalConfigHandler))).Queries("bucket", "{bucket:.*}")
+		// NotifyReplay
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/notify/replay").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.NotifyReplayHandler))).Queries("bucket", "{bucket:.*}", "start", "{start:.*}", "end", "{end:.*}", "arn", "{arn:.*}")
+
 		// Bucket replication operations
 		// GetBucketTargetHandler
 		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/list-remote-targets").HandlerFunc(
@@ -224,6 +328,12 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// RemoveRemoteTargetHandler
 		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/remove-remote-target").HandlerFunc(
 			gz(httpTraceHdrs(adminAPI.RemoveRemoteTargetHandler))).Queries("bucket", "{bucket:.*}", "arn", "{arn:.*}")
+		// SetReplicationBandwidth
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/replication/bandwidth").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.SetReplicationBandwidthHandler))).Queries("bucket", "{bucket:.*}", "arn", "{arn:.*}", "limit", "{limit:.*}")
+		// GetReplicationBandwidth
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/replication/bandwidth").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.GetReplicationBandwidthHandler)))
 		// ReplicationDiff - MinIO extension API
 		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/replication/diff").HandlerFunc(
 			gz(httpTraceHdrs(adminAPI.ReplicationDiffHandler))).Queries("bucket", "{bucket:.*}")
@@ -237,6 +347,8 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/describe-job").HandlerFunc(
 			gz(httpTraceHdrs(adminAPI.DescribeBatchJob)))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/status-job").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.BatchJobStatus)))
 		adminRouter.Methods(http.MethodDelete).Path(adminVersion + "/cancel-job").HandlerFunc(
 			gz(httpTraceHdrs(adminAPI.CancelBatchJob)))
 
@@ -256,6 +368,8 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/tier/{tier}").HandlerFunc(gz(httpTraceHdrs(adminAPI.VerifyTierHandler)))
 		// Tier stats
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/tier-stats").HandlerFunc(gz(httpTraceHdrs(adminAPI.TierStatsHandler)))
+		// Free-version compaction
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/compact-free-versions").HandlerFunc(gz(httpTraceHdrs(adminAPI.CompactFreeVersionsHandler))).Queries("bucket", "{bucket:.*}")
 
 		// Cluster Replication APIs
 		adminRouter.Methods(http.MethodPut).Path(adminVersion + "/site-replication/add").HandlerFunc(gz(httpTraceHdrs(adminAPI.SiteReplicationAdd)))
@@ -281,12 +395,22 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/force-unlock").
 				Queries("paths", "{paths:.*}").HandlerFunc(gz(httpTraceHdrs(adminAPI.ForceUnlockHandler)))
 		}
+		// Lock holders
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/top/locks/holders").
+			Queries("resource", "{resource:.*}").HandlerFunc(gz(httpTraceHdrs(adminAPI.LockHoldersHandler)))
+		// Object read streams
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/object-streams").HandlerFunc(gz(httpTraceHdrs(adminAPI.ObjectReadStreamsHandler)))
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/object-streams/cancel").
+			Queries("id", "{id:.*}").HandlerFunc(gz(httpTraceHdrs(adminAPI.CancelObjectReadStreamHandler)))
 
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/speedtest").HandlerFunc(httpTraceHdrs(adminAPI.SpeedTestHandler))
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/speedtest/object").HandlerFunc(httpTraceHdrs(adminAPI.ObjectSpeedTestHandler))
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/speedtest/drive").HandlerFunc(httpTraceHdrs(adminAPI.DriveSpeedtestHandler))
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/speedtest/net").HandlerFunc(httpTraceHdrs(adminAPI.NetperfHandler))
 
+		// S3 conformance self-test
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/selftest/s3").HandlerFunc(httpTraceHdrs(adminAPI.S3SelfTestHandler))
+
 		// HTTP Trace
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/trace").HandlerFunc(gz(http.HandlerFunc(adminAPI.TraceHandler)))
 
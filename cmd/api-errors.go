@@ -34,6 +34,7 @@ import (
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/bucket/cors"
 	"github.com/minio/minio/internal/bucket/lifecycle"
 	"github.com/minio/minio/internal/bucket/replication"
 	"github.com/minio/minio/internal/config/dns"
@@ -43,6 +44,7 @@ import (
 
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/bucket/versioning"
+	"github.com/minio/minio/internal/bucket/website"
 	levent "github.com/minio/minio/internal/config/lambda/event"
 	"github.com/minio/minio/internal/event"
 	"github.com/minio/minio/internal/hash"
@@ -418,6 +420,16 @@ const (
 	ErrLambdaARNInvalid
 	ErrLambdaARNNotFound
 
+	ErrObjectKeyTooDeep
+	ErrObjectNotAppendable
+
+	ErrBucketReadOnly
+	ErrBucketWriteOnce
+
+	ErrListingTimeout
+
+	ErrAdminNoSuchObjectStream
+
 	apiErrCodeEnd // This is used only for the testing code
 )
 
@@ -1986,6 +1998,36 @@ var errorCodes = errorCodeMap{
 		Description:    "The specified lambda ARN does not exist",
 		HTTPStatusCode: http.StatusNotFound,
 	},
+	ErrObjectKeyTooDeep: {
+		Code:           "XMinioObjectKeyTooDeep",
+		Description:    "Object name exceeds the maximum allowed number of path components.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrObjectNotAppendable: {
+		Code:           "XMinioObjectNotAppendable",
+		Description:    "The object can not be appended to.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrBucketReadOnly: {
+		Code:           "XMinioBucketReadOnly",
+		Description:    "The bucket is read-only, its objects can not be modified.",
+		HTTPStatusCode: http.StatusForbidden,
+	},
+	ErrBucketWriteOnce: {
+		Code:           "XMinioBucketWriteOnce",
+		Description:    "The bucket is write-once, its existing objects can not be overwritten or deleted.",
+		HTTPStatusCode: http.StatusForbidden,
+	},
+	ErrListingTimeout: {
+		Code:           "ListingTimeout",
+		Description:    "The listing stopped at its scan limit before finding entries to return, resume it from the marker in the X-Minio-Listing-Next-Marker header.",
+		HTTPStatusCode: http.StatusServiceUnavailable,
+	},
+	ErrAdminNoSuchObjectStream: {
+		Code:           "XMinioAdminNoSuchObjectStream",
+		Description:    "The specified object stream does not exist.",
+		HTTPStatusCode: http.StatusNotFound,
+	},
 	ErrPolicyAlreadyAttached: {
 		Code:           "XMinioPolicyAlreadyAttached",
 		Description:    "The specified policy is already attached.",
@@ -2028,6 +2070,8 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrAdminGroupNotEmpty
 	case errNoSuchJob:
 		apiErr = ErrAdminNoSuchJob
+	case errNoSuchObjectStream:
+		apiErr = ErrAdminNoSuchObjectStream
 	case errNoPolicyToAttachOrDetach:
 		apiErr = ErrAdminPolicyChangeAlreadyApplied
 	case errSignatureMismatch:
@@ -2194,6 +2238,10 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrNoSuchLifecycleConfiguration
 	case BucketSSEConfigNotFound:
 		apiErr = ErrNoSuchBucketSSEConfig
+	case BucketCorsNotFound:
+		apiErr = ErrNoSuchCORSConfiguration
+	case BucketWebsiteNotFound:
+		apiErr = ErrNoSuchWebsiteConfiguration
 	case BucketTaggingNotFound:
 		apiErr = ErrBucketTaggingNotFound
 	case BucketObjectLockConfigNotFound:
@@ -2258,10 +2306,16 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrUnsupportedNotification
 	case OperationTimedOut:
 		apiErr = ErrOperationTimedOut
+	case ListingTimeout:
+		apiErr = ErrListingTimeout
 	case BackendDown:
 		apiErr = ErrBackendDown
 	case ObjectNameTooLong:
 		apiErr = ErrKeyTooLongError
+	case ObjectNameTooDeep:
+		apiErr = ErrObjectKeyTooDeep
+	case ObjectNotAppendable:
+		apiErr = ErrObjectNotAppendable
 	case dns.ErrInvalidBucketName:
 		apiErr = ErrInvalidBucketName
 	case dns.ErrBucketConflict:
@@ -2313,6 +2367,11 @@ func toAPIError(ctx context.Context, err error) APIError {
 		}
 	case "XMinioBackendDown":
 		apiErr.Description = fmt.Sprintf("%s (%v)", apiErr.Description, err)
+	case "XMinioObjectNotAppendable":
+		var e ObjectNotAppendable
+		if errors.As(err, &e) && e.Err != nil {
+			apiErr.Description = fmt.Sprintf("%s (%v)", apiErr.Description, e.Err)
+		}
 	case "InternalError":
 		// If we see an internal error try to interpret
 		// any underlying errors if possible depending on
@@ -2350,6 +2409,18 @@ func toAPIError(ctx context.Context, err error) APIError {
 				Description:    fmt.Sprintf("Versioning configuration specified in the request is invalid. (%s)", e),
 				HTTPStatusCode: http.StatusBadRequest,
 			}
+		case cors.Error:
+			apiErr = APIError{
+				Code:           "InvalidRequest",
+				Description:    e.Error(),
+				HTTPStatusCode: http.StatusBadRequest,
+			}
+		case website.Error:
+			apiErr = APIError{
+				Code:           "InvalidArgument",
+				Description:    e.Error(),
+				HTTPStatusCode: http.StatusBadRequest,
+			}
 		case lifecycle.Error:
 			apiErr = APIError{
 				Code:           "InvalidRequest",
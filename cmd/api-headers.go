@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
@@ -108,6 +109,13 @@ func setPartsCountHeaders(w http.ResponseWriter, objInfo ObjectInfo) {
 }
 
 // Write object header
+// setWeakETagHeader sets the weak ETag stored for multipart objects.
+func setWeakETagHeader(w http.ResponseWriter, objInfo ObjectInfo) {
+	if weak := objInfo.UserDefined[multipartWeakETagKey]; weak != "" {
+		w.Header().Set(xhttp.MinIOWeakETag, weak)
+	}
+}
+
 func setObjectHeaders(w http.ResponseWriter, objInfo ObjectInfo, rs *HTTPRangeSpec, opts ObjectOptions) (err error) {
 	// set common headers
 	setCommonHeaders(w)
@@ -120,6 +128,7 @@ func setObjectHeaders(w http.ResponseWriter, objInfo ObjectInfo, rs *HTTPRangeSp
 	if objInfo.ETag != "" {
 		w.Header()[xhttp.ETag] = []string{"\"" + objInfo.ETag + "\""}
 	}
+	setWeakETagHeader(w, objInfo)
 
 	if objInfo.ContentType != "" {
 		w.Header().Set(xhttp.ContentType, objInfo.ContentType)
@@ -184,6 +193,16 @@ func setObjectHeaders(w http.ResponseWriter, objInfo ObjectInfo, rs *HTTPRangeSp
 	if err != nil {
 		return err
 	}
+	if opts.NoDecompression && objInfo.IsCompressed() {
+		// The stored compressed stream is returned.
+		w.Header().Set(xhttp.MinIOActualSize, strconv.FormatInt(totalObjectSize, 10))
+		totalObjectSize = objInfo.Size
+		if _, ok := crypto.IsEncrypted(objInfo.UserDefined); ok {
+			if totalObjectSize, err = objInfo.DecryptedSize(); err != nil {
+				return err
+			}
+		}
+	}
 
 	if rs == nil && opts.PartNumber > 0 {
 		rs = partNumberToRangeSpec(objInfo, opts.PartNumber)
@@ -221,6 +240,14 @@ func setObjectHeaders(w http.ResponseWriter, objInfo ObjectInfo, rs *HTTPRangeSp
 		lc.SetPredictionHeaders(w, objInfo.ToLifecycleOpts())
 	}
 
+	if v, ok := objInfo.UserDefined[clientMetadataKey]; ok {
+		w.Header().Set(xhttp.MinIOClientMetadata, base64.StdEncoding.EncodeToString([]byte(v)))
+	}
+
+	if v := objInfo.ContentHash(); v != "" {
+		w.Header().Set(xhttp.MinIOContentHash, v)
+	}
+
 	if v, ok := objInfo.UserDefined[ReservedMetadataPrefix+"compression"]; ok {
 		if i := strings.LastIndexByte(v, '/'); i >= 0 {
 			v = v[i+1:]
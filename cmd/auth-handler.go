@@ -158,6 +158,8 @@ func validateAdminSignature(ctx context.Context, r *http.Request, region string)
 		return cred, owner, s3Err
 	}
 
+	globalIAMSys.RecordAccessKeyUsed(cred)
+
 	logger.GetReqInfo(ctx).Cred = cred
 	logger.GetReqInfo(ctx).Owner = owner
 	logger.GetReqInfo(ctx).Region = globalSite.Region
@@ -320,6 +322,8 @@ func checkRequestAuthTypeWithVID(ctx context.Context, r *http.Request, action po
 }
 
 func authenticateRequest(ctx context.Context, r *http.Request, action policy.Action) (s3Err APIErrorCode) {
+	defer requestTimingPhase(ctx, requestPhaseAuth)()
+
 	if logger.GetReqInfo(ctx) == nil {
 		logger.LogIf(ctx, errors.New("unexpected context.Context does not have a logger.ReqInfo"), logger.Minio)
 		return ErrAccessDenied
@@ -350,6 +354,8 @@ func authenticateRequest(ctx context.Context, r *http.Request, action policy.Act
 		return s3Err
 	}
 
+	globalIAMSys.RecordAccessKeyUsed(cred)
+
 	logger.GetReqInfo(ctx).Cred = cred
 	logger.GetReqInfo(ctx).Owner = owner
 	logger.GetReqInfo(ctx).Region = globalSite.Region
@@ -381,6 +387,8 @@ func authenticateRequest(ctx context.Context, r *http.Request, action policy.Act
 }
 
 func authorizeRequest(ctx context.Context, r *http.Request, action policy.Action) (s3Err APIErrorCode) {
+	defer requestTimingPhase(ctx, requestPhaseAuth)()
+
 	reqInfo := logger.GetReqInfo(ctx)
 	if reqInfo == nil {
 		return ErrAccessDenied
@@ -494,10 +502,13 @@ func checkRequestAuthTypeCredential(ctx context.Context, r *http.Request, action
 	cred = reqInfo.Cred
 	owner = reqInfo.Owner
 	if s3Err != ErrNone {
+		globalHTTPStats.incAuthFailure(s3Err)
 		return cred, owner, s3Err
 	}
 
-	return cred, owner, authorizeRequest(ctx, r, action)
+	s3Err = authorizeRequest(ctx, r, action)
+	globalHTTPStats.incAuthFailure(s3Err)
+	return cred, owner, s3Err
 }
 
 // Verify if request has valid AWS Signature Version '2'.
@@ -624,6 +635,7 @@ func setAuthHandler(h http.Handler) http.Handler {
 
 		writeErrorResponse(r.Context(), w, errorCodes.ToAPIErr(ErrSignatureVersionNotSupported), r.URL)
 		atomic.AddUint64(&globalHTTPStats.rejectedRequestsAuth, 1)
+		globalHTTPStats.incAuthFailure(ErrSignatureVersionNotSupported)
 	})
 }
 
@@ -650,6 +662,7 @@ func validateSignature(atype authType, r *http.Request) (auth.Credentials, bool,
 		return cred, owner, s3Err
 	}
 
+	globalIAMSys.RecordAccessKeyUsed(cred)
 	return cred, owner, ErrNone
 }
 
@@ -699,6 +712,8 @@ func isPutRetentionAllowed(bucketName, objectName string, retDays int, retDate t
 // call verifies bucket policies and IAM policies, supports multi user
 // checks etc.
 func isPutActionAllowed(ctx context.Context, atype authType, bucketName, objectName string, r *http.Request, action iampolicy.Action) (s3Err APIErrorCode) {
+	defer requestTimingPhase(ctx, requestPhaseAuth)()
+
 	var cred auth.Credentials
 	var owner bool
 	region := globalSite.Region
@@ -146,6 +146,72 @@ type BatchReplicateFilter struct {
 	Metadata      []BatchJobReplicateKV `yaml:"metadata,omitempty" json:"metadata"`
 }
 
+// Match returns true if the object version passes the filter.
+func (f BatchReplicateFilter) Match(info FileInfo) bool {
+	if f.OlderThan > 0 && time.Since(info.ModTime) < f.OlderThan {
+		// skip all objects that are newer than specified older duration
+		return false
+	}
+
+	if f.NewerThan > 0 && time.Since(info.ModTime) >= f.NewerThan {
+		// skip all objects that are older than specified newer duration
+		return false
+	}
+
+	if !f.CreatedAfter.IsZero() && f.CreatedAfter.Before(info.ModTime) {
+		// skip all objects that are created before the specified time.
+		return false
+	}
+
+	if !f.CreatedBefore.IsZero() && f.CreatedBefore.After(info.ModTime) {
+		// skip all objects that are created after the specified time.
+		return false
+	}
+
+	if len(f.Tags) > 0 {
+		// Only parse object tags if tags filter is specified.
+		tagMap := map[string]string{}
+		tagStr := info.Metadata[xhttp.AmzObjectTagging]
+		if len(tagStr) != 0 {
+			t, err := tags.ParseObjectTags(tagStr)
+			if err != nil {
+				return false
+			}
+			tagMap = t.ToMap()
+		}
+
+		for _, kv := range f.Tags {
+			for t, v := range tagMap {
+				if kv.Match(BatchJobReplicateKV{Key: t, Value: v}) {
+					return true
+				}
+			}
+		}
+
+		// None of the provided tags filter match skip the object
+		return false
+	}
+
+	if len(f.Metadata) > 0 {
+		for _, kv := range f.Metadata {
+			for k, v := range info.Metadata {
+				if !strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") && !isStandardHeader(k) {
+					continue
+				}
+				// We only need to match x-amz-meta or standardHeaders
+				if kv.Match(BatchJobReplicateKV{Key: k, Value: v}) {
+					return true
+				}
+			}
+		}
+
+		// None of the provided metadata filters match skip the object.
+		return false
+	}
+
+	return true
+}
+
 // BatchReplicateNotification success or failure notification endpoint for each job attempts
 type BatchReplicateNotification struct {
 	Endpoint string `yaml:"endpoint" json:"endpoint"`
@@ -234,25 +300,30 @@ type BatchJobRequest struct {
 	Started   time.Time            `yaml:"-" json:"started"`
 	Location  string               `yaml:"-" json:"location"`
 	Replicate *BatchJobReplicateV1 `yaml:"replicate" json:"replicate"`
+	KeyRotate *BatchJobKeyRotateV1 `yaml:"keyrotate" json:"keyrotate"`
 	ctx       context.Context      `msg:"-"`
 }
 
 // Notify notifies notification endpoint if configured regarding job failure or success.
 func (r BatchJobReplicateV1) Notify(ctx context.Context, body io.Reader) error {
-	if r.Flags.Notify.Endpoint == "" {
+	return r.Flags.Notify.send(ctx, body)
+}
+
+func (n BatchReplicateNotification) send(ctx context.Context, body io.Reader) error {
+	if n.Endpoint == "" {
 		return nil
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Flags.Notify.Endpoint, body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.Endpoint, body)
 	if err != nil {
 		return err
 	}
 
-	if r.Flags.Notify.Token != "" {
-		req.Header.Set("Authorization", r.Flags.Notify.Token)
+	if n.Token != "" {
+		req.Header.Set("Authorization", n.Token)
 	}
 
 	clnt := http.Client{Transport: getRemoteInstanceTransport}
@@ -384,10 +455,23 @@ type batchJobInfo struct {
 	DeleteMarkersFailed int64 `json:"deleteMarkersFailed" msg:"dmf"`
 	BytesTransferred    int64 `json:"bytesTransferred" msg:"bt"`
 	BytesFailed         int64 `json:"bytesFailed" msg:"bf"`
+
+	// Last failed objects, for job types listing them.
+	Failures []batchJobFailure `json:"failures,omitempty" msg:"fl"`
+}
+
+// batchJobFailure is an object version a job failed to process.
+type batchJobFailure struct {
+	Bucket    string    `json:"bucket" msg:"b"`
+	Object    string    `json:"object" msg:"o"`
+	VersionID string    `json:"versionId,omitempty" msg:"v"`
+	Error     string    `json:"error" msg:"e"`
+	Time      time.Time `json:"time" msg:"t"`
 }
 
 const (
 	batchReplName      = "batch-replicate.bin"
+	batchKeyRotateName = "batch-keyrotate.bin"
 	batchReplFormat    = 1
 	batchReplVersionV1 = 1
 	batchReplVersion   = batchReplVersionV1
@@ -397,15 +481,33 @@ const (
 	batchReplJobAPIVersion        = "v1"
 	batchReplJobDefaultRetries    = 3
 	batchReplJobDefaultRetryDelay = 250 * time.Millisecond
+
+	// maximum number of failed objects listed per job.
+	batchJobMaxFailures = 1000
 )
 
+// fileName returns the name of the file the job info is persisted to.
+func (ri *batchJobInfo) fileName() string {
+	if ri.JobType == string(madmin.BatchJobKeyRotate) {
+		return batchKeyRotateName
+	}
+	return batchReplName
+}
+
 func (ri *batchJobInfo) load(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
-	data, err := readConfig(ctx, api, pathJoin(job.Location, batchReplName))
+	data, err := readConfig(ctx, api, pathJoin(job.Location, ri.fileName()))
 	if err != nil {
 		if errors.Is(err, errConfigNotFound) || isErrObjectNotFound(err) {
 			ri.Version = batchReplVersionV1
-			if job.Replicate.Flags.Retry.Attempts > 0 {
-				ri.RetryAttempts = job.Replicate.Flags.Retry.Attempts
+			var retry BatchReplicateRetry
+			switch {
+			case job.Replicate != nil:
+				retry = job.Replicate.Flags.Retry
+			case job.KeyRotate != nil:
+				retry = job.KeyRotate.Flags.Retry
+			}
+			if retry.Attempts > 0 {
+				ri.RetryAttempts = retry.Attempts
 			} else {
 				ri.RetryAttempts = batchReplJobDefaultRetries
 			}
@@ -468,6 +570,7 @@ func (ri *batchJobInfo) clone() *batchJobInfo {
 		ObjectsFailed:    ri.ObjectsFailed,
 		BytesTransferred: ri.BytesTransferred,
 		BytesFailed:      ri.BytesFailed,
+		Failures:         append([]batchJobFailure(nil), ri.Failures...),
 	}
 }
 
@@ -516,7 +619,7 @@ func (ri *batchJobInfo) updateAfter(ctx context.Context, api ObjectLayer, durati
 		if err != nil {
 			return err
 		}
-		return saveConfig(ctx, api, pathJoin(jobLocation, batchReplName), buf)
+		return saveConfig(ctx, api, pathJoin(jobLocation, ri.fileName()), buf)
 	}
 	ri.mu.Unlock()
 	return nil
@@ -535,6 +638,28 @@ func (ri *batchJobInfo) trackCurrentBucketObject(bucket string, info ObjectInfo,
 	ri.countItem(info.Size, info.DeleteMarker, failed)
 }
 
+// trackFailure lists a failed object version, keeping the last
+// batchJobMaxFailures failures.
+func (ri *batchJobInfo) trackFailure(bucket string, info ObjectInfo, err error) {
+	if ri == nil {
+		return
+	}
+
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	if len(ri.Failures) >= batchJobMaxFailures {
+		ri.Failures = append(ri.Failures[:0], ri.Failures[1:]...)
+	}
+	ri.Failures = append(ri.Failures, batchJobFailure{
+		Bucket:    bucket,
+		Object:    info.Name,
+		VersionID: info.VersionID,
+		Error:     err.Error(),
+		Time:      UTCNow(),
+	})
+}
+
 // Start start the batch replication job, resumes if there was a pending job via "job.ID"
 func (r *BatchJobReplicateV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
 	ri := &batchJobInfo{
@@ -555,68 +680,7 @@ func (r *BatchJobReplicateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	skip := func(info FileInfo) (ok bool) {
-		if r.Flags.Filter.OlderThan > 0 && time.Since(info.ModTime) < r.Flags.Filter.OlderThan {
-			// skip all objects that are newer than specified older duration
-			return false
-		}
-
-		if r.Flags.Filter.NewerThan > 0 && time.Since(info.ModTime) >= r.Flags.Filter.NewerThan {
-			// skip all objects that are older than specified newer duration
-			return false
-		}
-
-		if !r.Flags.Filter.CreatedAfter.IsZero() && r.Flags.Filter.CreatedAfter.Before(info.ModTime) {
-			// skip all objects that are created before the specified time.
-			return false
-		}
-
-		if !r.Flags.Filter.CreatedBefore.IsZero() && r.Flags.Filter.CreatedBefore.After(info.ModTime) {
-			// skip all objects that are created after the specified time.
-			return false
-		}
-
-		if len(r.Flags.Filter.Tags) > 0 {
-			// Only parse object tags if tags filter is specified.
-			tagMap := map[string]string{}
-			tagStr := info.Metadata[xhttp.AmzObjectTagging]
-			if len(tagStr) != 0 {
-				t, err := tags.ParseObjectTags(tagStr)
-				if err != nil {
-					return false
-				}
-				tagMap = t.ToMap()
-			}
-
-			for _, kv := range r.Flags.Filter.Tags {
-				for t, v := range tagMap {
-					if kv.Match(BatchJobReplicateKV{Key: t, Value: v}) {
-						return true
-					}
-				}
-			}
-
-			// None of the provided tags filter match skip the object
-			return false
-		}
-
-		if len(r.Flags.Filter.Metadata) > 0 {
-			for _, kv := range r.Flags.Filter.Metadata {
-				for k, v := range info.Metadata {
-					if !strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") && !isStandardHeader(k) {
-						continue
-					}
-					// We only need to match x-amz-meta or standardHeaders
-					if kv.Match(BatchJobReplicateKV{Key: k, Value: v}) {
-						return true
-					}
-				}
-			}
-
-			// None of the provided metadata filters match skip the object.
-			return false
-		}
-
-		return true
+		return r.Flags.Filter.Match(info)
 	}
 
 	u, err := url.Parse(r.Target.Endpoint)
@@ -666,6 +730,7 @@ func (r *BatchJobReplicateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 
 		for result := range results {
 			result := result
+			globalGuardrail.throttleLowPriority(ctx)
 			wk.Take()
 			go func() {
 				defer wk.Give()
@@ -842,10 +907,13 @@ func (r *BatchJobReplicateV1) Validate(ctx context.Context, job BatchJobRequest,
 	return nil
 }
 
-// Type returns type of batch job, currently only supports 'replicate'
+// Type returns type of batch job, currently supports 'replicate' and 'keyrotate'
 func (j BatchJobRequest) Type() madmin.BatchJobType {
-	if j.Replicate != nil {
+	switch {
+	case j.Replicate != nil:
 		return madmin.BatchJobReplicate
+	case j.KeyRotate != nil:
+		return madmin.BatchJobKeyRotate
 	}
 	return madmin.BatchJobType("unknown")
 }
@@ -853,20 +921,24 @@ func (j BatchJobRequest) Type() madmin.BatchJobType {
 // Validate validates the current job, used by 'save()' before
 // persisting the job request
 func (j BatchJobRequest) Validate(ctx context.Context, o ObjectLayer) error {
-	if j.Replicate != nil {
+	switch {
+	case j.Replicate != nil:
 		return j.Replicate.Validate(ctx, j, o)
+	case j.KeyRotate != nil:
+		return j.KeyRotate.Validate(ctx, j, o)
 	}
 	return errInvalidArgument
 }
 
 func (j BatchJobRequest) delete(ctx context.Context, api ObjectLayer) {
 	deleteConfig(ctx, api, pathJoin(j.Location, batchReplName))
+	deleteConfig(ctx, api, pathJoin(j.Location, batchKeyRotateName))
 	globalBatchJobsMetrics.delete(j.ID)
 	deleteConfig(ctx, api, j.Location)
 }
 
 func (j *BatchJobRequest) save(ctx context.Context, api ObjectLayer) error {
-	if j.Replicate == nil {
+	if j.Replicate == nil && j.KeyRotate == nil {
 		return errInvalidArgument
 	}
 
@@ -1004,6 +1076,56 @@ func (a adminAPIHandlers) DescribeBatchJob(w http.ResponseWriter, r *http.Reques
 	w.Write(buf)
 }
 
+// BatchJobStatus returns the progress of a batch job, including the
+// objects it failed to process for job types tracking them.
+func (a adminAPIHandlers) BatchJobStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "BatchJobStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.DescribeBatchJobAction)
+	if objectAPI == nil {
+		return
+	}
+
+	id := r.Form.Get("jobId")
+	if id == "" {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, errInvalidArgument), r.URL)
+		return
+	}
+
+	ri, ok := globalBatchJobsMetrics.status(id)
+	if !ok {
+		// Not running on this node, fallback to the persisted status.
+		req := &BatchJobRequest{}
+		if err := req.load(ctx, objectAPI, pathJoin(batchJobPrefix, id)); err != nil {
+			if !errors.Is(err, errNoSuchJob) {
+				logger.LogIf(ctx, err)
+			}
+
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		ri = &batchJobInfo{
+			JobID:     req.ID,
+			JobType:   string(req.Type()),
+			StartTime: req.Started,
+		}
+		if err := ri.load(ctx, objectAPI, *req); err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
+	buf, err := json.Marshal(ri)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, buf)
+}
+
 // StarBatchJob queue a new job for execution
 func (a adminAPIHandlers) StartBatchJob(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "StartBatchJob")
@@ -1153,15 +1275,20 @@ func (j *BatchJobPool) AddWorker() {
 			if !ok {
 				return
 			}
-			if job.Replicate != nil {
-				if err := job.Replicate.Start(job.ctx, j.objLayer, *job); err != nil {
-					if !isErrBucketNotFound(err) {
-						logger.LogIf(j.ctx, err)
-						j.canceler(job.ID, false)
-						continue
-					}
-					// Bucket not found proceed to delete such a job.
+			var err error
+			switch {
+			case job.Replicate != nil:
+				err = job.Replicate.Start(job.ctx, j.objLayer, *job)
+			case job.KeyRotate != nil:
+				err = job.KeyRotate.Start(job.ctx, j.objLayer, *job)
+			}
+			if err != nil {
+				if !isErrBucketNotFound(err) {
+					logger.LogIf(j.ctx, err)
+					j.canceler(job.ID, false)
+					continue
 				}
+				// Bucket not found proceed to delete such a job.
 			}
 			job.delete(j.ctx, j.objLayer)
 			j.canceler(job.ID, false)
@@ -1207,7 +1334,7 @@ func (j *BatchJobPool) queueJob(req *BatchJobRequest) error {
 		})
 	case j.jobCh <- req:
 	default:
-		return fmt.Errorf("batch job queue is currently full please try again later %#v", req)
+		return fmt.Errorf("batch job queue is currently full please try again later %s", req.ID)
 	}
 	return nil
 }
@@ -1272,7 +1399,7 @@ func (m *batchJobMetrics) report(jobID string) (metrics *madmin.BatchJobMetrics)
 	defer m.RUnlock()
 	for id, job := range m.metrics {
 		match := jobID != "" && id == jobID
-		metrics.Jobs[id] = madmin.JobMetric{
+		m := madmin.JobMetric{
 			JobID:         job.JobID,
 			JobType:       job.JobType,
 			StartTime:     job.StartTime,
@@ -1280,15 +1407,26 @@ func (m *batchJobMetrics) report(jobID string) (metrics *madmin.BatchJobMetrics)
 			RetryAttempts: job.RetryAttempts,
 			Complete:      job.Complete,
 			Failed:        job.Failed,
-			Replicate: &madmin.ReplicateInfo{
+		}
+		switch madmin.BatchJobType(job.JobType) {
+		case madmin.BatchJobKeyRotate:
+			m.KeyRotate = &madmin.KeyRotationInfo{
+				Bucket:        job.Bucket,
+				Object:        job.Object,
+				Objects:       job.Objects,
+				ObjectsFailed: job.ObjectsFailed,
+			}
+		default:
+			m.Replicate = &madmin.ReplicateInfo{
 				Bucket:           job.Bucket,
 				Object:           job.Object,
 				Objects:          job.Objects,
 				ObjectsFailed:    job.ObjectsFailed,
 				BytesTransferred: job.BytesTransferred,
 				BytesFailed:      job.BytesFailed,
-			},
+			}
 		}
+		metrics.Jobs[id] = m
 		if match {
 			break
 		}
@@ -1300,9 +1438,30 @@ func (m *batchJobMetrics) delete(jobID string) {
 	m.Lock()
 	defer m.Unlock()
 
+	if ri, ok := m.metrics[jobID]; ok {
+		job := ri.clusterJob()
+		if !job.State.finished() {
+			// job was removed before it could finish.
+			job.State = ClusterJobCanceled
+			job.EndTime = UTCNow()
+		}
+		go globalClusterJobsHistory.record(job)
+	}
 	delete(m.metrics, jobID)
 }
 
+// status returns a copy of the in-memory status of the job.
+func (m *batchJobMetrics) status(jobID string) (*batchJobInfo, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	ri, ok := m.metrics[jobID]
+	if !ok {
+		return nil, false
+	}
+	return ri.clone(), true
+}
+
 func (m *batchJobMetrics) save(jobID string, ri *batchJobInfo) {
 	m.Lock()
 	defer m.Unlock()
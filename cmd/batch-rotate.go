@@ -0,0 +1,521 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio/internal/bucket/lifecycle"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/etag"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/minio/internal/workers"
+	"github.com/minio/pkg/env"
+)
+
+// keyrotate:
+//   apiVersion: v1
+//   bucket: "mybucket"
+//   prefix: "myprefix/"
+//   encryption:
+//     type: "ssec"
+//     # one or more SSE-C keys, base64 encoded, and the key
+//     # each must be rotated to
+//     keys:
+//       - oldKey: "<old-key>"
+//         newKey: "<new-key>"
+//
+//   # optional flags based filtering criteria
+//   # for all objects, notification and retries
+//   # as for replicate jobs
+//   flags:
+//     filter:
+//       newerThan: "7d"
+//     notify:
+//       endpoint: "https://splunk-hec.dev.com"
+//       token: "Splunk ..." # e.g. "Bearer token"
+//     retry:
+//       attempts: 10
+//       delay: "500ms"
+
+// BatchKeyRotationType defines the encryption of the objects rotated
+// by a key rotation job.
+type BatchKeyRotationType string
+
+// Supported key rotation types.
+const (
+	BatchKeyRotateSSEC BatchKeyRotationType = "ssec"
+)
+
+// BatchJobKeyRotateKeys maps an SSE-C key to the key it must be rotated
+// to. The keys are only accepted when the job is started and are kept
+// in memory, the job definition persists their MD5 digests.
+type BatchJobKeyRotateKeys struct {
+	OldKey    string `yaml:"oldKey,omitempty" json:"-" msg:"-"`
+	NewKey    string `yaml:"newKey,omitempty" json:"-" msg:"-"`
+	OldKeyMD5 string `yaml:"oldKeyMD5,omitempty" json:"oldKeyMD5" msg:"okm"`
+	NewKeyMD5 string `yaml:"newKeyMD5,omitempty" json:"newKeyMD5" msg:"nkm"`
+
+	oldKey, newKey []byte `msg:"-"`
+}
+
+// BatchJobKeyRotateEncryption defines the keys of a key rotation job.
+type BatchJobKeyRotateEncryption struct {
+	Type BatchKeyRotationType    `yaml:"type" json:"type"`
+	Keys []BatchJobKeyRotateKeys `yaml:"keys" json:"keys"`
+}
+
+// BatchJobKeyRotateFlags various configurations for key rotation job
+// definition, same as for replication jobs.
+type BatchJobKeyRotateFlags struct {
+	Filter BatchReplicateFilter       `yaml:"filter" json:"filter"`
+	Notify BatchReplicateNotification `yaml:"notify" json:"notify"`
+	Retry  BatchReplicateRetry        `yaml:"retry" json:"retry"`
+}
+
+// BatchJobKeyRotateV1 v1 of batch key rotation job
+type BatchJobKeyRotateV1 struct {
+	APIVersion string                      `yaml:"apiVersion" json:"apiVersion"`
+	Flags      BatchJobKeyRotateFlags      `yaml:"flags" json:"flags"`
+	Bucket     string                      `yaml:"bucket" json:"bucket"`
+	Prefix     string                      `yaml:"prefix" json:"prefix"`
+	Encryption BatchJobKeyRotateEncryption `yaml:"encryption" json:"encryption"`
+}
+
+var (
+	errBatchKeyRotateNoKey        = errors.New("none of the old keys of the job decrypts the object")
+	errBatchKeyRotateLocked       = errors.New("object is under retention or legal hold")
+	errBatchKeyRotateTransitioned = errors.New("object is transitioned to a remote tier")
+	errBatchKeyRotateMismatch     = errors.New("checksum of the re-encrypted object does not match")
+)
+
+// errBatchKeyRotateKeysMissing is returned when a key rotation job is
+// resumed after a restart, the keys are only kept in memory.
+type errBatchKeyRotateKeysMissing struct{ jobID string }
+
+func (e errBatchKeyRotateKeysMissing) Error() string {
+	return fmt.Sprintf("batch key rotation job %s cannot resume, its keys are only kept in memory: cancel the job and start it again, objects already rotated are skipped", e.jobID)
+}
+
+// Notify notifies notification endpoint if configured regarding job failure or success.
+func (r BatchJobKeyRotateV1) Notify(ctx context.Context, body io.Reader) error {
+	return r.Flags.Notify.send(ctx, body)
+}
+
+func decodeSSECKey(key string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(b) != 32 {
+		return nil, crypto.ErrInvalidCustomerKey
+	}
+	return b, nil
+}
+
+func ssecKeyMD5(key []byte) string {
+	sum := md5.Sum(key)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// ssecHeader returns the request headers to read an object with the
+// SSE-C key.
+func ssecHeader(key []byte) http.Header {
+	h := http.Header{}
+	h.Set(xhttp.AmzServerSideEncryptionCustomerAlgorithm, xhttp.AmzEncryptionAES)
+	h.Set(xhttp.AmzServerSideEncryptionCustomerKey, base64.StdEncoding.EncodeToString(key))
+	h.Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, ssecKeyMD5(key))
+	return h
+}
+
+// keysFor returns the key the object is encrypted with and the key it
+// must be rotated to. Both are nil if the object is already encrypted
+// with a new key.
+func (r *BatchJobKeyRotateV1) keysFor(bucket, object string, metadata map[string]string) (oldKey, newKey []byte, err error) {
+	for _, k := range r.Encryption.Keys {
+		if _, err = decryptObjectMeta(k.oldKey, bucket, object, metadata); err == nil {
+			return k.oldKey, k.newKey, nil
+		}
+	}
+	for _, k := range r.Encryption.Keys {
+		if _, err = decryptObjectMeta(k.newKey, bucket, object, metadata); err == nil {
+			return nil, nil, nil
+		}
+	}
+	return nil, nil, errBatchKeyRotateNoKey
+}
+
+// KeyRotate re-encrypts an SSE-C object version with the new key mapped
+// to the key it is encrypted with. The version keeps its version ID,
+// modification time, metadata and tags. The data is read back with the
+// new key and its checksum compared to the data read with the old key.
+// Returns false if the version is already encrypted with a new key.
+func (r *BatchJobKeyRotateV1) KeyRotate(ctx context.Context, api ObjectLayer, objInfo ObjectInfo) (rotated bool, err error) {
+	bucket, object := r.Bucket, objInfo.Name
+
+	oldKey, newKey, err := r.keysFor(bucket, object, objInfo.UserDefined)
+	if err != nil || oldKey == nil {
+		return false, err
+	}
+	if objInfo.TransitionedObject.Status == lifecycle.TransitionComplete {
+		return false, errBatchKeyRotateTransitioned
+	}
+	if enforceRetentionForDeletion(ctx, objInfo) {
+		return false, errBatchKeyRotateLocked
+	}
+
+	versionID := objInfo.VersionID
+	if versionID == "" {
+		versionID = nullVersionID
+	}
+
+	gr, err := api.GetObjectNInfo(ctx, bucket, object, nil, ssecHeader(oldKey), noLock, ObjectOptions{VersionID: versionID})
+	if err != nil {
+		return false, err
+	}
+	defer gr.Close()
+	srcInfo := gr.ObjInfo
+
+	actualSize, err := srcInfo.GetActualSize()
+	if err != nil {
+		return false, err
+	}
+
+	srcSum := sha256.New()
+	hr, err := hash.NewReader(io.TeeReader(gr, srcSum), actualSize, "", "", actualSize)
+	if err != nil {
+		return false, err
+	}
+
+	metadata := cloneMSS(srcInfo.UserDefined)
+	crypto.RemoveInternalEntries(metadata)
+	delete(metadata, ReservedMetadataPrefix+"compression")
+	delete(metadata, ReservedMetadataPrefix+"actual-size")
+	if srcInfo.UserTags != "" {
+		metadata[xhttp.AmzObjectTagging] = srcInfo.UserTags
+	}
+
+	encReader, objEncKey, err := newEncryptReader(ctx, hr, crypto.SSEC, "", newKey, bucket, object, metadata, nil)
+	if err != nil {
+		return false, err
+	}
+	encSize := (&ObjectInfo{Size: actualSize}).EncryptedSize()
+	encHr, err := hash.NewReader(etag.Wrap(encReader, hr), encSize, "", "", actualSize)
+	if err != nil {
+		return false, err
+	}
+	pReader, err := NewPutObjReader(hr).WithEncryption(encHr, &objEncKey)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = api.PutObject(ctx, bucket, object, pReader, ObjectOptions{
+		VersionID:        versionID,
+		Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, object),
+		VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, object),
+		MTime:            srcInfo.ModTime,
+		UserDefined:      metadata,
+		CheckPrecondFn: func(oi ObjectInfo) bool {
+			// the version was overwritten since it was read, the
+			// stored ETag is sealed so compare the modification time.
+			return !oi.ModTime.Equal(srcInfo.ModTime)
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// Verify the data end to end, decrypting it with the new key.
+	vr, err := api.GetObjectNInfo(ctx, bucket, object, nil, ssecHeader(newKey), readLock, ObjectOptions{VersionID: versionID})
+	if err != nil {
+		return true, err
+	}
+	defer vr.Close()
+	dstSum := sha256.New()
+	if _, err = io.Copy(dstSum, vr); err != nil {
+		return true, err
+	}
+	if !bytes.Equal(srcSum.Sum(nil), dstSum.Sum(nil)) {
+		return true, errBatchKeyRotateMismatch
+	}
+	return true, nil
+}
+
+// Start the batch key rotation job, resumes if there was a pending job via "job.ID"
+func (r *BatchJobKeyRotateV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	for i := range r.Encryption.Keys {
+		if r.Encryption.Keys[i].oldKey == nil {
+			return errBatchKeyRotateKeysMissing{jobID: job.ID}
+		}
+	}
+
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.load(ctx, api, job); err != nil {
+		return err
+	}
+	globalBatchJobsMetrics.save(job.ID, ri)
+	lastObject := ri.Object
+
+	delay := r.Flags.Retry.Delay
+	if delay == 0 {
+		delay = batchReplJobDefaultRetryDelay
+	}
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	filter := func(info FileInfo) bool {
+		if info.Deleted || !crypto.SSEC.IsEncrypted(info.Metadata) {
+			return false
+		}
+		return r.Flags.Filter.Match(info)
+	}
+
+	workerSize, err := strconv.Atoi(env.Get("_MINIO_BATCH_KEYROTATION_WORKERS", strconv.Itoa(runtime.GOMAXPROCS(0)/2)))
+	if err != nil {
+		return err
+	}
+
+	wk, err := workers.New(workerSize)
+	if err != nil {
+		// invalid worker size.
+		return err
+	}
+
+	retryAttempts := ri.RetryAttempts
+	for attempts := 1; attempts <= retryAttempts; attempts++ {
+		attempts := attempts
+
+		ctx, cancel := context.WithCancel(ctx)
+
+		results := make(chan ObjectInfo, 100)
+		if err := api.Walk(ctx, r.Bucket, r.Prefix, results, ObjectOptions{
+			WalkMarker: lastObject,
+			WalkFilter: filter,
+		}); err != nil {
+			cancel()
+			// Do not need to retry if we can't list objects on source.
+			return err
+		}
+
+		for result := range results {
+			result := result
+			globalGuardrail.throttleLowPriority(ctx)
+			wk.Take()
+			go func() {
+				defer wk.Give()
+
+				stopFn := globalBatchJobsMetrics.traceKeyRotation(batchKeyRotationMetricObject, job.ID, attempts, result)
+				rotated, err := r.KeyRotate(ctx, api, result)
+				stopFn(err)
+				switch {
+				case err == nil && !rotated:
+					// already encrypted with a new key.
+					return
+				case isErrVersionNotFound(err), isErrObjectNotFound(err), isErrPreconditionFailed(err):
+					// object must be deleted or overwritten concurrently,
+					// allow these failures but do not count them
+					return
+				case err != nil:
+					logger.LogIf(ctx, fmt.Errorf("batch key rotation of %s/%s (%s) failed: %w", r.Bucket, result.Name, result.VersionID, err))
+					ri.trackFailure(r.Bucket, result, err)
+				}
+				ri.trackCurrentBucketObject(r.Bucket, result, err == nil)
+				globalBatchJobsMetrics.save(job.ID, ri)
+				// persist in-memory state to disk after every 10secs.
+				logger.LogIf(ctx, ri.updateAfter(ctx, api, 10*time.Second, job.Location))
+			}()
+		}
+		wk.Wait()
+
+		ri.RetryAttempts = attempts
+		ri.Complete = ri.ObjectsFailed == 0
+		ri.Failed = ri.ObjectsFailed > 0
+
+		globalBatchJobsMetrics.save(job.ID, ri)
+		// persist in-memory state to disk.
+		logger.LogIf(ctx, ri.updateAfter(ctx, api, 0, job.Location))
+
+		buf, _ := json.Marshal(ri.clone())
+		if err := r.Notify(ctx, bytes.NewReader(buf)); err != nil {
+			logger.LogIf(ctx, fmt.Errorf("Unable to notify %v", err))
+		}
+
+		cancel()
+		if ri.Failed && attempts < retryAttempts {
+			ri.ObjectsFailed = 0
+			ri.Bucket = ""
+			ri.Object = ""
+			ri.Objects = 0
+			ri.BytesFailed = 0
+			ri.BytesTransferred = 0
+			ri.Failures = nil
+			time.Sleep(delay + time.Duration(rnd.Float64()*float64(delay)))
+			continue
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// Validate validates the job definition input and decodes its keys.
+func (r *BatchJobKeyRotateV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.APIVersion != batchReplJobAPIVersion {
+		return errInvalidArgument
+	}
+
+	if r.Bucket == "" {
+		return errInvalidArgument
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchReplicationJobError{
+				Code:           "NoSuchSourceBucket",
+				Description:    "The specified bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	if r.Encryption.Type != BatchKeyRotateSSEC {
+		return batchReplicationJobError{
+			Code:           "NotImplemented",
+			Description:    fmt.Sprintf("Key rotation of '%s' encrypted objects is not supported", r.Encryption.Type),
+			HTTPStatusCode: http.StatusNotImplemented,
+		}
+	}
+
+	if len(r.Encryption.Keys) == 0 {
+		return batchReplicationJobError{
+			Code:           "InvalidArgument",
+			Description:    "At least one old and new key is required",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	for i := range r.Encryption.Keys {
+		k := &r.Encryption.Keys[i]
+		oldKey, err := decodeSSECKey(k.OldKey)
+		if err != nil {
+			return err
+		}
+		newKey, err := decodeSSECKey(k.NewKey)
+		if err != nil {
+			return err
+		}
+		if subtle.ConstantTimeCompare(oldKey, newKey) == 1 {
+			return batchReplicationJobError{
+				Code:           "InvalidArgument",
+				Description:    "The old and new keys must differ",
+				HTTPStatusCode: http.StatusBadRequest,
+			}
+		}
+		k.oldKey, k.newKey = oldKey, newKey
+		k.OldKeyMD5, k.NewKeyMD5 = ssecKeyMD5(oldKey), ssecKeyMD5(newKey)
+		// Only keep the decoded keys in memory.
+		k.OldKey, k.NewKey = "", ""
+	}
+	for i, k := range r.Encryption.Keys {
+		for _, other := range r.Encryption.Keys[i+1:] {
+			if k.OldKeyMD5 == other.OldKeyMD5 && k.NewKeyMD5 != other.NewKeyMD5 {
+				return batchReplicationJobError{
+					Code:           "InvalidArgument",
+					Description:    "An old key cannot be rotated to different new keys",
+					HTTPStatusCode: http.StatusBadRequest,
+				}
+			}
+		}
+	}
+
+	for _, tag := range r.Flags.Filter.Tags {
+		if err := tag.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, meta := range r.Flags.Filter.Metadata {
+		if err := meta.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return r.Flags.Retry.Validate()
+}
+
+//msgp:ignore batchKeyRotationMetric
+//go:generate stringer -type=batchKeyRotationMetric -trimprefix=batchKeyRotationMetric $GOFILE
+type batchKeyRotationMetric uint8
+
+const (
+	batchKeyRotationMetricObject batchKeyRotationMetric = iota
+)
+
+func batchKeyRotationTrace(d batchKeyRotationMetric, job string, startTime time.Time, duration time.Duration, info ObjectInfo, attempts int, err error) madmin.TraceInfo {
+	var errStr string
+	if err != nil {
+		errStr = err.Error()
+	}
+	funcName := fmt.Sprintf("batchKeyRotation.%s (job-name=%s)", d.String(), job)
+	if attempts > 0 {
+		funcName = fmt.Sprintf("batchKeyRotation.%s (job-name=%s,attempts=%s)", d.String(), job, humanize.Ordinal(attempts))
+	}
+	return madmin.TraceInfo{
+		TraceType: madmin.TraceBatchKeyRotation,
+		Time:      startTime,
+		NodeName:  globalLocalNodeName,
+		FuncName:  funcName,
+		Duration:  duration,
+		Path:      info.Name,
+		Error:     errStr,
+	}
+}
+
+func (m *batchJobMetrics) traceKeyRotation(d batchKeyRotationMetric, job string, attempts int, info ObjectInfo) func(err error) {
+	startTime := time.Now()
+	return func(err error) {
+		duration := time.Since(startTime)
+		if globalTrace.NumSubscribers(madmin.TraceBatchKeyRotation) > 0 {
+			globalTrace.Publish(batchKeyRotationTrace(d, job, startTime, duration, info, attempts, err))
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/etag"
+	"github.com/minio/minio/internal/hash"
+)
+
+func putSSECObject(ctx context.Context, t *testing.T, obj ObjectLayer, bucket, object string, data, key []byte) ObjectInfo {
+	t.Helper()
+
+	size := int64(len(data))
+	hr, err := hash.NewReader(bytes.NewReader(data), size, "", "", size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata := map[string]string{}
+	enc, objKey, err := newEncryptReader(ctx, hr, crypto.SSEC, "", key, bucket, object, metadata, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ehr, err := hash.NewReader(etag.Wrap(enc, hr), (&ObjectInfo{Size: size}).EncryptedSize(), "", "", size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pReader, err := NewPutObjReader(hr).WithEncryption(ehr, &objKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := obj.PutObject(ctx, bucket, object, pReader, ObjectOptions{Versioned: true, UserDefined: metadata})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info
+}
+
+func readSSECObject(ctx context.Context, obj ObjectLayer, bucket, object, versionID string, key []byte) ([]byte, error) {
+	gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, ssecHeader(key), readLock, ObjectOptions{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+	return io.ReadAll(gr)
+}
+
+func newSSECKey(t *testing.T) []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestBatchJobKeyRotate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+	initConfigSubsystem(ctx, obj)
+
+	bucket := "rotate"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{VersioningEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	oldKey, newKey, otherKey := newSSECKey(t), newSSECKey(t), newSSECKey(t)
+	job := BatchJobRequest{ID: "rotate-job"}
+	job.KeyRotate = &BatchJobKeyRotateV1{
+		APIVersion: batchReplJobAPIVersion,
+		Bucket:     bucket,
+		Encryption: BatchJobKeyRotateEncryption{
+			Type: BatchKeyRotateSSEC,
+			Keys: []BatchJobKeyRotateKeys{{
+				OldKey: base64.StdEncoding.EncodeToString(oldKey),
+				NewKey: base64.StdEncoding.EncodeToString(newKey),
+			}},
+		},
+	}
+	r := job.KeyRotate
+	if err = job.Validate(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if k := r.Encryption.Keys[0]; k.OldKey != "" || k.NewKey != "" || k.OldKeyMD5 != ssecKeyMD5(oldKey) || k.NewKeyMD5 != ssecKeyMD5(newKey) {
+		t.Fatalf("unexpected keys after validation: %+v", k)
+	}
+
+	data := make([]byte, 1<<20+17)
+	if _, err = rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+	info := putSSECObject(ctx, t, obj, bucket, "object", data, oldKey)
+
+	rotated, err := r.KeyRotate(ctx, obj, info)
+	if err != nil || !rotated {
+		t.Fatalf("expected object to be rotated: %v %v", rotated, err)
+	}
+
+	got, err := readSSECObject(ctx, obj, bucket, "object", info.VersionID, newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("data read with the new key does not match")
+	}
+	if _, err = readSSECObject(ctx, obj, bucket, "object", info.VersionID, oldKey); err == nil {
+		t.Fatal("expected the old key to be rejected")
+	}
+
+	rinfo, err := obj.GetObjectInfo(ctx, bucket, "object", ObjectOptions{VersionID: info.VersionID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rinfo.ModTime.Equal(info.ModTime) {
+		t.Fatalf("expected modification time %v, got %v", info.ModTime, rinfo.ModTime)
+	}
+
+	// An object already rotated is skipped.
+	if rotated, err = r.KeyRotate(ctx, obj, rinfo); err != nil || rotated {
+		t.Fatalf("expected object to be skipped: %v %v", rotated, err)
+	}
+
+	// An object encrypted with an unknown key fails.
+	info = putSSECObject(ctx, t, obj, bucket, "other", data, otherKey)
+	if _, err = r.KeyRotate(ctx, obj, info); !errors.Is(err, errBatchKeyRotateNoKey) {
+		t.Fatalf("expected %v, got %v", errBatchKeyRotateNoKey, err)
+	}
+}
+
+func TestBatchJobKeyRotateValidate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "rotate"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	key := base64.StdEncoding.EncodeToString(newSSECKey(t))
+	other := base64.StdEncoding.EncodeToString(newSSECKey(t))
+	third := base64.StdEncoding.EncodeToString(newSSECKey(t))
+
+	testCases := []struct {
+		name    string
+		bucket  string
+		typ     BatchKeyRotationType
+		keys    []BatchJobKeyRotateKeys
+		success bool
+	}{
+		{"valid", bucket, BatchKeyRotateSSEC, []BatchJobKeyRotateKeys{{OldKey: key, NewKey: other}}, true},
+		{"no such bucket", "missing", BatchKeyRotateSSEC, []BatchJobKeyRotateKeys{{OldKey: key, NewKey: other}}, false},
+		{"unsupported type", bucket, "sses3", []BatchJobKeyRotateKeys{{OldKey: key, NewKey: other}}, false},
+		{"no keys", bucket, BatchKeyRotateSSEC, nil, false},
+		{"invalid key", bucket, BatchKeyRotateSSEC, []BatchJobKeyRotateKeys{{OldKey: "c2hvcnQ=", NewKey: other}}, false},
+		{"same keys", bucket, BatchKeyRotateSSEC, []BatchJobKeyRotateKeys{{OldKey: key, NewKey: key}}, false},
+		{"conflicting keys", bucket, BatchKeyRotateSSEC, []BatchJobKeyRotateKeys{{OldKey: key, NewKey: other}, {OldKey: key, NewKey: third}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			job := BatchJobRequest{KeyRotate: &BatchJobKeyRotateV1{
+				APIVersion: batchReplJobAPIVersion,
+				Bucket:     tc.bucket,
+				Encryption: BatchJobKeyRotateEncryption{Type: tc.typ, Keys: tc.keys},
+			}}
+			if err := job.Validate(ctx, obj); (err == nil) != tc.success {
+				t.Fatalf("expected success %v, got %v", tc.success, err)
+			}
+		})
+	}
+}
@@ -77,6 +77,13 @@ func (bs *bootstrapTracer) Record(msg string) {
 }
 
 func (bs *bootstrapTracer) Events() []madmin.TraceInfo {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+	return bs.events()
+}
+
+// events must be called with bs.mu held.
+func (bs *bootstrapTracer) events() []madmin.TraceInfo {
 	traceInfo := make([]madmin.TraceInfo, 0, bootstrapMsgsLimit)
 
 	// Add all messages in order
@@ -95,13 +102,29 @@ func (bs *bootstrapTracer) Events() []madmin.TraceInfo {
 		}
 	}
 
-	bs.mu.RLock()
 	addAll(bs.info[bs.idx:])
 	addAll(bs.info[:bs.idx])
-	bs.mu.RUnlock()
 	return traceInfo
 }
 
+// Timeline returns the recorded events, oldest first, the duration of
+// each event is the time until the next one was recorded. With clear
+// the recorded events are dropped.
+func (bs *bootstrapTracer) Timeline(clear bool) []madmin.TraceInfo {
+	bs.mu.Lock()
+	events := bs.events()
+	if clear {
+		bs.info = [bootstrapMsgsLimit]bootstrapInfo{}
+		bs.idx = 0
+	}
+	bs.mu.Unlock()
+
+	for i := 1; i < len(events); i++ {
+		events[i-1].Duration = events[i].Time.Sub(events[i-1].Time)
+	}
+	return events
+}
+
 func (bs *bootstrapTracer) Publish(ctx context.Context, trace *pubsub.PubSub[madmin.TraceInfo, madmin.TraceType]) {
 	if bs.Empty() {
 		return
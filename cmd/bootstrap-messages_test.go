@@ -58,3 +58,30 @@ func TestBootstrap(t *testing.T) {
 		}
 	}
 }
+
+func TestBootstrapTimeline(t *testing.T) {
+	bsTracer := &bootstrapTracer{}
+	for i := 0; i < 3; i++ {
+		bsTracer.Record(fmt.Sprintf("msg-%d", i))
+	}
+
+	events := bsTracer.Timeline(false)
+	if len(events) != 3 {
+		t.Fatalf("Expected length of events %d but got %d", 3, len(events))
+	}
+	for i := 0; i < len(events)-1; i++ {
+		if want := events[i+1].Time.Sub(events[i].Time); events[i].Duration != want {
+			t.Fatalf("Expected duration %s but got %s", want, events[i].Duration)
+		}
+	}
+	if events[2].Duration != 0 {
+		t.Fatalf("Expected no duration for the last event but got %s", events[2].Duration)
+	}
+
+	if len(bsTracer.Timeline(true)) != 3 {
+		t.Fatal("Expected events to be returned before being cleared")
+	}
+	if !bsTracer.Empty() {
+		t.Fatalf("Expected all bootstrap events to have been cleared, but found %d events", len(bsTracer.Events()))
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/internal/amztime"
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+	"github.com/minio/minio/internal/bucket/replication"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+)
+
+// Maximum active retention, same as the maximum default retention of
+// the object lock configuration.
+const maxActiveRetentionDays = 36500
+
+var errActiveRetentionUpToDate = errors.New("retention is already extended")
+
+// BucketActiveRetentionConfig - per bucket settings of the active
+// retention extension, reading an object under retention extends its
+// retain-until date so that objects are kept while in use.
+type BucketActiveRetentionConfig struct {
+	Enabled bool `json:"enabled"`
+	// Days is the retention of an object from its last access.
+	Days int `json:"days"`
+}
+
+// parseBucketActiveRetentionConfig parses BucketActiveRetentionConfig from json
+func parseBucketActiveRetentionConfig(data []byte) (*BucketActiveRetentionConfig, error) {
+	cfg := &BucketActiveRetentionConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return cfg, err
+	}
+	if cfg.Days < 0 || cfg.Days > maxActiveRetentionDays || (cfg.Enabled && cfg.Days == 0) {
+		return cfg, fmt.Errorf("days must be between 1 and %d", maxActiveRetentionDays)
+	}
+	return cfg, nil
+}
+
+// activeRetainUntil returns the retain-until date of an object accessed
+// at the given time. It is rounded up to the next day, so that an object
+// accessed many times is updated at most once a day.
+func (c BucketActiveRetentionConfig) activeRetainUntil(now time.Time) time.Time {
+	day := 24 * time.Hour
+	return now.Add(time.Duration(c.Days)*day + day - 1).Truncate(day)
+}
+
+// activeRetentionExtension returns the retain-until date an object
+// under retention must be extended to when accessed at the given time,
+// and false if it doesn't need to be extended.
+func activeRetentionExtension(cfg BucketActiveRetentionConfig, objInfo ObjectInfo, now time.Time) (time.Time, bool) {
+	if !cfg.Enabled || objInfo.DeleteMarker {
+		return time.Time{}, false
+	}
+	ret := objectlock.GetObjectRetentionMeta(objInfo.UserDefined)
+	if !ret.Mode.Valid() {
+		return time.Time{}, false
+	}
+	until := cfg.activeRetainUntil(now)
+	// Retention can only be extended, in particular in COMPLIANCE mode.
+	if !until.After(ret.RetainUntilDate.Time) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// extendActiveRetention extends the retention of an object accessed
+// in a bucket with active retention enabled.
+func extendActiveRetention(ctx context.Context, objAPI ObjectLayer, bucket string, cfg BucketActiveRetentionConfig, objInfo ObjectInfo) {
+	now, err := objectlock.UTCNowNTP()
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	if _, ok := activeRetentionExtension(cfg, objInfo, now); !ok {
+		return
+	}
+
+	// Evaluated again under the write lock of the object, it may
+	// have been extended meanwhile.
+	opts := ObjectOptions{
+		VersionID: objInfo.VersionID,
+		EvalMetadataFn: func(oi *ObjectInfo) error {
+			until, ok := activeRetentionExtension(cfg, *oi, now)
+			if !ok {
+				return errActiveRetentionUpToDate
+			}
+			oi.UserDefined[strings.ToLower(xhttp.AmzObjectLockRetainUntilDate)] = amztime.ISO8601Format(until)
+			oi.UserDefined[ReservedMetadataPrefixLower+ObjectLockRetentionTimestamp] = UTCNow().Format(time.RFC3339Nano)
+			dsc := mustReplicate(ctx, bucket, oi.Name, getMustReplicateOptions(*oi, replication.MetadataReplicationType, ObjectOptions{}))
+			if dsc.ReplicateAny() {
+				oi.UserDefined[ReservedMetadataPrefixLower+ReplicationTimestamp] = UTCNow().Format(time.RFC3339Nano)
+				oi.UserDefined[ReservedMetadataPrefixLower+ReplicationStatus] = dsc.PendingStatus()
+			}
+			return nil
+		},
+	}
+	objInfo, err = objAPI.PutObjectMetadata(ctx, bucket, objInfo.Name, opts)
+	if err != nil {
+		if !errors.Is(err, errActiveRetentionUpToDate) && !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
+			logger.LogIf(ctx, err)
+		}
+		return
+	}
+
+	dsc := mustReplicate(ctx, bucket, objInfo.Name, getMustReplicateOptions(objInfo, replication.MetadataReplicationType, ObjectOptions{}))
+	if dsc.ReplicateAny() {
+		scheduleReplication(ctx, objInfo.Clone(), objAPI, dsc, replication.MetadataReplicationType)
+	}
+}
+
+// maybeExtendActiveRetention extends the retention of an accessed object
+// in the background, if due.
+func maybeExtendActiveRetention(objAPI ObjectLayer, bucket string, objInfo ObjectInfo) {
+	cfg, _, err := globalBucketMetadataSys.GetActiveRetentionConfig(GlobalContext, bucket)
+	if err != nil || !cfg.Enabled {
+		return
+	}
+	if _, ok := activeRetentionExtension(*cfg, objInfo, UTCNow()); !ok {
+		return
+	}
+	go extendActiveRetention(GlobalContext, objAPI, bucket, *cfg, objInfo.Clone())
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/internal/amztime"
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func TestParseBucketActiveRetentionConfig(t *testing.T) {
+	cfg, err := parseBucketActiveRetentionConfig([]byte(`{"enabled":true,"days":30}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Enabled || cfg.Days != 30 {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+	for _, data := range []string{`{"enabled":true}`, `{"enabled":true,"days":-1}`, `{"enabled":true,"days":36501}`} {
+		if _, err = parseBucketActiveRetentionConfig([]byte(data)); err == nil {
+			t.Fatalf("expected %s to fail", data)
+		}
+	}
+}
+
+func TestActiveRetentionExtension(t *testing.T) {
+	now := time.Date(2023, 5, 10, 15, 30, 0, 0, time.UTC)
+	cfg := BucketActiveRetentionConfig{Enabled: true, Days: 30}
+	objInfo := func(mode string, until time.Time) ObjectInfo {
+		return ObjectInfo{UserDefined: map[string]string{
+			strings.ToLower(xhttp.AmzObjectLockMode):            mode,
+			strings.ToLower(xhttp.AmzObjectLockRetainUntilDate): amztime.ISO8601Format(until),
+		}}
+	}
+	expected := time.Date(2023, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		cfg     BucketActiveRetentionConfig
+		objInfo ObjectInfo
+		extend  bool
+	}{
+		// retention ending before the active retention is extended.
+		{cfg, objInfo("COMPLIANCE", now.Add(time.Hour)), true},
+		{cfg, objInfo("GOVERNANCE", now.Add(-time.Hour)), true},
+		// already extended today.
+		{cfg, objInfo("COMPLIANCE", expected), false},
+		// retention is never shortened.
+		{cfg, objInfo("COMPLIANCE", now.Add(365*24*time.Hour)), false},
+		// objects without retention are not locked on access.
+		{cfg, ObjectInfo{UserDefined: map[string]string{}}, false},
+		{cfg, ObjectInfo{DeleteMarker: true}, false},
+		{BucketActiveRetentionConfig{}, objInfo("COMPLIANCE", now), false},
+	}
+	for i, tc := range testCases {
+		until, ok := activeRetentionExtension(tc.cfg, tc.objInfo, now)
+		if ok != tc.extend {
+			t.Fatalf("case %d: expected extension %v, got %v", i+1, tc.extend, ok)
+		}
+		if ok && !until.Equal(expected) {
+			t.Fatalf("case %d: expected retention until %v, got %v", i+1, expected, until)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/minio/minio/internal/crypto"
+)
+
+const (
+	// Internal metadata set on objects created with the append
+	// extension, only these objects can be appended to.
+	objectAppendKey = ReservedMetadataPrefixLower + "append"
+
+	// Maximum number of segments of an appended object when none is
+	// configured, same as the maximum number of parts of an upload.
+	defaultAppendMaxSegments = globalMaxPartID
+)
+
+// BucketAppendConfig - per bucket settings of the object append
+// extension, a PUT with "x-minio-append: true" appends its body to
+// the object as a new segment.
+type BucketAppendConfig struct {
+	Enabled bool `json:"enabled"`
+	// MaxSegments is the maximum number of segments of an object,
+	// defaults to defaultAppendMaxSegments.
+	MaxSegments int `json:"maxSegments,omitempty"`
+}
+
+// maxSegments returns the maximum number of segments of an object.
+func (c BucketAppendConfig) maxSegments() int {
+	if c.MaxSegments <= 0 {
+		return defaultAppendMaxSegments
+	}
+	return c.MaxSegments
+}
+
+// parseBucketAppendConfig parses BucketAppendConfig from json
+func parseBucketAppendConfig(data []byte) (*BucketAppendConfig, error) {
+	cfg := &BucketAppendConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return cfg, err
+	}
+	if cfg.MaxSegments < 0 || cfg.MaxSegments > globalMaxPartID {
+		return cfg, fmt.Errorf("maxSegments must be between 1 and %d", globalMaxPartID)
+	}
+	return cfg, nil
+}
+
+// bucketAppendConfig returns the append configuration of the bucket,
+// appends are disabled if it can't be loaded.
+func bucketAppendConfig(ctx context.Context, bucket string) BucketAppendConfig {
+	cfg, _, err := globalBucketMetadataSys.GetAppendConfig(ctx, bucket)
+	if err != nil {
+		return BucketAppendConfig{}
+	}
+	return *cfg
+}
+
+// isAppendObject returns true if the object version was created with
+// the append extension.
+func isAppendObject(meta map[string]string) bool {
+	return meta[objectAppendKey] == "true"
+}
+
+// checkAppendObjectAllowed returns an error if the PUT can't append to
+// the objects of the bucket.
+func checkAppendObjectAllowed(ctx context.Context, r *http.Request, bucket string, cfg BucketAppendConfig) error {
+	if !cfg.Enabled {
+		return errors.New("append is not enabled on the bucket")
+	}
+	if isReplicationEnabled(ctx, bucket) {
+		return errors.New("append is not supported on buckets with replication configured")
+	}
+	if crypto.Requested(r.Header) {
+		return errors.New("append is not supported on encrypted objects")
+	}
+	return nil
+}
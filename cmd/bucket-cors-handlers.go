@@ -0,0 +1,264 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio/internal/bucket/cors"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/mux"
+	"github.com/minio/pkg/bucket/policy"
+)
+
+const (
+	// Bucket CORS configuration file name.
+	bucketCorsConfig = "cors.xml"
+)
+
+// errCorsForbidden is returned for preflight requests not allowed by
+// the CORS configuration of a bucket.
+var errCorsForbidden = APIError{
+	Code:           "AccessForbidden",
+	Description:    "CORSResponse: This CORS request is not allowed. This is usually because the evalution of Origin, request method / Access-Control-Request-Method or Access-Control-Request-Headers are not whitelisted by the resource's CORS spec.",
+	HTTPStatusCode: http.StatusForbidden,
+}
+
+// PutBucketCorsHandler - Stores given bucket CORS configuration
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+func (api objectAPIHandlers) PutBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketCors")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	if globalAPIConfig.isStrictBucketConfig() {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	objAPI := api.ObjectAPI()
+	if objAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	// There is no CORS specific policy action, the bucket
+	// policy action is re-purposed.
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketPolicyAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	config, err := cors.ParseConfig(io.LimitReader(r.Body, maxBucketCorsConfigSize))
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketCorsConfig, configData); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketCorsHandler - Returns bucket CORS configuration
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketCors.html
+func (api objectAPIHandlers) GetBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketCors")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	if globalAPIConfig.isStrictBucketConfig() {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	objAPI := api.ObjectAPI()
+	if objAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	// There is no CORS specific policy action, the bucket
+	// policy action is re-purposed.
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketPolicyAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	config, _, err := globalBucketMetadataSys.GetCorsConfig(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, configData)
+}
+
+// DeleteBucketCorsHandler - Removes bucket CORS configuration
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketCors.html
+func (api objectAPIHandlers) DeleteBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketCors")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	if globalAPIConfig.isStrictBucketConfig() {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	objAPI := api.ObjectAPI()
+	if objAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	// There is no CORS specific policy action, the bucket
+	// policy action is re-purposed.
+	if s3Error := checkRequestAuthType(ctx, r, policy.DeleteBucketPolicyAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if _, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketCorsConfig); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Success.
+	writeSuccessNoContent(w)
+}
+
+// getRequestBucketCors returns the CORS configuration of the bucket
+// addressed by r, nil if the bucket has none.
+func getRequestBucketCors(r *http.Request) *cors.Config {
+	if globalBucketMetadataSys == nil || globalAPIConfig.isStrictBucketConfig() {
+		return nil
+	}
+	bucket, _ := request2BucketObjectName(r)
+	if bucket == "" || bucket == minioReservedBucket || isMinioMetaBucketName(bucket) {
+		return nil
+	}
+	// Only look at the loaded metadata, unauthenticated
+	// requests must not load metadata from the drives.
+	meta, err := globalBucketMetadataSys.Get(bucket)
+	if err != nil {
+		return nil
+	}
+	return meta.corsConfig
+}
+
+// bucketCorsHandler answers CORS requests to a bucket with a CORS
+// configuration, following S3: preflight requests are answered
+// directly, other requests get the CORS headers of the first rule
+// matching their origin and method.
+func bucketCorsHandler(w http.ResponseWriter, r *http.Request, config *cors.Config, handler http.Handler) {
+	origin := r.Header.Get(xhttp.Origin)
+	h := w.Header()
+	h.Add(xhttp.Vary, xhttp.Origin)
+
+	if r.Method == http.MethodOptions && r.Header.Get(xhttp.AccessControlRequestMethod) != "" {
+		h.Add(xhttp.Vary, xhttp.AccessControlRequestMethod)
+		h.Add(xhttp.Vary, xhttp.AccessControlRequestHeaders)
+
+		var headers []string
+		for _, v := range strings.Split(r.Header.Get(xhttp.AccessControlRequestHeaders), ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				headers = append(headers, strings.ToLower(v))
+			}
+		}
+		rule := config.Match(origin, r.Header.Get(xhttp.AccessControlRequestMethod), headers)
+		if rule == nil {
+			writeErrorResponse(r.Context(), w, errCorsForbidden, r.URL)
+			return
+		}
+		setCorsAllowHeaders(h, rule, origin)
+		if len(headers) > 0 {
+			h.Set(xhttp.AccessControlAllowHeaders, strings.Join(headers, ", "))
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if rule := config.Match(origin, r.Method, nil); rule != nil {
+		setCorsAllowHeaders(h, rule, origin)
+	}
+	handler.ServeHTTP(w, r)
+}
+
+func setCorsAllowHeaders(h http.Header, rule *cors.Rule, origin string) {
+	if rule.AllowsAnyOrigin() {
+		h.Set(xhttp.AccessControlAllowOrigin, "*")
+	} else {
+		h.Set(xhttp.AccessControlAllowOrigin, origin)
+		h.Set(xhttp.AccessControlAllowCredentials, "true")
+	}
+	h.Set(xhttp.AccessControlAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if len(rule.ExposeHeaders) > 0 {
+		h.Set(xhttp.AccessControlExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+	if rule.MaxAgeSeconds != nil {
+		h.Set(xhttp.AccessControlMaxAge, strconv.Itoa(*rule.MaxAgeSeconds))
+	}
+}
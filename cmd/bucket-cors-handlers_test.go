@@ -0,0 +1,148 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/bucket/cors"
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+// Wrapper for calling bucket CORS API handler tests for both Erasure multiple disks and single node setup.
+func TestBucketCorsHandlers(t *testing.T) {
+	ExecObjectLayerAPITest(t, testBucketCorsHandlers, []string{"PutBucketCors", "GetBucketCors", "DeleteBucketCors", "GetObject"})
+}
+
+func testBucketCorsHandlers(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	corsURL := makeTestTargetURL("", bucketName, "", url.Values{"cors": []string{""}})
+	do := func(method string, body []byte) *httptest.ResponseRecorder {
+		t.Helper()
+		req, err := newTestSignedRequestV4(method, corsURL, int64(len(body)), bytes.NewReader(body),
+			credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := do(http.MethodGet, nil); rec.Code != http.StatusNotFound {
+		t.Fatalf("%s: expected no CORS configuration, got %d", instanceType, rec.Code)
+	}
+	invalid := []byte(`<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>PATCH</AllowedMethod></CORSRule></CORSConfiguration>`)
+	if rec := do(http.MethodPut, invalid); rec.Code != http.StatusBadRequest {
+		t.Fatalf("%s: expected invalid CORS configuration to be rejected, got %d", instanceType, rec.Code)
+	}
+
+	config := []byte(`<CORSConfiguration>
+  <CORSRule>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedHeader>x-amz-*</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>600</MaxAgeSeconds>
+  </CORSRule>
+</CORSConfiguration>`)
+	if rec := do(http.MethodPut, config); rec.Code != http.StatusOK {
+		t.Fatalf("%s: unable to set CORS configuration: %d %s", instanceType, rec.Code, rec.Body.String())
+	}
+	rec := do(http.MethodGet, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("%s: unable to get CORS configuration: %d", instanceType, rec.Code)
+	}
+	var got cors.Config
+	if err := xml.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Rules) != 1 || got.Rules[0].AllowedOrigins[0] != "https://*.example.com" {
+		t.Fatalf("%s: unexpected CORS configuration %+v", instanceType, got)
+	}
+
+	handler := corsHandler(apiRouter)
+	objectURL := makeTestTargetURL("", bucketName, "object", url.Values{})
+	preflight := func(origin, method, headers string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodOptions, objectURL, nil)
+		req.Header.Set(xhttp.Origin, origin)
+		req.Header.Set(xhttp.AccessControlRequestMethod, method)
+		if headers != "" {
+			req.Header.Set(xhttp.AccessControlRequestHeaders, headers)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	origin := "https://app.example.com"
+	rec = preflight(origin, http.MethodPut, "X-Amz-Date, x-amz-content-sha256")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("%s: expected preflight to be allowed, got %d", instanceType, rec.Code)
+	}
+	for k, v := range map[string]string{
+		xhttp.AccessControlAllowOrigin:      origin,
+		xhttp.AccessControlAllowMethods:     "GET, PUT",
+		xhttp.AccessControlAllowHeaders:     "x-amz-date, x-amz-content-sha256",
+		xhttp.AccessControlAllowCredentials: "true",
+		xhttp.AccessControlMaxAge:           "600",
+	} {
+		if got := rec.Header().Get(k); got != v {
+			t.Errorf("%s: expected %s %q, got %q", instanceType, k, v, got)
+		}
+	}
+	for _, tc := range []struct{ origin, method, headers string }{
+		{origin: origin, method: http.MethodDelete},
+		{origin: origin, method: http.MethodPut, headers: "Authorization"},
+		{origin: "https://example.org", method: http.MethodGet},
+	} {
+		if rec = preflight(tc.origin, tc.method, tc.headers); rec.Code != http.StatusForbidden {
+			t.Errorf("%s: expected preflight %+v to be forbidden, got %d", instanceType, tc, rec.Code)
+		}
+	}
+
+	// Actual requests get the headers of the matching rule.
+	req, err := newTestSignedRequestV4(http.MethodGet, objectURL, 0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set(xhttp.Origin, origin)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Header().Get(xhttp.AccessControlAllowOrigin) != origin || rec.Header().Get(xhttp.AccessControlExposeHeaders) != "ETag" {
+		t.Errorf("%s: expected CORS headers on actual request, got %v", instanceType, rec.Header())
+	}
+
+	// Without a configuration the global CORS settings apply.
+	if rec = do(http.MethodDelete, nil); rec.Code != http.StatusNoContent {
+		t.Fatalf("%s: unable to delete CORS configuration: %d", instanceType, rec.Code)
+	}
+	rec = preflight("https://example.org", http.MethodGet, "")
+	if rec.Code == http.StatusForbidden || rec.Header().Get(xhttp.AccessControlAllowOrigin) == "" {
+		t.Fatalf("%s: expected global CORS settings to allow preflight, got %d %v", instanceType, rec.Code, rec.Header())
+	}
+}
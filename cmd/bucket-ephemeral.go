@@ -0,0 +1,49 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+)
+
+// BucketEphemeralConfig - per bucket settings for buckets holding
+// temporary data, such as caches, whose objects are not worth healing.
+type BucketEphemeralConfig struct {
+	// Enabled disables queuing partially written objects for healing
+	// (MRF) and healing objects found degraded when they are read.
+	Enabled bool `json:"enabled"`
+}
+
+// parseBucketEphemeralConfig parses BucketEphemeralConfig from json
+func parseBucketEphemeralConfig(data []byte) (*BucketEphemeralConfig, error) {
+	cfg := &BucketEphemeralConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// isBucketEphemeral returns true if the objects of bucket must not be
+// healed on writes and reads, the background scanner still heals them.
+func isBucketEphemeral(bucket string) bool {
+	if globalBucketMetadataSys == nil || isMinioMetaBucketName(bucket) {
+		return false
+	}
+	cfg, _, err := globalBucketMetadataSys.GetEphemeralConfig(GlobalContext, bucket)
+	return err == nil && cfg.Enabled
+}
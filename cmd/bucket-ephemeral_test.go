@@ -0,0 +1,75 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBucketEphemeralSkipsMRF(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	for _, bucket := range []string{"ephemeral", "durable"} {
+		if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	meta, err := loadBucketMetadata(ctx, obj, "ephemeral")
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta.ephemeralConfig, err = parseBucketEphemeralConfig([]byte(`{"enabled":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	globalBucketMetadataSys.Set("ephemeral", meta)
+
+	if !isBucketEphemeral("ephemeral") || isBucketEphemeral("durable") || isBucketEphemeral(minioMetaBucket) {
+		t.Fatal("unexpected ephemeral buckets")
+	}
+
+	// Capture the partial operations queued for healing.
+	opCh, ready := globalMRFState.opCh, atomic.LoadInt32(&globalMRFState.ready)
+	globalMRFState.opCh = make(chan partialOperation, 2)
+	atomic.StoreInt32(&globalMRFState.ready, 1)
+	defer func() {
+		globalMRFState.opCh = opCh
+		atomic.StoreInt32(&globalMRFState.ready, ready)
+	}()
+
+	set := obj.(*erasureServerPools).serverPools[0].sets[0]
+	set.addPartial("ephemeral", "object", "", 1)
+	set.addPartial("durable", "object", "", 1)
+
+	if n := len(globalMRFState.opCh); n != 1 {
+		t.Fatalf("expected 1 queued operation, got %d", n)
+	}
+	if op := <-globalMRFState.opCh; op.bucket != "durable" {
+		t.Fatalf("expected the durable bucket to be queued, got %s", op.bucket)
+	}
+}
@@ -509,6 +509,16 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 			}
 			continue
 		}
+		if apiErrCode := checkBucketState(ctx, objectAPI, r, bucket, object.ObjectName, object.VersionID, bucketStateOpDelete); apiErrCode != ErrNone {
+			apiErr := errorCodes.ToAPIErr(apiErrCode)
+			deleteResults[index].errInfo = DeleteError{
+				Code:      apiErr.Code,
+				Message:   apiErr.Description,
+				Key:       object.ObjectName,
+				VersionID: object.VersionID,
+			}
+			continue
+		}
 		if object.VersionID != "" && object.VersionID != nullVersionID {
 			if _, err := uuid.Parse(object.VersionID); err != nil {
 				apiErr := errorCodes.ToAPIErr(ErrNoSuchVersion)
@@ -967,6 +977,7 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(errCode), r.URL)
 		return
 	}
+	globalIAMSys.RecordAccessKeyUsed(cred)
 
 	// Once signature is validated, check if the user has
 	// explicit permissions for the user.
@@ -984,6 +995,16 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		return
 	}
 
+	if errCode = checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpWrite); errCode != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(errCode), r.URL)
+		return
+	}
+
+	if err = checkObjectNameDepth(bucket, object); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
 	policyBytes, err := base64.StdEncoding.DecodeString(formValues.Get("Policy"))
 	if err != nil {
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMalformedPOSTRequest), r.URL)
@@ -1263,6 +1284,10 @@ func (api objectAPIHandlers) HeadBucketHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if cfg, _, err := globalBucketMetadataSys.GetBucketStateConfig(ctx, bucket); err == nil && cfg.frozen() {
+		w.Header().Set(xhttp.MinIOBucketState, cfg.State)
+	}
+
 	writeResponse(w, http.StatusOK, nil, mimeXML)
 }
 
@@ -1287,6 +1312,11 @@ func (api objectAPIHandlers) DeleteBucketHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if s3Error := checkBucketState(ctx, objectAPI, r, bucket, "", "", bucketStateOpDeleteBucket); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
 	forceDelete := false
 	if value := r.Header.Get(xhttp.MinIOForceDelete); value != "" {
 		var err error
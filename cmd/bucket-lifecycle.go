@@ -34,10 +34,12 @@ import (
 	"github.com/minio/minio/internal/amztime"
 	sse "github.com/minio/minio/internal/bucket/encryption"
 	"github.com/minio/minio/internal/bucket/lifecycle"
+	"github.com/minio/minio/internal/config/ilm"
 	"github.com/minio/minio/internal/event"
 	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/minio/internal/s3select"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -76,6 +78,42 @@ type expiryState struct {
 	once                sync.Once
 	byDaysCh            chan expiryTask
 	byNewerNoncurrentCh chan newerNoncurrentTask
+
+	ctx        context.Context
+	objAPI     ObjectLayer
+	mu         sync.Mutex
+	numWorkers int
+	killCh     chan struct{}
+
+	// limits the number of object versions deleted per second,
+	// rate.Inf when no expiration rate is configured.
+	limiter *rate.Limiter
+
+	// number of pending tasks above which the scanner stops queueing
+	// expiry tasks until its next cycle, 0 means no limit.
+	pendingLimit int64
+
+	statsMu sync.Mutex
+	// set when the pending limit was reached in throttledCycle.
+	throttled      bool
+	throttledCycle uint32
+	// object versions deleted in the last minute.
+	deletes lastMinuteLatency
+	// pending object versions to expire per bucket.
+	backlog map[string]int64
+}
+
+// ExpiryStatus - status of the ILM expiry workers of a node.
+type ExpiryStatus struct {
+	Node          string           `json:"node"`
+	Workers       int              `json:"workers"`
+	PendingTasks  int              `json:"pendingTasks"`
+	PendingLimit  int              `json:"pendingLimit,omitempty"`
+	RateLimit     int              `json:"rateLimit,omitempty"`
+	DeletesPerSec float64          `json:"deletesPerSec"`
+	Throttled     bool             `json:"throttled"`
+	Backlog       map[string]int64 `json:"backlog,omitempty"`
+	Error         string           `json:"error,omitempty"`
 }
 
 // PendingTasks returns the number of pending ILM expiry tasks.
@@ -93,50 +131,213 @@ func (es *expiryState) close() {
 
 // enqueueByDays enqueues object versions expired by days for expiry.
 func (es *expiryState) enqueueByDays(oi ObjectInfo, restoredObject bool, rmVersion bool) {
+	es.addBacklog(oi.Bucket, 1)
 	select {
 	case <-GlobalContext.Done():
+		es.addBacklog(oi.Bucket, -1)
 		es.close()
 	case es.byDaysCh <- expiryTask{objInfo: oi, versionExpiry: rmVersion, restoredObject: restoredObject}:
 	default:
+		es.addBacklog(oi.Bucket, -1)
 	}
 }
 
 // enqueueByNewerNoncurrent enqueues object versions expired by
 // NewerNoncurrentVersions limit for expiry.
 func (es *expiryState) enqueueByNewerNoncurrent(bucket string, versions []ObjectToDelete) {
+	es.addBacklog(bucket, int64(len(versions)))
 	select {
 	case <-GlobalContext.Done():
+		es.addBacklog(bucket, -int64(len(versions)))
 		es.close()
 	case es.byNewerNoncurrentCh <- newerNoncurrentTask{bucket: bucket, versions: versions}:
 	default:
+		es.addBacklog(bucket, -int64(len(versions)))
+	}
+}
+
+// scannerMayEnqueue returns false if the scanner must not queue more
+// expiry tasks in its current cycle, since the pending tasks reached
+// the configured limit. Queueing resumes in the next scanner cycle.
+func (es *expiryState) scannerMayEnqueue(cycle uint32) bool {
+	limit := atomic.LoadInt64(&es.pendingLimit)
+
+	es.statsMu.Lock()
+	defer es.statsMu.Unlock()
+	if es.throttled {
+		if es.throttledCycle == cycle {
+			return false
+		}
+		es.throttled = false
+	}
+	if limit > 0 && int64(es.PendingTasks()) >= limit {
+		es.throttled = true
+		es.throttledCycle = cycle
+		return false
+	}
+	return true
+}
+
+func (es *expiryState) addBacklog(bucket string, n int64) {
+	es.statsMu.Lock()
+	defer es.statsMu.Unlock()
+	es.backlog[bucket] += n
+	if es.backlog[bucket] <= 0 {
+		delete(es.backlog, bucket)
+	}
+}
+
+// Backlog returns the number of object versions pending expiry per bucket.
+func (es *expiryState) Backlog() map[string]int64 {
+	es.statsMu.Lock()
+	defer es.statsMu.Unlock()
+	backlog := make(map[string]int64, len(es.backlog))
+	for bucket, n := range es.backlog {
+		backlog[bucket] = n
+	}
+	return backlog
+}
+
+// DeletesPerSec returns the average number of object versions deleted
+// per second over the last minute.
+func (es *expiryState) DeletesPerSec() float64 {
+	es.statsMu.Lock()
+	defer es.statsMu.Unlock()
+	return float64(es.deletes.getTotal().N) / 60
+}
+
+// status returns the status of the expiry workers of this node.
+func (es *expiryState) status() ExpiryStatus {
+	es.mu.Lock()
+	workers := es.numWorkers
+	es.mu.Unlock()
+
+	st := ExpiryStatus{
+		Node:          globalLocalNodeName,
+		Workers:       workers,
+		PendingTasks:  es.PendingTasks(),
+		PendingLimit:  int(atomic.LoadInt64(&es.pendingLimit)),
+		DeletesPerSec: es.DeletesPerSec(),
+		Backlog:       es.Backlog(),
+	}
+	if l := es.limiter.Limit(); l != rate.Inf {
+		st.RateLimit = int(l)
+	}
+	es.statsMu.Lock()
+	st.Throttled = es.throttled
+	es.statsMu.Unlock()
+	return st
+}
+
+// done records the expiry of n object versions of bucket.
+func (es *expiryState) done(bucket string, n int) {
+	es.addBacklog(bucket, -int64(n))
+
+	es.statsMu.Lock()
+	defer es.statsMu.Unlock()
+	for i := 0; i < n; i++ {
+		es.deletes.add(0)
+	}
+}
+
+// wait blocks until n object versions may be deleted without exceeding
+// the configured expiration rate.
+func (es *expiryState) wait(n int) error {
+	for i := 0; i < n; i++ {
+		if err := es.limiter.Wait(es.ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// worker waits for expiry tasks
+func (es *expiryState) worker(objectAPI ObjectLayer) {
+	for {
+		select {
+		case <-es.killCh:
+			return
+		case <-es.ctx.Done():
+			return
+		case t, ok := <-es.byDaysCh:
+			if !ok {
+				return
+			}
+			if es.wait(1) != nil {
+				return
+			}
+			if t.objInfo.TransitionedObject.Status != "" {
+				applyExpiryOnTransitionedObject(es.ctx, objectAPI, t.objInfo, t.restoredObject)
+			} else {
+				applyExpiryOnNonTransitionedObjects(es.ctx, objectAPI, t.objInfo, t.versionExpiry)
+			}
+			es.done(t.objInfo.Bucket, 1)
+		case t, ok := <-es.byNewerNoncurrentCh:
+			if !ok {
+				return
+			}
+			if es.wait(len(t.versions)) != nil {
+				return
+			}
+			deleteObjectVersions(es.ctx, objectAPI, t.bucket, t.versions)
+			es.done(t.bucket, len(t.versions))
+		}
+	}
+}
+
+// update applies the ILM expiry configuration, resizing the pool of
+// workers and updating the expiration rate and pending tasks limits.
+func (es *expiryState) update(cfg ilm.Config) {
+	if cfg.ExpirationRate > 0 {
+		es.limiter.SetLimit(rate.Limit(cfg.ExpirationRate))
+		es.limiter.SetBurst(cfg.ExpirationRate)
+	} else {
+		es.limiter.SetLimit(rate.Inf)
+	}
+	atomic.StoreInt64(&es.pendingLimit, int64(cfg.ExpirationPendingLimit))
+
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if es.objAPI == nil { // Init hasn't been called yet.
+		return
+	}
+	es.updateWorkers(cfg.ExpirationWorkers)
+}
+
+func (es *expiryState) updateWorkers(n int) {
+	for es.numWorkers < n {
+		go es.worker(es.objAPI)
+		es.numWorkers++
+	}
+
+	for es.numWorkers > n {
+		go func() { es.killCh <- struct{}{} }()
+		es.numWorkers--
 	}
 }
 
 var globalExpiryState *expiryState
 
-func newExpiryState() *expiryState {
+func newExpiryState(ctx context.Context) *expiryState {
 	return &expiryState{
 		byDaysCh:            make(chan expiryTask, 10000),
 		byNewerNoncurrentCh: make(chan newerNoncurrentTask, 10000),
+		ctx:                 ctx,
+		killCh:              make(chan struct{}),
+		limiter:             rate.NewLimiter(rate.Inf, 1),
+		backlog:             make(map[string]int64),
 	}
 }
 
 func initBackgroundExpiry(ctx context.Context, objectAPI ObjectLayer) {
-	globalExpiryState = newExpiryState()
-	go func() {
-		for t := range globalExpiryState.byDaysCh {
-			if t.objInfo.TransitionedObject.Status != "" {
-				applyExpiryOnTransitionedObject(ctx, objectAPI, t.objInfo, t.restoredObject)
-			} else {
-				applyExpiryOnNonTransitionedObjects(ctx, objectAPI, t.objInfo, t.versionExpiry)
-			}
-		}
-	}()
-	go func() {
-		for t := range globalExpiryState.byNewerNoncurrentCh {
-			deleteObjectVersions(ctx, objectAPI, t.bucket, t.versions)
-		}
-	}()
+	globalExpiryState = newExpiryState(ctx)
+
+	globalExpiryState.mu.Lock()
+	defer globalExpiryState.mu.Unlock()
+	globalExpiryState.objAPI = objectAPI
+	// Started with a single worker, resized once the ilm
+	// configuration is loaded.
+	globalExpiryState.updateWorkers(1)
 }
 
 // newerNoncurrentTask encapsulates arguments required by worker to expire objects
@@ -19,11 +19,13 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/minio/minio/internal/bucket/lifecycle"
+	"github.com/minio/minio/internal/config/ilm"
 	xhttp "github.com/minio/minio/internal/http"
 )
 
@@ -245,3 +247,70 @@ func TestValidateTransitionTier(t *testing.T) {
 		}
 	}
 }
+
+// TestExpiryStatePendingLimit simulates a scanner cycle enqueuing a large
+// burst of expiry tasks and checks the scanner is throttled once the
+// pending tasks reach the limit, until its next cycle.
+func TestExpiryStatePendingLimit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// No object layer, so no workers are started and tasks stay queued.
+	es := newExpiryState(ctx)
+	es.update(ilm.Config{ExpirationWorkers: 4, ExpirationPendingLimit: 100})
+
+	const cycle = 1
+	for i := 0; i < 1000; i++ {
+		if !es.scannerMayEnqueue(cycle) {
+			continue
+		}
+		es.enqueueByDays(ObjectInfo{Bucket: "bucket"}, false, false)
+	}
+	if n := es.PendingTasks(); n != 100 {
+		t.Fatalf("expected 100 pending tasks, got %d", n)
+	}
+	if n := es.Backlog()["bucket"]; n != 100 {
+		t.Fatalf("expected a backlog of 100 for bucket, got %d", n)
+	}
+	if st := es.status(); !st.Throttled || st.PendingLimit != 100 {
+		t.Fatalf("unexpected status %#v", st)
+	}
+
+	for es.PendingTasks() > 0 {
+		task := <-es.byDaysCh
+		es.done(task.objInfo.Bucket, 1)
+	}
+	if es.scannerMayEnqueue(cycle) {
+		t.Fatal("expected the scanner to stay throttled until its next cycle")
+	}
+	if !es.scannerMayEnqueue(cycle + 1) {
+		t.Fatal("expected the scanner to resume in its next cycle")
+	}
+	if backlog := es.Backlog(); len(backlog) != 0 {
+		t.Fatalf("expected no backlog, got %v", backlog)
+	}
+	if st := es.status(); st.Throttled || st.DeletesPerSec <= 0 {
+		t.Fatalf("unexpected status %#v", st)
+	}
+}
+
+// TestExpiryStateDroppedTasks checks tasks dropped on a full queue are
+// not accounted in the backlog.
+func TestExpiryStateDroppedTasks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	es := newExpiryState(ctx)
+	capacity := cap(es.byDaysCh)
+	for i := 0; i < capacity+100; i++ {
+		es.enqueueByDays(ObjectInfo{Bucket: "bucket"}, false, false)
+	}
+	es.enqueueByNewerNoncurrent("other", make([]ObjectToDelete, 10))
+
+	if n := es.Backlog()["bucket"]; n != int64(capacity) {
+		t.Fatalf("expected a backlog of %d for bucket, got %d", capacity, n)
+	}
+	if n := es.Backlog()["other"]; n != 10 {
+		t.Fatalf("expected a backlog of 10 for other, got %d", n)
+	}
+}
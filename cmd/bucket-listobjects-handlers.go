@@ -19,10 +19,13 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
+	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/mux"
 
@@ -59,6 +62,16 @@ func validateListObjectsArgs(prefix, marker, delimiter, encodingType string, max
 	return ErrNone
 }
 
+// writeListErrorResponse writes the error of a listing, with the marker
+// to resume from if it stopped at its scan limit.
+func writeListErrorResponse(ctx context.Context, w http.ResponseWriter, err error, reqURL *url.URL) {
+	var lt ListingTimeout
+	if errors.As(err, &lt) && lt.NextMarker != "" {
+		w.Header().Set(xhttp.MinIOListingNextMarker, lt.NextMarker)
+	}
+	writeErrorResponse(ctx, w, toAPIError(ctx, err), reqURL)
+}
+
 // ListObjectVersions - GET Bucket Object versions
 // You can use the versions subresource to list metadata about all
 // of the versions of objects in a bucket.
@@ -66,6 +79,8 @@ func (api objectAPIHandlers) ListObjectVersionsHandler(w http.ResponseWriter, r
 	ctx := newContext(r, w, "ListObjectVersions")
 
 	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+	ctx = withListConsistency(ctx, r.Header)
+	ctx = withListBudget(ctx)
 
 	vars := mux.Vars(r)
 	bucket := vars["bucket"]
@@ -103,7 +118,7 @@ func (api objectAPIHandlers) ListObjectVersionsHandler(w http.ResponseWriter, r
 	// marshaled into S3 compatible XML header.
 	listObjectVersionsInfo, err := listObjectVersions(ctx, bucket, prefix, marker, versionIDMarker, delimiter, maxkeys)
 	if err != nil {
-		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		writeListErrorResponse(ctx, w, err, r.URL)
 		return
 	}
 
@@ -130,6 +145,8 @@ func (api objectAPIHandlers) ListObjectsV2MHandler(w http.ResponseWriter, r *htt
 	ctx := newContext(r, w, "ListObjectsV2M")
 
 	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+	ctx = withListConsistency(ctx, r.Header)
+	ctx = withListBudget(ctx)
 
 	vars := mux.Vars(r)
 	bucket := vars["bucket"]
@@ -168,7 +185,7 @@ func (api objectAPIHandlers) ListObjectsV2MHandler(w http.ResponseWriter, r *htt
 	// marshaled into S3 compatible XML header.
 	listObjectsV2Info, err := listObjectsV2(ctx, bucket, prefix, token, delimiter, maxKeys, fetchOwner, startAfter)
 	if err != nil {
-		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		writeListErrorResponse(ctx, w, err, r.URL)
 		return
 	}
 
@@ -200,6 +217,8 @@ func (api objectAPIHandlers) ListObjectsV2Handler(w http.ResponseWriter, r *http
 	ctx := newContext(r, w, "ListObjectsV2")
 
 	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+	ctx = withListConsistency(ctx, r.Header)
+	ctx = withListBudget(ctx)
 
 	vars := mux.Vars(r)
 	bucket := vars["bucket"]
@@ -246,7 +265,7 @@ func (api objectAPIHandlers) ListObjectsV2Handler(w http.ResponseWriter, r *http
 		listObjectsV2Info, err = objectAPI.ListObjectsV2(ctx, bucket, prefix, token, delimiter, maxKeys, fetchOwner, startAfter)
 	}
 	if err != nil {
-		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		writeListErrorResponse(ctx, w, err, r.URL)
 		return
 	}
 
@@ -310,6 +329,8 @@ func (api objectAPIHandlers) ListObjectsV1Handler(w http.ResponseWriter, r *http
 	ctx := newContext(r, w, "ListObjectsV1")
 
 	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+	ctx = withListConsistency(ctx, r.Header)
+	ctx = withListBudget(ctx)
 
 	vars := mux.Vars(r)
 	bucket := vars["bucket"]
@@ -345,7 +366,7 @@ func (api objectAPIHandlers) ListObjectsV1Handler(w http.ResponseWriter, r *http
 	// marshaled into S3 compatible XML header.
 	listObjectsInfo, err := listObjects(ctx, bucket, prefix, marker, delimiter, maxKeys)
 	if err != nil {
-		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		writeListErrorResponse(ctx, w, err, r.URL)
 		return
 	}
 
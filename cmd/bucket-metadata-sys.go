@@ -28,11 +28,13 @@ import (
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/minio/internal/bucket/cors"
 	bucketsse "github.com/minio/minio/internal/bucket/encryption"
 	"github.com/minio/minio/internal/bucket/lifecycle"
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/bucket/replication"
 	"github.com/minio/minio/internal/bucket/versioning"
+	"github.com/minio/minio/internal/bucket/website"
 	"github.com/minio/minio/internal/event"
 	"github.com/minio/minio/internal/kms"
 	"github.com/minio/minio/internal/logger"
@@ -130,6 +132,33 @@ func (sys *BucketMetadataSys) updateAndParse(ctx context.Context, bucket string,
 	case bucketQuotaConfigFile:
 		meta.QuotaConfigJSON = configData
 		meta.QuotaConfigUpdatedAt = updatedAt
+	case bucketOverwriteConfigFile:
+		meta.OverwriteConfigJSON = configData
+		meta.OverwriteConfigUpdatedAt = updatedAt
+	case bucketReadThroughConfigFile:
+		meta.ReadThroughConfigJSON = configData
+		meta.ReadThroughConfigUpdatedAt = updatedAt
+	case bucketEventJournalConfigFile:
+		meta.EventJournalConfigJSON = configData
+		meta.EventJournalConfigUpdatedAt = updatedAt
+	case bucketCorsConfig:
+		meta.CorsConfigXML = configData
+		meta.CorsConfigUpdatedAt = updatedAt
+	case bucketWebsiteConfig:
+		meta.WebsiteConfigXML = configData
+		meta.WebsiteConfigUpdatedAt = updatedAt
+	case bucketAppendConfigFile:
+		meta.AppendConfigJSON = configData
+		meta.AppendConfigUpdatedAt = updatedAt
+	case bucketActiveRetentionConfigFile:
+		meta.ActiveRetentionConfigJSON = configData
+		meta.ActiveRetentionConfigUpdatedAt = updatedAt
+	case bucketStateConfigFile:
+		meta.BucketStateConfigJSON = configData
+		meta.BucketStateConfigUpdatedAt = updatedAt
+	case bucketEphemeralConfigFile:
+		meta.EphemeralConfigJSON = configData
+		meta.EphemeralConfigUpdatedAt = updatedAt
 	case objectLockConfig:
 		meta.ObjectLockConfigXML = configData
 		meta.ObjectLockConfigUpdatedAt = updatedAt
@@ -324,6 +353,129 @@ func (sys *BucketMetadataSys) GetQuotaConfig(ctx context.Context, bucket string)
 	return meta.quotaConfig, meta.QuotaConfigUpdatedAt, nil
 }
 
+// GetOverwriteConfig returns configured bucket overwrite behavior
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetOverwriteConfig(ctx context.Context, bucket string) (*BucketOverwriteConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(ctx, bucket)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if meta.overwriteConfig == nil {
+		return &BucketOverwriteConfig{}, meta.OverwriteConfigUpdatedAt, nil
+	}
+	return meta.overwriteConfig, meta.OverwriteConfigUpdatedAt, nil
+}
+
+// GetReadThroughConfig returns configured bucket read-through remote
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetReadThroughConfig(ctx context.Context, bucket string) (*BucketReadThroughConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(ctx, bucket)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if meta.readThroughConfig == nil {
+		return &BucketReadThroughConfig{}, meta.ReadThroughConfigUpdatedAt, nil
+	}
+	return meta.readThroughConfig, meta.ReadThroughConfigUpdatedAt, nil
+}
+
+// GetEventJournalConfig returns configured bucket event journal settings
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetEventJournalConfig(ctx context.Context, bucket string) (*BucketEventJournalConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(ctx, bucket)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if meta.eventJournalConfig == nil {
+		return &BucketEventJournalConfig{}, meta.EventJournalConfigUpdatedAt, nil
+	}
+	return meta.eventJournalConfig, meta.EventJournalConfigUpdatedAt, nil
+}
+
+// GetAppendConfig returns configured bucket object append settings
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetAppendConfig(ctx context.Context, bucket string) (*BucketAppendConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(ctx, bucket)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if meta.appendConfig == nil {
+		return &BucketAppendConfig{}, meta.AppendConfigUpdatedAt, nil
+	}
+	return meta.appendConfig, meta.AppendConfigUpdatedAt, nil
+}
+
+// GetActiveRetentionConfig returns configured bucket active retention
+// settings. The returned object may not be modified.
+func (sys *BucketMetadataSys) GetActiveRetentionConfig(ctx context.Context, bucket string) (*BucketActiveRetentionConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(ctx, bucket)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if meta.activeRetentionConfig == nil {
+		return &BucketActiveRetentionConfig{}, meta.ActiveRetentionConfigUpdatedAt, nil
+	}
+	return meta.activeRetentionConfig, meta.ActiveRetentionConfigUpdatedAt, nil
+}
+
+// GetBucketStateConfig returns the administrative state of the bucket.
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetBucketStateConfig(ctx context.Context, bucket string) (*BucketStateConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(ctx, bucket)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if meta.bucketStateConfig == nil {
+		return &BucketStateConfig{State: bucketStateNormal}, meta.BucketStateConfigUpdatedAt, nil
+	}
+	return meta.bucketStateConfig, meta.BucketStateConfigUpdatedAt, nil
+}
+
+// GetEphemeralConfig returns configured bucket ephemeral settings
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetEphemeralConfig(ctx context.Context, bucket string) (*BucketEphemeralConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(ctx, bucket)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if meta.ephemeralConfig == nil {
+		return &BucketEphemeralConfig{}, meta.EphemeralConfigUpdatedAt, nil
+	}
+	return meta.ephemeralConfig, meta.EphemeralConfigUpdatedAt, nil
+}
+
+// GetCorsConfig returns configured bucket CORS config
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetCorsConfig(bucket string) (*cors.Config, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketCorsNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.corsConfig == nil {
+		return nil, time.Time{}, BucketCorsNotFound{Bucket: bucket}
+	}
+	return meta.corsConfig, meta.CorsConfigUpdatedAt, nil
+}
+
+// GetWebsiteConfig returns configured bucket website config
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetWebsiteConfig(bucket string) (*website.Config, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketWebsiteNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.websiteConfig == nil {
+		return nil, time.Time{}, BucketWebsiteNotFound{Bucket: bucket}
+	}
+	return meta.websiteConfig, meta.WebsiteConfigUpdatedAt, nil
+}
+
 // GetReplicationConfig returns configured bucket replication config
 // The returned object may not be modified.
 func (sys *BucketMetadataSys) GetReplicationConfig(ctx context.Context, bucket string) (*replication.Config, time.Time, error) {
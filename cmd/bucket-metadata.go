@@ -31,11 +31,13 @@ import (
 
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/minio/internal/bucket/cors"
 	bucketsse "github.com/minio/minio/internal/bucket/encryption"
 	"github.com/minio/minio/internal/bucket/lifecycle"
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/bucket/replication"
 	"github.com/minio/minio/internal/bucket/versioning"
+	"github.com/minio/minio/internal/bucket/website"
 	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/event"
 	"github.com/minio/minio/internal/fips"
@@ -67,27 +69,45 @@ var (
 // bucketMetadataFormat refers to the format.
 // bucketMetadataVersion can be used to track a rolling upgrade of a field.
 type BucketMetadata struct {
-	Name                        string
-	Created                     time.Time
-	LockEnabled                 bool // legacy not used anymore.
-	PolicyConfigJSON            []byte
-	NotificationConfigXML       []byte
-	LifecycleConfigXML          []byte
-	ObjectLockConfigXML         []byte
-	VersioningConfigXML         []byte
-	EncryptionConfigXML         []byte
-	TaggingConfigXML            []byte
-	QuotaConfigJSON             []byte
-	ReplicationConfigXML        []byte
-	BucketTargetsConfigJSON     []byte
-	BucketTargetsConfigMetaJSON []byte
-	PolicyConfigUpdatedAt       time.Time
-	ObjectLockConfigUpdatedAt   time.Time
-	EncryptionConfigUpdatedAt   time.Time
-	TaggingConfigUpdatedAt      time.Time
-	QuotaConfigUpdatedAt        time.Time
-	ReplicationConfigUpdatedAt  time.Time
-	VersioningConfigUpdatedAt   time.Time
+	Name                           string
+	Created                        time.Time
+	LockEnabled                    bool // legacy not used anymore.
+	PolicyConfigJSON               []byte
+	NotificationConfigXML          []byte
+	LifecycleConfigXML             []byte
+	ObjectLockConfigXML            []byte
+	VersioningConfigXML            []byte
+	EncryptionConfigXML            []byte
+	TaggingConfigXML               []byte
+	QuotaConfigJSON                []byte
+	ReplicationConfigXML           []byte
+	BucketTargetsConfigJSON        []byte
+	BucketTargetsConfigMetaJSON    []byte
+	PolicyConfigUpdatedAt          time.Time
+	ObjectLockConfigUpdatedAt      time.Time
+	EncryptionConfigUpdatedAt      time.Time
+	TaggingConfigUpdatedAt         time.Time
+	QuotaConfigUpdatedAt           time.Time
+	ReplicationConfigUpdatedAt     time.Time
+	VersioningConfigUpdatedAt      time.Time
+	OverwriteConfigJSON            []byte
+	OverwriteConfigUpdatedAt       time.Time
+	ReadThroughConfigJSON          []byte
+	ReadThroughConfigUpdatedAt     time.Time
+	EventJournalConfigJSON         []byte
+	EventJournalConfigUpdatedAt    time.Time
+	CorsConfigXML                  []byte
+	CorsConfigUpdatedAt            time.Time
+	WebsiteConfigXML               []byte
+	WebsiteConfigUpdatedAt         time.Time
+	AppendConfigJSON               []byte
+	AppendConfigUpdatedAt          time.Time
+	ActiveRetentionConfigJSON      []byte
+	ActiveRetentionConfigUpdatedAt time.Time
+	BucketStateConfigJSON          []byte
+	BucketStateConfigUpdatedAt     time.Time
+	EphemeralConfigJSON            []byte
+	EphemeralConfigUpdatedAt       time.Time
 
 	// Unexported fields. Must be updated atomically.
 	policyConfig           *policy.Policy
@@ -101,6 +121,15 @@ type BucketMetadata struct {
 	replicationConfig      *replication.Config
 	bucketTargetConfig     *madmin.BucketTargets
 	bucketTargetConfigMeta map[string]string
+	overwriteConfig        *BucketOverwriteConfig
+	readThroughConfig      *BucketReadThroughConfig
+	eventJournalConfig     *BucketEventJournalConfig
+	corsConfig             *cors.Config
+	websiteConfig          *website.Config
+	appendConfig           *BucketAppendConfig
+	activeRetentionConfig  *BucketActiveRetentionConfig
+	bucketStateConfig      *BucketStateConfig
+	ephemeralConfig        *BucketEphemeralConfig
 }
 
 // newBucketMetadata creates BucketMetadata with the supplied name and Created to Now.
@@ -110,7 +139,14 @@ func newBucketMetadata(name string) BucketMetadata {
 		notificationConfig: &event.Config{
 			XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/",
 		},
-		quotaConfig: &madmin.BucketQuota{},
+		quotaConfig:           &madmin.BucketQuota{},
+		overwriteConfig:       &BucketOverwriteConfig{},
+		readThroughConfig:     &BucketReadThroughConfig{},
+		eventJournalConfig:    &BucketEventJournalConfig{},
+		appendConfig:          &BucketAppendConfig{},
+		activeRetentionConfig: &BucketActiveRetentionConfig{},
+		bucketStateConfig:     &BucketStateConfig{State: bucketStateNormal},
+		ephemeralConfig:       &BucketEphemeralConfig{},
 		versioningConfig: &versioning.Versioning{
 			XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/",
 		},
@@ -275,6 +311,87 @@ func (b *BucketMetadata) parseAllConfigs(ctx context.Context, objectAPI ObjectLa
 		}
 	}
 
+	if len(b.OverwriteConfigJSON) != 0 {
+		b.overwriteConfig, err = parseBucketOverwriteConfig(b.OverwriteConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.overwriteConfig = &BucketOverwriteConfig{}
+	}
+
+	if len(b.ReadThroughConfigJSON) != 0 {
+		b.readThroughConfig, err = parseBucketReadThroughConfig(b.ReadThroughConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.readThroughConfig = &BucketReadThroughConfig{}
+	}
+
+	if len(b.EventJournalConfigJSON) != 0 {
+		b.eventJournalConfig, err = parseBucketEventJournalConfig(b.EventJournalConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.eventJournalConfig = &BucketEventJournalConfig{}
+	}
+
+	if len(b.CorsConfigXML) != 0 {
+		b.corsConfig, err = cors.ParseConfig(bytes.NewReader(b.CorsConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.corsConfig = nil
+	}
+
+	if len(b.WebsiteConfigXML) != 0 {
+		b.websiteConfig, err = website.ParseConfig(bytes.NewReader(b.WebsiteConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.websiteConfig = nil
+	}
+
+	if len(b.AppendConfigJSON) != 0 {
+		b.appendConfig, err = parseBucketAppendConfig(b.AppendConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.appendConfig = &BucketAppendConfig{}
+	}
+
+	if len(b.ActiveRetentionConfigJSON) != 0 {
+		b.activeRetentionConfig, err = parseBucketActiveRetentionConfig(b.ActiveRetentionConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.activeRetentionConfig = &BucketActiveRetentionConfig{}
+	}
+
+	if len(b.BucketStateConfigJSON) != 0 {
+		b.bucketStateConfig, err = parseBucketStateConfig(b.BucketStateConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.bucketStateConfig = &BucketStateConfig{State: bucketStateNormal}
+	}
+
+	if len(b.EphemeralConfigJSON) != 0 {
+		b.ephemeralConfig, err = parseBucketEphemeralConfig(b.EphemeralConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.ephemeralConfig = &BucketEphemeralConfig{}
+	}
+
 	if len(b.ReplicationConfigXML) != 0 {
 		b.replicationConfig, err = replication.ParseConfig(bytes.NewReader(b.ReplicationConfigXML))
 		if err != nil {
@@ -417,6 +534,42 @@ func (b *BucketMetadata) defaultTimestamps() {
 	if b.VersioningConfigUpdatedAt.IsZero() {
 		b.VersioningConfigUpdatedAt = b.Created
 	}
+
+	if b.OverwriteConfigUpdatedAt.IsZero() {
+		b.OverwriteConfigUpdatedAt = b.Created
+	}
+
+	if b.ReadThroughConfigUpdatedAt.IsZero() {
+		b.ReadThroughConfigUpdatedAt = b.Created
+	}
+
+	if b.EventJournalConfigUpdatedAt.IsZero() {
+		b.EventJournalConfigUpdatedAt = b.Created
+	}
+
+	if b.CorsConfigUpdatedAt.IsZero() {
+		b.CorsConfigUpdatedAt = b.Created
+	}
+
+	if b.WebsiteConfigUpdatedAt.IsZero() {
+		b.WebsiteConfigUpdatedAt = b.Created
+	}
+
+	if b.AppendConfigUpdatedAt.IsZero() {
+		b.AppendConfigUpdatedAt = b.Created
+	}
+
+	if b.ActiveRetentionConfigUpdatedAt.IsZero() {
+		b.ActiveRetentionConfigUpdatedAt = b.Created
+	}
+
+	if b.BucketStateConfigUpdatedAt.IsZero() {
+		b.BucketStateConfigUpdatedAt = b.Created
+	}
+
+	if b.EphemeralConfigUpdatedAt.IsZero() {
+		b.EphemeralConfigUpdatedAt = b.Created
+	}
 }
 
 // Save config to supplied ObjectLayer api.
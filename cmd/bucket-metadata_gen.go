@@ -150,6 +150,114 @@ func (z *BucketMetadata) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "VersioningConfigUpdatedAt")
 				return
 			}
+		case "OverwriteConfigJSON":
+			z.OverwriteConfigJSON, err = dc.ReadBytes(z.OverwriteConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "OverwriteConfigJSON")
+				return
+			}
+		case "OverwriteConfigUpdatedAt":
+			z.OverwriteConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "OverwriteConfigUpdatedAt")
+				return
+			}
+		case "ReadThroughConfigJSON":
+			z.ReadThroughConfigJSON, err = dc.ReadBytes(z.ReadThroughConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "ReadThroughConfigJSON")
+				return
+			}
+		case "ReadThroughConfigUpdatedAt":
+			z.ReadThroughConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "ReadThroughConfigUpdatedAt")
+				return
+			}
+		case "EventJournalConfigJSON":
+			z.EventJournalConfigJSON, err = dc.ReadBytes(z.EventJournalConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "EventJournalConfigJSON")
+				return
+			}
+		case "EventJournalConfigUpdatedAt":
+			z.EventJournalConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "EventJournalConfigUpdatedAt")
+				return
+			}
+		case "CorsConfigXML":
+			z.CorsConfigXML, err = dc.ReadBytes(z.CorsConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "CorsConfigXML")
+				return
+			}
+		case "CorsConfigUpdatedAt":
+			z.CorsConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "CorsConfigUpdatedAt")
+				return
+			}
+		case "WebsiteConfigXML":
+			z.WebsiteConfigXML, err = dc.ReadBytes(z.WebsiteConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "WebsiteConfigXML")
+				return
+			}
+		case "WebsiteConfigUpdatedAt":
+			z.WebsiteConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "WebsiteConfigUpdatedAt")
+				return
+			}
+		case "AppendConfigJSON":
+			z.AppendConfigJSON, err = dc.ReadBytes(z.AppendConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "AppendConfigJSON")
+				return
+			}
+		case "AppendConfigUpdatedAt":
+			z.AppendConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "AppendConfigUpdatedAt")
+				return
+			}
+		case "ActiveRetentionConfigJSON":
+			z.ActiveRetentionConfigJSON, err = dc.ReadBytes(z.ActiveRetentionConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "ActiveRetentionConfigJSON")
+				return
+			}
+		case "ActiveRetentionConfigUpdatedAt":
+			z.ActiveRetentionConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "ActiveRetentionConfigUpdatedAt")
+				return
+			}
+		case "BucketStateConfigJSON":
+			z.BucketStateConfigJSON, err = dc.ReadBytes(z.BucketStateConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "BucketStateConfigJSON")
+				return
+			}
+		case "BucketStateConfigUpdatedAt":
+			z.BucketStateConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "BucketStateConfigUpdatedAt")
+				return
+			}
+		case "EphemeralConfigJSON":
+			z.EphemeralConfigJSON, err = dc.ReadBytes(z.EphemeralConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "EphemeralConfigJSON")
+				return
+			}
+		case "EphemeralConfigUpdatedAt":
+			z.EphemeralConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "EphemeralConfigUpdatedAt")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -163,9 +271,9 @@ func (z *BucketMetadata) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *BucketMetadata) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 21
+	// map header, size 39
 	// write "Name"
-	err = en.Append(0xde, 0x0, 0x15, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	err = en.Append(0xde, 0x0, 0x27, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
 	if err != nil {
 		return
 	}
@@ -374,15 +482,195 @@ func (z *BucketMetadata) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "VersioningConfigUpdatedAt")
 		return
 	}
+	// write "OverwriteConfigJSON"
+	err = en.Append(0xb3, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.OverwriteConfigJSON)
+	if err != nil {
+		err = msgp.WrapError(err, "OverwriteConfigJSON")
+		return
+	}
+	// write "OverwriteConfigUpdatedAt"
+	err = en.Append(0xb8, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.OverwriteConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "OverwriteConfigUpdatedAt")
+		return
+	}
+	// write "ReadThroughConfigJSON"
+	err = en.Append(0xb5, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.ReadThroughConfigJSON)
+	if err != nil {
+		err = msgp.WrapError(err, "ReadThroughConfigJSON")
+		return
+	}
+	// write "ReadThroughConfigUpdatedAt"
+	err = en.Append(0xba, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.ReadThroughConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "ReadThroughConfigUpdatedAt")
+		return
+	}
+	// write "EventJournalConfigJSON"
+	err = en.Append(0xb6, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.EventJournalConfigJSON)
+	if err != nil {
+		err = msgp.WrapError(err, "EventJournalConfigJSON")
+		return
+	}
+	// write "EventJournalConfigUpdatedAt"
+	err = en.Append(0xbb, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.EventJournalConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "EventJournalConfigUpdatedAt")
+		return
+	}
+	// write "CorsConfigXML"
+	err = en.Append(0xad, 0x43, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.CorsConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "CorsConfigXML")
+		return
+	}
+	// write "CorsConfigUpdatedAt"
+	err = en.Append(0xb3, 0x43, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.CorsConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "CorsConfigUpdatedAt")
+		return
+	}
+	// write "WebsiteConfigXML"
+	err = en.Append(0xb0, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.WebsiteConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "WebsiteConfigXML")
+		return
+	}
+	// write "WebsiteConfigUpdatedAt"
+	err = en.Append(0xb6, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.WebsiteConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "WebsiteConfigUpdatedAt")
+		return
+	}
+	// write "AppendConfigJSON"
+	err = en.Append(0xb0, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.AppendConfigJSON)
+	if err != nil {
+		err = msgp.WrapError(err, "AppendConfigJSON")
+		return
+	}
+	// write "AppendConfigUpdatedAt"
+	err = en.Append(0xb5, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.AppendConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "AppendConfigUpdatedAt")
+		return
+	}
+	// write "ActiveRetentionConfigJSON"
+	err = en.Append(0xb9, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.ActiveRetentionConfigJSON)
+	if err != nil {
+		err = msgp.WrapError(err, "ActiveRetentionConfigJSON")
+		return
+	}
+	// write "ActiveRetentionConfigUpdatedAt"
+	err = en.Append(0xbe, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.ActiveRetentionConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "ActiveRetentionConfigUpdatedAt")
+		return
+	}
+	// write "BucketStateConfigJSON"
+	err = en.Append(0xb5, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.BucketStateConfigJSON)
+	if err != nil {
+		err = msgp.WrapError(err, "BucketStateConfigJSON")
+		return
+	}
+	// write "BucketStateConfigUpdatedAt"
+	err = en.Append(0xba, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.BucketStateConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "BucketStateConfigUpdatedAt")
+		return
+	}
+	// write "EphemeralConfigJSON"
+	err = en.Append(0xb3, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.EphemeralConfigJSON)
+	if err != nil {
+		err = msgp.WrapError(err, "EphemeralConfigJSON")
+		return
+	}
+	// write "EphemeralConfigUpdatedAt"
+	err = en.Append(0xb8, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.EphemeralConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "EphemeralConfigUpdatedAt")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *BucketMetadata) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 21
+	// map header, size 39
 	// string "Name"
-	o = append(o, 0xde, 0x0, 0x15, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = append(o, 0xde, 0x0, 0x27, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
 	o = msgp.AppendString(o, z.Name)
 	// string "Created"
 	o = append(o, 0xa7, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64)
@@ -444,6 +732,60 @@ func (z *BucketMetadata) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "VersioningConfigUpdatedAt"
 	o = append(o, 0xb9, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
 	o = msgp.AppendTime(o, z.VersioningConfigUpdatedAt)
+	// string "OverwriteConfigJSON"
+	o = append(o, 0xb3, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	o = msgp.AppendBytes(o, z.OverwriteConfigJSON)
+	// string "OverwriteConfigUpdatedAt"
+	o = append(o, 0xb8, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.OverwriteConfigUpdatedAt)
+	// string "ReadThroughConfigJSON"
+	o = append(o, 0xb5, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	o = msgp.AppendBytes(o, z.ReadThroughConfigJSON)
+	// string "ReadThroughConfigUpdatedAt"
+	o = append(o, 0xba, 0x52, 0x65, 0x61, 0x64, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.ReadThroughConfigUpdatedAt)
+	// string "EventJournalConfigJSON"
+	o = append(o, 0xb6, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	o = msgp.AppendBytes(o, z.EventJournalConfigJSON)
+	// string "EventJournalConfigUpdatedAt"
+	o = append(o, 0xbb, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.EventJournalConfigUpdatedAt)
+	// string "CorsConfigXML"
+	o = append(o, 0xad, 0x43, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.CorsConfigXML)
+	// string "CorsConfigUpdatedAt"
+	o = append(o, 0xb3, 0x43, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.CorsConfigUpdatedAt)
+	// string "WebsiteConfigXML"
+	o = append(o, 0xb0, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.WebsiteConfigXML)
+	// string "WebsiteConfigUpdatedAt"
+	o = append(o, 0xb6, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.WebsiteConfigUpdatedAt)
+	// string "AppendConfigJSON"
+	o = append(o, 0xb0, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	o = msgp.AppendBytes(o, z.AppendConfigJSON)
+	// string "AppendConfigUpdatedAt"
+	o = append(o, 0xb5, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.AppendConfigUpdatedAt)
+	// string "ActiveRetentionConfigJSON"
+	o = append(o, 0xb9, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	o = msgp.AppendBytes(o, z.ActiveRetentionConfigJSON)
+	// string "ActiveRetentionConfigUpdatedAt"
+	o = append(o, 0xbe, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.ActiveRetentionConfigUpdatedAt)
+	// string "BucketStateConfigJSON"
+	o = append(o, 0xb5, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	o = msgp.AppendBytes(o, z.BucketStateConfigJSON)
+	// string "BucketStateConfigUpdatedAt"
+	o = append(o, 0xba, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.BucketStateConfigUpdatedAt)
+	// string "EphemeralConfigJSON"
+	o = append(o, 0xb3, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
+	o = msgp.AppendBytes(o, z.EphemeralConfigJSON)
+	// string "EphemeralConfigUpdatedAt"
+	o = append(o, 0xb8, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.EphemeralConfigUpdatedAt)
 	return
 }
 
@@ -591,6 +933,114 @@ func (z *BucketMetadata) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "VersioningConfigUpdatedAt")
 				return
 			}
+		case "OverwriteConfigJSON":
+			z.OverwriteConfigJSON, bts, err = msgp.ReadBytesBytes(bts, z.OverwriteConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "OverwriteConfigJSON")
+				return
+			}
+		case "OverwriteConfigUpdatedAt":
+			z.OverwriteConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "OverwriteConfigUpdatedAt")
+				return
+			}
+		case "ReadThroughConfigJSON":
+			z.ReadThroughConfigJSON, bts, err = msgp.ReadBytesBytes(bts, z.ReadThroughConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "ReadThroughConfigJSON")
+				return
+			}
+		case "ReadThroughConfigUpdatedAt":
+			z.ReadThroughConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReadThroughConfigUpdatedAt")
+				return
+			}
+		case "EventJournalConfigJSON":
+			z.EventJournalConfigJSON, bts, err = msgp.ReadBytesBytes(bts, z.EventJournalConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "EventJournalConfigJSON")
+				return
+			}
+		case "EventJournalConfigUpdatedAt":
+			z.EventJournalConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EventJournalConfigUpdatedAt")
+				return
+			}
+		case "CorsConfigXML":
+			z.CorsConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.CorsConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "CorsConfigXML")
+				return
+			}
+		case "CorsConfigUpdatedAt":
+			z.CorsConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CorsConfigUpdatedAt")
+				return
+			}
+		case "WebsiteConfigXML":
+			z.WebsiteConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.WebsiteConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "WebsiteConfigXML")
+				return
+			}
+		case "WebsiteConfigUpdatedAt":
+			z.WebsiteConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "WebsiteConfigUpdatedAt")
+				return
+			}
+		case "AppendConfigJSON":
+			z.AppendConfigJSON, bts, err = msgp.ReadBytesBytes(bts, z.AppendConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "AppendConfigJSON")
+				return
+			}
+		case "AppendConfigUpdatedAt":
+			z.AppendConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AppendConfigUpdatedAt")
+				return
+			}
+		case "ActiveRetentionConfigJSON":
+			z.ActiveRetentionConfigJSON, bts, err = msgp.ReadBytesBytes(bts, z.ActiveRetentionConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "ActiveRetentionConfigJSON")
+				return
+			}
+		case "ActiveRetentionConfigUpdatedAt":
+			z.ActiveRetentionConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ActiveRetentionConfigUpdatedAt")
+				return
+			}
+		case "BucketStateConfigJSON":
+			z.BucketStateConfigJSON, bts, err = msgp.ReadBytesBytes(bts, z.BucketStateConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "BucketStateConfigJSON")
+				return
+			}
+		case "BucketStateConfigUpdatedAt":
+			z.BucketStateConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BucketStateConfigUpdatedAt")
+				return
+			}
+		case "EphemeralConfigJSON":
+			z.EphemeralConfigJSON, bts, err = msgp.ReadBytesBytes(bts, z.EphemeralConfigJSON)
+			if err != nil {
+				err = msgp.WrapError(err, "EphemeralConfigJSON")
+				return
+			}
+		case "EphemeralConfigUpdatedAt":
+			z.EphemeralConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EphemeralConfigUpdatedAt")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -605,6 +1055,6 @@ func (z *BucketMetadata) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *BucketMetadata) Msgsize() (s int) {
-	s = 3 + 5 + msgp.StringPrefixSize + len(z.Name) + 8 + msgp.TimeSize + 12 + msgp.BoolSize + 17 + msgp.BytesPrefixSize + len(z.PolicyConfigJSON) + 22 + msgp.BytesPrefixSize + len(z.NotificationConfigXML) + 19 + msgp.BytesPrefixSize + len(z.LifecycleConfigXML) + 20 + msgp.BytesPrefixSize + len(z.ObjectLockConfigXML) + 20 + msgp.BytesPrefixSize + len(z.VersioningConfigXML) + 20 + msgp.BytesPrefixSize + len(z.EncryptionConfigXML) + 17 + msgp.BytesPrefixSize + len(z.TaggingConfigXML) + 16 + msgp.BytesPrefixSize + len(z.QuotaConfigJSON) + 21 + msgp.BytesPrefixSize + len(z.ReplicationConfigXML) + 24 + msgp.BytesPrefixSize + len(z.BucketTargetsConfigJSON) + 28 + msgp.BytesPrefixSize + len(z.BucketTargetsConfigMetaJSON) + 22 + msgp.TimeSize + 26 + msgp.TimeSize + 26 + msgp.TimeSize + 23 + msgp.TimeSize + 21 + msgp.TimeSize + 27 + msgp.TimeSize + 26 + msgp.TimeSize
+	s = 3 + 5 + msgp.StringPrefixSize + len(z.Name) + 8 + msgp.TimeSize + 12 + msgp.BoolSize + 17 + msgp.BytesPrefixSize + len(z.PolicyConfigJSON) + 22 + msgp.BytesPrefixSize + len(z.NotificationConfigXML) + 19 + msgp.BytesPrefixSize + len(z.LifecycleConfigXML) + 20 + msgp.BytesPrefixSize + len(z.ObjectLockConfigXML) + 20 + msgp.BytesPrefixSize + len(z.VersioningConfigXML) + 20 + msgp.BytesPrefixSize + len(z.EncryptionConfigXML) + 17 + msgp.BytesPrefixSize + len(z.TaggingConfigXML) + 16 + msgp.BytesPrefixSize + len(z.QuotaConfigJSON) + 21 + msgp.BytesPrefixSize + len(z.ReplicationConfigXML) + 24 + msgp.BytesPrefixSize + len(z.BucketTargetsConfigJSON) + 28 + msgp.BytesPrefixSize + len(z.BucketTargetsConfigMetaJSON) + 22 + msgp.TimeSize + 26 + msgp.TimeSize + 26 + msgp.TimeSize + 23 + msgp.TimeSize + 21 + msgp.TimeSize + 27 + msgp.TimeSize + 26 + msgp.TimeSize + 20 + msgp.BytesPrefixSize + len(z.OverwriteConfigJSON) + 25 + msgp.TimeSize + 22 + msgp.BytesPrefixSize + len(z.ReadThroughConfigJSON) + 27 + msgp.TimeSize + 23 + msgp.BytesPrefixSize + len(z.EventJournalConfigJSON) + 28 + msgp.TimeSize + 14 + msgp.BytesPrefixSize + len(z.CorsConfigXML) + 20 + msgp.TimeSize + 17 + msgp.BytesPrefixSize + len(z.WebsiteConfigXML) + 23 + msgp.TimeSize + 17 + msgp.BytesPrefixSize + len(z.AppendConfigJSON) + 22 + msgp.TimeSize + 26 + msgp.BytesPrefixSize + len(z.ActiveRetentionConfigJSON) + 31 + msgp.TimeSize + 22 + msgp.BytesPrefixSize + len(z.BucketStateConfigJSON) + 27 + msgp.TimeSize + 20 + msgp.BytesPrefixSize + len(z.EphemeralConfigJSON) + 25 + msgp.TimeSize
 	return
 }
@@ -22,7 +22,11 @@ import (
 	"errors"
 	"math"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/minio/minio/internal/amztime"
 	"github.com/minio/minio/internal/auth"
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/bucket/replication"
@@ -343,6 +347,152 @@ func checkPutObjectLockAllowed(ctx context.Context, rq *http.Request, bucket, ob
 	return mode, retainDate, legalHold, ErrNone
 }
 
+// Operations on a sample object checked by testObjectLockEnforcement.
+const (
+	objectLockTestDelete           = "delete"
+	objectLockTestDeleteVersion    = "delete-version"
+	objectLockTestOverwrite        = "overwrite"
+	objectLockTestOverwriteVersion = "overwrite-version"
+	objectLockTestExpiry           = "lifecycle-expiry"
+)
+
+// ObjectLockTestOperation - whether an operation on the sample object
+// would be blocked. Bypassable is set when a blocked operation is allowed
+// with the x-amz-bypass-governance-retention header and the
+// s3:BypassGovernanceRetention permission.
+type ObjectLockTestOperation struct {
+	Operation  string `json:"operation"`
+	Blocked    bool   `json:"blocked"`
+	Bypassable bool   `json:"bypassable,omitempty"`
+	Reason     string `json:"reason"`
+}
+
+// ObjectLockTestResult - the retention applied to a sample object written
+// to a bucket with the given default retention, and the operations on it
+// that would be blocked.
+type ObjectLockTestResult struct {
+	Bucket       string                    `json:"bucket"`
+	Hypothetical bool                      `json:"hypothetical"`
+	Mode         string                    `json:"mode,omitempty"`
+	Validity     string                    `json:"validity,omitempty"`
+	LegalHold    bool                      `json:"legalHold"`
+	ModTime      time.Time                 `json:"modTime"`
+	RetainUntil  *time.Time                `json:"retainUntil,omitempty"`
+	Operations   []ObjectLockTestOperation `json:"operations"`
+}
+
+// testObjectLockEnforcement runs the object lock enforcement on a sample
+// object version written age ago to bucket, with the default retention
+// ret and optionally under legal hold. Nothing is read from or written to
+// the bucket, except its object lock configuration, which must be
+// enabled.
+func testObjectLockEnforcement(ctx context.Context, bucket string, ret objectlock.Retention, legalHold bool, age time.Duration) (res ObjectLockTestResult, err error) {
+	now, err := objectlock.UTCNowNTP()
+	if err != nil {
+		return res, err
+	}
+
+	oi := ObjectInfo{
+		Bucket:      bucket,
+		Name:        "object-lock-test",
+		VersionID:   mustGetUUID(),
+		ModTime:     now.Add(-age),
+		UserDefined: make(map[string]string),
+	}
+	res = ObjectLockTestResult{
+		Bucket:    bucket,
+		LegalHold: legalHold,
+		ModTime:   oi.ModTime,
+	}
+	// The default retention of the bucket is applied as on upload.
+	if ret.Mode.Valid() && ret.Validity > 0 {
+		retainUntil := oi.ModTime.Add(ret.Validity)
+		oi.UserDefined[strings.ToLower(xhttp.AmzObjectLockMode)] = string(ret.Mode)
+		oi.UserDefined[strings.ToLower(xhttp.AmzObjectLockRetainUntilDate)] = amztime.ISO8601Format(retainUntil.UTC())
+		res.Mode = string(ret.Mode)
+		res.Validity = ret.Validity.String()
+		res.RetainUntil = &retainUntil
+	}
+	if legalHold {
+		oi.UserDefined[strings.ToLower(xhttp.AmzObjectLockLegalHold)] = string(objectlock.LegalHoldOn)
+	}
+
+	reason := func(code APIErrorCode) string {
+		if code == ErrNone {
+			return "allowed"
+		}
+		return errorCodes.ToAPIErr(code).Description
+	}
+	// Governance retention is the only one that can be bypassed, a legal
+	// hold never is.
+	bypassable := ret.Mode == objectlock.RetGovernance && !legalHold
+
+	// Deleting without a version ID adds a delete marker, the version is
+	// kept.
+	res.Operations = append(res.Operations, ObjectLockTestOperation{
+		Operation: objectLockTestDelete,
+		Reason:    "allowed, a delete marker is added and the version is kept",
+	})
+
+	rq := &http.Request{
+		Method: http.MethodDelete,
+		URL:    &url.URL{Path: SlashSeparator + bucket + SlashSeparator + oi.Name},
+		Header: make(http.Header),
+		Form:   make(url.Values),
+	}
+	code := enforceRetentionBypassForDelete(ctx, rq, bucket, ObjectToDelete{
+		ObjectV: ObjectV{
+			ObjectName: oi.Name,
+			VersionID:  oi.VersionID,
+		},
+	}, oi, nil)
+	res.Operations = append(res.Operations, ObjectLockTestOperation{
+		Operation:  objectLockTestDeleteVersion,
+		Blocked:    code != ErrNone,
+		Bypassable: code != ErrNone && bypassable,
+		Reason:     reason(code),
+	})
+
+	getObjectInfo := func(ctx context.Context, bucket, object string, opts ObjectOptions) (ObjectInfo, error) {
+		return oi, nil
+	}
+	rq = &http.Request{
+		Method: http.MethodPut,
+		URL:    &url.URL{Path: SlashSeparator + bucket + SlashSeparator + oi.Name},
+		Header: make(http.Header),
+		Form:   make(url.Values),
+	}
+	_, _, _, code = checkPutObjectLockAllowed(ctx, rq, bucket, oi.Name, getObjectInfo, ErrNone, ErrNone)
+	op := ObjectLockTestOperation{
+		Operation: objectLockTestOverwrite,
+		Blocked:   code != ErrNone,
+		Reason:    reason(code),
+	}
+	if code == ErrNone {
+		op.Reason = "allowed, a new version is added and the version is kept"
+	}
+	res.Operations = append(res.Operations, op)
+
+	rq.Form.Set(xhttp.VersionID, oi.VersionID)
+	_, _, _, code = checkPutObjectLockAllowed(ctx, rq, bucket, oi.Name, getObjectInfo, ErrNone, ErrNone)
+	res.Operations = append(res.Operations, ObjectLockTestOperation{
+		Operation: objectLockTestOverwriteVersion,
+		Blocked:   code != ErrNone,
+		Reason:    reason(code),
+	})
+
+	op = ObjectLockTestOperation{
+		Operation: objectLockTestExpiry,
+		Reason:    "allowed",
+	}
+	if enforceRetentionForDeletion(ctx, oi) {
+		op.Blocked = true
+		op.Reason = "the version is under retention or legal hold, lifecycle expiry skips it"
+	}
+	res.Operations = append(res.Operations, op)
+	return res, nil
+}
+
 // NewBucketObjectLockSys returns initialized BucketObjectLockSys
 func NewBucketObjectLockSys() *BucketObjectLockSys {
 	return &BucketObjectLockSys{}
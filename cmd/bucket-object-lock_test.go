@@ -0,0 +1,89 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+)
+
+func TestObjectLockEnforcementTest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+	initConfigSubsystem(ctx, obj)
+
+	bucket := "locked"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{LockEnabled: true, VersioningEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	governance := objectlock.Retention{Mode: objectlock.RetGovernance, Validity: 24 * time.Hour, LockEnabled: true}
+	compliance := objectlock.Retention{Mode: objectlock.RetCompliance, Validity: 24 * time.Hour, LockEnabled: true}
+
+	// Operation outcomes, in order: delete, delete-version, overwrite,
+	// overwrite-version, lifecycle-expiry.
+	testCases := []struct {
+		name       string
+		ret        objectlock.Retention
+		legalHold  bool
+		age        time.Duration
+		blocked    []bool
+		bypassable bool
+	}{
+		{"no retention", objectlock.Retention{LockEnabled: true}, false, 0, []bool{false, false, false, false, false}, false},
+		{"governance", governance, false, 0, []bool{false, true, false, false, true}, true},
+		{"governance expired", governance, false, 48 * time.Hour, []bool{false, false, false, false, false}, false},
+		{"compliance", compliance, false, time.Hour, []bool{false, true, false, true, true}, false},
+		{"legal hold", objectlock.Retention{LockEnabled: true}, true, 0, []bool{false, true, false, true, true}, false},
+		{"governance legal hold", governance, true, 0, []bool{false, true, false, true, true}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := testObjectLockEnforcement(ctx, bucket, tc.ret, tc.legalHold, tc.age)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Operations) != len(tc.blocked) {
+				t.Fatalf("expected %d operations, got %d", len(tc.blocked), len(res.Operations))
+			}
+			for i, op := range res.Operations {
+				if op.Blocked != tc.blocked[i] {
+					t.Errorf("%s: expected blocked %t, got %t (%s)", op.Operation, tc.blocked[i], op.Blocked, op.Reason)
+				}
+			}
+			if bypassable := res.Operations[1].Bypassable; bypassable != tc.bypassable {
+				t.Errorf("expected bypassable %t, got %t", tc.bypassable, bypassable)
+			}
+			if (tc.ret.Validity > 0) != (res.RetainUntil != nil) {
+				t.Errorf("unexpected retain until %v", res.RetainUntil)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/minio/minio/internal/amztime"
+	"github.com/minio/minio/internal/config/storageclass"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+)
+
+// BucketOverwriteConfig - per bucket settings controlling how
+// overwrites of existing objects are handled.
+type BucketOverwriteConfig struct {
+	// SkipIdenticalOverwrite when enabled, PutObject requests carrying a
+	// Content-MD5 or a full object checksum identical to the latest
+	// version, with the same metadata and tags, are acknowledged
+	// without writing any new data.
+	SkipIdenticalOverwrite bool `json:"skip-identical-overwrite"`
+}
+
+// parseBucketOverwriteConfig parses BucketOverwriteConfig from json
+func parseBucketOverwriteConfig(data []byte) (*BucketOverwriteConfig, error) {
+	cfg := &BucketOverwriteConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// skipIdenticalOverwriteEnabled returns true if identical overwrites
+// should be skipped for the bucket.
+func skipIdenticalOverwriteEnabled(ctx context.Context, bucket string) bool {
+	cfg, _, err := globalBucketMetadataSys.GetOverwriteConfig(ctx, bucket)
+	if err != nil {
+		return false
+	}
+	return cfg.SkipIdenticalOverwrite
+}
+
+// overwriteClientMetadata returns the metadata of an object version set
+// by the client, content headers, user-defined metadata and object lock
+// settings, with lower case keys. Tags and expires are not part of
+// ObjectInfo.UserDefined and are compared separately.
+func overwriteClientMetadata(meta map[string]string) map[string]string {
+	m := make(map[string]string, len(meta))
+	for k, v := range meta {
+		k = strings.ToLower(k)
+		switch {
+		case strings.HasPrefix(k, "x-amz-meta-x-minio-internal-"):
+			// Internal encryption metadata.
+			continue
+		case strings.HasPrefix(k, "x-amz-meta-"), strings.HasPrefix(k, "x-minio-meta-"),
+			strings.HasPrefix(k, "x-amz-object-lock-"):
+		case equals(k, "content-type", "cache-control", "content-language", "content-encoding", "content-disposition"):
+		default:
+			continue
+		}
+		m[k] = v
+	}
+	return m
+}
+
+// isIdenticalOverwrite returns the client visible ETag of oi and true
+// if oi holds the same content as the incoming PUT described by h, meta,
+// actualSize, md5hex and cksum. The content is considered identical
+// when the size, the supplied Content-MD5 and/or checksum, the storage
+// class, the encryption mode, the metadata and the tags all match.
+func isIdenticalOverwrite(ctx context.Context, oi ObjectInfo, h http.Header, meta map[string]string, actualSize int64, md5hex string, cksum *hash.Checksum) (string, bool) {
+	if md5hex == "" && (cksum == nil || cksum.Encoded == "") {
+		return "", false
+	}
+	if oi.DeleteMarker || oi.IsDir || oi.isMultipart() || strings.Contains(oi.ETag, "-") {
+		return "", false
+	}
+
+	// A PUT replaces the metadata of the object, it is only identical
+	// if the metadata doesn't change.
+	if !reflect.DeepEqual(overwriteClientMetadata(meta), overwriteClientMetadata(oi.UserDefined)) {
+		return "", false
+	}
+	if meta[xhttp.AmzObjectTagging] != oi.UserTags {
+		return "", false
+	}
+	if exp, ok := meta["expires"]; ok {
+		t, err := amztime.ParseHeader(exp)
+		if err != nil || !t.UTC().Equal(oi.Expires) {
+			return "", false
+		}
+	} else if !oi.Expires.IsZero() {
+		return "", false
+	}
+
+	wantSC := h.Get(xhttp.AmzStorageClass)
+	if wantSC == "" {
+		wantSC = storageclass.STANDARD
+	}
+	haveSC := oi.StorageClass
+	if haveSC == "" {
+		haveSC = storageclass.STANDARD
+	}
+	if wantSC != haveSC {
+		return "", false
+	}
+
+	var wantKind crypto.Type
+	switch {
+	case crypto.SSEC.IsRequested(h):
+		return "", false
+	case crypto.S3KMS.IsRequested(h):
+		wantKind = crypto.S3KMS
+	case crypto.S3.IsRequested(h):
+		wantKind = crypto.S3
+	}
+	kind, encrypted := crypto.IsEncrypted(oi.UserDefined)
+	if encrypted != (wantKind != nil) || kind != wantKind {
+		return "", false
+	}
+
+	size, err := oi.GetActualSize()
+	if err != nil || size != actualSize {
+		return "", false
+	}
+
+	etag := oi.ETag
+	switch kind {
+	case crypto.S3KMS:
+		keyID := strings.TrimPrefix(h.Get(xhttp.AmzServerSideEncryptionKmsID), crypto.ARNPrefix)
+		if keyID != "" && keyID != strings.TrimPrefix(oi.KMSKeyID(), crypto.ARNPrefix) {
+			return "", false
+		}
+		if len(etag) < 32 {
+			return "", false
+		}
+		etag = etag[len(etag)-32:]
+	case crypto.S3:
+		objs := []ObjectInfo{oi}
+		if err := DecryptETags(ctx, GlobalKMS, objs); err != nil {
+			logger.LogIf(ctx, err)
+			return "", false
+		}
+		etag = objs[0].ETag
+	}
+
+	if md5hex != "" && etag != md5hex {
+		return "", false
+	}
+	if cksum != nil && cksum.Encoded != "" {
+		if oi.decryptChecksums()[cksum.Type.String()] != cksum.Encoded {
+			return "", false
+		}
+	}
+	return etag, true
+}
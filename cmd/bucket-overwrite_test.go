@@ -0,0 +1,155 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func TestParseBucketOverwriteConfig(t *testing.T) {
+	cfg, err := parseBucketOverwriteConfig([]byte(`{"skip-identical-overwrite":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.SkipIdenticalOverwrite {
+		t.Fatal("expected skip-identical-overwrite to be enabled")
+	}
+	if _, err = parseBucketOverwriteConfig([]byte(`{"skip-identical-overwrite":`)); err == nil {
+		t.Fatal("expected malformed config to fail")
+	}
+}
+
+func TestIsIdenticalOverwrite(t *testing.T) {
+	const md5hex = "5d41402abc4b2a76b9719d911017c592"
+	crc := hash.NewChecksumString(hash.ChecksumCRC32C.String(), "mnG7TA==")
+	rrs := http.Header{}
+	rrs.Set(xhttp.AmzStorageClass, "REDUCED_REDUNDANCY")
+	latest := ObjectInfo{
+		Size:         5,
+		ETag:         md5hex,
+		StorageClass: "STANDARD",
+		Checksum:     crc.AppendTo(nil),
+		UserDefined: map[string]string{
+			"content-type":                 "text/plain",
+			"X-Amz-Meta-Owner":             "alice",
+			"x-minio-internal-compression": "klauspost/compress/s2",
+		},
+		UserTags: "project=a",
+	}
+	meta := map[string]string{
+		"content-type":         "text/plain",
+		"X-Amz-Meta-Owner":     "alice",
+		xhttp.AmzObjectTagging: "project=a",
+		xhttp.AmzStorageClass:  "STANDARD",
+	}
+	withMeta := func(k, v string) map[string]string {
+		m := make(map[string]string, len(meta))
+		for mk, mv := range meta {
+			m[mk] = mv
+		}
+		if v == "" {
+			delete(m, k)
+		} else {
+			m[k] = v
+		}
+		return m
+	}
+
+	testCases := []struct {
+		name     string
+		oi       func() ObjectInfo
+		header   http.Header
+		meta     map[string]string
+		size     int64
+		md5hex   string
+		cksum    *hash.Checksum
+		expected bool
+	}{
+		{"md5-match", func() ObjectInfo { return latest }, http.Header{}, meta, 5, md5hex, nil, true},
+		{"checksum-match", func() ObjectInfo { return latest }, http.Header{}, meta, 5, "", crc, true},
+		{"no-digest", func() ObjectInfo { return latest }, http.Header{}, meta, 5, "", nil, false},
+		{"md5-mismatch", func() ObjectInfo { return latest }, http.Header{}, meta, 5, "7d793037a0760186574b0282f2f435e7", nil, false},
+		{"size-mismatch", func() ObjectInfo { return latest }, http.Header{}, meta, 6, md5hex, nil, false},
+		{
+			"storage-class-mismatch", func() ObjectInfo { return latest },
+			rrs, meta, 5, md5hex, nil, false,
+		},
+		{
+			"delete-marker", func() ObjectInfo {
+				oi := latest
+				oi.DeleteMarker = true
+				return oi
+			}, http.Header{}, meta, 5, md5hex, nil, false,
+		},
+		{
+			"multipart-etag", func() ObjectInfo {
+				oi := latest
+				oi.ETag = md5hex + "-2"
+				return oi
+			}, http.Header{}, meta, 5, md5hex, nil, false,
+		},
+		{
+			"sse-c-requested", func() ObjectInfo { return latest },
+			http.Header{xhttp.AmzServerSideEncryptionCustomerAlgorithm: []string{xhttp.AmzEncryptionAES}}, meta, 5, md5hex, nil, false,
+		},
+		{
+			"user-metadata-mismatch", func() ObjectInfo { return latest },
+			http.Header{}, withMeta("X-Amz-Meta-Owner", "bob"), 5, md5hex, nil, false,
+		},
+		{
+			"user-metadata-added", func() ObjectInfo { return latest },
+			http.Header{}, withMeta("X-Amz-Meta-Team", "storage"), 5, md5hex, nil, false,
+		},
+		{
+			"content-type-mismatch", func() ObjectInfo { return latest },
+			http.Header{}, withMeta("content-type", "application/json"), 5, md5hex, nil, false,
+		},
+		{
+			"tags-mismatch", func() ObjectInfo { return latest },
+			http.Header{}, withMeta(xhttp.AmzObjectTagging, ""), 5, md5hex, nil, false,
+		},
+		{
+			"retention-mismatch", func() ObjectInfo {
+				oi := latest
+				oi.UserDefined = map[string]string{
+					"content-type":           "text/plain",
+					"X-Amz-Meta-Owner":       "alice",
+					"x-amz-object-lock-mode": "GOVERNANCE",
+				}
+				return oi
+			}, http.Header{}, meta, 5, md5hex, nil, false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			etag, ok := isIdenticalOverwrite(context.Background(), testCase.oi(), testCase.header, testCase.meta, testCase.size, testCase.md5hex, testCase.cksum)
+			if ok != testCase.expected {
+				t.Fatalf("expected %v, got %v", testCase.expected, ok)
+			}
+			if ok && etag != md5hex {
+				t.Fatalf("expected etag %s, got %s", md5hex, etag)
+			}
+		})
+	}
+}
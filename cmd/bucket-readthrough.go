@@ -0,0 +1,274 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/textproto"
+	"sync"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+)
+
+// readThroughService is the type of the remote targets serving the
+// reads of objects missing from a bucket, typically the cluster the
+// bucket is being migrated from.
+const readThroughService madmin.ServiceType = "readthrough"
+
+// Maximum number of objects populated concurrently from read-through
+// remotes, reads beyond that are only proxied.
+const readThroughPopulateWorkers = 16
+
+// BucketReadThroughConfig - per bucket read-through remote settings.
+type BucketReadThroughConfig struct {
+	// Arn of the remote target of type "readthrough" reads of
+	// objects missing locally are proxied to.
+	Arn string `json:"arn"`
+	// PopulateOnRead when enabled, objects read from the remote are
+	// written locally so that subsequent reads are served locally.
+	PopulateOnRead bool `json:"populate-on-read"`
+}
+
+// Enabled returns true if reads are proxied to a remote.
+func (c *BucketReadThroughConfig) Enabled() bool {
+	return c != nil && c.Arn != ""
+}
+
+// parseBucketReadThroughConfig parses BucketReadThroughConfig from json
+func parseBucketReadThroughConfig(data []byte) (*BucketReadThroughConfig, error) {
+	cfg := &BucketReadThroughConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return cfg, err
+	}
+	arn, err := madmin.ParseARN(cfg.Arn)
+	if err != nil {
+		return cfg, err
+	}
+	if arn.Type != readThroughService {
+		return cfg, fmt.Errorf("remote target %s is not of type %s", cfg.Arn, readThroughService)
+	}
+	return cfg, nil
+}
+
+// isValidTargetType returns true if remote targets of type t can be
+// set on a bucket.
+func isValidTargetType(t madmin.ServiceType) bool {
+	return t.IsValid() || t == readThroughService
+}
+
+// readThroughTargets returns the read-through remote of bucket, if a
+// read of an object which failed locally with err must be proxied to
+// it. oi is the object info returned along with err, if any.
+func readThroughTargets(ctx context.Context, bucket string, oi ObjectInfo, err error, opts ObjectOptions) (*madmin.BucketTargets, *BucketReadThroughConfig) {
+	// Never proxy requests which are themselves proxied, the remote
+	// may be reading through from this cluster.
+	if opts.ProxyRequest || opts.ProxyHeaderSet {
+		return &madmin.BucketTargets{}, nil
+	}
+	if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
+		return &madmin.BucketTargets{}, nil
+	}
+	// Objects deleted locally must stay deleted.
+	if oi.DeleteMarker {
+		return &madmin.BucketTargets{}, nil
+	}
+	cfg, _, cerr := globalBucketMetadataSys.GetReadThroughConfig(ctx, bucket)
+	if cerr != nil || !cfg.Enabled() {
+		return &madmin.BucketTargets{}, nil
+	}
+	tgt := globalBucketTargetSys.GetRemoteBucketTargetByArn(ctx, bucket, cfg.Arn)
+	return &madmin.BucketTargets{Targets: []madmin.BucketTarget{tgt}}, cfg
+}
+
+// proxyGetToReadThroughRemote serves a GET of an object missing locally
+// from the read-through remote of bucket, if configured. oi and lerr
+// are the results of the local read.
+func proxyGetToReadThroughRemote(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, oi ObjectInfo, lerr error, opts ObjectOptions) (*GetObjectReader, proxyResult, error) {
+	tgts, cfg := readThroughTargets(ctx, bucket, oi, lerr, opts)
+	if tgts.Empty() {
+		return nil, proxyResult{}, nil
+	}
+	gr, proxy, err := proxyGetToReplicationTarget(ctx, bucket, object, rs, h, opts, tgts)
+	if err != nil || gr == nil || !proxy.Proxy {
+		return gr, proxy, err
+	}
+	globalBucketConnStats.incReadThroughProxied(bucket)
+	if cfg.PopulateOnRead {
+		globalReadThroughPopulator.queue(bucket, object, cfg.Arn, gr.ObjInfo)
+	}
+	return gr, proxy, nil
+}
+
+// proxyHeadToReadThroughRemote serves a HEAD of an object missing
+// locally from the read-through remote of bucket, if configured.
+func proxyHeadToReadThroughRemote(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, oi ObjectInfo, lerr error, opts ObjectOptions) (ObjectInfo, proxyResult) {
+	tgts, _ := readThroughTargets(ctx, bucket, oi, lerr, opts)
+	if tgts.Empty() {
+		return ObjectInfo{}, proxyResult{}
+	}
+	roi, proxy := proxyHeadToReplicationTarget(ctx, bucket, object, rs, opts, tgts)
+	if proxy.Proxy {
+		globalBucketConnStats.incReadThroughProxied(bucket)
+	}
+	return roi, proxy
+}
+
+// readThroughPopulator writes objects read from read-through remotes
+// locally, in the background.
+type readThroughPopulator struct {
+	mu       sync.Mutex
+	inflight map[string]struct{}
+	workers  chan struct{}
+}
+
+var globalReadThroughPopulator = &readThroughPopulator{
+	inflight: make(map[string]struct{}),
+	workers:  make(chan struct{}, readThroughPopulateWorkers),
+}
+
+// queue populates object described by oi, read from the remote arn,
+// unless it is already being populated or all workers are busy, in
+// which case a later read populates it.
+func (p *readThroughPopulator) queue(bucket, object, arn string, oi ObjectInfo) {
+	key := pathJoin(bucket, object)
+
+	p.mu.Lock()
+	if _, ok := p.inflight[key]; ok {
+		p.mu.Unlock()
+		return
+	}
+	select {
+	case p.workers <- struct{}{}:
+	default:
+		p.mu.Unlock()
+		return
+	}
+	p.inflight[key] = struct{}{}
+	p.mu.Unlock()
+
+	go func() {
+		defer func() {
+			p.mu.Lock()
+			delete(p.inflight, key)
+			p.mu.Unlock()
+			<-p.workers
+		}()
+		ctx := GlobalContext
+		if err := populateFromReadThroughRemote(ctx, bucket, object, arn, oi); err != nil {
+			logger.LogIf(ctx, fmt.Errorf("Unable to populate %s/%s(%s) from read-through remote %s - %w", bucket, object, oi.VersionID, arn, err))
+		}
+	}()
+}
+
+// populateFromReadThroughRemote copies the version of object described
+// by oi from the remote arn to the local bucket through PutObject. The
+// object is only written if it still does not exist locally, writes and
+// deletes made locally take precedence over the remote.
+func populateFromReadThroughRemote(ctx context.Context, bucket, object, arn string, oi ObjectInfo) error {
+	objectAPI := newObjectLayerFn()
+	if objectAPI == nil {
+		return errServerNotInitialized
+	}
+
+	// Encrypted objects are served decrypted by the remote, do not
+	// store them locally in plaintext.
+	if _, ok := oi.UserDefined[xhttp.AmzServerSideEncryption]; ok {
+		return nil
+	}
+	if _, ok := oi.UserDefined[xhttp.AmzServerSideEncryptionCustomerAlgorithm]; ok {
+		return nil
+	}
+	if _, err := globalBucketSSEConfigSys.Get(bucket); err == nil {
+		return nil
+	}
+
+	tgt := globalBucketTargetSys.GetRemoteTargetClient(ctx, arn)
+	if tgt == nil {
+		// Remote removed in the meantime.
+		return nil
+	}
+
+	lk := objectAPI.NewNSLock(bucket, object)
+	lkctx, err := lk.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return err
+	}
+	ctx = lkctx.Context()
+	defer lk.Unlock(lkctx)
+
+	loi, err := objectAPI.GetObjectInfo(ctx, bucket, object, ObjectOptions{NoLock: true})
+	if err == nil || loi.DeleteMarker {
+		return nil
+	}
+	if !isErrObjectNotFound(err) {
+		return err
+	}
+
+	gopts := minio.GetObjectOptions{
+		VersionID: oi.VersionID,
+		Internal: minio.AdvancedGetOptions{
+			ReplicationProxyRequest: "true",
+		},
+	}
+	// Make sure to copy the version which was read.
+	if err = gopts.SetMatchETag(oi.ETag); err != nil {
+		return err
+	}
+	c := minio.Core{Client: tgt.Client}
+	obj, info, h, err := c.GetObject(ctx, tgt.Bucket, object, gopts)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+
+	metadata := make(map[string]string)
+	if err = extractMetadataFromMime(ctx, textproto.MIMEHeader(h), metadata); err != nil {
+		return err
+	}
+	if oi.UserTags != "" {
+		metadata[xhttp.AmzObjectTagging] = oi.UserTags
+	}
+
+	hr, err := hash.NewReader(obj, info.Size, "", "", info.Size)
+	if err != nil {
+		return err
+	}
+
+	opts := ObjectOptions{
+		UserDefined:      metadata,
+		MTime:            info.LastModified,
+		Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, object),
+		VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, object),
+		NoLock:           true,
+	}
+	if opts.Versioned && info.VersionID != "" && info.VersionID != nullVersionID {
+		opts.VersionID = info.VersionID
+	}
+	if _, err = objectAPI.PutObject(ctx, bucket, object, NewPutObjReader(hr), opts); err != nil {
+		return err
+	}
+	globalBucketConnStats.incReadThroughPopulated(bucket, info.Size)
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestParseBucketReadThroughConfig(t *testing.T) {
+	testCases := []struct {
+		data       string
+		shouldFail bool
+	}{
+		{`{"arn":"arn:minio:readthrough::id:bucket","populate-on-read":true}`, false},
+		{`{"arn":"arn:minio:readthrough::id:bucket"}`, false},
+		// Remote targets of other types are rejected.
+		{`{"arn":"arn:minio:replication::id:bucket"}`, true},
+		{`{"arn":"invalid"}`, true},
+		{`{"arn":`, true},
+	}
+	for i, tc := range testCases {
+		cfg, err := parseBucketReadThroughConfig([]byte(tc.data))
+		if tc.shouldFail {
+			if err == nil {
+				t.Errorf("Test %d: expected failure for %s", i+1, tc.data)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+			continue
+		}
+		if !cfg.Enabled() {
+			t.Errorf("Test %d: expected read-through to be enabled", i+1)
+		}
+	}
+}
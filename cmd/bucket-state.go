@@ -0,0 +1,203 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/minio/minio/internal/bucket/replication"
+	xhttp "github.com/minio/minio/internal/http"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// Administrative states of a bucket.
+const (
+	bucketStateNormal    = "normal"
+	bucketStateReadOnly  = "read-only"
+	bucketStateWriteOnce = "write-once"
+)
+
+var errBucketStateRejected = errors.New("request rejected by the bucket state")
+
+// BucketStateConfig - administrative state of a bucket, enforced on top
+// of IAM and bucket policies.
+type BucketStateConfig struct {
+	State string `json:"state"`
+	// ExemptReplication lets replication from remote sites allowed the
+	// replication actions through the state, it is blocked like any other
+	// request otherwise.
+	ExemptReplication bool `json:"exemptReplication,omitempty"`
+}
+
+// parseBucketStateConfig parses BucketStateConfig from json
+func parseBucketStateConfig(data []byte) (*BucketStateConfig, error) {
+	cfg := &BucketStateConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return cfg, err
+	}
+	switch cfg.State {
+	case "":
+		cfg.State = bucketStateNormal
+	case bucketStateNormal, bucketStateReadOnly, bucketStateWriteOnce:
+	default:
+		return cfg, fmt.Errorf("unknown bucket state %s, expected %s, %s or %s",
+			cfg.State, bucketStateNormal, bucketStateReadOnly, bucketStateWriteOnce)
+	}
+	return cfg, nil
+}
+
+// frozen returns true if the state restricts mutations of the bucket.
+func (c BucketStateConfig) frozen() bool {
+	return c.State == bucketStateReadOnly || c.State == bucketStateWriteOnce
+}
+
+// bucketStateOp classifies the mutations of a bucket by what the
+// administrative states allow.
+type bucketStateOp int
+
+const (
+	// Writes a new version of an object, e.g. PutObject, CopyObject and
+	// CompleteMultipartUpload.
+	bucketStateOpWrite bucketStateOp = iota
+	// Changes an object without replacing its content, e.g. tagging,
+	// retention, legal hold and the parts of a multipart upload.
+	bucketStateOpUpdate
+	// Deletes an object version or adds a delete marker.
+	bucketStateOpDelete
+	// Deletes the bucket.
+	bucketStateOpDeleteBucket
+)
+
+// bucketStateRejections counts the requests rejected by the
+// administrative state, by bucket.
+type bucketStateRejections struct {
+	mu     sync.Mutex
+	counts map[string]uint64
+}
+
+var globalBucketStateRejections = &bucketStateRejections{counts: make(map[string]uint64)}
+
+func (r *bucketStateRejections) inc(bucket string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counts[bucket]++
+}
+
+// get returns the number of rejections of the bucket.
+func (r *bucketStateRejections) get(bucket string) uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.counts[bucket]
+}
+
+// objectExists returns true if the object, or the given version of it,
+// exists and is not a delete marker.
+func objectExists(ctx context.Context, objAPI ObjectLayer, bucket, object, versionID string) (bool, error) {
+	oi, err := objAPI.GetObjectInfo(ctx, bucket, object, ObjectOptions{VersionID: versionID, NoLock: true})
+	switch {
+	case err == nil:
+		return !oi.DeleteMarker, nil
+	case isErrObjectNotFound(err), isErrVersionNotFound(err), isErrMethodNotAllowed(err):
+		// GetObjectInfo of a delete marker returns MethodNotAllowed.
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// isReplicaRequest returns true for the requests of replication from
+// remote sites whose credentials are allowed the replication action of
+// the mutation, the headers alone don't make a request a replica.
+func isReplicaRequest(ctx context.Context, r *http.Request, bucket, object string, op bucketStateOp) bool {
+	if r.Header.Get(xhttp.MinIOSourceReplicationRequest) != "true" {
+		return false
+	}
+	var action iampolicy.Action
+	switch op {
+	case bucketStateOpWrite, bucketStateOpUpdate:
+		if r.Header.Get(xhttp.AmzBucketReplicationStatus) != replication.Replica.String() {
+			return false
+		}
+		action = iampolicy.ReplicateObjectAction
+	case bucketStateOpDelete:
+		action = iampolicy.ReplicateDeleteAction
+	default:
+		return false
+	}
+	return isPutActionAllowed(ctx, getRequestAuthType(r), bucket, object, r, action) == ErrNone
+}
+
+// checkBucketState returns the error of a mutation of the bucket not
+// allowed by its administrative state, ErrNone otherwise. It must be
+// called once the request is authorized, unauthorized callers don't
+// learn the state of the bucket or which keys exist.
+//
+// In the write-once state writes and deletes are only allowed for keys
+// which don't exist when the request starts, concurrent writes of the
+// same new key are not serialized.
+func checkBucketState(ctx context.Context, objAPI ObjectLayer, r *http.Request, bucket, object, versionID string, op bucketStateOp) APIErrorCode {
+	cfg, _, err := globalBucketMetadataSys.GetBucketStateConfig(ctx, bucket)
+	if err != nil || !cfg.frozen() {
+		// Missing buckets are reported by the request.
+		return ErrNone
+	}
+	if cfg.ExemptReplication && isReplicaRequest(ctx, r, bucket, object, op) {
+		return ErrNone
+	}
+
+	errCode := ErrNone
+	switch cfg.State {
+	case bucketStateReadOnly:
+		errCode = ErrBucketReadOnly
+	case bucketStateWriteOnce:
+		switch op {
+		case bucketStateOpUpdate:
+		case bucketStateOpDeleteBucket:
+			errCode = ErrBucketWriteOnce
+		default:
+			if op == bucketStateOpWrite {
+				// Writes create a new version of the latest.
+				versionID = ""
+			}
+			exists, err := objectExists(ctx, objAPI, bucket, object, versionID)
+			if err != nil {
+				return toAPIErrorCode(ctx, err)
+			}
+			if exists {
+				errCode = ErrBucketWriteOnce
+			}
+		}
+	}
+	if errCode != ErrNone {
+		globalBucketStateRejections.inc(bucket)
+	}
+	return errCode
+}
+
+// bucketStateBlocksILM returns true if the administrative state of the
+// bucket stops lifecycle expiry and transition, both change existing
+// objects.
+func bucketStateBlocksILM(ctx context.Context, bucket string) bool {
+	cfg, _, err := globalBucketMetadataSys.GetBucketStateConfig(ctx, bucket)
+	return err == nil && cfg.frozen()
+}
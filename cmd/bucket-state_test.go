@@ -0,0 +1,178 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/bucket/replication"
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func TestParseBucketStateConfig(t *testing.T) {
+	cfg, err := parseBucketStateConfig([]byte(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.State != bucketStateNormal || cfg.frozen() {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+	cfg, err = parseBucketStateConfig([]byte(`{"state":"write-once","exemptReplication":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.State != bucketStateWriteOnce || !cfg.ExemptReplication || !cfg.frozen() {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+	if _, err = parseBucketStateConfig([]byte(`{"state":"frozen"}`)); err == nil {
+		t.Fatal("expected unknown state to fail")
+	}
+}
+
+func TestCheckBucketState(t *testing.T) {
+	ExecObjectLayerTest(t, testCheckBucketState)
+}
+
+func testCheckBucketState(obj ObjectLayer, instanceType string, t TestErrHandler) {
+	ctx := context.Background()
+	bucket := "bucket-state"
+	if err := obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatalf("%s: %s", instanceType, err)
+	}
+	data := []byte("data")
+	if _, err := obj.PutObject(ctx, bucket, "existing", mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatalf("%s: %s", instanceType, err)
+	}
+
+	setState := func(cfg BucketStateConfig) {
+		meta, err := loadBucketMetadata(ctx, obj, bucket)
+		if err != nil {
+			t.Fatalf("%s: %s", instanceType, err)
+		}
+		meta.bucketStateConfig = &cfg
+		globalBucketMetadataSys.Set(bucket, meta)
+	}
+
+	r, _ := http.NewRequest(http.MethodPut, "/", nil)
+	replicaHeaders := map[string]string{
+		xhttp.MinIOSourceReplicationRequest: "true",
+		xhttp.AmzBucketReplicationStatus:    replication.Replica.String(),
+	}
+	// Replication is authorized with the credentials of the request,
+	// anonymous requests with the same headers are not exempted.
+	credentials := globalActiveCred
+	replica, err := newTestSignedRequestV4(http.MethodPut, "/"+bucket+"/existing", 0, nil, credentials.AccessKey, credentials.SecretKey, replicaHeaders)
+	if err != nil {
+		t.Fatalf("%s: %s", instanceType, err)
+	}
+	anonReplica, _ := http.NewRequest(http.MethodPut, "/"+bucket+"/existing", nil)
+	for k, v := range replicaHeaders {
+		anonReplica.Header.Set(k, v)
+	}
+
+	testCases := []struct {
+		cfg      BucketStateConfig
+		r        *http.Request
+		object   string
+		op       bucketStateOp
+		expected APIErrorCode
+	}{
+		{BucketStateConfig{State: bucketStateNormal}, r, "existing", bucketStateOpDelete, ErrNone},
+		{BucketStateConfig{State: bucketStateReadOnly}, r, "new", bucketStateOpWrite, ErrBucketReadOnly},
+		{BucketStateConfig{State: bucketStateReadOnly}, r, "existing", bucketStateOpUpdate, ErrBucketReadOnly},
+		{BucketStateConfig{State: bucketStateReadOnly}, replica, "existing", bucketStateOpWrite, ErrBucketReadOnly},
+		{BucketStateConfig{State: bucketStateReadOnly, ExemptReplication: true}, replica, "existing", bucketStateOpWrite, ErrNone},
+		{BucketStateConfig{State: bucketStateReadOnly, ExemptReplication: true}, r, "existing", bucketStateOpWrite, ErrBucketReadOnly},
+		{BucketStateConfig{State: bucketStateReadOnly, ExemptReplication: true}, anonReplica, "existing", bucketStateOpWrite, ErrBucketReadOnly},
+		{BucketStateConfig{State: bucketStateReadOnly, ExemptReplication: true}, replica, "", bucketStateOpDeleteBucket, ErrBucketReadOnly},
+		{BucketStateConfig{State: bucketStateWriteOnce}, r, "new", bucketStateOpWrite, ErrNone},
+		{BucketStateConfig{State: bucketStateWriteOnce}, r, "existing", bucketStateOpWrite, ErrBucketWriteOnce},
+		{BucketStateConfig{State: bucketStateWriteOnce}, r, "existing", bucketStateOpDelete, ErrBucketWriteOnce},
+		{BucketStateConfig{State: bucketStateWriteOnce}, r, "new", bucketStateOpDelete, ErrNone},
+		{BucketStateConfig{State: bucketStateWriteOnce}, r, "existing", bucketStateOpUpdate, ErrNone},
+		{BucketStateConfig{State: bucketStateWriteOnce}, r, "", bucketStateOpDeleteBucket, ErrBucketWriteOnce},
+	}
+	for i, tc := range testCases {
+		setState(tc.cfg)
+		before := globalBucketStateRejections.get(bucket)
+		if errCode := checkBucketState(ctx, obj, tc.r, bucket, tc.object, "", tc.op); errCode != tc.expected {
+			t.Fatalf("%s: case %d: expected %v, got %v", instanceType, i+1, tc.expected, errCode)
+		}
+		rejected := globalBucketStateRejections.get(bucket) - before
+		if (tc.expected != ErrNone) != (rejected == 1) {
+			t.Fatalf("%s: case %d: unexpected rejections count %d", instanceType, i+1, rejected)
+		}
+		if bucketStateBlocksILM(ctx, bucket) != tc.cfg.frozen() {
+			t.Fatalf("%s: case %d: expected lifecycle to be blocked in %s state", instanceType, i+1, tc.cfg.State)
+		}
+	}
+}
+
+func TestBucketStateAfterAuth(t *testing.T) {
+	ExecObjectLayerAPITest(t, testBucketStateAfterAuth, []string{"PutObject"})
+}
+
+func testBucketStateAfterAuth(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	ctx := context.Background()
+	data := []byte("data")
+	if _, err := obj.PutObject(ctx, bucketName, "existing", mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatalf("%s: %s", instanceType, err)
+	}
+	meta, err := loadBucketMetadata(ctx, obj, bucketName)
+	if err != nil {
+		t.Fatalf("%s: %s", instanceType, err)
+	}
+	meta.bucketStateConfig = &BucketStateConfig{State: bucketStateWriteOnce}
+	globalBucketMetadataSys.Set(bucketName, meta)
+
+	testCases := []struct {
+		accessKey, secretKey string
+		expected             string
+	}{
+		// Unauthorized callers don't learn which keys exist.
+		{"", "", "AccessDenied"},
+		{credentials.AccessKey, credentials.SecretKey, "XMinioBucketWriteOnce"},
+	}
+	for i, tc := range testCases {
+		req, err := newTestSignedRequestV4(http.MethodPut, getPutObjectURL("", bucketName, "existing"),
+			int64(len(data)), bytes.NewReader(data), tc.accessKey, tc.secretKey, nil)
+		if err != nil {
+			t.Fatalf("%s: case %d: %s", instanceType, i+1, err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("%s: case %d: expected status 403, got %d", instanceType, i+1, rec.Code)
+		}
+		var apiErr APIErrorResponse
+		if err = xml.Unmarshal(rec.Body.Bytes(), &apiErr); err != nil {
+			t.Fatalf("%s: case %d: %s", instanceType, i+1, err)
+		}
+		if apiErr.Code != tc.expected {
+			t.Fatalf("%s: case %d: expected %s, got %s", instanceType, i+1, tc.expected, apiErr.Code)
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"sync"
 	"time"
 
@@ -203,7 +204,7 @@ func (sys *BucketTargetSys) Delete(bucket string) {
 
 // SetTarget - sets a new minio-go client target for this bucket.
 func (sys *BucketTargetSys) SetTarget(ctx context.Context, bucket string, tgt *madmin.BucketTarget, update bool) error {
-	if !tgt.Type.IsValid() && !update {
+	if !isValidTargetType(tgt.Type) && !update {
 		return BucketRemoteArnTypeInvalid{Bucket: bucket}
 	}
 	clnt, err := sys.getRemoteTargetClient(tgt)
@@ -279,6 +280,62 @@ func (sys *BucketTargetSys) updateBandwidthLimit(bucket, arn string, limit int64
 	globalBucketMonitor.SetBandwidthLimit(bucket, arn, limit)
 }
 
+// SetBandwidthLimit - sets the replication bandwidth limit of the remote
+// target with arn, unlike SetTarget the remote is not validated again.
+func (sys *BucketTargetSys) SetBandwidthLimit(bucket, arn string, limit int64) error {
+	sys.Lock()
+	defer sys.Unlock()
+
+	tgts := sys.targetsMap[bucket]
+	newtgts := make([]madmin.BucketTarget, len(tgts))
+	found := false
+	for idx, t := range tgts {
+		if t.Arn == arn && t.Type == madmin.ReplicationService {
+			t.BandwidthLimit = limit
+			found = true
+		}
+		newtgts[idx] = t
+	}
+	if !found {
+		return BucketRemoteTargetNotFound{Bucket: bucket}
+	}
+	sys.targetsMap[bucket] = newtgts
+	sys.updateBandwidthLimit(bucket, arn, limit)
+	return nil
+}
+
+// ReplicationBandwidth is the configured limit and the bandwidth currently
+// consumed by replication of a bucket to a remote target, cluster wide.
+type ReplicationBandwidth struct {
+	Bucket              string  `json:"bucket"`
+	ARN                 string  `json:"arn"`
+	LimitBytesPerSec    int64   `json:"limitBytesPerSec"`
+	ConsumedBytesPerSec float64 `json:"consumedBytesPerSec"`
+}
+
+// getReplicationBandwidth returns the replication bandwidth of the remote
+// targets of bucket, of all buckets if bucket is empty.
+func getReplicationBandwidth(ctx context.Context, bucket string) []ReplicationBandwidth {
+	report := globalNotificationSys.GetBandwidthReports(ctx, bucket)
+	tgts := globalBucketTargetSys.ListTargets(ctx, bucket, string(madmin.ReplicationService))
+	bws := make([]ReplicationBandwidth, 0, len(tgts))
+	for _, tgt := range tgts {
+		bws = append(bws, ReplicationBandwidth{
+			Bucket:              tgt.SourceBucket,
+			ARN:                 tgt.Arn,
+			LimitBytesPerSec:    tgt.BandwidthLimit,
+			ConsumedBytesPerSec: report.BucketStats[tgt.SourceBucket][tgt.Arn].CurrentBandwidthInBytesPerSecond,
+		})
+	}
+	sort.Slice(bws, func(i, j int) bool {
+		if bws[i].Bucket != bws[j].Bucket {
+			return bws[i].Bucket < bws[j].Bucket
+		}
+		return bws[i].ARN < bws[j].ARN
+	})
+	return bws
+}
+
 // RemoveTarget - removes a remote bucket target for this source bucket.
 func (sys *BucketTargetSys) RemoveTarget(ctx context.Context, bucket, arnStr string) error {
 	if arnStr == "" {
@@ -307,6 +364,14 @@ func (sys *BucketTargetSys) RemoveTarget(ctx context.Context, bucket, arnStr str
 		}
 	}
 
+	if arn.Type == readThroughService {
+		// reject removal of remote target while reads are proxied to it
+		cfg, _, err := globalBucketMetadataSys.GetReadThroughConfig(ctx, bucket)
+		if err == nil && cfg.Arn == arnStr {
+			return BucketRemoteRemoveDisallowed{Bucket: bucket}
+		}
+	}
+
 	// delete ARN type from list of matching targets
 	sys.Lock()
 	defer sys.Unlock()
@@ -488,7 +553,7 @@ func (sys *BucketTargetSys) getRemoteARN(bucket string, target *madmin.BucketTar
 			return tgt.Arn, true
 		}
 	}
-	if !target.Type.IsValid() {
+	if !isValidTargetType(target.Type) {
 		return
 	}
 	return generateARN(target, deplID), false
@@ -0,0 +1,68 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"time"
+)
+
+// NodeClock is the current time of a node and its skew relative to the
+// coordinator, the node which collected the times of all nodes.
+type NodeClock struct {
+	Node string    `json:"node"`
+	Time time.Time `json:"time,omitempty"`
+	// Skew is positive when the clock of the node is ahead of the
+	// clock of the coordinator.
+	Skew time.Duration `json:"skew"`
+	// RoundTrip is the duration of the request for the time of the
+	// node, the skew is accurate within half of it.
+	RoundTrip time.Duration `json:"roundTrip"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// ClusterClock is the time of every node of the cluster.
+type ClusterClock struct {
+	Coordinator string        `json:"coordinator"`
+	MaxSkew     time.Duration `json:"maxSkew"`
+	Nodes       []NodeClock   `json:"nodes"`
+}
+
+// peerClockSkew computes the skew of the time reported by a peer for a
+// request sent and answered at the given times of the coordinator,
+// assuming the peer read its time half way through the round trip.
+func peerClockSkew(sent, received, peer time.Time) (skew, roundTrip time.Duration) {
+	roundTrip = received.Sub(sent)
+	return peer.Sub(sent.Add(roundTrip / 2)), roundTrip
+}
+
+func newClusterClock(nodes []NodeClock) ClusterClock {
+	c := ClusterClock{
+		Coordinator: globalLocalNodeName,
+		Nodes:       nodes,
+	}
+	for _, n := range nodes {
+		skew := n.Skew
+		if skew < 0 {
+			skew = -skew
+		}
+		if n.Error == "" && skew > c.MaxSkew {
+			c.MaxSkew = skew
+		}
+	}
+	return c
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeerClockSkew(t *testing.T) {
+	sent := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		received, peer time.Time
+		skew, rtt      time.Duration
+	}{
+		// in sync, peer answered half way through
+		{sent.Add(100 * time.Millisecond), sent.Add(50 * time.Millisecond), 0, 100 * time.Millisecond},
+		// peer ahead
+		{sent.Add(100 * time.Millisecond), sent.Add(2050 * time.Millisecond), 2 * time.Second, 100 * time.Millisecond},
+		// peer behind
+		{sent.Add(10 * time.Millisecond), sent.Add(-time.Minute + 5*time.Millisecond), -time.Minute, 10 * time.Millisecond},
+	}
+	for i, tc := range testCases {
+		skew, rtt := peerClockSkew(sent, tc.received, tc.peer)
+		if skew != tc.skew || rtt != tc.rtt {
+			t.Errorf("case %d: expected skew %v and round trip %v, got %v and %v", i+1, tc.skew, tc.rtt, skew, rtt)
+		}
+	}
+}
+
+func TestNewClusterClock(t *testing.T) {
+	c := newClusterClock([]NodeClock{
+		{Node: "a", Skew: time.Second},
+		{Node: "b", Skew: -3 * time.Second},
+		{Node: "c", Skew: time.Hour, Error: "offline"},
+		{Node: "d"},
+	})
+	if c.MaxSkew != 3*time.Second {
+		t.Errorf("expected max skew of 3s, got %v", c.MaxSkew)
+	}
+	if len(c.Nodes) != 4 {
+		t.Errorf("expected 4 nodes, got %d", len(c.Nodes))
+	}
+}
@@ -0,0 +1,452 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/internal/logger"
+)
+
+// ClusterJobType is the kind of a long-running cluster activity.
+type ClusterJobType string
+
+// Supported cluster job types.
+const (
+	ClusterJobDecommission ClusterJobType = "decommission"
+	ClusterJobRebalance    ClusterJobType = "rebalance"
+	ClusterJobSiteResync   ClusterJobType = "site-resync"
+	ClusterJobBatch        ClusterJobType = "batch"
+	ClusterJobHeal         ClusterJobType = "heal"
+)
+
+// ClusterJobState is the normalized state of a cluster job.
+type ClusterJobState string
+
+// Normalized cluster job states.
+const (
+	ClusterJobPending  ClusterJobState = "pending"
+	ClusterJobRunning  ClusterJobState = "running"
+	ClusterJobComplete ClusterJobState = "complete"
+	ClusterJobFailed   ClusterJobState = "failed"
+	ClusterJobCanceled ClusterJobState = "canceled"
+)
+
+func (s ClusterJobState) finished() bool {
+	return s == ClusterJobComplete || s == ClusterJobFailed || s == ClusterJobCanceled
+}
+
+// ClusterJobInfo is the common description of a long-running cluster
+// activity such as a decommission, rebalance, site resync, batch job
+// or heal sequence.
+type ClusterJobInfo struct {
+	ID         string          `json:"id"`
+	Type       ClusterJobType  `json:"type"`
+	Scope      string          `json:"scope"`
+	StartTime  time.Time       `json:"startTime"`
+	EndTime    time.Time       `json:"endTime,omitempty"`
+	Progress   float64         `json:"progressPercent,omitempty"`
+	Throughput float64         `json:"bytesPerSec,omitempty"`
+	State      ClusterJobState `json:"state"`
+	Node       string          `json:"node"`
+}
+
+// clusterJobReporter is implemented by subsystems running long-running
+// cluster activities, adapting their specific state into ClusterJobInfo.
+type clusterJobReporter interface {
+	clusterJobs(history bool) []ClusterJobInfo
+}
+
+func throughput(bytes int64, start, end time.Time) float64 {
+	if end.IsZero() {
+		end = UTCNow()
+	}
+	elapsed := end.Sub(start).Seconds()
+	if bytes <= 0 || elapsed <= 0 {
+		return 0
+	}
+	return float64(bytes) / elapsed
+}
+
+// decommissionClusterJob describes the decommission of the pool at idx
+// started at start, failed and canceled decommissions reset the start
+// time of the pool so it is passed separately.
+func (p *poolMeta) decommissionClusterJob(idx int, start time.Time) ClusterJobInfo {
+	pool := p.Pools[idx]
+	d := pool.Decommission
+	job := ClusterJobInfo{
+		ID:         fmt.Sprintf("decom-%d-%d", idx, start.Unix()),
+		Type:       ClusterJobDecommission,
+		Scope:      pool.CmdLine,
+		StartTime:  start,
+		Throughput: throughput(d.BytesDone, start, pool.LastUpdate),
+		State:      ClusterJobRunning,
+		Node:       globalLocalNodeName,
+	}
+	if used := d.TotalSize - d.StartSize; used > 0 {
+		job.Progress = 100 * float64(d.BytesDone) / float64(used)
+	}
+	switch {
+	case d.Complete:
+		job.State = ClusterJobComplete
+	case d.Failed:
+		job.State = ClusterJobFailed
+	case d.Canceled:
+		job.State = ClusterJobCanceled
+	}
+	if job.State.finished() {
+		job.EndTime = pool.LastUpdate
+	}
+	return job
+}
+
+// clusterJobs reports decommission and rebalance activity of the pools
+// controlled by this node.
+func (z *erasureServerPools) clusterJobs(history bool) (jobs []ClusterJobInfo) {
+	z.poolMetaMutex.RLock()
+	for idx, pool := range z.poolMeta.Pools {
+		d := pool.Decommission
+		if d == nil || d.StartTime.IsZero() || !globalEndpoints[idx].Endpoints[0].IsLocal {
+			continue
+		}
+		job := z.poolMeta.decommissionClusterJob(idx, d.StartTime)
+		if job.State.finished() && !history {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	z.poolMetaMutex.RUnlock()
+
+	z.rebalMu.RLock()
+	defer z.rebalMu.RUnlock()
+	r := z.rebalMeta
+	if r == nil {
+		return jobs
+	}
+	for idx, ps := range r.PoolStats {
+		if ps == nil || !ps.Participating || !globalEndpoints[idx].Endpoints[0].IsLocal {
+			continue
+		}
+		job := ClusterJobInfo{
+			ID:         fmt.Sprintf("%s-%d", r.ID, idx),
+			Type:       ClusterJobRebalance,
+			Scope:      globalEndpoints[idx].CmdLine,
+			StartTime:  ps.Info.StartTime,
+			EndTime:    ps.Info.EndTime,
+			Throughput: throughput(int64(ps.Bytes), ps.Info.StartTime, ps.Info.EndTime),
+			Node:       globalLocalNodeName,
+		}
+		if len(ps.Buckets) > 0 || len(ps.RebalancedBuckets) > 0 {
+			job.Progress = 100 * float64(len(ps.RebalancedBuckets)) / float64(len(ps.Buckets)+len(ps.RebalancedBuckets))
+		}
+		switch ps.Info.Status {
+		case rebalStarted:
+			job.State = ClusterJobRunning
+		case rebalCompleted:
+			job.State = ClusterJobComplete
+		case rebalStopped:
+			job.State = ClusterJobCanceled
+		case rebalFailed:
+			job.State = ClusterJobFailed
+		default:
+			job.State = ClusterJobPending
+		}
+		if job.State.finished() && !history {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+func (rs SiteResyncStatus) clusterJob(id string) ClusterJobInfo {
+	job := ClusterJobInfo{
+		ID:         id,
+		Type:       ClusterJobSiteResync,
+		Scope:      rs.DeplID,
+		StartTime:  rs.StartTime,
+		Throughput: throughput(rs.ReplicatedSize, rs.StartTime, rs.LastUpdate),
+		Node:       globalLocalNodeName,
+	}
+	if rs.TotBuckets > 0 {
+		var done int
+		for _, st := range rs.BucketStatuses {
+			if st == ResyncCompleted || st == ResyncFailed {
+				done++
+			}
+		}
+		job.Progress = 100 * float64(done) / float64(rs.TotBuckets)
+	}
+	switch rs.Status {
+	case ResyncStarted:
+		job.State = ClusterJobRunning
+	case ResyncCompleted:
+		job.State = ClusterJobComplete
+	case ResyncFailed:
+		job.State = ClusterJobFailed
+	case ResyncCanceled:
+		job.State = ClusterJobCanceled
+	default:
+		job.State = ClusterJobPending
+	}
+	if job.State.finished() {
+		job.EndTime = rs.LastUpdate
+	}
+	return job
+}
+
+// clusterJobs reports site replication resync operations known to this node.
+func (sm *siteResyncMetrics) clusterJobs(history bool) (jobs []ClusterJobInfo) {
+	if sm == nil {
+		return nil
+	}
+	sm.RLock()
+	defer sm.RUnlock()
+	for id, rs := range sm.resyncStatus {
+		job := rs.clusterJob(id)
+		if job.State.finished() && !history {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+func (ri *batchJobInfo) clusterJob() ClusterJobInfo {
+	job := ClusterJobInfo{
+		ID:         ri.JobID,
+		Type:       ClusterJobBatch,
+		Scope:      ri.JobType,
+		StartTime:  ri.StartTime,
+		Throughput: throughput(ri.BytesTransferred, ri.StartTime, ri.LastUpdate),
+		State:      ClusterJobRunning,
+		Node:       globalLocalNodeName,
+	}
+	switch {
+	case ri.Complete:
+		job.State = ClusterJobComplete
+	case ri.Failed:
+		job.State = ClusterJobFailed
+	}
+	if job.State.finished() {
+		job.EndTime = ri.LastUpdate
+	}
+	return job
+}
+
+// clusterJobs reports batch jobs currently tracked by this node.
+func (m *batchJobMetrics) clusterJobs(history bool) (jobs []ClusterJobInfo) {
+	m.RLock()
+	defer m.RUnlock()
+	for _, ri := range m.metrics {
+		job := ri.clusterJob()
+		if job.State.finished() && !history {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+func (h *healSequence) clusterJob() ClusterJobInfo {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	job := ClusterJobInfo{
+		ID:        h.clientToken,
+		Type:      ClusterJobHeal,
+		Scope:     pathJoin(h.bucket, h.object),
+		StartTime: h.startTime,
+		EndTime:   h.endTime,
+		Node:      globalLocalNodeName,
+	}
+	switch h.currentStatus.Summary {
+	case healRunningStatus:
+		job.State = ClusterJobRunning
+	case healFinishedStatus:
+		job.State = ClusterJobComplete
+	case healStoppedStatus:
+		job.State = ClusterJobFailed
+		if errors.Is(h.ctx.Err(), context.Canceled) {
+			job.State = ClusterJobCanceled
+		}
+	default:
+		job.State = ClusterJobPending
+	}
+	return job
+}
+
+// clusterJobs reports client initiated heal sequences on this node,
+// the background heal sequence is not a job and is skipped.
+func (ahs *allHealState) clusterJobs(history bool) (jobs []ClusterJobInfo) {
+	ahs.RLock()
+	defer ahs.RUnlock()
+	for _, h := range ahs.healSeqMap {
+		if h.clientToken == bgHealingUUID {
+			continue
+		}
+		job := h.clusterJob()
+		if job.State.finished() && !history {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+const (
+	clusterJobsHistoryPrefix = minioConfigPrefix + "/cluster-jobs"
+
+	// maximum number of finished jobs remembered per node.
+	clusterJobsHistoryMax = 100
+)
+
+// clusterJobsHistory keeps a bounded list of finished jobs run by this
+// node whose state is otherwise dropped from memory once they end, it
+// is persisted in the meta bucket so that it survives restarts.
+type clusterJobsHistory struct {
+	sync.Mutex
+	loaded bool
+	jobs   []ClusterJobInfo
+}
+
+var globalClusterJobsHistory = &clusterJobsHistory{}
+
+func clusterJobsHistoryPath() string {
+	return pathJoin(clusterJobsHistoryPrefix, globalLocalNodeNameHex+".json")
+}
+
+func (jh *clusterJobsHistory) load(ctx context.Context, objAPI ObjectLayer) {
+	if jh.loaded || objAPI == nil {
+		return
+	}
+	data, err := readConfig(ctx, objAPI, clusterJobsHistoryPath())
+	if err != nil && !errors.Is(err, errConfigNotFound) {
+		logger.LogIf(ctx, err)
+		return
+	}
+	if len(data) > 0 {
+		var jobs []ClusterJobInfo
+		if err = json.Unmarshal(data, &jobs); err != nil {
+			logger.LogIf(ctx, err)
+			return
+		}
+		// Keep jobs recorded before the history could be loaded.
+		jh.jobs = append(jobs, jh.jobs...)
+	}
+	jh.loaded = true
+}
+
+// record adds a finished job to the history, replacing an earlier entry
+// of the same job, and persists it.
+func (jh *clusterJobsHistory) record(job ClusterJobInfo) {
+	if !job.State.finished() {
+		return
+	}
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		return
+	}
+
+	jh.Lock()
+	defer jh.Unlock()
+
+	ctx := GlobalContext
+	jh.load(ctx, objAPI)
+	for i, j := range jh.jobs {
+		if j.Type == job.Type && j.ID == job.ID {
+			jh.jobs = append(jh.jobs[:i], jh.jobs[i+1:]...)
+			break
+		}
+	}
+	jh.jobs = append(jh.jobs, job)
+	if len(jh.jobs) > clusterJobsHistoryMax {
+		jh.jobs = jh.jobs[len(jh.jobs)-clusterJobsHistoryMax:]
+	}
+	if !jh.loaded {
+		// Do not overwrite a history we were unable to read.
+		return
+	}
+	data, err := json.Marshal(jh.jobs)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	logger.LogIf(ctx, saveConfig(ctx, objAPI, clusterJobsHistoryPath(), data))
+}
+
+func (jh *clusterJobsHistory) clusterJobs(history bool) []ClusterJobInfo {
+	if !history {
+		return nil
+	}
+	jh.Lock()
+	defer jh.Unlock()
+
+	jh.load(GlobalContext, newObjectLayerFn())
+	return append([]ClusterJobInfo(nil), jh.jobs...)
+}
+
+// localClusterJobs returns the jobs controlled by this node.
+func localClusterJobs(history bool) []ClusterJobInfo {
+	reporters := []clusterJobReporter{
+		&globalBatchJobsMetrics,
+		globalAllHealState,
+		globalSiteResyncMetrics,
+		globalClusterJobsHistory,
+	}
+	if z, ok := newObjectLayerFn().(*erasureServerPools); ok {
+		reporters = append(reporters, z)
+	}
+
+	var jobs []ClusterJobInfo
+	for _, r := range reporters {
+		jobs = append(jobs, r.clusterJobs(history)...)
+	}
+	return jobs
+}
+
+// mergeClusterJobs de-duplicates jobs reported by multiple nodes and
+// sorts them with the most recently started first.
+func mergeClusterJobs(jobs ...[]ClusterJobInfo) []ClusterJobInfo {
+	type jobKey struct {
+		typ ClusterJobType
+		id  string
+	}
+	seen := make(map[jobKey]struct{})
+	merged := []ClusterJobInfo{}
+	for _, js := range jobs {
+		for _, job := range js {
+			k := jobKey{typ: job.Type, id: job.ID}
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			merged = append(merged, job)
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].StartTime.After(merged[j].StartTime)
+	})
+	return merged
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestMergeClusterJobs(t *testing.T) {
+	now := time.Now()
+	local := []ClusterJobInfo{
+		{ID: "a", Type: ClusterJobHeal, StartTime: now.Add(-time.Hour)},
+		{ID: "b", Type: ClusterJobBatch, StartTime: now},
+	}
+	peer := []ClusterJobInfo{
+		// same resync reported by every node
+		{ID: "a", Type: ClusterJobSiteResync, StartTime: now.Add(-time.Minute)},
+		{ID: "a", Type: ClusterJobHeal, StartTime: now.Add(-time.Hour)},
+	}
+
+	jobs := mergeClusterJobs(local, peer)
+	if len(jobs) != 3 {
+		t.Fatalf("expected 3 jobs, got %d: %v", len(jobs), jobs)
+	}
+	for i, want := range []ClusterJobType{ClusterJobBatch, ClusterJobSiteResync, ClusterJobHeal} {
+		if jobs[i].Type != want {
+			t.Errorf("job %d: expected type %s, got %s", i, want, jobs[i].Type)
+		}
+	}
+}
+
+func TestClusterJobThroughput(t *testing.T) {
+	start := time.Now().Add(-10 * time.Second)
+	if got := throughput(100, start, start.Add(10*time.Second)); got != 10 {
+		t.Errorf("expected 10 bytes/sec, got %v", got)
+	}
+	if got := throughput(0, start, start.Add(10*time.Second)); got != 0 {
+		t.Errorf("expected 0 bytes/sec, got %v", got)
+	}
+}
+
+// waitClusterJob waits for the job to be recorded in the history.
+func waitClusterJob(t *testing.T, typ ClusterJobType, id string) ClusterJobInfo {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, job := range globalClusterJobsHistory.clusterJobs(true) {
+			if job.Type == typ && job.ID == id {
+				return job
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%s job %s was not recorded in the history", typ, id)
+	return ClusterJobInfo{}
+}
+
+func TestClusterJobsHistoryDecommission(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasurePools()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+	initAllSubsystems(ctx)
+
+	defer func(h *clusterJobsHistory) { globalClusterJobsHistory = h }(globalClusterJobsHistory)
+	globalClusterJobsHistory = &clusterJobsHistory{}
+
+	z := obj.(*erasureServerPools)
+	testCases := []struct {
+		finish func(ctx context.Context, idx int) error
+		state  ClusterJobState
+	}{
+		{z.CompleteDecommission, ClusterJobComplete},
+		{z.DecommissionFailed, ClusterJobFailed},
+		{z.DecommissionCancel, ClusterJobCanceled},
+	}
+	for i, tc := range testCases {
+		start := UTCNow().Add(time.Duration(-i-1) * time.Hour).Truncate(time.Second)
+		z.poolMetaMutex.Lock()
+		z.poolMeta.Pools[1].Decommission = &PoolDecommissionInfo{
+			StartTime: start,
+			StartSize: 100,
+			TotalSize: 300,
+			BytesDone: 100,
+		}
+		z.poolMetaMutex.Unlock()
+
+		if err = tc.finish(ctx, 1); err != nil {
+			t.Fatalf("case %d: %v", i+1, err)
+		}
+		job := waitClusterJob(t, ClusterJobDecommission, fmt.Sprintf("decom-1-%d", start.Unix()))
+		if job.State != tc.state {
+			t.Errorf("case %d: expected state %s, got %s", i+1, tc.state, job.State)
+		}
+		if !job.StartTime.Equal(start) || job.EndTime.IsZero() {
+			t.Errorf("case %d: unexpected start %v and end %v", i+1, job.StartTime, job.EndTime)
+		}
+		if job.Progress != 50 {
+			t.Errorf("case %d: expected progress 50, got %v", i+1, job.Progress)
+		}
+	}
+
+	// The history is persisted and survives a restart.
+	globalClusterJobsHistory = &clusterJobsHistory{}
+	if jobs := globalClusterJobsHistory.clusterJobs(true); len(jobs) != len(testCases) {
+		t.Fatalf("expected %d jobs after reload, got %d: %v", len(testCases), len(jobs), jobs)
+	}
+}
+
+func TestClusterJobsHistorySiteResync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+
+	defer func(h *clusterJobsHistory) { globalClusterJobsHistory = h }(globalClusterJobsHistory)
+	globalClusterJobsHistory = &clusterJobsHistory{}
+
+	globalSiteReplicationSys.Lock()
+	enabled := globalSiteReplicationSys.enabled
+	globalSiteReplicationSys.enabled = true
+	globalSiteReplicationSys.Unlock()
+	defer func() {
+		globalSiteReplicationSys.Lock()
+		globalSiteReplicationSys.enabled = enabled
+		globalSiteReplicationSys.Unlock()
+	}()
+
+	sm := &siteResyncMetrics{
+		resyncStatus:  make(map[string]SiteResyncStatus),
+		peerResyncMap: make(map[string]resyncState),
+	}
+
+	// A resync completed by its last bucket.
+	rs := newSiteResyncStatus("peer-1", []BucketInfo{{Name: "a"}, {Name: "b"}})
+	sm.updateState(rs)
+	sm.incBucket(resyncOpts{resyncID: rs.ResyncID, bucket: "a"}, ResyncCompleted)
+	if jobs := globalClusterJobsHistory.clusterJobs(true); len(jobs) != 0 {
+		t.Fatalf("unexpected jobs recorded before the resync finished: %v", jobs)
+	}
+	sm.incBucket(resyncOpts{resyncID: rs.ResyncID, bucket: "b"}, ResyncCompleted)
+	if job := waitClusterJob(t, ClusterJobSiteResync, rs.ResyncID); job.State != ClusterJobComplete || job.Progress != 100 {
+		t.Errorf("expected a complete resync, got %v", job)
+	}
+
+	// A canceled resync.
+	rs = newSiteResyncStatus("peer-2", []BucketInfo{{Name: "a"}})
+	sm.updateState(rs)
+	rs.Status = ResyncCanceled
+	rs.LastUpdate = UTCNow()
+	sm.updateState(rs)
+	if job := waitClusterJob(t, ClusterJobSiteResync, rs.ResyncID); job.State != ClusterJobCanceled || job.Scope != "peer-2" {
+		t.Errorf("expected a canceled resync, got %v", job)
+	}
+}
@@ -31,12 +31,15 @@ import (
 	"github.com/minio/minio/internal/config/callhome"
 	"github.com/minio/minio/internal/config/compress"
 	"github.com/minio/minio/internal/config/dns"
+	"github.com/minio/minio/internal/config/drive"
 	"github.com/minio/minio/internal/config/etcd"
+	"github.com/minio/minio/internal/config/guardrail"
 	"github.com/minio/minio/internal/config/heal"
 	xldap "github.com/minio/minio/internal/config/identity/ldap"
 	"github.com/minio/minio/internal/config/identity/openid"
 	idplugin "github.com/minio/minio/internal/config/identity/plugin"
 	xtls "github.com/minio/minio/internal/config/identity/tls"
+	"github.com/minio/minio/internal/config/ilm"
 	"github.com/minio/minio/internal/config/lambda"
 	"github.com/minio/minio/internal/config/notify"
 	"github.com/minio/minio/internal/config/policy/opa"
@@ -44,6 +47,7 @@ import (
 	"github.com/minio/minio/internal/config/scanner"
 	"github.com/minio/minio/internal/config/storageclass"
 	"github.com/minio/minio/internal/config/subnet"
+	"github.com/minio/minio/internal/config/update"
 	"github.com/minio/minio/internal/crypto"
 	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/kms"
@@ -71,6 +75,10 @@ func initHelp() {
 		config.ScannerSubSys:        scanner.DefaultKVS,
 		config.SubnetSubSys:         subnet.DefaultKVS,
 		config.CallhomeSubSys:       callhome.DefaultKVS,
+		config.ILMSubSys:            ilm.DefaultKVS,
+		config.DriveSubSys:          drive.DefaultKVS,
+		config.UpdateSubSys:         update.DefaultKVS,
+		config.GuardrailSubSys:      guardrail.DefaultKVS,
 	}
 	for k, v := range notify.DefaultNotificationKVS {
 		kvs[k] = v
@@ -110,6 +118,22 @@ func initHelp() {
 			Key:         config.ScannerSubSys,
 			Description: "manage namespace scanning for usage calculation, lifecycle, healing and more",
 		},
+		config.HelpKV{
+			Key:         config.ILMSubSys,
+			Description: "manage ILM expiry workers and their throttling",
+		},
+		config.HelpKV{
+			Key:         config.DriveSubSys,
+			Description: "manage drive operation timeouts and hung drive detection",
+		},
+		config.HelpKV{
+			Key:         config.UpdateSubSys,
+			Description: "manage the release info used to check for updates",
+		},
+		config.HelpKV{
+			Key:         config.GuardrailSubSys,
+			Description: "manage load shedding on CPU, memory and goroutine thresholds",
+		},
 		config.HelpKV{
 			Key:         config.CompressionSubSys,
 			Description: "enable server side compression of objects",
@@ -236,6 +260,10 @@ func initHelp() {
 		config.CompressionSubSys:    compress.Help,
 		config.HealSubSys:           heal.Help,
 		config.ScannerSubSys:        scanner.Help,
+		config.ILMSubSys:            ilm.Help,
+		config.DriveSubSys:          drive.Help,
+		config.UpdateSubSys:         update.Help,
+		config.GuardrailSubSys:      guardrail.Help,
 		config.IdentityOpenIDSubSys: openid.Help,
 		config.IdentityLDAPSubSys:   xldap.Help,
 		config.IdentityTLSSubSys:    xtls.Help,
@@ -297,10 +325,8 @@ func validateSubSysConfig(s config.Config, subSys string, objAPI ObjectLayer) er
 		if objAPI == nil {
 			return errServerNotInitialized
 		}
-		for _, setDriveCount := range objAPI.SetDriveCounts() {
-			if _, err := storageclass.LookupConfig(s[config.StorageClassSubSys][config.Default], setDriveCount); err != nil {
-				return err
-			}
+		if _, err := storageclass.LookupPoolsConfig(s[config.StorageClassSubSys][config.Default], objAPI.SetDriveCounts()); err != nil {
+			return err
 		}
 	case config.CacheSubSys:
 		if _, err := cache.LookupConfig(s[config.CacheSubSys][config.Default]); err != nil {
@@ -318,6 +344,22 @@ func validateSubSysConfig(s config.Config, subSys string, objAPI ObjectLayer) er
 		if _, err := scanner.LookupConfig(s[config.ScannerSubSys][config.Default]); err != nil {
 			return err
 		}
+	case config.ILMSubSys:
+		if _, err := ilm.LookupConfig(s[config.ILMSubSys][config.Default]); err != nil {
+			return err
+		}
+	case config.DriveSubSys:
+		if _, err := drive.LookupConfig(s[config.DriveSubSys][config.Default]); err != nil {
+			return err
+		}
+	case config.UpdateSubSys:
+		if _, err := update.LookupConfig(s[config.UpdateSubSys][config.Default]); err != nil {
+			return err
+		}
+	case config.GuardrailSubSys:
+		if _, err := guardrail.LookupConfig(s[config.GuardrailSubSys][config.Default]); err != nil {
+			return err
+		}
 	case config.EtcdSubSys:
 		etcdCfg, err := etcd.LookupConfig(s[config.EtcdSubSys][config.Default], globalRootCAs)
 		if err != nil {
@@ -573,7 +615,35 @@ func applyDynamicConfigForSubSys(ctx context.Context, objAPI ObjectLayer, s conf
 		}
 		// update dynamic scanner values.
 		scannerCycle.Store(scannerCfg.Cycle)
+		scannerSpeed.Store(scannerCfg.Speed)
 		logger.LogIf(ctx, scannerSleeper.Update(scannerCfg.Delay, scannerCfg.MaxWait))
+		globalScannerDrives.update(scannerCfg.MaxDrivesInParallel, scannerCfg.DriveLatencyThreshold)
+	case config.ILMSubSys:
+		ilmCfg, err := ilm.LookupConfig(s[config.ILMSubSys][config.Default])
+		if err != nil {
+			return fmt.Errorf("Unable to apply ilm config: %w", err)
+		}
+		if globalExpiryState != nil {
+			globalExpiryState.update(ilmCfg)
+		}
+	case config.DriveSubSys:
+		driveCfg, err := drive.LookupConfig(s[config.DriveSubSys][config.Default])
+		if err != nil {
+			return fmt.Errorf("Unable to apply drive config: %w", err)
+		}
+		driveTimeouts.update(driveCfg)
+	case config.UpdateSubSys:
+		updateCfg, err := update.LookupConfig(s[config.UpdateSubSys][config.Default])
+		if err != nil {
+			return fmt.Errorf("Unable to apply update config: %w", err)
+		}
+		globalUpdateChecker.setInfoURL(updateCfg.URL)
+	case config.GuardrailSubSys:
+		guardrailCfg, err := guardrail.LookupConfig(s[config.GuardrailSubSys][config.Default])
+		if err != nil {
+			return fmt.Errorf("Unable to apply guardrail config: %w", err)
+		}
+		globalGuardrail.update(guardrailCfg)
 	case config.LoggerWebhookSubSys:
 		loggerCfg, err := logger.LookupConfigForSubSys(s, config.LoggerWebhookSubSys)
 		if err != nil {
@@ -584,7 +654,10 @@ func applyDynamicConfigForSubSys(ctx context.Context, objAPI ObjectLayer, s conf
 			if l.Enabled {
 				l.LogOnce = logger.LogOnceConsoleIf
 				l.UserAgent = userAgent
-				l.Transport = NewHTTPTransportWithClientCerts(l.ClientCert, l.ClientKey)
+				l.Transport = NewHTTPTransportWithClientCerts(l.ClientCert, l.ClientKey, l.CertAuthority)
+				if err := config.CheckClientCertExpiry(l.ClientCert, l.ClientKey); err != nil {
+					logger.LogIf(ctx, fmt.Errorf("WARNING: %s target %s: %w", subSys, n, err))
+				}
 			}
 			loggerCfg.HTTP[n] = l
 		}
@@ -601,7 +674,10 @@ func applyDynamicConfigForSubSys(ctx context.Context, objAPI ObjectLayer, s conf
 			if l.Enabled {
 				l.LogOnce = logger.LogOnceConsoleIf
 				l.UserAgent = userAgent
-				l.Transport = NewHTTPTransportWithClientCerts(l.ClientCert, l.ClientKey)
+				l.Transport = NewHTTPTransportWithClientCerts(l.ClientCert, l.ClientKey, l.CertAuthority)
+				if err := config.CheckClientCertExpiry(l.ClientCert, l.ClientKey); err != nil {
+					logger.LogIf(ctx, fmt.Errorf("WARNING: %s target %s: %w", subSys, n, err))
+				}
 			}
 			loggerCfg.AuditWebhook[n] = l
 		}
@@ -624,18 +700,14 @@ func applyDynamicConfigForSubSys(ctx context.Context, objAPI ObjectLayer, s conf
 			logger.LogIf(ctx, fmt.Errorf("Unable to update audit kafka targets: %v", errs))
 		}
 	case config.StorageClassSubSys:
-		for i, setDriveCount := range setDriveCounts {
-			sc, err := storageclass.LookupConfig(s[config.StorageClassSubSys][config.Default], setDriveCount)
-			if err != nil {
-				logger.LogIf(ctx, fmt.Errorf("Unable to initialize storage class config: %w", err))
-				break
-			}
-			// if we validated all setDriveCounts and it was successful
-			// proceed to store the correct storage class globally.
-			if i == len(setDriveCounts)-1 {
-				globalStorageClass.Update(sc)
-			}
+		// proceed to store the storage class globally only if
+		// it was validated for all setDriveCounts successfully.
+		sc, err := storageclass.LookupPoolsConfig(s[config.StorageClassSubSys][config.Default], setDriveCounts)
+		if err != nil {
+			logger.LogIf(ctx, fmt.Errorf("Unable to initialize storage class config: %w", err))
+			break
 		}
+		globalStorageClass.Update(sc)
 	case config.SubnetSubSys:
 		subnetConfig, err := subnet.LookupConfig(s[config.SubnetSubSys][config.Default], globalProxyTransport)
 		if err != nil {
@@ -83,6 +83,15 @@ func (sys *HTTPConsoleLoggerSys) HasLogListeners() bool {
 	return sys != nil && sys.pubsub.Subscribers() > 0
 }
 
+// NumSubscribers returns the number of active console log subscribers
+// on this node.
+func (sys *HTTPConsoleLoggerSys) NumSubscribers() int32 {
+	if sys == nil {
+		return 0
+	}
+	return sys.pubsub.Subscribers()
+}
+
 // Subscribe starts console logging for this node.
 func (sys *HTTPConsoleLoggerSys) Subscribe(subCh chan log.Info, doneCh <-chan struct{}, node string, last int, logKind madmin.LogMask, filter func(entry log.Info) bool) error {
 	// Enable console logging for remote client.
@@ -0,0 +1,295 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Scanner drive states.
+const (
+	scannerDriveIdle      = "idle"
+	scannerDriveScanning  = "scanning"
+	scannerDriveBackedOff = "backed-off"
+)
+
+const (
+	// scannerDriveCheckInterval is the interval drive latencies are checked.
+	scannerDriveCheckInterval = 5 * time.Second
+
+	// A drive is backed off once its latency stays above the threshold
+	// for scannerDriveBackoffAfter, and resumed once its latency stays
+	// below scannerDriveResumeRatio of the threshold for
+	// scannerDriveResumeAfter. The band and the delays keep drives
+	// near the threshold from flapping.
+	scannerDriveBackoffAfter = 30 * time.Second
+	scannerDriveResumeAfter  = 2 * time.Minute
+	scannerDriveResumeRatio  = 0.8
+)
+
+// scannerDriveLatencyMetrics are the drive operations whose latency
+// reflects the load of the drive. Operations taking time proportional
+// to their size or the namespace, like walking, are left out.
+var scannerDriveLatencyMetrics = []storageMetric{
+	storageMetricStatVol,
+	storageMetricListDir,
+	storageMetricRenameData,
+	storageMetricWriteAll,
+	storageMetricDeleteVersion,
+	storageMetricWriteMetadata,
+	storageMetricUpdateMetadata,
+	storageMetricReadVersion,
+	storageMetricReadXL,
+	storageMetricReadAll,
+	storageMetricStatInfoFile,
+}
+
+// scannerDrive is the scanner state of a local drive.
+type scannerDrive struct {
+	scanning  int // number of running scans.
+	backedOff bool
+	latency   time.Duration
+
+	// changed is when the drive was last backed off or resumed,
+	// crossed is when the latency crossed the threshold in the
+	// opposite direction of the state, zero if it did not.
+	changed time.Time
+	crossed time.Time
+}
+
+// scannerDrives limits the number of drives scanned in parallel on
+// this node and backs off scanning of drives with a high latency.
+type scannerDrives struct {
+	mu          sync.Mutex
+	maxParallel int
+	threshold   time.Duration
+	active      int
+	drives      map[string]*scannerDrive
+
+	// wakeup is closed and replaced when a scan may proceed.
+	wakeup chan struct{}
+}
+
+var globalScannerDrives = newScannerDrives()
+
+func newScannerDrives() *scannerDrives {
+	return &scannerDrives{
+		drives: make(map[string]*scannerDrive),
+		wakeup: make(chan struct{}),
+	}
+}
+
+// ScannerDriveStatus is the scanner state of a drive.
+type ScannerDriveStatus struct {
+	Drive     string        `json:"drive"`
+	State     string        `json:"state"`
+	Latency   time.Duration `json:"latency"`
+	ChangedAt time.Time     `json:"changedAt,omitempty"`
+}
+
+// ScannerNodeStatus is the scanner state of the drives of a node.
+type ScannerNodeStatus struct {
+	Node                  string               `json:"node"`
+	MaxDrivesInParallel   int                  `json:"maxDrivesInParallel"`
+	DriveLatencyThreshold time.Duration        `json:"driveLatencyThreshold"`
+	EffectiveConcurrency  int                  `json:"effectiveConcurrency"`
+	Drives                []ScannerDriveStatus `json:"drives,omitempty"`
+	Error                 string               `json:"error,omitempty"`
+}
+
+// update applies a new scanner configuration.
+func (s *scannerDrives) update(maxParallel int, threshold time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maxParallel = maxParallel
+	s.threshold = threshold
+	if threshold <= 0 {
+		now := time.Now()
+		for _, d := range s.drives {
+			if d.backedOff {
+				d.backedOff = false
+				d.changed = now
+			}
+			d.crossed = time.Time{}
+		}
+	}
+	s.wake()
+}
+
+// wake wakes up waiting scans, s.mu must be held.
+func (s *scannerDrives) wake() {
+	close(s.wakeup)
+	s.wakeup = make(chan struct{})
+}
+
+// drive returns the state of drive, s.mu must be held.
+func (s *scannerDrives) drive(drive string) *scannerDrive {
+	d, ok := s.drives[drive]
+	if !ok {
+		d = &scannerDrive{}
+		s.drives[drive] = d
+	}
+	return d
+}
+
+// acquire waits until drive may be scanned. The returned function
+// must be called when the scan is done.
+func (s *scannerDrives) acquire(ctx context.Context, drive string) (release func(), err error) {
+	if err = s.waitSlot(ctx, drive); err != nil {
+		return nil, err
+	}
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.active--
+		s.drive(drive).scanning--
+		s.wake()
+	}, nil
+}
+
+// pause is called by a scan of drive between folders, if the drive
+// is backed off the scan gives up its slot until the drive resumes.
+func (s *scannerDrives) pause(ctx context.Context, drive string) error {
+	s.mu.Lock()
+	d := s.drive(drive)
+	if !d.backedOff {
+		s.mu.Unlock()
+		return nil
+	}
+	s.active--
+	d.scanning--
+	s.wake()
+	s.mu.Unlock()
+	return s.waitSlot(ctx, drive)
+}
+
+func (s *scannerDrives) waitSlot(ctx context.Context, drive string) error {
+	for {
+		s.mu.Lock()
+		d := s.drive(drive)
+		if !d.backedOff && (s.maxParallel <= 0 || s.active < s.maxParallel) {
+			s.active++
+			d.scanning++
+			s.mu.Unlock()
+			return nil
+		}
+		wakeup := s.wakeup
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wakeup:
+		}
+	}
+}
+
+// check updates the drive states with the given drive latencies.
+func (s *scannerDrives) check(latencies map[string]time.Duration, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := false
+	for drive, latency := range latencies {
+		d := s.drive(drive)
+		d.latency = latency
+		if s.threshold <= 0 {
+			continue
+		}
+		var crossing bool
+		var after time.Duration
+		if d.backedOff {
+			crossing = latency < time.Duration(float64(s.threshold)*scannerDriveResumeRatio)
+			after = scannerDriveResumeAfter
+		} else {
+			crossing = latency > s.threshold
+			after = scannerDriveBackoffAfter
+		}
+		switch {
+		case !crossing:
+			d.crossed = time.Time{}
+		case d.crossed.IsZero():
+			d.crossed = now
+		case now.Sub(d.crossed) >= after:
+			d.backedOff = !d.backedOff
+			d.changed = now
+			d.crossed = time.Time{}
+			changed = true
+		}
+	}
+	if changed {
+		s.wake()
+	}
+}
+
+// status returns the scanner state of the drives of this node.
+func (s *scannerDrives) status() ScannerNodeStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := ScannerNodeStatus{
+		Node:                  globalLocalNodeName,
+		MaxDrivesInParallel:   s.maxParallel,
+		DriveLatencyThreshold: s.threshold,
+		EffectiveConcurrency:  s.active,
+	}
+	for drive, d := range s.drives {
+		state := scannerDriveIdle
+		switch {
+		case d.backedOff:
+			state = scannerDriveBackedOff
+		case d.scanning > 0:
+			state = scannerDriveScanning
+		}
+		st.Drives = append(st.Drives, ScannerDriveStatus{
+			Drive:     drive,
+			State:     state,
+			Latency:   d.latency,
+			ChangedAt: d.changed,
+		})
+	}
+	sort.Slice(st.Drives, func(i, j int) bool {
+		return st.Drives[i].Drive < st.Drives[j].Drive
+	})
+	return st
+}
+
+// run checks the latency of the local drives until ctx is canceled.
+func (s *scannerDrives) run(ctx context.Context) {
+	t := time.NewTicker(scannerDriveCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		globalLocalDrivesMu.RLock()
+		localDrives := globalLocalDrives
+		globalLocalDrivesMu.RUnlock()
+
+		latencies := make(map[string]time.Duration, len(localDrives))
+		for _, drive := range localDrives {
+			if d, ok := drive.(*xlStorageDiskIDCheck); ok {
+				latencies[d.String()] = d.lastMinuteLatency(scannerDriveLatencyMetrics...)
+			}
+		}
+		s.check(latencies, time.Now())
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestScannerDrivesBackoff(t *testing.T) {
+	s := newScannerDrives()
+	s.update(0, 100*time.Millisecond)
+
+	state := func() string {
+		t.Helper()
+		st := s.status()
+		if len(st.Drives) != 1 {
+			t.Fatalf("expected one drive, got %v", st.Drives)
+		}
+		return st.Drives[0].State
+	}
+	check := func(now time.Time, latency time.Duration) {
+		s.check(map[string]time.Duration{"/disk1": latency}, now)
+	}
+
+	now := time.Now()
+	check(now, 200*time.Millisecond)
+	if state() != scannerDriveIdle {
+		t.Fatal("drive backed off without delay")
+	}
+	// A dip below the threshold restarts the delay.
+	check(now.Add(20*time.Second), 50*time.Millisecond)
+	check(now.Add(25*time.Second), 200*time.Millisecond)
+	check(now.Add(40*time.Second), 200*time.Millisecond)
+	if state() != scannerDriveIdle {
+		t.Fatal("drive backed off after latency dip")
+	}
+	check(now.Add(55*time.Second), 200*time.Millisecond)
+	if state() != scannerDriveBackedOff {
+		t.Fatal("drive not backed off")
+	}
+
+	// Latency within the hysteresis band does not resume.
+	now = now.Add(time.Minute)
+	check(now, 90*time.Millisecond)
+	check(now.Add(5*time.Minute), 90*time.Millisecond)
+	if state() != scannerDriveBackedOff {
+		t.Fatal("drive resumed within the hysteresis band")
+	}
+	check(now.Add(6*time.Minute), 10*time.Millisecond)
+	check(now.Add(7*time.Minute), 10*time.Millisecond)
+	if state() != scannerDriveBackedOff {
+		t.Fatal("drive resumed without delay")
+	}
+	check(now.Add(8*time.Minute), 10*time.Millisecond)
+	if state() != scannerDriveIdle {
+		t.Fatal("drive not resumed")
+	}
+
+	// Disabling the threshold resumes backed off drives.
+	s.check(map[string]time.Duration{"/disk1": time.Second}, now)
+	s.check(map[string]time.Duration{"/disk1": time.Second}, now.Add(time.Minute))
+	if state() != scannerDriveBackedOff {
+		t.Fatal("drive not backed off")
+	}
+	s.update(0, 0)
+	if state() != scannerDriveIdle {
+		t.Fatal("drive not resumed when disabling back off")
+	}
+}
+
+func TestScannerDrivesAcquire(t *testing.T) {
+	s := newScannerDrives()
+	s.update(1, time.Millisecond)
+	ctx := context.Background()
+
+	release, err := s.acquire(ctx, "/disk1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st := s.status(); st.EffectiveConcurrency != 1 || st.Drives[0].State != scannerDriveScanning {
+		t.Fatalf("unexpected status %+v", st)
+	}
+
+	// The second drive waits for the first scan.
+	acquired := make(chan func())
+	go func() {
+		release, err := s.acquire(ctx, "/disk2")
+		if err != nil {
+			t.Error(err)
+		}
+		acquired <- release
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("more drives scanned than allowed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+	release2 := <-acquired
+
+	// A scan of a backed off drive gives up its slot.
+	now := time.Now()
+	s.check(map[string]time.Duration{"/disk2": time.Second}, now)
+	s.check(map[string]time.Duration{"/disk2": time.Second}, now.Add(time.Minute))
+	paused := make(chan error)
+	go func() {
+		paused <- s.pause(ctx, "/disk2")
+	}()
+	release, err = s.acquire(ctx, "/disk1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+
+	s.update(1, 0)
+	if err := <-paused; err != nil {
+		t.Fatal(err)
+	}
+	release2()
+	if st := s.status(); st.EffectiveConcurrency != 0 {
+		t.Fatalf("expected no running scans, got %d", st.EffectiveConcurrency)
+	}
+
+	// Waiting scans are canceled with their context.
+	release, _ = s.acquire(ctx, "/disk1")
+	defer release()
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := s.acquire(cctx, "/disk2"); err != context.Canceled {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
@@ -30,6 +30,29 @@ type scannerMetrics struct {
 
 	cycleInfoMu sync.Mutex
 	cycleInfo   *currentScannerCycle
+
+	// bucketScans contains the duration of the last completed
+	// scan of each bucket on an erasure set of this node.
+	bucketScansMu sync.Mutex
+	bucketScans   map[string]time.Duration
+
+	// objectsSkipped is the number of objects not scanned since
+	// they were accounted for by a previous cycle.
+	objectsSkipped uint64
+
+	// cycleObjects contains the objects scanned and skipped by
+	// this node in the current and the previous cycle.
+	cycleObjectsMu   sync.Mutex
+	cycleObjects     scannerCycleObjects
+	lastCycleObjects scannerCycleObjects
+}
+
+// scannerCycleObjects contains the objects scanned and skipped
+// in a scanner cycle.
+type scannerCycleObjects struct {
+	cycle   uint64
+	scanned uint64
+	skipped uint64
 }
 
 var globalScannerMetrics scannerMetrics
@@ -202,6 +225,11 @@ func (p *scannerMetrics) lifetime(m scannerMetric) uint64 {
 	return val
 }
 
+// lifetimeSkipped returns the lifetime count of skipped objects.
+func (p *scannerMetrics) lifetimeSkipped() uint64 {
+	return atomic.LoadUint64(&p.objectsSkipped)
+}
+
 // lastMinute returns the last minute statistics of a metric.
 // m should be < scannerMetricLastRealtime
 func (p *scannerMetrics) lastMinute(m scannerMetric) AccElem {
@@ -253,6 +281,73 @@ func (p *scannerMetrics) getCycle() *currentScannerCycle {
 	return &c
 }
 
+// setBucketScanDuration records the duration of a completed bucket scan.
+func (p *scannerMetrics) setBucketScanDuration(bucket string, d time.Duration) {
+	p.bucketScansMu.Lock()
+	defer p.bucketScansMu.Unlock()
+	if p.bucketScans == nil {
+		p.bucketScans = make(map[string]time.Duration)
+	}
+	p.bucketScans[bucket] = d
+}
+
+// pruneBucketScans removes the durations of buckets not present in buckets.
+func (p *scannerMetrics) pruneBucketScans(buckets []BucketInfo) {
+	keep := make(map[string]struct{}, len(buckets))
+	for _, b := range buckets {
+		keep[b.Name] = struct{}{}
+	}
+	p.bucketScansMu.Lock()
+	defer p.bucketScansMu.Unlock()
+	for bucket := range p.bucketScans {
+		if _, ok := keep[bucket]; !ok {
+			delete(p.bucketScans, bucket)
+		}
+	}
+}
+
+// getBucketScanDurations returns a copy of the last bucket scan durations.
+func (p *scannerMetrics) getBucketScanDurations() map[string]time.Duration {
+	p.bucketScansMu.Lock()
+	defer p.bucketScansMu.Unlock()
+	m := make(map[string]time.Duration, len(p.bucketScans))
+	for bucket, d := range p.bucketScans {
+		m[bucket] = d
+	}
+	return m
+}
+
+// addCycleObjects records objects scanned and skipped in cycle.
+// Counts of a newer cycle make the current cycle the previous one.
+func (p *scannerMetrics) addCycleObjects(cycle, scanned, skipped uint64) {
+	atomic.AddUint64(&p.objectsSkipped, skipped)
+
+	p.cycleObjectsMu.Lock()
+	defer p.cycleObjectsMu.Unlock()
+	switch {
+	case cycle > p.cycleObjects.cycle:
+		p.lastCycleObjects = p.cycleObjects
+		p.cycleObjects = scannerCycleObjects{cycle: cycle}
+	case cycle < p.cycleObjects.cycle:
+		// Late results of the previous cycle.
+		if cycle == p.lastCycleObjects.cycle {
+			p.lastCycleObjects.scanned += scanned
+			p.lastCycleObjects.skipped += skipped
+		}
+		return
+	}
+	p.cycleObjects.scanned += scanned
+	p.cycleObjects.skipped += skipped
+}
+
+// getCycleObjects returns the objects scanned and skipped in the
+// current and the previous cycle.
+func (p *scannerMetrics) getCycleObjects() (current, last scannerCycleObjects) {
+	p.cycleObjectsMu.Lock()
+	defer p.cycleObjectsMu.Unlock()
+	return p.cycleObjects, p.lastCycleObjects
+}
+
 func (p *scannerMetrics) report() madmin.ScannerMetrics {
 	var m madmin.ScannerMetrics
 	cycle := p.getCycle()
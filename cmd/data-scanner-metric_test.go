@@ -0,0 +1,67 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScannerMetricsBucketScans(t *testing.T) {
+	var p scannerMetrics
+	p.setBucketScanDuration("bucket1", time.Second)
+	p.setBucketScanDuration("bucket2", 2*time.Second)
+	p.setBucketScanDuration("bucket1", 3*time.Second)
+
+	scans := p.getBucketScanDurations()
+	if len(scans) != 2 || scans["bucket1"] != 3*time.Second || scans["bucket2"] != 2*time.Second {
+		t.Fatalf("unexpected bucket scans %v", scans)
+	}
+
+	// The returned durations are a copy.
+	scans["bucket3"] = time.Second
+	if _, ok := p.getBucketScanDurations()["bucket3"]; ok {
+		t.Fatal("expected the bucket scans not to be modified")
+	}
+
+	p.pruneBucketScans([]BucketInfo{{Name: "bucket2"}, {Name: "bucket4"}})
+	scans = p.getBucketScanDurations()
+	if len(scans) != 1 || scans["bucket2"] != 2*time.Second {
+		t.Fatalf("expected only the scan of bucket2 to remain, got %v", scans)
+	}
+}
+
+func TestScannerNodeMetricsBucketScans(t *testing.T) {
+	const bucket = "test-bucket-scan-duration"
+	globalScannerMetrics.setBucketScanDuration(bucket, 1500*time.Millisecond)
+	defer globalScannerMetrics.pruneBucketScans(nil)
+
+	var found bool
+	for _, m := range getScannerNodeMetrics().Get() {
+		if m.Description.Name != lastScanDuration || m.VariableLabels["bucket"] != bucket {
+			continue
+		}
+		found = true
+		if m.Description.Namespace != bucketMetricNamespace || m.Value != 1.5 {
+			t.Errorf("unexpected metric %+v", m)
+		}
+	}
+	if !found {
+		t.Errorf("expected the %s metric of %s", lastScanDuration, bucket)
+	}
+}
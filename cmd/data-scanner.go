@@ -69,10 +69,12 @@ var (
 	// Sleeper values are updated when config is loaded.
 	scannerSleeper = newDynamicSleeper(10, 10*time.Second, true)
 	scannerCycle   = uatomic.NewDuration(dataScannerStartDelay)
+	scannerSpeed   = uatomic.NewString("")
 )
 
 // initDataScanner will start the scanner in the background.
 func initDataScanner(ctx context.Context, objAPI ObjectLayer) {
+	go globalScannerDrives.run(ctx)
 	go func() {
 		r := rand.New(rand.NewSource(time.Now().UnixNano()))
 		// Run the data scanner in a loop
@@ -158,6 +160,9 @@ func runDataScanner(ctx context.Context, objAPI ObjectLayer) {
 	ctx, cancel := globalLeaderLock.GetLock(ctx)
 	defer cancel()
 
+	// Requests made while another node was running the scanner were handled there.
+	globalDataUsageRebuild.reset()
+
 	// Load current bloom cycle
 	var cycleInfo currentScannerCycle
 
@@ -180,57 +185,140 @@ func runDataScanner(ctx context.Context, objAPI ObjectLayer) {
 		case <-ctx.Done():
 			return
 		case <-scannerTimer.C:
-			// Reset the timer for next cycle.
-			// If scanner takes longer we start at once.
-			scannerTimer.Reset(scannerCycle.Load())
-
-			stopFn := globalScannerMetrics.log(scannerMetricScanCycle)
-			cycleInfo.current = cycleInfo.next
-			cycleInfo.started = time.Now()
-			globalScannerMetrics.setCycle(&cycleInfo)
-
-			bgHealInfo := readBackgroundHealInfo(ctx, objAPI)
-			scanMode := getCycleScanMode(cycleInfo.current, bgHealInfo.BitrotStartCycle, bgHealInfo.BitrotStartTime)
-			if bgHealInfo.CurrentScanMode != scanMode {
-				newHealInfo := bgHealInfo
-				newHealInfo.CurrentScanMode = scanMode
-				if scanMode == madmin.HealDeepScan {
-					newHealInfo.BitrotStartTime = time.Now().UTC()
-					newHealInfo.BitrotStartCycle = cycleInfo.current
+		case <-globalDataUsageRebuild.wakeup:
+			// Start the rebuild at once.
+			if !scannerTimer.Stop() {
+				select {
+				case <-scannerTimer.C:
+				default:
 				}
-				saveBackgroundHealInfo(ctx, objAPI, newHealInfo)
 			}
-
-			// Wait before starting next cycle and wait on startup.
-			results := make(chan DataUsageInfo, 1)
-			go storeDataUsageInBackend(ctx, objAPI, results)
-			err := objAPI.NSScanner(ctx, results, uint32(cycleInfo.current), scanMode)
-			logger.LogIf(ctx, err)
-			res := map[string]string{"cycle": fmt.Sprint(cycleInfo.current)}
-			if err != nil {
-				res["error"] = err.Error()
+		}
+		// Reset the timer for next cycle.
+		// If scanner takes longer we start at once.
+		scannerTimer.Reset(scannerCycle.Load())
+
+		stopFn := globalScannerMetrics.log(scannerMetricScanCycle)
+		cycleInfo.current = cycleInfo.next
+		cycleInfo.started = time.Now()
+		globalScannerMetrics.setCycle(&cycleInfo)
+
+		bgHealInfo := readBackgroundHealInfo(ctx, objAPI)
+		scanMode := getCycleScanMode(cycleInfo.current, bgHealInfo.BitrotStartCycle, bgHealInfo.BitrotStartTime)
+		if bgHealInfo.CurrentScanMode != scanMode {
+			newHealInfo := bgHealInfo
+			newHealInfo.CurrentScanMode = scanMode
+			if scanMode == madmin.HealDeepScan {
+				newHealInfo.BitrotStartTime = time.Now().UTC()
+				newHealInfo.BitrotStartCycle = cycleInfo.current
 			}
-			stopFn(res)
-			if err == nil {
-				// Store new cycle...
-				cycleInfo.next++
-				cycleInfo.current = 0
-				cycleInfo.cycleCompleted = append(cycleInfo.cycleCompleted, time.Now())
-				if len(cycleInfo.cycleCompleted) > dataUsageUpdateDirCycles {
-					cycleInfo.cycleCompleted = cycleInfo.cycleCompleted[len(cycleInfo.cycleCompleted)-dataUsageUpdateDirCycles:]
-				}
-				globalScannerMetrics.setCycle(&cycleInfo)
-				tmp := make([]byte, 8, 8+cycleInfo.Msgsize())
-				// Cycle for backward compat.
-				binary.LittleEndian.PutUint64(tmp, cycleInfo.next)
-				tmp, _ = cycleInfo.MarshalMsg(tmp)
-				err = saveConfig(ctx, objAPI, dataUsageBloomNamePath, tmp)
-				logger.LogIf(ctx, err)
+			saveBackgroundHealInfo(ctx, objAPI, newHealInfo)
+		}
+
+		res := map[string]string{"cycle": fmt.Sprint(cycleInfo.current)}
+		cycleCtx, rebuild := globalDataUsageRebuild.startCycle(ctx)
+		if rebuild {
+			// No cycle is running, drop the caches so
+			// the cycle below scans everything again.
+			res["rebuild"] = "true"
+			logger.LogIf(ctx, deleteDataUsageCaches(ctx, objAPI))
+		}
+
+		// Wait before starting next cycle and wait on startup.
+		results := make(chan DataUsageInfo, 1)
+		go storeDataUsageInBackend(ctx, objAPI, results)
+		err := objAPI.NSScanner(cycleCtx, results, uint32(cycleInfo.current), scanMode)
+		globalDataUsageRebuild.endCycle()
+		logger.LogIf(ctx, err)
+		if err != nil {
+			res["error"] = err.Error()
+		}
+		stopFn(res)
+		if err == nil {
+			// Store new cycle...
+			cycleInfo.next++
+			cycleInfo.current = 0
+			cycleInfo.cycleCompleted = append(cycleInfo.cycleCompleted, time.Now())
+			if len(cycleInfo.cycleCompleted) > dataUsageUpdateDirCycles {
+				cycleInfo.cycleCompleted = cycleInfo.cycleCompleted[len(cycleInfo.cycleCompleted)-dataUsageUpdateDirCycles:]
 			}
+			globalScannerMetrics.setCycle(&cycleInfo)
+			tmp := make([]byte, 8, 8+cycleInfo.Msgsize())
+			// Cycle for backward compat.
+			binary.LittleEndian.PutUint64(tmp, cycleInfo.next)
+			tmp, _ = cycleInfo.MarshalMsg(tmp)
+			err = saveConfig(ctx, objAPI, dataUsageBloomNamePath, tmp)
+			logger.LogIf(ctx, err)
 		}
 	}
 }
 
+// dataUsageRebuildState tracks requests to discard the data usage
+// caches and rebuild them with a full scan.
+type dataUsageRebuildState struct {
+	mu        sync.Mutex
+	requested bool
+	cancel    context.CancelFunc // cancels the running scanner cycle, if any
+
+	// wakeup starts the next scanner cycle without waiting.
+	wakeup chan struct{}
+}
+
+var globalDataUsageRebuild = &dataUsageRebuildState{
+	wakeup: make(chan struct{}, 1),
+}
+
+// request aborts the running scanner cycle, if any, and asks for the next
+// one to start at once and rebuild the data usage caches.
+func (s *dataUsageRebuildState) request() {
+	s.mu.Lock()
+	s.requested = true
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// reset drops any pending request.
+func (s *dataUsageRebuildState) reset() {
+	s.mu.Lock()
+	s.requested = false
+	s.mu.Unlock()
+
+	select {
+	case <-s.wakeup:
+	default:
+	}
+}
+
+// startCycle returns the context of a new scanner cycle and whether
+// the data usage caches must be rebuilt before it starts.
+func (s *dataUsageRebuildState) startCycle(ctx context.Context) (context.Context, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	rebuild := s.requested
+	s.requested = false
+	return ctx, rebuild
+}
+
+// endCycle releases the context of the scanner cycle.
+func (s *dataUsageRebuildState) endCycle() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+}
+
 type cachedFolder struct {
 	name              string
 	parent            *dataUsageHash
@@ -258,6 +346,11 @@ type folderScanner struct {
 
 	// updateCurrentPath should be called whenever a new path is scanned.
 	updateCurrentPath func(string)
+
+	// objectsScanned and objectsSkipped count the objects scanned
+	// and the objects of compacted folders skipped in this scan.
+	objectsScanned uint64
+	objectsSkipped uint64
 }
 
 // Cache structure and compaction:
@@ -307,6 +400,12 @@ func scanDataFolder(ctx context.Context, poolIdx, setIdx int, basePath string, c
 	case "", dataUsageRoot:
 		return cache, errors.New("internal error: root scan attempted")
 	}
+	release, err := globalScannerDrives.acquire(ctx, basePath)
+	if err != nil {
+		return cache, err
+	}
+	defer release()
+
 	updatePath, closeDisk := globalScannerMetrics.currentPathUpdater(basePath, cache.Info.Name)
 	defer closeDisk()
 
@@ -322,6 +421,9 @@ func scanDataFolder(ctx context.Context, poolIdx, setIdx int, basePath string, c
 		updates:               cache.Info.updates,
 		updateCurrentPath:     updatePath,
 	}
+	defer func() {
+		globalScannerMetrics.addCycleObjects(uint64(cache.Info.NextCycle), s.objectsScanned, s.objectsSkipped)
+	}()
 
 	// Add disks for set healing.
 	if poolIdx >= 0 && setIdx >= 0 {
@@ -353,7 +455,7 @@ func scanDataFolder(ctx context.Context, poolIdx, setIdx int, basePath string, c
 	}
 	root := dataUsageEntry{}
 	folder := cachedFolder{name: cache.Info.Name, objectHealProbDiv: 1}
-	err := s.scanFolder(ctx, folder, &root)
+	err = s.scanFolder(ctx, folder, &root)
 	if err != nil {
 		// No useful information...
 		return cache, err
@@ -417,6 +519,12 @@ func (f *folderScanner) scanFolder(ctx context.Context, folder cachedFolder, int
 		if !f.oldCache.Info.replication.Empty() && f.oldCache.Info.replication.Config.HasActiveRules(prefix, true) {
 			replicationCfg = f.oldCache.Info.replication
 		}
+		// Give way to production IO on a backed off drive.
+		if err := globalScannerDrives.pause(ctx, f.root); err != nil {
+			return err
+		}
+		// Pause while the node sheds load.
+		globalGuardrail.waitLowPriority(ctx)
 		// Check if we can skip it due to bloom filter...
 		scannerSleeper.Sleep(ctx, dataScannerSleepPerFolder)
 
@@ -482,6 +590,7 @@ func (f *folderScanner) scanFolder(ctx context.Context, folder cachedFolder, int
 				debug:       f.dataUsageScannerDebug,
 				lifeCycle:   activeLifeCycle,
 				replication: replicationCfg,
+				cycle:       f.oldCache.Info.NextCycle,
 			}
 
 			item.heal.enabled = thisHash.modAlt(f.oldCache.Info.NextCycle/folder.objectHealProbDiv, f.healObjectSelect/folder.objectHealProbDiv) && globalIsErasure
@@ -513,6 +622,7 @@ func (f *folderScanner) scanFolder(ctx context.Context, folder cachedFolder, int
 
 			into.addSizes(sz)
 			into.Objects++
+			f.objectsScanned++
 
 			wait() // wait to proceed to next entry.
 
@@ -634,6 +744,9 @@ func (f *folderScanner) scanFolder(ctx context.Context, folder cachedFolder, int
 					// Transfer and add as child...
 					f.newCache.copyWithChildren(&f.oldCache, h, folder.parent)
 					into.addChild(h)
+					if e := f.oldCache.find(folder.name); e != nil {
+						f.objectsSkipped += e.Objects
+					}
 					continue
 				}
 			}
@@ -852,6 +965,7 @@ type scannerItem struct {
 		bitrot  bool
 	} // Has the object been selected for heal check?
 	debug bool
+	cycle uint32 // Scanner cycle the item is scanned in.
 }
 
 type sizeSummary struct {
@@ -865,6 +979,16 @@ type sizeSummary struct {
 	failedCount     uint64
 	replTargetStats map[string]replTargetSizeSummary
 	tiers           map[string]tierStats
+	objLock         *objectLockStats     // only set for buckets with object lock enabled
+	inlined         uint64               // versions with data inlined in metadata
+	notInlined      uint64               // versions with data in a data directory
+	sseS3           uint64               // objects encrypted with SSE-S3
+	sseKMS          uint64               // objects encrypted with SSE-KMS
+	sseC            uint64               // objects encrypted with SSE-C
+	current         uint64               // latest versions, excluding delete markers
+	noncurrent      uint64               // noncurrent versions, excluding delete markers
+	storageClasses  map[string]tierStats // versions by storage class, excluding transitioned versions
+	modTime         time.Time            // modification time of the latest version, zero if deleted
 }
 
 // replTargetSizeSummary holds summary of replication stats by target
@@ -930,6 +1054,12 @@ func (i *scannerItem) applyLifecycle(ctx context.Context, o ObjectLayer, oi Obje
 	if i.lifeCycle == nil {
 		return false, size
 	}
+	if globalQuarantineSys.skip(ctx, quarantineSkipILM, i.bucket, i.objectPath(), oi.VersionID) {
+		return false, size
+	}
+	if bucketStateBlocksILM(ctx, i.bucket) {
+		return false, size
+	}
 
 	versionID := oi.VersionID
 	rCfg, _ := globalBucketObjectLockSys.Get(i.bucket)
@@ -945,6 +1075,10 @@ func (i *scannerItem) applyLifecycle(ctx context.Context, o ObjectLayer, oi Obje
 
 	switch lcEvt.Action {
 	case lifecycle.DeleteAction, lifecycle.DeleteVersionAction, lifecycle.DeleteRestoredAction, lifecycle.DeleteRestoredVersionAction:
+		if !globalExpiryState.scannerMayEnqueue(i.cycle) {
+			// Too many pending expiry tasks, expire in a later cycle.
+			return false, size
+		}
 		return applyLifecycleAction(lcEvt.Action, oi, ""), 0
 	case lifecycle.TransitionAction, lifecycle.TransitionVersionAction:
 		return applyLifecycleAction(lcEvt.Action, oi, lcEvt.StorageClass), size
@@ -1002,6 +1136,13 @@ func (i *scannerItem) applyNewerNoncurrentVersionLimit(ctx context.Context, _ Ob
 	if lim == 0 || len(fivs) <= lim+1 { // fewer than lim _noncurrent_ versions
 		return fivs, nil
 	}
+	if bucketStateBlocksILM(ctx, i.bucket) {
+		return fivs, nil
+	}
+	if !globalExpiryState.scannerMayEnqueue(i.cycle) {
+		// Too many pending expiry tasks, expire in a later cycle.
+		return fivs, nil
+	}
 
 	overflowVersions := fivs[lim+1:]
 	// current version + most recent lim noncurrent versions
@@ -1015,6 +1156,11 @@ func (i *scannerItem) applyNewerNoncurrentVersionLimit(ctx context.Context, _ Ob
 	toDel := make([]ObjectToDelete, 0, len(overflowVersions))
 	for _, fi := range overflowVersions {
 		obj := fi.ToObjectInfo(i.bucket, i.objectPath(), versioned)
+		// skip quarantined versions
+		if globalQuarantineSys.skip(ctx, quarantineSkipILM, i.bucket, i.objectPath(), fi.VersionID) {
+			fivs = append(fivs, fi)
+			continue
+		}
 		// skip versions with object locking enabled
 		if rcfg.LockEnabled && enforceRetentionForDeletion(ctx, obj) {
 			if i.debug {
@@ -1054,7 +1200,7 @@ func (i *scannerItem) applyNewerNoncurrentVersionLimit(ctx context.Context, _ Ob
 // after applying lifecycle checks configured.
 func (i *scannerItem) applyVersionActions(ctx context.Context, o ObjectLayer, fivs []FileInfo) ([]FileInfo, error) {
 	if i.heal.enabled {
-		if healDeleteDangling {
+		if healDeleteDangling && !globalQuarantineSys.skip(ctx, quarantineSkipDangling, i.bucket, i.objectPath(), "") {
 			done := globalScannerMetrics.time(scannerMetricCleanAbandoned)
 			err := o.CheckAbandonedParts(ctx, i.bucket, i.objectPath(), madmin.HealOpts{Remove: healDeleteDangling})
 			done()
@@ -1408,6 +1554,13 @@ func (d *dynamicSleeper) Sleep(ctx context.Context, base time.Duration) {
 	}
 }
 
+// Get the current sleep factor and maximum sleep.
+func (d *dynamicSleeper) Get() (factor float64, maxWait time.Duration) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.factor, d.maxSleep
+}
+
 // Update the current settings and cycle all waiting.
 // Parameters are the same as in the contructor.
 func (d *dynamicSleeper) Update(factor float64, maxWait time.Duration) error {
@@ -35,6 +35,8 @@ import (
 	"github.com/klauspost/compress/zstd"
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio/internal/bucket/lifecycle"
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/hash"
 	"github.com/minio/minio/internal/logger"
 	"github.com/tinylib/msgp/msgp"
@@ -51,6 +53,9 @@ type sizeHistogram [dataUsageBucketLen]uint64
 // versionsHistogram is a histogram of number of versions in an object.
 type versionsHistogram [dataUsageVersionLen]uint64
 
+// ageHistogram is a histogram of object ages in days.
+type ageHistogram [dataUsageAgeLen]uint64
+
 type dataUsageEntry struct {
 	Children dataUsageHashMap `msg:"ch"`
 	// These fields do no include any children.
@@ -59,9 +64,19 @@ type dataUsageEntry struct {
 	Versions         uint64               `msg:"vs"` // Versions that are not delete markers.
 	ObjSizes         sizeHistogram        `msg:"szs"`
 	ObjVersions      versionsHistogram    `msg:"vh"`
+	ObjAges          ageHistogram         `msg:"ag"`
 	ReplicationStats *replicationAllStats `msg:"rs,omitempty"`
 	AllTierStats     *allTierStats        `msg:"ats,omitempty"`
+	ObjectLock       *objectLockStats     `msg:"ol,omitempty"`
 	Compacted        bool                 `msg:"c"`
+	Inlined          uint64               `msg:"inl"`    // Versions with data inlined in metadata.
+	NotInlined       uint64               `msg:"ninl"`   // Versions with data in a data directory.
+	SSES3            uint64               `msg:"sses3"`  // Objects encrypted with SSE-S3.
+	SSEKMS           uint64               `msg:"ssekms"` // Objects encrypted with SSE-KMS.
+	SSEC             uint64               `msg:"ssec"`   // Objects encrypted with SSE-C.
+	Current          uint64               `msg:"cur"`    // Latest versions, excluding delete markers.
+	Noncurrent       uint64               `msg:"ncur"`   // Noncurrent versions, excluding delete markers.
+	StorageClasses   *storageClassStats   `msg:"scs,omitempty"`
 }
 
 // allTierStats is a collection of per-tier stats across all configured remote
@@ -104,6 +119,90 @@ func (ats *allTierStats) adminStats(stats map[string]madmin.TierStats) map[strin
 	return stats
 }
 
+// storageClassStats holds the stats of the object versions stored in
+// each storage class, transitioned versions are accounted on their tier.
+type storageClassStats struct {
+	Classes map[string]tierStats `msg:"cl"`
+}
+
+func newStorageClassStats() *storageClassStats {
+	return &storageClassStats{
+		Classes: make(map[string]tierStats),
+	}
+}
+
+func (scs *storageClassStats) addSizes(sz sizeSummary) {
+	for sc, st := range sz.storageClasses {
+		scs.Classes[sc] = scs.Classes[sc].add(st)
+	}
+}
+
+func (scs *storageClassStats) merge(other *storageClassStats) {
+	for sc, st := range other.Classes {
+		scs.Classes[sc] = scs.Classes[sc].add(st)
+	}
+}
+
+// objectLockStats holds retention and legal hold counts of the object
+// versions in a bucket with object lock enabled.
+type objectLockStats struct {
+	NoRetention uint64 `msg:"nr"` // versions without an active retention
+	Governance  uint64 `msg:"gv"` // versions under governance retention
+	Compliance  uint64 `msg:"cp"` // versions under compliance retention
+	LegalHold   uint64 `msg:"lh"` // versions with legal hold on
+
+	// Versions under retention by retain-until month, formatted as "2006-01".
+	RetainUntil map[string]uint64 `msg:"ru"`
+}
+
+// objectLockRetainUntilFormat is the layout of objectLockStats.RetainUntil keys.
+const objectLockRetainUntilFormat = "2006-01"
+
+func newObjectLockStats() *objectLockStats {
+	return &objectLockStats{
+		RetainUntil: make(map[string]uint64),
+	}
+}
+
+// objectLockActiveRetention returns the retention of an object version
+// from its metadata if it is still in effect at now.
+func objectLockActiveRetention(meta map[string]string, now time.Time) (ret objectlock.ObjectRetention, ok bool) {
+	ret = objectlock.GetObjectRetentionMeta(meta)
+	if !ret.Mode.Valid() || !ret.RetainUntilDate.After(now) {
+		return ret, false
+	}
+	return ret, true
+}
+
+// add counts an object version using its metadata.
+func (ols *objectLockStats) add(meta map[string]string, now time.Time) {
+	if objectlock.GetObjectLegalHoldMeta(meta).Status == objectlock.LegalHoldOn {
+		ols.LegalHold++
+	}
+	ret, ok := objectLockActiveRetention(meta, now)
+	if !ok {
+		ols.NoRetention++
+		return
+	}
+	switch ret.Mode {
+	case objectlock.RetGovernance:
+		ols.Governance++
+	case objectlock.RetCompliance:
+		ols.Compliance++
+	}
+	ols.RetainUntil[ret.RetainUntilDate.UTC().Format(objectLockRetainUntilFormat)]++
+}
+
+func (ols *objectLockStats) merge(other *objectLockStats) {
+	ols.NoRetention += other.NoRetention
+	ols.Governance += other.Governance
+	ols.Compliance += other.Compliance
+	ols.LegalHold += other.LegalHold
+	for month, n := range other.RetainUntil {
+		ols.RetainUntil[month] += n
+	}
+}
+
 // tierStats holds per-tier stats of a remote tier.
 type tierStats struct {
 	TotalSize   uint64 `msg:"ts"`
@@ -295,8 +394,18 @@ type dataUsageCacheInfo struct {
 func (e *dataUsageEntry) addSizes(summary sizeSummary) {
 	e.Size += summary.totalSize
 	e.Versions += summary.versions
+	e.Inlined += summary.inlined
+	e.NotInlined += summary.notInlined
+	e.SSES3 += summary.sseS3
+	e.SSEKMS += summary.sseKMS
+	e.SSEC += summary.sseC
+	e.Current += summary.current
+	e.Noncurrent += summary.noncurrent
 	e.ObjSizes.add(summary.totalSize)
 	e.ObjVersions.add(summary.versions)
+	if !summary.modTime.IsZero() {
+		e.ObjAges.add(UTCNow().Sub(summary.modTime))
+	}
 
 	if e.ReplicationStats == nil {
 		e.ReplicationStats = &replicationAllStats{
@@ -327,6 +436,18 @@ func (e *dataUsageEntry) addSizes(summary sizeSummary) {
 		}
 		e.AllTierStats.addSizes(summary)
 	}
+	if summary.objLock != nil {
+		if e.ObjectLock == nil {
+			e.ObjectLock = newObjectLockStats()
+		}
+		e.ObjectLock.merge(summary.objLock)
+	}
+	if summary.storageClasses != nil {
+		if e.StorageClasses == nil {
+			e.StorageClasses = newStorageClassStats()
+		}
+		e.StorageClasses.addSizes(summary)
+	}
 }
 
 // merge other data usage entry into this, excluding children.
@@ -334,6 +455,13 @@ func (e *dataUsageEntry) merge(other dataUsageEntry) {
 	e.Objects += other.Objects
 	e.Versions += other.Versions
 	e.Size += other.Size
+	e.Inlined += other.Inlined
+	e.NotInlined += other.NotInlined
+	e.SSES3 += other.SSES3
+	e.SSEKMS += other.SSEKMS
+	e.SSEC += other.SSEC
+	e.Current += other.Current
+	e.Noncurrent += other.Noncurrent
 	if other.ReplicationStats != nil {
 		if e.ReplicationStats == nil {
 			e.ReplicationStats = &replicationAllStats{Targets: make(map[string]replicationStats)}
@@ -361,12 +489,30 @@ func (e *dataUsageEntry) merge(other dataUsageEntry) {
 		e.ObjVersions[i] += v
 	}
 
+	for i, v := range other.ObjAges[:] {
+		e.ObjAges[i] += v
+	}
+
 	if other.AllTierStats != nil {
 		if e.AllTierStats == nil {
 			e.AllTierStats = newAllTierStats()
 		}
 		e.AllTierStats.merge(other.AllTierStats)
 	}
+
+	if other.ObjectLock != nil {
+		if e.ObjectLock == nil {
+			e.ObjectLock = newObjectLockStats()
+		}
+		e.ObjectLock.merge(other.ObjectLock)
+	}
+
+	if other.StorageClasses != nil {
+		if e.StorageClasses == nil {
+			e.StorageClasses = newStorageClassStats()
+		}
+		e.StorageClasses.merge(other.StorageClasses)
+	}
 }
 
 // mod returns true if the hash mod cycles == cycle.
@@ -422,6 +568,16 @@ func (e dataUsageEntry) clone() dataUsageEntry {
 		ats.merge(e.AllTierStats)
 		e.AllTierStats = ats
 	}
+	if e.ObjectLock != nil {
+		ols := newObjectLockStats()
+		ols.merge(e.ObjectLock)
+		e.ObjectLock = ols
+	}
+	if e.StorageClasses != nil {
+		scs := newStorageClassStats()
+		scs.merge(e.StorageClasses)
+		e.StorageClasses = scs
+	}
 	return e
 }
 
@@ -742,6 +898,26 @@ func (h *versionsHistogram) toMap() map[string]uint64 {
 	return res
 }
 
+// add an object age to the histogram.
+func (h *ageHistogram) add(age time.Duration) {
+	days := int64(age / (24 * time.Hour))
+	for i, interval := range ObjectsAgeHistogramIntervals[:] {
+		if days >= interval.start && days <= interval.end {
+			h[i]++
+			break
+		}
+	}
+}
+
+// toMap returns the map to a map[string]uint64.
+func (h *ageHistogram) toMap() map[string]uint64 {
+	res := make(map[string]uint64, dataUsageAgeLen)
+	for i, count := range h {
+		res[ObjectsAgeHistogramIntervals[i].name] = count
+	}
+	return res
+}
+
 func (d *dataUsageCache) tiersUsageInfo(buckets []BucketInfo) *allTierStats {
 	dst := newAllTierStats()
 	for _, bucket := range buckets {
@@ -777,6 +953,27 @@ func (d *dataUsageCache) bucketsUsageInfo(buckets []BucketInfo) map[string]Bucke
 			ObjectsCount:            flat.Objects,
 			ObjectSizesHistogram:    flat.ObjSizes.toMap(),
 			ObjectVersionsHistogram: flat.ObjVersions.toMap(),
+			ObjectAgesHistogram:     flat.ObjAges.toMap(),
+			InlinedCount:            flat.Inlined,
+			NotInlinedCount:         flat.NotInlined,
+			CurrentVersionsCount:    flat.Current,
+			NoncurrentVersionsCount: flat.Noncurrent,
+			EncryptedCount: map[string]uint64{
+				crypto.S3.String():    flat.SSES3,
+				crypto.S3KMS.String(): flat.SSEKMS,
+				crypto.SSEC.String():  flat.SSEC,
+			},
+		}
+		if flat.ObjectLock != nil {
+			bui.LegalHoldCount = flat.ObjectLock.LegalHold
+		}
+		if flat.StorageClasses != nil {
+			bui.StorageClassSize = make(map[string]uint64, len(flat.StorageClasses.Classes))
+			bui.StorageClassObjects = make(map[string]uint64, len(flat.StorageClasses.Classes))
+			for sc, st := range flat.StorageClasses.Classes {
+				bui.StorageClassSize[sc] = st.TotalSize
+				bui.StorageClassObjects[sc] = uint64(st.NumObjects)
+			}
 		}
 		if flat.ReplicationStats != nil {
 			bui.ReplicaSize = flat.ReplicationStats.ReplicaSize
@@ -8,6 +8,84 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// DecodeMsg implements msgp.Decodable
+func (z *ageHistogram) DecodeMsg(dc *msgp.Reader) (err error) {
+	var zb0001 uint32
+	zb0001, err = dc.ReadArrayHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	if zb0001 != uint32(dataUsageAgeLen) {
+		err = msgp.ArrayError{Wanted: uint32(dataUsageAgeLen), Got: zb0001}
+		return
+	}
+	for za0001 := range z {
+		z[za0001], err = dc.ReadUint64()
+		if err != nil {
+			err = msgp.WrapError(err, za0001)
+			return
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *ageHistogram) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteArrayHeader(uint32(dataUsageAgeLen))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for za0001 := range z {
+		err = en.WriteUint64(z[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, za0001)
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ageHistogram) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendArrayHeader(o, uint32(dataUsageAgeLen))
+	for za0001 := range z {
+		o = msgp.AppendUint64(o, z[za0001])
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ageHistogram) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadArrayHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	if zb0001 != uint32(dataUsageAgeLen) {
+		err = msgp.ArrayError{Wanted: uint32(dataUsageAgeLen), Got: zb0001}
+		return
+	}
+	for za0001 := range z {
+		z[za0001], bts, err = msgp.ReadUint64Bytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err, za0001)
+			return
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ageHistogram) Msgsize() (s int) {
+	s = msgp.ArrayHeaderSize + (dataUsageAgeLen * (msgp.Uint64Size))
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *allTierStats) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -1558,6 +1636,24 @@ func (z *dataUsageEntry) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "ag":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ObjAges")
+				return
+			}
+			if zb0004 != uint32(dataUsageAgeLen) {
+				err = msgp.ArrayError{Wanted: uint32(dataUsageAgeLen), Got: zb0004}
+				return
+			}
+			for za0003 := range z.ObjAges {
+				z.ObjAges[za0003], err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "ObjAges", za0003)
+					return
+				}
+			}
 		case "rs":
 			if dc.IsNil() {
 				err = dc.ReadNil()
@@ -1594,12 +1690,90 @@ func (z *dataUsageEntry) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "ol":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectLock")
+					return
+				}
+				z.ObjectLock = nil
+			} else {
+				if z.ObjectLock == nil {
+					z.ObjectLock = new(objectLockStats)
+				}
+				err = z.ObjectLock.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectLock")
+					return
+				}
+			}
 		case "c":
 			z.Compacted, err = dc.ReadBool()
 			if err != nil {
 				err = msgp.WrapError(err, "Compacted")
 				return
 			}
+		case "inl":
+			z.Inlined, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Inlined")
+				return
+			}
+		case "ninl":
+			z.NotInlined, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "NotInlined")
+				return
+			}
+		case "sses3":
+			z.SSES3, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "SSES3")
+				return
+			}
+		case "ssekms":
+			z.SSEKMS, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "SSEKMS")
+				return
+			}
+		case "ssec":
+			z.SSEC, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "SSEC")
+				return
+			}
+		case "cur":
+			z.Current, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Current")
+				return
+			}
+		case "ncur":
+			z.Noncurrent, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Noncurrent")
+				return
+			}
+		case "scs":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "StorageClasses")
+					return
+				}
+				z.StorageClasses = nil
+			} else {
+				if z.StorageClasses == nil {
+					z.StorageClasses = new(storageClassStats)
+				}
+				err = z.StorageClasses.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "StorageClasses")
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -1614,19 +1788,27 @@ func (z *dataUsageEntry) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 	// omitempty: check for empty values
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(19)
+	var zb0001Mask uint32 /* 19 bits */
 	_ = zb0001Mask
 	if z.ReplicationStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x40
+		zb0001Mask |= 0x80
 	}
 	if z.AllTierStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x80
+		zb0001Mask |= 0x100
+	}
+	if z.ObjectLock == nil {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if z.StorageClasses == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40000
 	}
 	// variable map header, size zb0001Len
-	err = en.Append(0x80 | uint8(zb0001Len))
+	err = en.WriteMapHeader(zb0001Len)
 	if err != nil {
 		return
 	}
@@ -1707,7 +1889,24 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x40) == 0 { // if not empty
+	// write "ag"
+	err = en.Append(0xa2, 0x61, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(dataUsageAgeLen))
+	if err != nil {
+		err = msgp.WrapError(err, "ObjAges")
+		return
+	}
+	for za0003 := range z.ObjAges {
+		err = en.WriteUint64(z.ObjAges[za0003])
+		if err != nil {
+			err = msgp.WrapError(err, "ObjAges", za0003)
+			return
+		}
+	}
+	if (zb0001Mask & 0x80) == 0 { // if not empty
 		// write "rs"
 		err = en.Append(0xa2, 0x72, 0x73)
 		if err != nil {
@@ -1726,7 +1925,7 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 			}
 		}
 	}
-	if (zb0001Mask & 0x80) == 0 { // if not empty
+	if (zb0001Mask & 0x100) == 0 { // if not empty
 		// write "ats"
 		err = en.Append(0xa3, 0x61, 0x74, 0x73)
 		if err != nil {
@@ -1745,6 +1944,25 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 			}
 		}
 	}
+	if (zb0001Mask & 0x200) == 0 { // if not empty
+		// write "ol"
+		err = en.Append(0xa2, 0x6f, 0x6c)
+		if err != nil {
+			return
+		}
+		if z.ObjectLock == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.ObjectLock.EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectLock")
+				return
+			}
+		}
+	}
 	// write "c"
 	err = en.Append(0xa1, 0x63)
 	if err != nil {
@@ -1755,6 +1973,95 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Compacted")
 		return
 	}
+	// write "inl"
+	err = en.Append(0xa3, 0x69, 0x6e, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Inlined)
+	if err != nil {
+		err = msgp.WrapError(err, "Inlined")
+		return
+	}
+	// write "ninl"
+	err = en.Append(0xa4, 0x6e, 0x69, 0x6e, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.NotInlined)
+	if err != nil {
+		err = msgp.WrapError(err, "NotInlined")
+		return
+	}
+	// write "sses3"
+	err = en.Append(0xa5, 0x73, 0x73, 0x65, 0x73, 0x33)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.SSES3)
+	if err != nil {
+		err = msgp.WrapError(err, "SSES3")
+		return
+	}
+	// write "ssekms"
+	err = en.Append(0xa6, 0x73, 0x73, 0x65, 0x6b, 0x6d, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.SSEKMS)
+	if err != nil {
+		err = msgp.WrapError(err, "SSEKMS")
+		return
+	}
+	// write "ssec"
+	err = en.Append(0xa4, 0x73, 0x73, 0x65, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.SSEC)
+	if err != nil {
+		err = msgp.WrapError(err, "SSEC")
+		return
+	}
+	// write "cur"
+	err = en.Append(0xa3, 0x63, 0x75, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Current)
+	if err != nil {
+		err = msgp.WrapError(err, "Current")
+		return
+	}
+	// write "ncur"
+	err = en.Append(0xa4, 0x6e, 0x63, 0x75, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Noncurrent)
+	if err != nil {
+		err = msgp.WrapError(err, "Noncurrent")
+		return
+	}
+	if (zb0001Mask & 0x40000) == 0 { // if not empty
+		// write "scs"
+		err = en.Append(0xa3, 0x73, 0x63, 0x73)
+		if err != nil {
+			return
+		}
+		if z.StorageClasses == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.StorageClasses.EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "StorageClasses")
+				return
+			}
+		}
+	}
 	return
 }
 
@@ -1762,19 +2069,27 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(19)
+	var zb0001Mask uint32 /* 19 bits */
 	_ = zb0001Mask
 	if z.ReplicationStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x40
+		zb0001Mask |= 0x80
 	}
 	if z.AllTierStats == nil {
 		zb0001Len--
-		zb0001Mask |= 0x80
+		zb0001Mask |= 0x100
+	}
+	if z.ObjectLock == nil {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if z.StorageClasses == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40000
 	}
 	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
+	o = msgp.AppendMapHeader(o, zb0001Len)
 	if zb0001Len == 0 {
 		return
 	}
@@ -1806,7 +2121,13 @@ func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 	for za0002 := range z.ObjVersions {
 		o = msgp.AppendUint64(o, z.ObjVersions[za0002])
 	}
-	if (zb0001Mask & 0x40) == 0 { // if not empty
+	// string "ag"
+	o = append(o, 0xa2, 0x61, 0x67)
+	o = msgp.AppendArrayHeader(o, uint32(dataUsageAgeLen))
+	for za0003 := range z.ObjAges {
+		o = msgp.AppendUint64(o, z.ObjAges[za0003])
+	}
+	if (zb0001Mask & 0x80) == 0 { // if not empty
 		// string "rs"
 		o = append(o, 0xa2, 0x72, 0x73)
 		if z.ReplicationStats == nil {
@@ -1819,7 +2140,7 @@ func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 			}
 		}
 	}
-	if (zb0001Mask & 0x80) == 0 { // if not empty
+	if (zb0001Mask & 0x100) == 0 { // if not empty
 		// string "ats"
 		o = append(o, 0xa3, 0x61, 0x74, 0x73)
 		if z.AllTierStats == nil {
@@ -1832,9 +2153,56 @@ func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 			}
 		}
 	}
+	if (zb0001Mask & 0x200) == 0 { // if not empty
+		// string "ol"
+		o = append(o, 0xa2, 0x6f, 0x6c)
+		if z.ObjectLock == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.ObjectLock.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectLock")
+				return
+			}
+		}
+	}
 	// string "c"
 	o = append(o, 0xa1, 0x63)
 	o = msgp.AppendBool(o, z.Compacted)
+	// string "inl"
+	o = append(o, 0xa3, 0x69, 0x6e, 0x6c)
+	o = msgp.AppendUint64(o, z.Inlined)
+	// string "ninl"
+	o = append(o, 0xa4, 0x6e, 0x69, 0x6e, 0x6c)
+	o = msgp.AppendUint64(o, z.NotInlined)
+	// string "sses3"
+	o = append(o, 0xa5, 0x73, 0x73, 0x65, 0x73, 0x33)
+	o = msgp.AppendUint64(o, z.SSES3)
+	// string "ssekms"
+	o = append(o, 0xa6, 0x73, 0x73, 0x65, 0x6b, 0x6d, 0x73)
+	o = msgp.AppendUint64(o, z.SSEKMS)
+	// string "ssec"
+	o = append(o, 0xa4, 0x73, 0x73, 0x65, 0x63)
+	o = msgp.AppendUint64(o, z.SSEC)
+	// string "cur"
+	o = append(o, 0xa3, 0x63, 0x75, 0x72)
+	o = msgp.AppendUint64(o, z.Current)
+	// string "ncur"
+	o = append(o, 0xa4, 0x6e, 0x63, 0x75, 0x72)
+	o = msgp.AppendUint64(o, z.Noncurrent)
+	if (zb0001Mask & 0x40000) == 0 { // if not empty
+		// string "scs"
+		o = append(o, 0xa3, 0x73, 0x63, 0x73)
+		if z.StorageClasses == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.StorageClasses.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "StorageClasses")
+				return
+			}
+		}
+	}
 	return
 }
 
@@ -1916,6 +2284,24 @@ func (z *dataUsageEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "ag":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjAges")
+				return
+			}
+			if zb0004 != uint32(dataUsageAgeLen) {
+				err = msgp.ArrayError{Wanted: uint32(dataUsageAgeLen), Got: zb0004}
+				return
+			}
+			for za0003 := range z.ObjAges {
+				z.ObjAges[za0003], bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ObjAges", za0003)
+					return
+				}
+			}
 		case "rs":
 			if msgp.IsNil(bts) {
 				bts, err = msgp.ReadNilBytes(bts)
@@ -1950,27 +2336,103 @@ func (z *dataUsageEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "ol":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.ObjectLock = nil
+			} else {
+				if z.ObjectLock == nil {
+					z.ObjectLock = new(objectLockStats)
+				}
+				bts, err = z.ObjectLock.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectLock")
+					return
+				}
+			}
 		case "c":
 			z.Compacted, bts, err = msgp.ReadBoolBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Compacted")
 				return
 			}
-		default:
-			bts, err = msgp.Skip(bts)
+		case "inl":
+			z.Inlined, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err)
+				err = msgp.WrapError(err, "Inlined")
 				return
 			}
-		}
-	}
-	o = bts
-	return
+		case "ninl":
+			z.NotInlined, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NotInlined")
+				return
+			}
+		case "sses3":
+			z.SSES3, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SSES3")
+				return
+			}
+		case "ssekms":
+			z.SSEKMS, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SSEKMS")
+				return
+			}
+		case "ssec":
+			z.SSEC, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SSEC")
+				return
+			}
+		case "cur":
+			z.Current, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Current")
+				return
+			}
+		case "ncur":
+			z.Noncurrent, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Noncurrent")
+				return
+			}
+		case "scs":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.StorageClasses = nil
+			} else {
+				if z.StorageClasses == nil {
+					z.StorageClasses = new(storageClassStats)
+				}
+				bts, err = z.StorageClasses.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "StorageClasses")
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *dataUsageEntry) Msgsize() (s int) {
-	s = 1 + 3 + z.Children.Msgsize() + 3 + msgp.Int64Size + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.ArrayHeaderSize + (dataUsageBucketLen * (msgp.Uint64Size)) + 3 + msgp.ArrayHeaderSize + (dataUsageVersionLen * (msgp.Uint64Size)) + 3
+	s = 3 + 3 + z.Children.Msgsize() + 3 + msgp.Int64Size + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.ArrayHeaderSize + (dataUsageBucketLen * (msgp.Uint64Size)) + 3 + msgp.ArrayHeaderSize + (dataUsageVersionLen * (msgp.Uint64Size)) + 3 + msgp.ArrayHeaderSize + (dataUsageAgeLen * (msgp.Uint64Size)) + 3
 	if z.ReplicationStats == nil {
 		s += msgp.NilSize
 	} else {
@@ -1982,7 +2444,18 @@ func (z *dataUsageEntry) Msgsize() (s int) {
 	} else {
 		s += z.AllTierStats.Msgsize()
 	}
-	s += 2 + msgp.BoolSize
+	s += 3
+	if z.ObjectLock == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.ObjectLock.Msgsize()
+	}
+	s += 2 + msgp.BoolSize + 4 + msgp.Uint64Size + 5 + msgp.Uint64Size + 6 + msgp.Uint64Size + 7 + msgp.Uint64Size + 5 + msgp.Uint64Size + 4 + msgp.Uint64Size + 5 + msgp.Uint64Size + 4
+	if z.StorageClasses == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.StorageClasses.Msgsize()
+	}
 	return
 }
 
@@ -2711,6 +3184,279 @@ func (z dataUsageHash) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *objectLockStats) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "nr":
+			z.NoRetention, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "NoRetention")
+				return
+			}
+		case "gv":
+			z.Governance, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Governance")
+				return
+			}
+		case "cp":
+			z.Compliance, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Compliance")
+				return
+			}
+		case "lh":
+			z.LegalHold, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "LegalHold")
+				return
+			}
+		case "ru":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "RetainUntil")
+				return
+			}
+			if z.RetainUntil == nil {
+				z.RetainUntil = make(map[string]uint64, zb0002)
+			} else if len(z.RetainUntil) > 0 {
+				for key := range z.RetainUntil {
+					delete(z.RetainUntil, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 uint64
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "RetainUntil")
+					return
+				}
+				za0002, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "RetainUntil", za0001)
+					return
+				}
+				z.RetainUntil[za0001] = za0002
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *objectLockStats) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "nr"
+	err = en.Append(0x85, 0xa2, 0x6e, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.NoRetention)
+	if err != nil {
+		err = msgp.WrapError(err, "NoRetention")
+		return
+	}
+	// write "gv"
+	err = en.Append(0xa2, 0x67, 0x76)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Governance)
+	if err != nil {
+		err = msgp.WrapError(err, "Governance")
+		return
+	}
+	// write "cp"
+	err = en.Append(0xa2, 0x63, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Compliance)
+	if err != nil {
+		err = msgp.WrapError(err, "Compliance")
+		return
+	}
+	// write "lh"
+	err = en.Append(0xa2, 0x6c, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.LegalHold)
+	if err != nil {
+		err = msgp.WrapError(err, "LegalHold")
+		return
+	}
+	// write "ru"
+	err = en.Append(0xa2, 0x72, 0x75)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.RetainUntil)))
+	if err != nil {
+		err = msgp.WrapError(err, "RetainUntil")
+		return
+	}
+	for za0001, za0002 := range z.RetainUntil {
+		err = en.WriteString(za0001)
+		if err != nil {
+			err = msgp.WrapError(err, "RetainUntil")
+			return
+		}
+		err = en.WriteUint64(za0002)
+		if err != nil {
+			err = msgp.WrapError(err, "RetainUntil", za0001)
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *objectLockStats) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "nr"
+	o = append(o, 0x85, 0xa2, 0x6e, 0x72)
+	o = msgp.AppendUint64(o, z.NoRetention)
+	// string "gv"
+	o = append(o, 0xa2, 0x67, 0x76)
+	o = msgp.AppendUint64(o, z.Governance)
+	// string "cp"
+	o = append(o, 0xa2, 0x63, 0x70)
+	o = msgp.AppendUint64(o, z.Compliance)
+	// string "lh"
+	o = append(o, 0xa2, 0x6c, 0x68)
+	o = msgp.AppendUint64(o, z.LegalHold)
+	// string "ru"
+	o = append(o, 0xa2, 0x72, 0x75)
+	o = msgp.AppendMapHeader(o, uint32(len(z.RetainUntil)))
+	for za0001, za0002 := range z.RetainUntil {
+		o = msgp.AppendString(o, za0001)
+		o = msgp.AppendUint64(o, za0002)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *objectLockStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "nr":
+			z.NoRetention, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NoRetention")
+				return
+			}
+		case "gv":
+			z.Governance, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Governance")
+				return
+			}
+		case "cp":
+			z.Compliance, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Compliance")
+				return
+			}
+		case "lh":
+			z.LegalHold, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LegalHold")
+				return
+			}
+		case "ru":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RetainUntil")
+				return
+			}
+			if z.RetainUntil == nil {
+				z.RetainUntil = make(map[string]uint64, zb0002)
+			} else if len(z.RetainUntil) > 0 {
+				for key := range z.RetainUntil {
+					delete(z.RetainUntil, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 uint64
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "RetainUntil")
+					return
+				}
+				za0002, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "RetainUntil", za0001)
+					return
+				}
+				z.RetainUntil[za0001] = za0002
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *objectLockStats) Msgsize() (s int) {
+	s = 1 + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 3 + msgp.MapHeaderSize
+	if z.RetainUntil != nil {
+		for za0001, za0002 := range z.RetainUntil {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.Uint64Size
+		}
+	}
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *replicationAllStats) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -3611,6 +4357,284 @@ func (z *sizeHistogram) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *storageClassStats) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "cl":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Classes")
+				return
+			}
+			if z.Classes == nil {
+				z.Classes = make(map[string]tierStats, zb0002)
+			} else if len(z.Classes) > 0 {
+				for key := range z.Classes {
+					delete(z.Classes, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 tierStats
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Classes")
+					return
+				}
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Classes", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "Classes", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ts":
+						za0002.TotalSize, err = dc.ReadUint64()
+						if err != nil {
+							err = msgp.WrapError(err, "Classes", za0001, "TotalSize")
+							return
+						}
+					case "nv":
+						za0002.NumVersions, err = dc.ReadInt()
+						if err != nil {
+							err = msgp.WrapError(err, "Classes", za0001, "NumVersions")
+							return
+						}
+					case "no":
+						za0002.NumObjects, err = dc.ReadInt()
+						if err != nil {
+							err = msgp.WrapError(err, "Classes", za0001, "NumObjects")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Classes", za0001)
+							return
+						}
+					}
+				}
+				z.Classes[za0001] = za0002
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *storageClassStats) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 1
+	// write "cl"
+	err = en.Append(0x81, 0xa2, 0x63, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.Classes)))
+	if err != nil {
+		err = msgp.WrapError(err, "Classes")
+		return
+	}
+	for za0001, za0002 := range z.Classes {
+		err = en.WriteString(za0001)
+		if err != nil {
+			err = msgp.WrapError(err, "Classes")
+			return
+		}
+		// map header, size 3
+		// write "ts"
+		err = en.Append(0x83, 0xa2, 0x74, 0x73)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(za0002.TotalSize)
+		if err != nil {
+			err = msgp.WrapError(err, "Classes", za0001, "TotalSize")
+			return
+		}
+		// write "nv"
+		err = en.Append(0xa2, 0x6e, 0x76)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(za0002.NumVersions)
+		if err != nil {
+			err = msgp.WrapError(err, "Classes", za0001, "NumVersions")
+			return
+		}
+		// write "no"
+		err = en.Append(0xa2, 0x6e, 0x6f)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(za0002.NumObjects)
+		if err != nil {
+			err = msgp.WrapError(err, "Classes", za0001, "NumObjects")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *storageClassStats) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "cl"
+	o = append(o, 0x81, 0xa2, 0x63, 0x6c)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Classes)))
+	for za0001, za0002 := range z.Classes {
+		o = msgp.AppendString(o, za0001)
+		// map header, size 3
+		// string "ts"
+		o = append(o, 0x83, 0xa2, 0x74, 0x73)
+		o = msgp.AppendUint64(o, za0002.TotalSize)
+		// string "nv"
+		o = append(o, 0xa2, 0x6e, 0x76)
+		o = msgp.AppendInt(o, za0002.NumVersions)
+		// string "no"
+		o = append(o, 0xa2, 0x6e, 0x6f)
+		o = msgp.AppendInt(o, za0002.NumObjects)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *storageClassStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "cl":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Classes")
+				return
+			}
+			if z.Classes == nil {
+				z.Classes = make(map[string]tierStats, zb0002)
+			} else if len(z.Classes) > 0 {
+				for key := range z.Classes {
+					delete(z.Classes, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 tierStats
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Classes")
+					return
+				}
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Classes", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Classes", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ts":
+						za0002.TotalSize, bts, err = msgp.ReadUint64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Classes", za0001, "TotalSize")
+							return
+						}
+					case "nv":
+						za0002.NumVersions, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Classes", za0001, "NumVersions")
+							return
+						}
+					case "no":
+						za0002.NumObjects, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Classes", za0001, "NumObjects")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Classes", za0001)
+							return
+						}
+					}
+				}
+				z.Classes[za0001] = za0002
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *storageClassStats) Msgsize() (s int) {
+	s = 1 + 3 + msgp.MapHeaderSize
+	if z.Classes != nil {
+		for za0001, za0002 := range z.Classes {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + 1 + 3 + msgp.Uint64Size + 3 + msgp.IntSize + 3 + msgp.IntSize
+		}
+	}
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *tierStats) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
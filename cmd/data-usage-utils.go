@@ -61,9 +61,18 @@ type BucketUsageInfo struct {
 	ObjectsCount            uint64                           `json:"objectsCount"`
 	ObjectSizesHistogram    map[string]uint64                `json:"objectsSizesHistogram"`
 	ObjectVersionsHistogram map[string]uint64                `json:"objectsVersionsHistogram"`
+	ObjectAgesHistogram     map[string]uint64                `json:"objectsAgesHistogram,omitempty"`
 	VersionsCount           uint64                           `json:"versionsCount"`
 	ReplicaSize             uint64                           `json:"objectReplicaTotalSize"`
 	ReplicationInfo         map[string]BucketTargetUsageInfo `json:"objectsReplicationInfo"`
+	InlinedCount            uint64                           `json:"inlinedCount"`
+	NotInlinedCount         uint64                           `json:"notInlinedCount"`
+	EncryptedCount          map[string]uint64                `json:"encryptedCount"` // Objects by SSE type.
+	CurrentVersionsCount    uint64                           `json:"currentVersionsCount"`
+	NoncurrentVersionsCount uint64                           `json:"noncurrentVersionsCount"`
+	LegalHoldCount          uint64                           `json:"legalHoldCount"`
+	StorageClassSize        map[string]uint64                `json:"storageClassSize,omitempty"`    // Size of versions by storage class.
+	StorageClassObjects     map[string]uint64                `json:"storageClassObjects,omitempty"` // Objects by storage class.
 }
 
 // DataUsageInfo represents data usage stats of the underlying Object API
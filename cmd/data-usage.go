@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/minio/minio/internal/logger"
@@ -91,6 +92,85 @@ func loadPrefixUsageFromBackend(ctx context.Context, objAPI ObjectLayer, bucket
 	return m, nil
 }
 
+// loadObjectLockStatsFromBackend returns the object lock stats of a bucket
+// aggregated across all erasure sets, along with the time of the oldest
+// set scan contributing to them.
+func loadObjectLockStatsFromBackend(ctx context.Context, objAPI ObjectLayer, bucket string) (*objectLockStats, time.Time, error) {
+	stats := newObjectLockStats()
+
+	z, ok := objAPI.(*erasureServerPools)
+	if !ok {
+		return stats, time.Time{}, nil
+	}
+
+	var lastUpdate time.Time
+	cache := dataUsageCache{}
+	for _, pool := range z.serverPools {
+		for _, er := range pool.sets {
+			if err := cache.load(ctx, er, bucket+slashSeparator+dataUsageCacheName); err != nil {
+				return nil, time.Time{}, err
+			}
+			root := cache.find(bucket)
+			if root == nil {
+				// We dont have usage information for this bucket in this
+				// set, go to the next set
+				continue
+			}
+			if lastUpdate.IsZero() || cache.Info.LastUpdate.Before(lastUpdate) {
+				lastUpdate = cache.Info.LastUpdate
+			}
+			if flat := cache.flatten(*root); flat.ObjectLock != nil {
+				stats.merge(flat.ObjectLock)
+			}
+		}
+	}
+
+	return stats, lastUpdate, nil
+}
+
+// deleteDataUsageCaches removes the data usage caches of all erasure sets,
+// so the next scanner cycle rebuilds them from scratch.
+// The aggregated usage is kept until the new cycle replaces it.
+func deleteDataUsageCaches(ctx context.Context, objAPI ObjectLayer) error {
+	z, ok := objAPI.(*erasureServerPools)
+	if !ok {
+		return nil
+	}
+
+	buckets, err := z.ListBuckets(ctx, BucketOptions{})
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(buckets)+1)
+	names = append(names, dataUsageCacheName)
+	for _, bucket := range buckets {
+		names = append(names, pathJoin(bucket.Name, dataUsageCacheName))
+	}
+
+	for _, pool := range z.serverPools {
+		for _, er := range pool.sets {
+			for _, name := range names {
+				err := deleteConfig(ctx, er, pathJoin(bucketMetaPrefix, name))
+				if err != nil && !errors.Is(err, errConfigNotFound) {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// loadErasureSetUsage returns the usage of an erasure set, as of the
+// last scanner cycle.
+func loadErasureSetUsage(ctx context.Context, set *erasureObjects) (DataUsageInfo, error) {
+	cache := dataUsageCache{}
+	if err := cache.load(ctx, set, dataUsageCacheName); err != nil {
+		return DataUsageInfo{}, err
+	}
+	return cache.dui(dataUsageRoot, nil), nil
+}
+
 func loadDataUsageFromBackend(ctx context.Context, objAPI ObjectLayer) (DataUsageInfo, error) {
 	buf, err := readConfig(ctx, objAPI, dataUsageObjNamePath)
 	if err != nil {
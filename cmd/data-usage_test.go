@@ -26,6 +26,9 @@ import (
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
+
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 )
 
 type usageTestFile struct {
@@ -578,6 +581,27 @@ func TestDataUsageCacheSerialize(t *testing.T) {
 			},
 		},
 	}
+	e.ObjAges = ageHistogram{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	e.ObjectLock = &objectLockStats{
+		NoRetention: 1,
+		Governance:  2,
+		Compliance:  3,
+		LegalHold:   4,
+		RetainUntil: map[string]uint64{"2027-01": 5},
+	}
+	e.Inlined = 6
+	e.NotInlined = 7
+	e.SSES3 = 8
+	e.SSEKMS = 9
+	e.SSEC = 10
+	e.Current = 11
+	e.Noncurrent = 12
+	e.StorageClasses = &storageClassStats{
+		Classes: map[string]tierStats{
+			"STANDARD":           {TotalSize: 13, NumVersions: 2, NumObjects: 1},
+			"REDUCED_REDUNDANCY": {TotalSize: 14, NumVersions: 1, NumObjects: 1},
+		},
+	}
 	want.replace("abucket/dir2", "", *e)
 	var buf bytes.Buffer
 	err = want.serializeTo(&buf)
@@ -620,3 +644,224 @@ func equalAsJSON(a, b interface{}) bool {
 	}
 	return bytes.Equal(aj, bj)
 }
+
+func TestObjectLockStats(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+	retention := func(mode objectlock.RetMode, until time.Time) map[string]string {
+		return map[string]string{
+			objectlock.AmzObjectLockMode:            string(mode),
+			objectlock.AmzObjectLockRetainUntilDate: until.Format(time.RFC3339),
+		}
+	}
+
+	versions := []map[string]string{
+		{},
+		retention(objectlock.RetGovernance, now.AddDate(0, 0, 1)),
+		retention(objectlock.RetCompliance, now.AddDate(1, 0, 0)),
+		retention(objectlock.RetCompliance, now.AddDate(1, 0, 5)),
+		// Expired retention is not in effect anymore.
+		retention(objectlock.RetCompliance, now.AddDate(0, 0, -1)),
+		{objectlock.AmzObjectLockLegalHold: string(objectlock.LegalHoldOn)},
+		{objectlock.AmzObjectLockLegalHold: string(objectlock.LegalHoldOff)},
+	}
+
+	var e dataUsageEntry
+	for i, meta := range versions {
+		summary := sizeSummary{objLock: newObjectLockStats()}
+		summary.objLock.add(meta, now)
+		if i%2 == 0 {
+			e.addSizes(summary)
+			continue
+		}
+		e.merge(dataUsageEntry{ObjectLock: summary.objLock})
+	}
+
+	want := &objectLockStats{
+		NoRetention: 4,
+		Governance:  1,
+		Compliance:  2,
+		LegalHold:   1,
+		RetainUntil: map[string]uint64{"2026-03": 1, "2027-03": 2},
+	}
+	if !equalAsJSON(e.ObjectLock, want) {
+		t.Fatalf("object lock stats mismatch\nwant: %+v\ngot:  %+v", want, e.ObjectLock)
+	}
+
+	// Clones must not share the histogram.
+	c := e.clone()
+	c.ObjectLock.RetainUntil["2026-03"]++
+	if e.ObjectLock.RetainUntil["2026-03"] != 1 {
+		t.Fatal("clone shares object lock stats with the original entry")
+	}
+
+	// Buckets without object lock enabled keep no stats.
+	var none dataUsageEntry
+	none.addSizes(sizeSummary{totalSize: 10, versions: 1})
+	if none.ObjectLock != nil {
+		t.Fatalf("expected no object lock stats, got %+v", none.ObjectLock)
+	}
+}
+
+func TestBucketUsageLegalHold(t *testing.T) {
+	var d dataUsageCache
+	d.replace(dataUsageRoot, "", dataUsageEntry{})
+	d.replace("bucket", dataUsageRoot, dataUsageEntry{Versions: 3, ObjectLock: &objectLockStats{NoRetention: 3, LegalHold: 2}})
+	d.replace("bucket/prefix", "bucket", dataUsageEntry{Versions: 2, ObjectLock: &objectLockStats{NoRetention: 2, LegalHold: 1}})
+	d.replace("other", dataUsageRoot, dataUsageEntry{Versions: 1})
+
+	bui := d.bucketsUsageInfo([]BucketInfo{{Name: "bucket"}, {Name: "other"}})
+	if got := bui["bucket"].LegalHoldCount; got != 3 {
+		t.Errorf("expected 3 versions under legal hold, got %d", got)
+	}
+	// Buckets without object lock enabled keep no stats.
+	if got := bui["other"].LegalHoldCount; got != 0 {
+		t.Errorf("expected no versions under legal hold, got %d", got)
+	}
+}
+
+func TestStorageClassStats(t *testing.T) {
+	versions := []ObjectInfo{
+		{StorageClass: "STANDARD", Size: 10, IsLatest: true},
+		{StorageClass: "STANDARD", Size: 20},
+		{StorageClass: "REDUCED_REDUNDANCY", Size: 5, IsLatest: true},
+	}
+
+	var e dataUsageEntry
+	for i, oi := range versions {
+		summary := sizeSummary{storageClasses: map[string]tierStats{oi.StorageClass: oi.tierStats()}}
+		if i%2 == 0 {
+			e.addSizes(summary)
+			continue
+		}
+		s := newStorageClassStats()
+		s.addSizes(summary)
+		e.merge(dataUsageEntry{StorageClasses: s})
+	}
+
+	want := map[string]tierStats{
+		"STANDARD":           {TotalSize: 30, NumVersions: 2, NumObjects: 1},
+		"REDUCED_REDUNDANCY": {TotalSize: 5, NumVersions: 1, NumObjects: 1},
+	}
+	if !equalAsJSON(e.StorageClasses.Classes, want) {
+		t.Fatalf("storage class stats mismatch\nwant: %+v\ngot:  %+v", want, e.StorageClasses.Classes)
+	}
+
+	// Clones must not share the stats.
+	c := e.clone()
+	c.StorageClasses.Classes["STANDARD"] = tierStats{}
+	if e.StorageClasses.Classes["STANDARD"].TotalSize != 30 {
+		t.Fatal("clone shares storage class stats with the original entry")
+	}
+}
+
+func TestAgeHistogram(t *testing.T) {
+	const day = 24 * time.Hour
+	testCases := []struct {
+		age  time.Duration
+		want string
+	}{
+		{0, "LESS_THAN_1_DAY"},
+		{day - time.Second, "LESS_THAN_1_DAY"},
+		{day, "BETWEEN_1_AND_7_DAYS"},
+		{7 * day, "BETWEEN_7_AND_30_DAYS"},
+		{89 * day, "BETWEEN_30_AND_90_DAYS"},
+		{364 * day, "BETWEEN_180_AND_365_DAYS"},
+		{365 * day, "BETWEEN_1_AND_2_YEARS"},
+		{1825 * day, "GREATER_THAN_5_YEARS"},
+	}
+	for i, testCase := range testCases {
+		var h ageHistogram
+		h.add(testCase.age)
+		for name, count := range h.toMap() {
+			if want := name == testCase.want; want != (count == 1) {
+				t.Errorf("Test %d: age %v, unexpected count %d for %s", i+1, testCase.age, count, name)
+			}
+		}
+	}
+
+	// Objects whose latest version is deleted are not counted.
+	var e dataUsageEntry
+	e.addSizes(sizeSummary{totalSize: 10})
+	e.addSizes(sizeSummary{totalSize: 10, modTime: UTCNow().Add(-10 * day)})
+	if got := e.ObjAges.toMap()["BETWEEN_7_AND_30_DAYS"]; got != 1 {
+		t.Fatalf("expected 1 object between 7 and 30 days, got %d", got)
+	}
+	var total uint64
+	for _, count := range e.ObjAges {
+		total += count
+	}
+	if total != 1 {
+		t.Fatalf("expected 1 object in the age histogram, got %d", total)
+	}
+}
+
+func TestDataUsageRebuildState(t *testing.T) {
+	s := &dataUsageRebuildState{wakeup: make(chan struct{}, 1)}
+
+	ctx, rebuild := s.startCycle(context.Background())
+	if rebuild {
+		t.Fatal("unexpected rebuild without request")
+	}
+
+	// A request aborts the running cycle and wakes up the scanner.
+	s.request()
+	s.request()
+	if ctx.Err() == nil {
+		t.Fatal("running cycle was not canceled")
+	}
+	s.endCycle()
+	select {
+	case <-s.wakeup:
+	default:
+		t.Fatal("scanner was not woken up")
+	}
+
+	ctx, rebuild = s.startCycle(context.Background())
+	if !rebuild {
+		t.Fatal("expected rebuild after request")
+	}
+	if ctx.Err() != nil {
+		t.Fatal("new cycle canceled")
+	}
+	s.endCycle()
+
+	if _, rebuild = s.startCycle(context.Background()); rebuild {
+		t.Fatal("request was not cleared")
+	}
+	s.endCycle()
+
+	// Stale requests are dropped.
+	s.request()
+	s.reset()
+	select {
+	case <-s.wakeup:
+		t.Fatal("stale wakeup was not drained")
+	default:
+	}
+	if _, rebuild = s.startCycle(context.Background()); rebuild {
+		t.Fatal("stale request was not dropped")
+	}
+	s.endCycle()
+}
+
+func TestScannerCycleObjects(t *testing.T) {
+	var m scannerMetrics
+	m.addCycleObjects(1, 10, 5)
+	m.addCycleObjects(1, 2, 0)
+	m.addCycleObjects(2, 3, 20)
+	// Late results of the previous cycle.
+	m.addCycleObjects(1, 1, 1)
+	// Results older than the previous cycle are dropped.
+	m.addCycleObjects(0, 100, 100)
+
+	current, last := m.getCycleObjects()
+	if want := (scannerCycleObjects{cycle: 2, scanned: 3, skipped: 20}); current != want {
+		t.Errorf("current cycle: want %+v, got %+v", want, current)
+	}
+	if want := (scannerCycleObjects{cycle: 1, scanned: 13, skipped: 6}); last != want {
+		t.Errorf("last cycle: want %+v, got %+v", want, last)
+	}
+	if got := m.lifetimeSkipped(); got != 126 {
+		t.Errorf("want 126 skipped objects, got %d", got)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Maximum number of corrupt versions listed in a bitrot scan report.
+const bitrotScanMaxCorrupt = 10000
+
+// BitrotScanCorrupt is a version of an object with corrupt data on some
+// drives.
+type BitrotScanCorrupt struct {
+	Bucket    string   `json:"bucket"`
+	Object    string   `json:"object"`
+	VersionID string   `json:"versionId,omitempty"`
+	Drives    []string `json:"drives"`
+}
+
+// BitrotScanReport is the result of a bitrot scan of an erasure set.
+type BitrotScanReport struct {
+	Pool     int                 `json:"pool"`
+	Set      int                 `json:"set"`
+	Started  time.Time           `json:"started"`
+	Finished time.Time           `json:"finished"`
+	Buckets  int                 `json:"buckets"`
+	Versions uint64              `json:"versions"`
+	Corrupt  []BitrotScanCorrupt `json:"corrupt"`
+	// Truncated is set if more corrupt versions were found than listed.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+func (r *BitrotScanReport) addCorrupt(c BitrotScanCorrupt) {
+	if len(r.Corrupt) >= bitrotScanMaxCorrupt {
+		r.Truncated = true
+		return
+	}
+	r.Corrupt = append(r.Corrupt, c)
+}
+
+// BitrotScanSet verifies the data of all the objects of an erasure set
+// against their bitrot checksums, and reports the corrupt ones without
+// healing them.
+func (z *erasureServerPools) BitrotScanSet(ctx context.Context, poolIdx, setIdx int) (BitrotScanReport, error) {
+	if poolIdx < 0 || poolIdx >= len(z.serverPools) || setIdx < 0 || setIdx >= len(z.serverPools[poolIdx].sets) {
+		return BitrotScanReport{}, errInvalidArgument
+	}
+	set := z.serverPools[poolIdx].sets[setIdx]
+
+	buckets, err := z.ListBuckets(ctx, BucketOptions{})
+	if err != nil {
+		return BitrotScanReport{}, err
+	}
+
+	report := BitrotScanReport{
+		Pool:    poolIdx,
+		Set:     setIdx,
+		Started: UTCNow(),
+		Buckets: len(buckets),
+		Corrupt: []BitrotScanCorrupt{},
+	}
+	for _, bucket := range buckets {
+		if err = set.bitrotScanBucket(ctx, bucket.Name, &report); err != nil {
+			return BitrotScanReport{}, err
+		}
+	}
+	report.Finished = UTCNow()
+	return report, nil
+}
+
+// bitrotScanBucket verifies the data of all the objects of a bucket
+// stored on this set.
+func (er erasureObjects) bitrotScanBucket(ctx context.Context, bucket string, report *BitrotScanReport) error {
+	disks := er.getOnlineDisks()
+	if len(disks) == 0 {
+		return fmt.Errorf("no online drives found for set with endpoints %s", er.getEndpoints())
+	}
+
+	// How to resolve partial results.
+	resolver := metadataResolutionParams{
+		dirQuorum: len(disks) / 2, // make sure to capture all quorum ratios
+		objQuorum: len(disks) / 2, // make sure to capture all quorum ratios
+		bucket:    bucket,
+	}
+
+	scanEntry := func(entry metaCacheEntry) {
+		if !entry.isObject() {
+			return
+		}
+		fivs, err := entry.fileInfoVersions(bucket)
+		if err != nil {
+			return
+		}
+		for _, version := range fivs.Versions {
+			if version.Deleted || version.IsRemote() {
+				continue
+			}
+			report.Versions++
+			if drives := er.bitrotScanVersion(ctx, bucket, version.Name, version.VersionID); len(drives) > 0 {
+				report.addCorrupt(BitrotScanCorrupt{
+					Bucket:    bucket,
+					Object:    decodeDirObject(version.Name),
+					VersionID: version.VersionID,
+					Drives:    drives,
+				})
+			}
+		}
+	}
+
+	err := listPathRaw(ctx, listPathRawOptions{
+		disks:          disks,
+		bucket:         bucket,
+		recursive:      true,
+		minDisks:       len(disks) / 2, // to capture all quorum ratios
+		reportNotFound: false,
+		agreed:         scanEntry,
+		partial: func(entries metaCacheEntries, _ []error) {
+			entry, ok := entries.resolve(&resolver)
+			if ok {
+				scanEntry(*entry)
+			}
+		},
+	})
+	if err != nil && !errors.Is(err, errVolumeNotFound) {
+		return err
+	}
+	return ctx.Err()
+}
+
+// bitrotScanVersion returns the endpoints of the drives holding corrupt
+// data of a version of an object.
+func (er erasureObjects) bitrotScanVersion(ctx context.Context, bucket, object, versionID string) (drives []string) {
+	disks := er.getDisks()
+	endpoints := er.getEndpoints()
+	metaArr, errs := readAllFileInfo(ctx, disks, bucket, object, versionID, true)
+	for i, meta := range metaArr {
+		if errs[i] != nil || !meta.IsValid() || meta.Deleted || meta.IsRemote() || len(meta.Parts) == 0 {
+			continue
+		}
+		var err error
+		if len(meta.Data) > 0 || meta.Size == 0 {
+			// Inline data is verified against the checksum of its
+			// only part.
+			checksumInfo := meta.Erasure.GetChecksumInfo(meta.Parts[0].Number)
+			err = bitrotVerify(bytes.NewReader(meta.Data),
+				int64(len(meta.Data)),
+				meta.Erasure.ShardFileSize(meta.Size),
+				checksumInfo.Algorithm,
+				checksumInfo.Hash, meta.Erasure.ShardSize())
+		} else {
+			err = disks[i].VerifyFile(ctx, bucket, object, meta)
+		}
+		if errors.Is(err, errFileCorrupt) {
+			drives = append(drives, endpoints[i].String())
+		}
+	}
+	return drives
+}
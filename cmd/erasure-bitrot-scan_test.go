@@ -0,0 +1,91 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestBitrotScanSet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	z := obj.(*erasureServerPools)
+	data := bytes.Repeat([]byte("a"), 1024*1024)
+	for _, object := range []string{"corrupt", "intact", "small"} {
+		if object == "small" {
+			data = data[:100]
+		}
+		if _, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	set := z.serverPools[0].getHashedSet("corrupt")
+	if _, err = z.BitrotScanSet(ctx, 0, len(z.serverPools[0].sets)); err != errInvalidArgument {
+		t.Fatalf("expected errInvalidArgument for an unknown set, got %v", err)
+	}
+
+	// Flip a byte of the data of the object on the first drive.
+	disk := set.getDisks()[0]
+	fi, err := disk.ReadVersion(ctx, bucket, "corrupt", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	partPath := pathJoin("corrupt", fi.DataDir, "part.1")
+	part, err := disk.ReadAll(ctx, bucket, partPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part[len(part)-1] ^= 0xff
+	if err = disk.WriteAll(ctx, bucket, partPath, part); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := z.BitrotScanSet(ctx, set.poolIndex, set.setIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Buckets != 1 || report.Versions != 3 {
+		t.Fatalf("expected 3 versions of 1 bucket scanned, got %+v", report)
+	}
+	if len(report.Corrupt) != 1 || report.Corrupt[0].Object != "corrupt" || len(report.Corrupt[0].Drives) != 1 ||
+		report.Corrupt[0].Drives[0] != set.getEndpoints()[0].String() {
+		t.Fatalf("expected the object to be corrupt on the first drive, got %+v", report.Corrupt)
+	}
+
+	// The scan does not heal.
+	got, err := disk.ReadAll(ctx, bucket, partPath)
+	if err != nil || !bytes.Equal(got, part) {
+		t.Fatalf("expected the corrupt data to be left as is, got %v", err)
+	}
+}
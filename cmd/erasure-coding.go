@@ -118,6 +118,17 @@ func (e *Erasure) DecodeDataAndParityBlocks(ctx context.Context, data [][]byte)
 	return nil
 }
 
+// DecodeRequiredBlocks decodes only the blocks marked as required,
+// other missing blocks are left empty.
+// It returns an error if the decoding failed.
+func (e *Erasure) DecodeRequiredBlocks(ctx context.Context, data [][]byte, required []bool) error {
+	if err := e.encoder().ReconstructSome(data, required); err != nil {
+		logger.LogIf(ctx, err)
+		return err
+	}
+	return nil
+}
+
 // ShardSize - returns actual shared size from erasure blockSize.
 func (e *Erasure) ShardSize() int64 {
 	return ceilFrac(e.blockSize, int64(e.dataBlocks))
@@ -298,7 +298,12 @@ func (e Erasure) Heal(ctx context.Context, writers []io.Writer, readers []io.Rea
 	}
 
 	var bufs [][]byte
+	required := make([]bool, len(writers))
 	for block := startBlock; block < endBlock; block++ {
+		for i := range writers {
+			required[i] = writers[i] != nil
+		}
+
 		var err error
 		bufs, err = reader.Read(bufs)
 		if len(bufs) > 0 {
@@ -311,8 +316,9 @@ func (e Erasure) Heal(ctx context.Context, writers []io.Writer, readers []io.Rea
 			return err
 		}
 
-		if err = e.DecodeDataAndParityBlocks(ctx, bufs); err != nil {
-			logger.LogIf(ctx, err)
+		// Only reconstruct the blocks written, parity blocks
+		// of healthy drives need not be recomputed.
+		if err = e.DecodeRequiredBlocks(ctx, bufs, required); err != nil {
 			return err
 		}
 
@@ -330,3 +336,24 @@ func (e Erasure) Heal(ctx context.Context, writers []io.Writer, readers []io.Rea
 
 	return derr
 }
+
+// HealParity reconstructs and writes the parity shards with a writer from
+// the data shards alone, without reading parity or reconstructing data.
+// It is only valid when no data shard has a writer, and fails with
+// errErasureReadQuorum as soon as a data shard cannot be read, healing
+// then needs to fall back to Heal.
+func (e Erasure) HealParity(ctx context.Context, writers []io.Writer, readers []io.ReaderAt, totalLength int64) error {
+	if len(writers) != e.parityBlocks+e.dataBlocks || len(readers) != len(writers) {
+		return errInvalidArgument
+	}
+	for i := 0; i < e.dataBlocks; i++ {
+		if writers[i] != nil || readers[i] == nil {
+			return errInvalidArgument
+		}
+	}
+
+	// Do not fall back to parity reads on a failed data read.
+	dataReaders := make([]io.ReaderAt, len(readers))
+	copy(dataReaders, readers[:e.dataBlocks])
+	return e.Heal(ctx, writers, dataReaders, totalLength)
+}
@@ -27,3 +27,6 @@ var errErasureWriteQuorum = errors.New("Write failed. Insufficient number of dri
 
 // errNoHealRequired - returned when healing is attempted on a previously healed disks.
 var errNoHealRequired = errors.New("No healing is required")
+
+// errHealObjectChanged - returned when an object changed before its healed shards were switched in.
+var errHealObjectChanged = errors.New("Heal aborted. The object changed while being healed")
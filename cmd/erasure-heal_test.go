@@ -155,3 +155,68 @@ func TestErasureHeal(t *testing.T) {
 		}
 	}
 }
+
+func TestErasureHealParity(t *testing.T) {
+	const dataBlocks, parityBlocks, size = 4, 4, oneMiByte
+	setup, err := newErasureTestSetup(t, dataBlocks, parityBlocks, blockSizeV2)
+	if err != nil {
+		t.Fatalf("failed to setup Erasure environment: %v", err)
+	}
+	disks := setup.disks
+	erasure, err := NewErasure(context.Background(), dataBlocks, parityBlocks, blockSizeV2)
+	if err != nil {
+		t.Fatalf("failed to create ErasureStorage: %v", err)
+	}
+	data := make([]byte, size)
+	if _, err = io.ReadFull(rand.Reader, data); err != nil {
+		t.Fatalf("failed to create random test data: %v", err)
+	}
+	writers := make([]io.Writer, len(disks))
+	for i, disk := range disks {
+		writers[i] = newBitrotWriter(disk, "testbucket", "testobject", erasure.ShardFileSize(size), DefaultBitrotAlgorithm, erasure.ShardSize())
+	}
+	_, err = erasure.Encode(context.Background(), bytes.NewReader(data), writers, make([]byte, blockSizeV2), erasure.dataBlocks+1)
+	closeBitrotWriters(writers)
+	if err != nil {
+		t.Fatalf("failed to encode test data: %v", err)
+	}
+
+	heal := func(staleDisks []int, bad int) ([]io.Writer, error) {
+		readers := make([]io.ReaderAt, len(disks))
+		for i, disk := range disks {
+			readers[i] = newBitrotReader(disk, nil, "testbucket", "testobject", erasure.ShardFileSize(size), DefaultBitrotAlgorithm, bitrotWriterSum(writers[i]), erasure.ShardSize())
+		}
+		if bad >= 0 {
+			readers[bad].(*streamingBitrotReader).disk = badDisk{nil}
+		}
+		staleWriters := make([]io.Writer, len(disks))
+		for _, i := range staleDisks {
+			readers[i] = nil
+			os.Remove(pathJoin(disks[i].String(), "testbucket", "testobject"))
+			staleWriters[i] = newBitrotWriter(disks[i], "testbucket", "testobject", erasure.ShardFileSize(size), DefaultBitrotAlgorithm, erasure.ShardSize())
+		}
+		err := erasure.HealParity(context.Background(), staleWriters, readers, size)
+		closeBitrotReaders(readers)
+		closeBitrotWriters(staleWriters)
+		return staleWriters, err
+	}
+
+	staleWriters, err := heal([]int{5, 7}, -1)
+	if err != nil {
+		t.Fatalf("parity heal failed: %v", err)
+	}
+	for _, i := range []int{5, 7} {
+		if !bytes.Equal(bitrotWriterSum(staleWriters[i]), bitrotWriterSum(writers[i])) {
+			t.Errorf("drive %d: parity heal returned different bitrot checksums", i)
+		}
+	}
+
+	// Parity is never used to heal other parity.
+	if _, err = heal([]int{6}, 1); err != errErasureReadQuorum {
+		t.Errorf("expected %v with a bad data drive, got %v", errErasureReadQuorum, err)
+	}
+	// Data shards are never healed.
+	if _, err = heal([]int{2}, -1); err != errInvalidArgument {
+		t.Errorf("expected %v with a stale data drive, got %v", errInvalidArgument, err)
+	}
+}
@@ -317,18 +317,50 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 		DiskCount: len(storageDisks),
 	}
 
+	// Quarantined objects are preserved as they are.
+	if globalQuarantineSys.skip(ctx, quarantineSkipHeal, bucket, object, versionID) {
+		return result, nil
+	}
+
+	// The healed shards are reconstructed under a read lock, so that
+	// reads of the object are not blocked while a heal rewrites it,
+	// switchLock then swaps it for the write lock to switch them in.
+	var switchLock func() (context.Context, error)
 	if !opts.NoLock {
 		lk := er.NewNSLock(bucket, object)
-		lkctx, err := lk.GetLock(ctx, globalOperationTimeout)
+		lkctx, err := lk.GetRLock(ctx, globalOperationTimeout)
 		if err != nil {
 			return result, err
 		}
+		unlock := func() { lk.RUnlock(lkctx) }
+		defer func() { unlock() }()
+
+		parentCtx := ctx
 		ctx = lkctx.Context()
-		defer lk.Unlock(lkctx)
+		switchLock = func() (context.Context, error) {
+			unlock()
+			unlock = func() {}
+			wlk := er.NewNSLock(bucket, object)
+			wlkctx, err := wlk.GetLock(parentCtx, globalOperationTimeout)
+			if err != nil {
+				return nil, err
+			}
+			unlock = func() { wlk.Unlock(wlkctx) }
+			return wlkctx.Context(), nil
+		}
 	}
 
 	// Re-read when we have lock...
 	partsMetadata, errs := readAllFileInfo(ctx, storageDisks, bucket, object, versionID, true)
+
+	// Keep the metadata as read, to check that the object is unchanged
+	// once the write lock is taken.
+	readMetadata := make([]FileInfo, len(partsMetadata))
+	for i, fi := range partsMetadata {
+		readMetadata[i] = fi
+		readMetadata[i].Metadata = cloneMSS(fi.Metadata)
+	}
+	readErrs := append([]error(nil), errs...)
 	if isAllNotFound(errs) {
 		err := errFileNotFound
 		if versionID != "" {
@@ -341,6 +373,15 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 
 	readQuorum, _, err := objectQuorumFromMeta(ctx, partsMetadata, errs, er.defaultParityCount)
 	if err != nil {
+		if switchLock != nil {
+			// Purging a dangling object needs the write lock, heal
+			// again once it is taken as the object may have changed.
+			if ctx, err = switchLock(); err != nil {
+				return result, err
+			}
+			opts.NoLock = true
+			return er.healObject(ctx, bucket, object, versionID, opts)
+		}
 		m, err := er.deleteIfDangling(ctx, bucket, object, partsMetadata, errs, nil, ObjectOptions{
 			VersionID: versionID,
 		})
@@ -562,6 +603,13 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 		dstDataDir = migrateDataDir
 	}
 
+	// When all data shards are intact and only parity shards need
+	// healing, the parity is encoded from the data shards alone.
+	parityOnly := !latestMeta.Deleted && !latestMeta.IsRemote() && !latestMeta.XLV1 && !recreate
+	for i := 0; parityOnly && i < erasure.dataBlocks; i++ {
+		parityOnly = latestDisks[i] != OfflineDisk && outDatedDisks[i] == OfflineDisk
+	}
+
 	var inlineBuffers []*bytes.Buffer
 	if !latestMeta.Deleted && !latestMeta.IsRemote() {
 		if latestMeta.InlineData() || recreate {
@@ -577,36 +625,56 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 			partIdx := latestMeta.Parts[partIndex].Index
 			partChecksums := latestMeta.Parts[partIndex].Checksums
 			tillOffset := erasure.ShardFileOffset(0, partSize, partSize)
-			readers := make([]io.ReaderAt, len(latestDisks))
 			checksumAlgo := erasureInfo.GetChecksumInfo(partNumber).Algorithm
-			for i, disk := range latestDisks {
-				if disk == OfflineDisk {
-					continue
+			newReaders := func() []io.ReaderAt {
+				readers := make([]io.ReaderAt, len(latestDisks))
+				for i, disk := range latestDisks {
+					if disk == OfflineDisk {
+						continue
+					}
+					checksumInfo := copyPartsMetadata[i].Erasure.GetChecksumInfo(partNumber)
+					partPath := pathJoin(object, srcDataDir, fmt.Sprintf("part.%d", partNumber))
+					readers[i] = newBitrotReader(disk, copyPartsMetadata[i].Data, bucket, partPath, tillOffset, checksumAlgo,
+						checksumInfo.Hash, erasure.ShardSize())
 				}
-				checksumInfo := copyPartsMetadata[i].Erasure.GetChecksumInfo(partNumber)
-				partPath := pathJoin(object, srcDataDir, fmt.Sprintf("part.%d", partNumber))
-				readers[i] = newBitrotReader(disk, copyPartsMetadata[i].Data, bucket, partPath, tillOffset, checksumAlgo,
-					checksumInfo.Hash, erasure.ShardSize())
+				return readers
 			}
-			writers := make([]io.Writer, len(outDatedDisks))
-			for i, disk := range outDatedDisks {
-				if disk == OfflineDisk {
-					continue
-				}
-				partPath := pathJoin(tmpID, dstDataDir, fmt.Sprintf("part.%d", partNumber))
-				if len(inlineBuffers) > 0 {
-					inlineBuffers[i] = bytes.NewBuffer(make([]byte, 0, erasure.ShardFileSize(latestMeta.Size)+32))
-					writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], DefaultBitrotAlgorithm, erasure.ShardSize())
-				} else {
-					writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, partPath,
-						tillOffset, DefaultBitrotAlgorithm, erasure.ShardSize())
+			newWriters := func() []io.Writer {
+				writers := make([]io.Writer, len(outDatedDisks))
+				for i, disk := range outDatedDisks {
+					if disk == OfflineDisk {
+						continue
+					}
+					partPath := pathJoin(tmpID, dstDataDir, fmt.Sprintf("part.%d", partNumber))
+					if len(inlineBuffers) > 0 {
+						inlineBuffers[i] = bytes.NewBuffer(make([]byte, 0, erasure.ShardFileSize(latestMeta.Size)+32))
+						writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], DefaultBitrotAlgorithm, erasure.ShardSize())
+					} else {
+						writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, partPath,
+							tillOffset, DefaultBitrotAlgorithm, erasure.ShardSize())
+					}
 				}
+				return writers
 			}
+			readers, writers := newReaders(), newWriters()
 
 			// Heal each part. erasure.Heal() will write the healed
 			// part to .minio/tmp/uuid/ which needs to be renamed
 			// later to the final location.
-			err = erasure.Heal(ctx, writers, readers, partSize)
+			if parityOnly {
+				err = erasure.HealParity(ctx, writers, readers, partSize)
+				if errors.Is(err, errErasureReadQuorum) {
+					// A data shard is not intact after all, heal
+					// this and the remaining parts from all shards.
+					parityOnly = false
+					closeBitrotReaders(readers)
+					closeBitrotWriters(writers)
+					readers, writers = newReaders(), newWriters()
+				}
+			}
+			if !parityOnly {
+				err = erasure.Heal(ctx, writers, readers, partSize)
+			}
 			closeBitrotReaders(readers)
 			closeBitrotWriters(writers)
 			if err != nil {
@@ -654,6 +722,16 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 
 	defer er.deleteAll(context.Background(), minioMetaTmpBucket, tmpID)
 
+	if switchLock != nil {
+		if ctx, err = switchLock(); err != nil {
+			return result, err
+		}
+		afterMetadata, afterErrs := readAllFileInfo(ctx, storageDisks, bucket, object, versionID, false)
+		if !healObjectUnchanged(readMetadata, afterMetadata, readErrs, afterErrs) {
+			return result, errHealObjectChanged
+		}
+	}
+
 	// Rename from tmp location to the actual location.
 	for i, disk := range outDatedDisks {
 		if disk == OfflineDisk {
@@ -700,6 +778,27 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 	return result, nil
 }
 
+// healObjectUnchanged returns true if the metadata of the object read
+// from every drive is the same as the metadata read before.
+func healObjectUnchanged(before, after []FileInfo, beforeErrs, afterErrs []error) bool {
+	for i := range before {
+		if beforeErrs[i] != nil || afterErrs[i] != nil {
+			if !errors.Is(afterErrs[i], beforeErrs[i]) {
+				return false
+			}
+			continue
+		}
+		switch {
+		case !after[i].Equals(before[i]),
+			after[i].VersionID != before[i].VersionID,
+			after[i].DataDir != before[i].DataDir,
+			after[i].Deleted != before[i].Deleted:
+			return false
+		}
+	}
+	return true
+}
+
 // checkAbandonedParts will check if an object has abandoned parts,
 // meaning data-dirs or inlined data that are no longer referenced by the xl.meta
 // Errors are generally ignored by this function.
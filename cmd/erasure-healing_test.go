@@ -27,12 +27,15 @@ import (
 	"os"
 	"path"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/dustin/go-humanize"
 	uuid2 "github.com/google/uuid"
 	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio/internal/amztime"
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 )
 
 // Tests isObjectDangling function
@@ -747,6 +750,91 @@ func TestHealingDanglingObject(t *testing.T) {
 	}
 }
 
+// Tests that dangling object versions under object lock survive the
+// dangling object cleanup.
+func TestHealingDanglingObjectLocked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resetGlobalHealState()
+	defer resetGlobalHealState()
+
+	nDisks := 16
+	fsDirs, err := getRandomDisks(nDisks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer removeRoots(fsDirs)
+
+	objLayer, _, err := initObjectLayer(ctx, mustGetPoolEndpoints(fsDirs...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bucket := getRandomBucketName()
+	data := bytes.Repeat([]byte("a"), 128*1024)
+
+	err = objLayer.MakeBucket(ctx, bucket, MakeBucketOptions{})
+	if err != nil {
+		t.Fatalf("Failed to make a bucket - %v", err)
+	}
+
+	retainUntil := amztime.ISO8601Format(time.Now().Add(24 * time.Hour))
+	testCases := []struct {
+		meta     map[string]string
+		survives bool
+	}{
+		// Test 1: no object lock, the dangling version is deleted.
+		{nil, false},
+		// Test 2: legal hold.
+		{map[string]string{
+			strings.ToLower(objectlock.AmzObjectLockLegalHold): string(objectlock.LegalHoldOn),
+		}, true},
+		// Test 3: compliance retention.
+		{map[string]string{
+			strings.ToLower(objectlock.AmzObjectLockMode):            string(objectlock.RetCompliance),
+			strings.ToLower(objectlock.AmzObjectLockRetainUntilDate): retainUntil,
+		}, true},
+		// Test 4: expired compliance retention.
+		{map[string]string{
+			strings.ToLower(objectlock.AmzObjectLockMode):            string(objectlock.RetCompliance),
+			strings.ToLower(objectlock.AmzObjectLockRetainUntilDate): amztime.ISO8601Format(time.Now().Add(-time.Hour)),
+		}, false},
+	}
+
+	er := objLayer.(*erasureServerPools).serverPools[0].sets[0]
+	for i, testCase := range testCases {
+		object := getRandomObjectName()
+		_, err = objLayer.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{
+			UserDefined: testCase.meta,
+		})
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+
+		// Leave xl.meta on fewer than read quorum drives.
+		disks := er.getDisks()
+		for _, disk := range disks[:12] {
+			if err = disk.Delete(ctx, bucket, pathJoin(object, xlStorageFormatFile), DeleteOptions{}); err != nil {
+				t.Fatalf("Test %d: %v", i+1, err)
+			}
+		}
+
+		if _, err = objLayer.HealObject(ctx, bucket, object, "", madmin.HealOpts{Remove: true}); err == nil {
+			t.Fatalf("Test %d: expected an error healing a dangling object", i+1)
+		}
+
+		_, err = disks[15].ReadVersion(ctx, bucket, object, "", false)
+		if testCase.survives && err != nil {
+			t.Fatalf("Test %d: expected locked version to survive, got %v", i+1, err)
+		}
+		if !testCase.survives && err == nil {
+			t.Fatalf("Test %d: expected dangling version to be deleted, got %v", i+1, err)
+		}
+	}
+}
+
 func TestHealCorrectQuorum(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -1670,3 +1758,82 @@ func TestHealLastDataShard(t *testing.T) {
 		})
 	}
 }
+
+func TestReconstructObjects(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatalf("Failed to make a bucket - %v", err)
+	}
+
+	z := obj.(*erasureServerPools)
+	objects := []objectVersion{{Object: "object1"}, {Object: "object2"}, {Object: "missing"}}
+	data := bytes.Repeat([]byte("a"), 1024*1024)
+	for _, o := range objects[:2] {
+		if _, err = obj.PutObject(ctx, bucket, o.Object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+			t.Fatalf("Failed to put an object - %v", err)
+		}
+		// Remove the object from the first drive of its set.
+		disk := z.serverPools[0].getHashedSet(o.Object).getDisks()[0]
+		if err = disk.Delete(ctx, bucket, o.Object, DeleteOptions{Recursive: true}); err != nil {
+			t.Fatalf("Failed to delete the object - %v", err)
+		}
+	}
+
+	results, errs := z.ReconstructObjects(ctx, bucket, objects)
+	for i, o := range objects[:2] {
+		if errs[i] != nil {
+			t.Fatalf("Failed to reconstruct %s - %v", o.Object, errs[i])
+		}
+		if results[i].Object != o.Object {
+			t.Fatalf("Expected the result of %s, got %s", o.Object, results[i].Object)
+		}
+		disk := z.serverPools[0].getHashedSet(o.Object).getDisks()[0]
+		if _, err = disk.StatInfoFile(ctx, bucket, o.Object+"/"+xlStorageFormatFile, false); err != nil {
+			t.Errorf("Expected xl.meta of %s to be present but stat failed - %v", o.Object, err)
+		}
+	}
+	if !isErrObjectNotFound(errs[2]) {
+		t.Fatalf("Expected ObjectNotFound for a missing object, got %v", errs[2])
+	}
+}
+
+func TestHealObjectUnchanged(t *testing.T) {
+	modTime := UTCNow()
+	fi := FileInfo{VersionID: "v1", DataDir: "d1", ModTime: modTime, Metadata: map[string]string{"etag": "abc"}}
+	before := []FileInfo{fi, fi, {}}
+	beforeErrs := []error{nil, nil, errFileNotFound}
+
+	if !healObjectUnchanged(before, []FileInfo{fi, fi, {}}, beforeErrs, []error{nil, nil, errFileNotFound}) {
+		t.Fatal("expected the object to be unchanged")
+	}
+
+	// Healed by another heal in the meantime.
+	if healObjectUnchanged(before, []FileInfo{fi, fi, fi}, beforeErrs, []error{nil, nil, nil}) {
+		t.Fatal("expected the object to be changed once healed")
+	}
+
+	// Overwritten in the meantime.
+	overwritten := fi
+	overwritten.DataDir = "d2"
+	overwritten.ModTime = modTime.Add(time.Second)
+	if healObjectUnchanged(before, []FileInfo{overwritten, overwritten, {}}, beforeErrs, []error{nil, nil, errFileNotFound}) {
+		t.Fatal("expected the object to be changed once overwritten")
+	}
+
+	// Metadata updated in the meantime.
+	tagged := fi
+	tagged.Metadata = map[string]string{"etag": "abc", "x-amz-tagging": "k=v"}
+	if healObjectUnchanged(before, []FileInfo{tagged, tagged, {}}, beforeErrs, []error{nil, nil, errFileNotFound}) {
+		t.Fatal("expected the object to be changed once its metadata is updated")
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// ObjectMetadataDiffDrive is the xl.meta of an object read from a drive.
+type ObjectMetadataDiffDrive struct {
+	Endpoint string `json:"endpoint"`
+	Versions int    `json:"versions"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ObjectMetadataDiffField is a drive whose metadata of a version differs
+// from the metadata most drives agree on.
+type ObjectMetadataDiffField struct {
+	Endpoint string   `json:"endpoint"`
+	Fields   []string `json:"fields"`
+}
+
+// ObjectMetadataDiffVersion is a version of an object found on any of
+// the drives, with the drives which disagree on it.
+type ObjectMetadataDiffVersion struct {
+	VersionID    string    `json:"versionId"`
+	ModTime      time.Time `json:"modTime"`
+	DeleteMarker bool      `json:"deleteMarker,omitempty"`
+
+	// Agreed lists the drives holding the metadata most drives agree
+	// on, Differ those holding other metadata and Missing those not
+	// holding the version.
+	Agreed  []string                  `json:"agreed"`
+	Differ  []ObjectMetadataDiffField `json:"differ,omitempty"`
+	Missing []string                  `json:"missing,omitempty"`
+}
+
+// ObjectMetadataDiff compares the xl.meta of an object across the drives
+// of its erasure set.
+type ObjectMetadataDiff struct {
+	Bucket string `json:"bucket"`
+	Object string `json:"object"`
+
+	// Consistent is set if all drives hold the same versions with the
+	// same metadata.
+	Consistent bool                        `json:"consistent"`
+	Drives     []ObjectMetadataDiffDrive   `json:"drives"`
+	Versions   []ObjectMetadataDiffVersion `json:"versions"`
+}
+
+// objectMetadataDiffFields returns the fields of the metadata of a
+// version which differ between two drives, the erasure index and the
+// checksums of the parts are specific to each drive.
+func objectMetadataDiffFields(a, b FileInfo) (fields []string) {
+	if !a.ModTime.Equal(b.ModTime) {
+		fields = append(fields, "modTime")
+	}
+	if a.IsLatest != b.IsLatest {
+		fields = append(fields, "isLatest")
+	}
+	if a.Deleted != b.Deleted {
+		fields = append(fields, "deleteMarker")
+	}
+	if a.Size != b.Size {
+		fields = append(fields, "size")
+	}
+	if a.DataDir != b.DataDir {
+		fields = append(fields, "dataDir")
+	}
+	if a.Erasure.DataBlocks != b.Erasure.DataBlocks || a.Erasure.ParityBlocks != b.Erasure.ParityBlocks ||
+		a.Erasure.BlockSize != b.Erasure.BlockSize || !equalInts(a.Erasure.Distribution, b.Erasure.Distribution) {
+		fields = append(fields, "erasure")
+	}
+	if len(a.Parts) != len(b.Parts) {
+		fields = append(fields, "parts")
+	} else {
+		for i := range a.Parts {
+			if a.Parts[i].Number != b.Parts[i].Number || a.Parts[i].Size != b.Parts[i].Size {
+				fields = append(fields, "parts")
+				break
+			}
+		}
+	}
+	if !a.MetadataEquals(b) {
+		fields = append(fields, "metadata")
+	}
+	if !a.TransitionInfoEquals(b) {
+		fields = append(fields, "transition")
+	}
+	if !a.ReplicationInfoEquals(b) {
+		fields = append(fields, "replication")
+	}
+	return fields
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// newObjectMetadataDiff compares the versions read from the xl.meta of
+// each drive, or the error reading it. With a versionID only this version
+// is compared.
+func newObjectMetadataDiff(bucket, object, versionID string, endpoints []string, drives [][]FileInfo, errs []error) ObjectMetadataDiff {
+	diff := ObjectMetadataDiff{
+		Bucket:     bucket,
+		Object:     object,
+		Consistent: true,
+		Drives:     make([]ObjectMetadataDiffDrive, len(drives)),
+		Versions:   []ObjectMetadataDiffVersion{},
+	}
+
+	// Versions of each drive, by version ID.
+	byDrive := make([]map[string]FileInfo, len(drives))
+	var versionIDs []string
+	modTimes := make(map[string]time.Time)
+	for i, fivs := range drives {
+		diff.Drives[i] = ObjectMetadataDiffDrive{Endpoint: endpoints[i], Versions: len(fivs)}
+		if errs[i] != nil && !errors.Is(errs[i], errFileNotFound) {
+			diff.Drives[i].Error = errs[i].Error()
+			diff.Consistent = false
+		}
+		byDrive[i] = make(map[string]FileInfo, len(fivs))
+		for _, fi := range fivs {
+			vid := fi.VersionID
+			if vid == "" {
+				vid = nullVersionID
+			}
+			if versionID != "" && vid != versionID {
+				continue
+			}
+			byDrive[i][vid] = fi
+			if _, ok := modTimes[vid]; !ok {
+				versionIDs = append(versionIDs, vid)
+			}
+			if fi.ModTime.After(modTimes[vid]) {
+				modTimes[vid] = fi.ModTime
+			}
+		}
+	}
+
+	// Newest first.
+	sort.SliceStable(versionIDs, func(i, j int) bool {
+		return modTimes[versionIDs[i]].After(modTimes[versionIDs[j]])
+	})
+
+	for _, vid := range versionIDs {
+		// Pick the metadata most drives agree on.
+		ref, refAgreed := -1, 0
+		for i := range drives {
+			fi, ok := byDrive[i][vid]
+			if !ok {
+				continue
+			}
+			agreed := 0
+			for j := range drives {
+				if ofi, ok := byDrive[j][vid]; ok && len(objectMetadataDiffFields(fi, ofi)) == 0 {
+					agreed++
+				}
+			}
+			if agreed > refAgreed {
+				ref, refAgreed = i, agreed
+			}
+		}
+
+		refFI := byDrive[ref][vid]
+		v := ObjectMetadataDiffVersion{
+			VersionID:    vid,
+			ModTime:      refFI.ModTime,
+			DeleteMarker: refFI.Deleted,
+			Agreed:       []string{},
+		}
+		for i := range drives {
+			fi, ok := byDrive[i][vid]
+			if !ok {
+				v.Missing = append(v.Missing, endpoints[i])
+				continue
+			}
+			if fields := objectMetadataDiffFields(refFI, fi); len(fields) > 0 {
+				v.Differ = append(v.Differ, ObjectMetadataDiffField{Endpoint: endpoints[i], Fields: fields})
+				continue
+			}
+			v.Agreed = append(v.Agreed, endpoints[i])
+		}
+		if len(v.Agreed) != len(drives) {
+			diff.Consistent = false
+		}
+		diff.Versions = append(diff.Versions, v)
+	}
+	return diff
+}
+
+// ObjectMetadataDiff compares the xl.meta of an object read from each
+// drive of its erasure set.
+func (z *erasureServerPools) ObjectMetadataDiff(ctx context.Context, bucket, object, versionID string) (ObjectMetadataDiff, error) {
+	if err := checkGetObjArgs(ctx, bucket, object); err != nil {
+		return ObjectMetadataDiff{}, err
+	}
+
+	object = encodeDirObject(object)
+
+	lk := z.NewNSLock(bucket, object)
+	lkctx, err := lk.GetRLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return ObjectMetadataDiff{}, err
+	}
+	ctx = lkctx.Context()
+	defer lk.RUnlock(lkctx)
+
+	for _, pool := range z.serverPools {
+		set := pool.getHashedSet(object)
+		metaArr, _, errs := readAllRawXL(ctx, set.getDisks(), bucket, object, false)
+		if isAllNotFound(errs) {
+			continue
+		}
+
+		endpoints := set.getEndpoints()
+		names := make([]string, len(endpoints))
+		drives := make([][]FileInfo, len(metaArr))
+		for i := range metaArr {
+			names[i] = endpoints[i].String()
+			if metaArr[i] == nil {
+				continue
+			}
+			drives[i], errs[i] = metaArr[i].ListVersions(bucket, object)
+		}
+		return newObjectMetadataDiff(bucket, decodeDirObject(object), versionID, names, drives, errs), nil
+	}
+	return ObjectMetadataDiff{}, toObjectErr(errFileNotFound, bucket, object)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewObjectMetadataDiff(t *testing.T) {
+	now := time.Now()
+	v1 := FileInfo{VersionID: "v1", ModTime: now.Add(-time.Hour), DataDir: "d1", Size: 10}
+	v2 := FileInfo{VersionID: "v2", ModTime: now, DataDir: "d2", Size: 20, IsLatest: true}
+	resized := v2
+	resized.Size = 30
+	endpoints := []string{"d0", "d1", "d2", "d3"}
+
+	diff := newObjectMetadataDiff("bucket", "object", "", endpoints, [][]FileInfo{
+		{v2, v1},
+		{v2, v1},
+		{resized, v1},
+		nil,
+	}, []error{nil, nil, nil, errDiskNotFound})
+
+	if diff.Consistent {
+		t.Fatal("expected an inconsistent diff")
+	}
+	if diff.Drives[3].Error == "" || diff.Drives[0].Versions != 2 {
+		t.Fatalf("unexpected drives %+v", diff.Drives)
+	}
+	if len(diff.Versions) != 2 || diff.Versions[0].VersionID != "v2" || diff.Versions[1].VersionID != "v1" {
+		t.Fatalf("expected versions newest first, got %+v", diff.Versions)
+	}
+
+	got := diff.Versions[0]
+	if !reflect.DeepEqual(got.Agreed, []string{"d0", "d1"}) || !reflect.DeepEqual(got.Missing, []string{"d3"}) {
+		t.Fatalf("unexpected drives for v2 %+v", got)
+	}
+	if len(got.Differ) != 1 || got.Differ[0].Endpoint != "d2" || !reflect.DeepEqual(got.Differ[0].Fields, []string{"size"}) {
+		t.Fatalf("unexpected differing drives for v2 %+v", got.Differ)
+	}
+	if got = diff.Versions[1]; len(got.Agreed) != 3 || len(got.Differ) != 0 {
+		t.Fatalf("unexpected drives for v1 %+v", got)
+	}
+
+	// Only the requested version is compared.
+	diff = newObjectMetadataDiff("bucket", "object", "v1", endpoints[:3], [][]FileInfo{
+		{v2, v1},
+		{v2, v1},
+		{resized, v1},
+	}, []error{nil, nil, nil})
+	if !diff.Consistent || len(diff.Versions) != 1 {
+		t.Fatalf("expected a consistent diff of v1, got %+v", diff)
+	}
+}
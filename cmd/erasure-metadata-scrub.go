@@ -0,0 +1,128 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"time"
+)
+
+// States of the metadata of an object on a drive.
+const (
+	// The drive agrees on the modtime and version ID.
+	scrubDriveOK = "ok"
+	// The drive has an older modtime.
+	scrubDriveStale = "stale"
+	// The drive has a newer modtime, or another version ID with the
+	// same modtime.
+	scrubDriveDivergent = "divergent"
+	// The drive has no metadata for the object.
+	scrubDriveMissing = "missing"
+	// The drive is offline.
+	scrubDriveOffline = "offline"
+	// The metadata could not be read from the drive.
+	scrubDriveError = "error"
+)
+
+// ObjectMetadataScrubDrive is the metadata of an object on a drive.
+type ObjectMetadataScrubDrive struct {
+	Endpoint  string    `json:"endpoint"`
+	State     string    `json:"state"`
+	ModTime   time.Time `json:"modTime,omitempty"`
+	VersionID string    `json:"versionId,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// ObjectMetadataScrub reports how the metadata of an object agrees
+// across the drives of its erasure set, relative to the metadata picked
+// to serve the object.
+type ObjectMetadataScrub struct {
+	ModTime    time.Time `json:"modTime"`
+	VersionID  string    `json:"versionId,omitempty"`
+	ReadQuorum int       `json:"readQuorum"`
+
+	// Number of drives agreeing on the modtime, on the version ID and
+	// on both.
+	ModTimeAgreed   int `json:"modTimeAgreed"`
+	VersionIDAgreed int `json:"versionIdAgreed"`
+	Agreed          int `json:"agreed"`
+
+	// Consistent is set if all drives agree.
+	Consistent bool                       `json:"consistent"`
+	Drives     []ObjectMetadataScrubDrive `json:"drives"`
+}
+
+// newObjectMetadataScrub compares the metadata read from each drive,
+// metaArr and errs, with the picked metadata fi.
+func newObjectMetadataScrub(endpoints []Endpoint, metaArr []FileInfo, errs []error, fi FileInfo, readQuorum int) *ObjectMetadataScrub {
+	scrub := &ObjectMetadataScrub{
+		ModTime:    fi.ModTime,
+		VersionID:  fi.VersionID,
+		ReadQuorum: readQuorum,
+		Drives:     make([]ObjectMetadataScrubDrive, len(metaArr)),
+	}
+	for i, meta := range metaArr {
+		d := &scrub.Drives[i]
+		if i < len(endpoints) {
+			d.Endpoint = endpoints[i].String()
+		}
+
+		var err error
+		if i < len(errs) {
+			err = errs[i]
+		}
+		switch {
+		case errors.Is(err, errDiskNotFound):
+			d.State = scrubDriveOffline
+			continue
+		case errors.Is(err, errFileNotFound), errors.Is(err, errFileVersionNotFound), errors.Is(err, errVolumeNotFound):
+			d.State = scrubDriveMissing
+			continue
+		case err != nil:
+			d.State = scrubDriveError
+			d.Error = err.Error()
+			continue
+		case !meta.IsValid():
+			d.State = scrubDriveError
+			d.Error = "invalid metadata"
+			continue
+		}
+
+		d.ModTime = meta.ModTime
+		d.VersionID = meta.VersionID
+		sameModTime := meta.ModTime.Equal(fi.ModTime)
+		sameVersionID := meta.VersionID == fi.VersionID
+		if sameModTime {
+			scrub.ModTimeAgreed++
+		}
+		if sameVersionID {
+			scrub.VersionIDAgreed++
+		}
+		switch {
+		case sameModTime && sameVersionID:
+			d.State = scrubDriveOK
+			scrub.Agreed++
+		case meta.ModTime.Before(fi.ModTime):
+			d.State = scrubDriveStale
+		default:
+			d.State = scrubDriveDivergent
+		}
+	}
+	scrub.Consistent = scrub.Agreed == len(metaArr)
+	return scrub
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewObjectMetadataScrub(t *testing.T) {
+	now := time.Now()
+	valid := func(modTime time.Time, versionID string) FileInfo {
+		return FileInfo{
+			VersionID: versionID,
+			ModTime:   modTime,
+			Erasure: ErasureInfo{
+				DataBlocks:   2,
+				ParityBlocks: 2,
+				Index:        1,
+				Distribution: []int{1, 2, 3, 4},
+			},
+		}
+	}
+	fi := valid(now, "v2")
+	metaArr := []FileInfo{
+		fi,
+		valid(now.Add(-time.Hour), "v1"),
+		valid(now.Add(time.Hour), "v3"),
+		valid(now, "v3"),
+		{},
+		{},
+		{},
+	}
+	errs := []error{nil, nil, nil, nil, errFileNotFound, errDiskNotFound, errFileCorrupt}
+
+	scrub := newObjectMetadataScrub(nil, metaArr, errs, fi, 4)
+	want := []string{
+		scrubDriveOK,
+		scrubDriveStale,
+		scrubDriveDivergent,
+		scrubDriveDivergent,
+		scrubDriveMissing,
+		scrubDriveOffline,
+		scrubDriveError,
+	}
+	for i, state := range want {
+		if scrub.Drives[i].State != state {
+			t.Errorf("drive %d: expected %s, got %s", i, state, scrub.Drives[i].State)
+		}
+	}
+	if scrub.ModTimeAgreed != 2 || scrub.VersionIDAgreed != 1 || scrub.Agreed != 1 || scrub.Consistent {
+		t.Errorf("unexpected counts %+v", scrub)
+	}
+}
+
+func TestGetObjectInfoMetadataScrub(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+	initConfigSubsystem(ctx, obj)
+
+	z := obj.(*erasureServerPools)
+	xl := z.serverPools[0].sets[0]
+
+	bucket := "bucket"
+	object := "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader([]byte("abcd")), int64(len("abcd")), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objInfo, err := obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if objInfo.MetadataScrub != nil {
+		t.Fatal("metadata scrubbed without being requested")
+	}
+
+	objInfo, err = obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{MetadataScrub: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scrub := objInfo.MetadataScrub
+	if scrub == nil || !scrub.Consistent || scrub.Agreed != len(scrub.Drives) {
+		t.Fatalf("expected consistent metadata, got %+v", scrub)
+	}
+
+	// Remove the object from one drive.
+	disks := xl.getDisks()
+	if err = disks[0].Delete(ctx, bucket, object, DeleteOptions{Recursive: true}); err != nil {
+		t.Fatal(err)
+	}
+	objInfo, err = obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{MetadataScrub: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scrub = objInfo.MetadataScrub
+	if scrub.Consistent || scrub.Agreed != len(scrub.Drives)-1 || scrub.Drives[0].State != scrubDriveMissing {
+		t.Fatalf("expected one drive missing metadata, got %+v", scrub)
+	}
+	if scrub.Drives[0].Endpoint != disks[0].Endpoint().String() {
+		t.Errorf("expected endpoint %s, got %s", disks[0].Endpoint(), scrub.Drives[0].Endpoint)
+	}
+}
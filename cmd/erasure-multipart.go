@@ -362,8 +362,14 @@ func (er erasureObjects) newMultipartUpload(ctx context.Context, bucket string,
 	if opts.PreserveETag != "" {
 		userDefined["etag"] = opts.PreserveETag
 	}
+	if err := preserveClientMetadata(userDefined, opts); err != nil {
+		return nil, err
+	}
+	if opts.ContentHash != "" {
+		userDefined[contentHashKey] = opts.ContentHash
+	}
 	onlineDisks := er.getDisks()
-	parityDrives := globalStorageClass.GetParityForSC(userDefined[xhttp.AmzStorageClass])
+	parityDrives := globalStorageClass.GetParityForPoolSC(er.poolIndex, userDefined[xhttp.AmzStorageClass])
 	if parityDrives < 0 {
 		parityDrives = er.defaultParityCount
 	}
@@ -638,6 +644,7 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		// No need to allocate fully fi.Erasure.BlockSize buffer if the incoming data is smaller.
 		buffer = make([]byte, size, 2*size+int64(fi.Erasure.ParityBlocks+fi.Erasure.DataBlocks-1))
 	}
+	defer globalObjectBufferStats.track(int64(cap(buffer)))()
 
 	if len(buffer) > int(fi.Erasure.BlockSize) {
 		buffer = buffer[:fi.Erasure.BlockSize]
@@ -658,6 +665,7 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		bufB := er.bp.Get()
 		defer er.bp.Put(bufA)
 		defer er.bp.Put(bufB)
+		defer globalObjectBufferStats.track(int64(cap(bufA) + cap(bufB)))()
 		ra, err := readahead.NewReaderBuffer(data, [][]byte{bufA[:fi.Erasure.BlockSize], bufB[:fi.Erasure.BlockSize]})
 		if err == nil {
 			toEncode = ra
@@ -665,7 +673,9 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		}
 	}
 
+	done := requestTimingPhase(ctx, requestPhaseData)
 	n, err := erasure.Encode(pctx, toEncode, writers, buffer, writeQuorum)
+	done()
 	closeBitrotWriters(writers)
 	if err != nil {
 		return pi, toObjectErr(err, bucket, object)
@@ -1161,6 +1171,9 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 			fi.Metadata["etag"] = getCompleteMultipartMD5(parts)
 		}
 	}
+	if globalAPIConfig.isMultipartWeakETag() && !crypto.IsEncrypted(fi.Metadata) {
+		fi.Metadata[multipartWeakETagKey] = getMultipartWeakETag(fi.Metadata["etag"])
+	}
 
 	// Save the consolidated actual size.
 	fi.Metadata[ReservedMetadataPrefix+"actual-size"] = strconv.FormatInt(objectActualSize, 10)
@@ -1210,6 +1223,10 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 		er.removePartMeta(bucket, object, uploadID, fi.DataDir, part.Number)
 	}
 
+	for i := range partsMetadata {
+		partsMetadata[i].Durable = opts.Durable
+	}
+
 	// Rename the multipart object to final location.
 	onlineDisks, versionsDisparity, err := renameData(ctx, onlineDisks, minioMetaMultipartBucket, uploadIDPath,
 		partsMetadata, bucket, object, writeQuorum)
@@ -0,0 +1,242 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// appendObject appends the data to the latest version of an object
+// created with append as a new part, in the data directory of that
+// version, or creates the object if it doesn't exist. In versioned
+// buckets a new version is added which references the parts of the
+// version appended to, no data is copied.
+func (er erasureObjects) appendObject(ctx context.Context, bucket, object string, r *PutObjReader, opts ObjectOptions) (ObjectInfo, error) {
+	// Reading the latest version and writing the
+	// next one must happen under the same lock.
+	if !opts.NoLock {
+		lk := er.NewNSLock(bucket, object)
+		lkctx, err := lk.GetLock(ctx, globalOperationTimeout)
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		ctx = lkctx.Context()
+		defer lk.Unlock(lkctx)
+		opts.NoLock = true
+	}
+
+	maxSegments := opts.Append.MaxSegments
+	if maxSegments <= 0 {
+		maxSegments = defaultAppendMaxSegments
+	}
+	checkPrecondFn := opts.CheckPrecondFn
+	opts.Append = AppendOptions{}
+	opts.CheckPrecondFn = nil
+
+	fi, metaArr, onlineDisks, err := er.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+	if err != nil {
+		err = toObjectErr(err, bucket, object)
+		if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
+			return ObjectInfo{}, err
+		}
+	}
+	if err != nil || fi.Deleted {
+		// First segment, create the object.
+		if checkPrecondFn != nil && checkPrecondFn(ObjectInfo{}) {
+			return ObjectInfo{}, PreConditionFailed{}
+		}
+		opts.UserDefined = cloneMSS(opts.UserDefined)
+		opts.UserDefined[objectAppendKey] = "true"
+		return er.putObject(ctx, bucket, object, r, opts)
+	}
+
+	objInfo := fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended)
+	if checkPrecondFn != nil && checkPrecondFn(objInfo) {
+		return ObjectInfo{}, PreConditionFailed{}
+	}
+	if !isAppendObject(fi.Metadata) || fi.IsRemote() || fi.InlineData() || len(fi.Parts) == 0 {
+		return ObjectInfo{}, ObjectNotAppendable{Bucket: bucket, Object: object}
+	}
+	if len(fi.Parts) >= maxSegments {
+		return ObjectInfo{}, ObjectNotAppendable{
+			Bucket: bucket,
+			Object: object,
+			Err:    fmt.Errorf("maximum of %d segments reached", maxSegments),
+		}
+	}
+
+	versionID := fi.VersionID
+	switch {
+	case opts.Versioned:
+		versionID = mustGetUUID()
+	case versionID != "":
+		// The null version written while versioning is suspended
+		// would replace the null version, possibly not appendable.
+		return ObjectInfo{}, ObjectNotAppendable{
+			Bucket: bucket,
+			Object: object,
+			Err:    errors.New("versioning is suspended"),
+		}
+	}
+
+	data := r.Reader
+	if data.Size() == 0 {
+		// Nothing to append.
+		return objInfo, nil
+	}
+
+	writeQuorum := fi.WriteQuorum(er.defaultWQuorum())
+	onlineDisks = shuffleDisks(onlineDisks, fi.Erasure.Distribution)
+	metaArr = shufflePartsMetadata(metaArr, fi.Erasure.Distribution)
+
+	partNumber := fi.Parts[len(fi.Parts)-1].Number + 1
+	partSuffix := fmt.Sprintf("part.%d", partNumber)
+	tmpPart := mustGetUUID()
+	tmpPartPath := pathJoin(tmpPart, partSuffix)
+
+	// Delete the temporary part, there is nothing left to delete once
+	// it was moved next to the previous segments.
+	defer er.deleteAll(context.Background(), minioMetaTmpBucket, tmpPart)
+
+	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	if err != nil {
+		return ObjectInfo{}, toObjectErr(err, bucket, object)
+	}
+
+	// Fetch buffer for I/O, returns from the pool if not allocates a new one and returns.
+	var buffer []byte
+	switch size := data.Size(); {
+	case size >= fi.Erasure.BlockSize || size == -1:
+		buffer = er.bp.Get()
+		defer er.bp.Put(buffer)
+	default:
+		// No need to allocate fully fi.Erasure.BlockSize buffer if the incoming data is smaller.
+		buffer = make([]byte, size, 2*size+int64(fi.Erasure.ParityBlocks+fi.Erasure.DataBlocks-1))
+	}
+	defer globalObjectBufferStats.track(int64(cap(buffer)))()
+
+	if len(buffer) > int(fi.Erasure.BlockSize) {
+		buffer = buffer[:fi.Erasure.BlockSize]
+	}
+
+	writers := make([]io.Writer, len(onlineDisks))
+	for i, disk := range onlineDisks {
+		if disk == nil {
+			continue
+		}
+		writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, tmpPartPath, erasure.ShardFileSize(data.Size()), DefaultBitrotAlgorithm, erasure.ShardSize())
+	}
+
+	done := requestTimingPhase(ctx, requestPhaseData)
+	n, err := erasure.Encode(ctx, data, writers, buffer, writeQuorum)
+	done()
+	closeBitrotWriters(writers)
+	if err != nil {
+		return ObjectInfo{}, toObjectErr(err, bucket, object)
+	}
+
+	// Should return IncompleteBody{} error when reader has fewer bytes
+	// than specified in request header.
+	if n < data.Size() {
+		return ObjectInfo{}, IncompleteBody{Bucket: bucket, Object: object}
+	}
+
+	for i := range writers {
+		if writers[i] == nil {
+			onlineDisks[i] = nil
+		}
+	}
+
+	// Move the part next to the previous segments, the previous
+	// versions don't reference it.
+	partPath := pathJoin(object, fi.DataDir, partSuffix)
+	onlineDisks, err = renamePart(ctx, onlineDisks, minioMetaTmpBucket, tmpPartPath, bucket, partPath, writeQuorum)
+	if err != nil {
+		return ObjectInfo{}, toObjectErr(err, bucket, object)
+	}
+
+	modTime := opts.MTime
+	if opts.MTime.IsZero() {
+		modTime = UTCNow()
+	}
+
+	// The ETag is computed like the ETag of a multipart upload.
+	partETag := r.MD5CurrentHexString()
+	parts := make([]CompletePart, 0, len(fi.Parts)+1)
+	for _, part := range fi.Parts {
+		parts = append(parts, CompletePart{PartNumber: part.Number, ETag: part.ETag})
+	}
+	parts = append(parts, CompletePart{PartNumber: partNumber, ETag: partETag})
+
+	metadata := cloneMSS(fi.Metadata)
+	metadata["etag"] = getCompleteMultipartMD5(parts)
+
+	for i := range metaArr {
+		if onlineDisks[i] == nil {
+			continue
+		}
+		metaArr[i].VersionID = versionID
+		metaArr[i].AddObjectPart(partNumber, partETag, n, data.ActualSize(), modTime, nil, nil)
+		metaArr[i].Erasure.AddChecksumInfo(ChecksumInfo{
+			PartNumber: partNumber,
+			Algorithm:  DefaultBitrotAlgorithm,
+			Hash:       bitrotWriterSum(writers[i]),
+		})
+		metaArr[i].Size += n
+		metaArr[i].ModTime = modTime
+		metaArr[i].Metadata = metadata
+		// The checksum of the whole object no longer matches.
+		metaArr[i].Checksum = nil
+		metaArr[i].Durable = opts.Durable
+		metaArr[i].Fresh = false
+	}
+
+	// Write the new version, in place of the previous one in
+	// unversioned buckets.
+	onlineDisks, err = writeUniqueFileInfo(ctx, onlineDisks, bucket, object, metaArr, writeQuorum)
+	if err != nil {
+		return ObjectInfo{}, toObjectErr(err, bucket, object)
+	}
+
+	for i := range onlineDisks {
+		if onlineDisks[i] != nil && onlineDisks[i].IsOnline() {
+			// Object info is the same in all disks, so we can pick
+			// the first meta from online disk
+			fi = metaArr[i]
+			break
+		}
+	}
+
+	// Whether a disk was initially or becomes offline
+	// during this append, send it to the MRF list.
+	for i := range onlineDisks {
+		if onlineDisks[i] != nil && onlineDisks[i].IsOnline() {
+			continue
+		}
+		er.addPartial(bucket, object, fi.VersionID, fi.Size)
+		break
+	}
+
+	// we are adding a new version to this object under the namespace lock, so this is the latest version.
+	fi.IsLatest = true
+
+	return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestParseBucketAppendConfig(t *testing.T) {
+	cfg, err := parseBucketAppendConfig([]byte(`{"enabled":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Enabled || cfg.maxSegments() != defaultAppendMaxSegments {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+	if _, err = parseBucketAppendConfig([]byte(`{"enabled":true,"maxSegments":10001}`)); err == nil {
+		t.Fatal("expected too many segments to fail")
+	}
+}
+
+func TestAppendObject(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	const bucket = "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{VersioningEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	appendData := func(object string, data string, opts ObjectOptions) (ObjectInfo, error) {
+		opts.Append = AppendOptions{Enabled: true, MaxSegments: 3}
+		return obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader([]byte(data)), int64(len(data)), "", ""), opts)
+	}
+	readData := func(object, versionID string) string {
+		gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, nil, ObjectOptions{VersionID: versionID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer gr.Close()
+		b, err := io.ReadAll(gr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	// Unversioned, segments are added to the same version.
+	for _, data := range []string{"first,", "second,", "third"} {
+		if _, err = appendData("log", data, ObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	oi, err := obj.GetObjectInfo(ctx, bucket, "log", ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oi.Size != int64(len("first,second,third")) || !strings.HasSuffix(oi.ETag, "-3") || len(oi.Parts) != 3 {
+		t.Fatalf("unexpected object info, size %d, etag %s, parts %d", oi.Size, oi.ETag, len(oi.Parts))
+	}
+	if got := readData("log", ""); got != "first,second,third" {
+		t.Fatalf("expected appended content, got %q", got)
+	}
+
+	// Bounded by the maximum number of segments.
+	var notAppendable ObjectNotAppendable
+	if _, err = appendData("log", "fourth", ObjectOptions{}); !errors.As(err, &notAppendable) {
+		t.Fatalf("expected ObjectNotAppendable past the maximum segments, got %v", err)
+	}
+
+	// Objects not created with append can't be appended to.
+	if _, err = obj.PutObject(ctx, bucket, "plain", mustGetPutObjReader(t, bytes.NewReader([]byte("abcd")), 4, "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = appendData("plain", "efgh", ObjectOptions{}); !errors.As(err, &notAppendable) {
+		t.Fatalf("expected ObjectNotAppendable, got %v", err)
+	}
+
+	// Preconditions are evaluated against the latest version.
+	var precondFailed PreConditionFailed
+	failPrecond := ObjectOptions{CheckPrecondFn: func(oi ObjectInfo) bool { return true }}
+	if _, err = appendData("log", "x", failPrecond); !errors.As(err, &precondFailed) {
+		t.Fatalf("expected PreConditionFailed, got %v", err)
+	}
+
+	// Versioned, each append is a new version sharing the data of the previous one.
+	versioned := ObjectOptions{Versioned: true}
+	v1, err := appendData("vlog", "one,", versioned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v2, err := appendData("vlog", "two", versioned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v1.VersionID == v2.VersionID || v1.VersionID == "" {
+		t.Fatalf("expected a new version, got %s and %s", v1.VersionID, v2.VersionID)
+	}
+	if got := readData("vlog", v1.VersionID); got != "one," {
+		t.Fatalf("expected the previous version to be unchanged, got %q", got)
+	}
+	if got := readData("vlog", v2.VersionID); got != "one,two" {
+		t.Fatalf("expected appended content, got %q", got)
+	}
+
+	// Removing the latest version keeps the data of the previous one.
+	if _, err = obj.DeleteObject(ctx, bucket, "vlog", ObjectOptions{VersionID: v2.VersionID, Versioned: true}); err != nil {
+		t.Fatal(err)
+	}
+	if got := readData("vlog", v1.VersionID); got != "one," {
+		t.Fatalf("expected the previous version to be readable, got %q", got)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// encodedChunk is a chunk of an object erasure coded as a part file.
+type encodedChunk struct {
+	size    int64
+	writers []io.Writer
+}
+
+// encodeChunkSize returns the size of the chunks an object of the given
+// size is split into to be erasure coded in parallel, rounded up to a
+// multiple of the block size, and the number of chunks encoded in
+// parallel. The size is 0 when the object is encoded as a single part.
+func encodeChunkSize(size, blockSize int64) (chunkSize int64, workers int) {
+	chunkSize, workers = globalAPIConfig.getEncodeChunks()
+	if chunkSize <= 0 || workers <= 0 {
+		return 0, 0
+	}
+	chunkSize = ceilFrac(chunkSize, blockSize) * blockSize
+	if size < 2*chunkSize {
+		return 0, 0
+	}
+	return chunkSize, workers
+}
+
+// encodeChunks erasure codes data of the given size as consecutive chunks
+// of chunkSize bytes, written as the part files part.1, part.2... under
+// tmpDataDir. Data is read in order, each chunk is buffered and encoded
+// by its own goroutine, up to workers at a time.
+//
+// On success the writers of drives which did not store all chunks are
+// cleared in every chunk. A short read returns no chunks along with the
+// number of bytes read.
+func (er erasureObjects) encodeChunks(ctx context.Context, data io.Reader, size, chunkSize int64, workers int,
+	erasure Erasure, onlineDisks []StorageAPI, tmpDataDir string, writeQuorum int,
+) (chunks []encodedChunk, total int64, err error) {
+	chunks = make([]encodedChunk, ceilFrac(size, chunkSize))
+	errs := make([]error, len(chunks))
+
+	// A failed chunk stops reading the following ones.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Chunk buffers are allocated on first use and reused.
+	bufs := make(chan []byte, workers)
+	for i := 0; i < workers; i++ {
+		bufs <- nil
+	}
+
+	var wg sync.WaitGroup
+	for c := range chunks {
+		buf := <-bufs
+		if ctx.Err() != nil {
+			break
+		}
+		if buf == nil {
+			buf = make([]byte, chunkSize)
+		}
+
+		n := chunkSize
+		if remaining := size - total; remaining < n {
+			n = remaining
+		}
+		// The buffer of a chunk is in use until the chunk is encoded.
+		release := globalObjectBufferStats.track(n)
+		read, rerr := io.ReadFull(data, buf[:n])
+		total += int64(read)
+		if rerr != nil {
+			release()
+			wg.Wait()
+			if errors.Is(rerr, io.ErrUnexpectedEOF) || errors.Is(rerr, io.EOF) {
+				return nil, total, nil
+			}
+			return nil, total, rerr
+		}
+
+		partPath := pathJoin(tmpDataDir, fmt.Sprintf("part.%d", c+1))
+		writers := make([]io.Writer, len(onlineDisks))
+		for i, disk := range onlineDisks {
+			if disk == nil || !disk.IsOnline() {
+				continue
+			}
+			writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, partPath, erasure.ShardFileSize(n), DefaultBitrotAlgorithm, erasure.ShardSize())
+		}
+
+		wg.Add(1)
+		go func(c int, buf []byte, writers []io.Writer) {
+			defer wg.Done()
+			defer func() { bufs <- buf }()
+			defer release()
+
+			buffer := er.bp.Get()
+			defer er.bp.Put(buffer)
+			if len(buffer) > int(erasure.blockSize) {
+				buffer = buffer[:erasure.blockSize]
+			}
+
+			written, err := erasure.Encode(ctx, bytes.NewReader(buf), writers, buffer, writeQuorum)
+			closeBitrotWriters(writers)
+			if err != nil {
+				errs[c] = err
+				cancel()
+			}
+			chunks[c] = encodedChunk{size: written, writers: writers}
+		}(c, buf[:n], writers)
+	}
+
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, total, err
+		}
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, total, err
+	}
+
+	// Reading to EOF verifies the checksums of the data.
+	if _, err = io.Copy(io.Discard, data); err != nil {
+		return nil, total, err
+	}
+
+	online := 0
+	for i := range onlineDisks {
+		stored := true
+		for _, chunk := range chunks {
+			stored = stored && chunk.writers[i] != nil
+		}
+		if stored {
+			online++
+			continue
+		}
+		for _, chunk := range chunks {
+			chunk.writers[i] = nil
+		}
+	}
+	if online < writeQuorum {
+		return nil, total, errErasureWriteQuorum
+	}
+	return chunks, total, nil
+}
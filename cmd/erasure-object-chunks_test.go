@@ -0,0 +1,118 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	crand "crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/minio/minio/internal/hash"
+)
+
+func TestPutObjectEncodeChunks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	globalAPIConfig.mu.Lock()
+	globalAPIConfig.encodeChunkSize = blockSizeV2
+	globalAPIConfig.encodeChunkWorkers = 2
+	globalAPIConfig.mu.Unlock()
+	defer func() {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.encodeChunkSize = 0
+		globalAPIConfig.encodeChunkWorkers = 0
+		globalAPIConfig.mu.Unlock()
+	}()
+
+	bucket, object := "bucket", "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 3 full chunks and a partial one.
+	data := make([]byte, 3*blockSizeV2+blockSizeV2/2)
+	if _, err = io.ReadFull(crand.Reader, data); err != nil {
+		t.Fatal(err)
+	}
+	sum := md5.Sum(data)
+	md5Hex := hex.EncodeToString(sum[:])
+
+	objInfo, err := obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), md5Hex, ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if objInfo.ETag != md5Hex {
+		t.Fatalf("expected the ETag %s, got %s", md5Hex, objInfo.ETag)
+	}
+	if len(objInfo.Parts) != 4 {
+		t.Fatalf("expected 4 parts, got %d", len(objInfo.Parts))
+	}
+	if inUse := globalObjectBufferStats.bytesInUse(); inUse != 0 {
+		t.Fatalf("expected the chunk buffers to be released, %d bytes still in use", inUse)
+	}
+
+	// A range across the first and second chunk.
+	rs := &HTTPRangeSpec{Start: blockSizeV2 - 10, End: blockSizeV2 + 9}
+	gr, err := obj.GetObjectNInfo(ctx, bucket, object, rs, nil, readLock, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(gr)
+	gr.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data[blockSizeV2-10:blockSizeV2+10]) {
+		t.Fatal("Corrupted data found")
+	}
+
+	// The object is a single part to clients.
+	oi, err := obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs := partNumberToRangeSpec(oi, 1); rs.Start != 0 || rs.End != -1 {
+		t.Fatalf("expected part 1 to be the whole object, got %d-%d", rs.Start, rs.End)
+	}
+
+	// The MD5 of the content is verified once all chunks are read.
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "d41d8cd98f00b204e9800998ecf8427e", ""), ObjectOptions{})
+	var badDigest hash.BadDigest
+	if !errors.As(err, &badDigest) {
+		t.Fatalf("expected a bad digest, got %v", err)
+	}
+
+	// Short reads are incomplete bodies.
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data[:len(data)-1]), int64(len(data)), "", ""), ObjectOptions{})
+	if _, ok := err.(IncompleteBody); !ok {
+		t.Fatalf("expected an incomplete body, got %v", err)
+	}
+}
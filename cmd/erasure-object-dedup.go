@@ -0,0 +1,124 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/logger"
+)
+
+// contentSHA256Key stores the SHA-256 of the data of an object version
+// as written on the drives, set when api dedup_versions is enabled.
+const contentSHA256Key = ReservedMetadataPrefixLower + "content-sha256"
+
+// canDedupVersion returns true if the data of a new version of an
+// object may be shared with its latest version. Encrypted objects are
+// never shared, their data is sealed with a key unique to each version,
+// nor are writes which must land on all the online drives.
+func canDedupVersion(bucket string, opts ObjectOptions, userDefined map[string]string) bool {
+	return globalAPIConfig.isDedupVersions() &&
+		opts.Versioned &&
+		opts.DataDir == "" &&
+		opts.EncryptFn == nil &&
+		!opts.WriteAllOnline &&
+		!opts.Speedtest &&
+		!crypto.IsEncrypted(userDefined) &&
+		!isMinioMetaBucketName(bucket)
+}
+
+// sameVersionData returns true if the data of the latest version of an
+// object is identical to the data of the new version fi, on the drives.
+func sameVersionData(latest, fi FileInfo) bool {
+	sum := fi.Metadata[contentSHA256Key]
+	return sum != "" &&
+		latest.Metadata[contentSHA256Key] == sum &&
+		!latest.Deleted &&
+		!latest.InlineData() &&
+		latest.DataDir != "" &&
+		latest.TransitionStatus == "" &&
+		len(latest.Parts) == 1 &&
+		latest.Size == fi.Size &&
+		latest.Erasure.DataBlocks == fi.Erasure.DataBlocks &&
+		latest.Erasure.ParityBlocks == fi.Erasure.ParityBlocks &&
+		latest.Erasure.BlockSize == fi.Erasure.BlockSize
+}
+
+// dedupVersion adds the new version fi of the object as a reference to
+// the data directory of the latest version when both have the same data,
+// instead of writing it again. It returns false when the data of the new
+// version must be written, the caller then renames it in place which
+// replaces any version partially written here.
+//
+// A data directory shared by versions is only removed with the last
+// version referencing it, see xlMetaV2.SharedDataDirCount.
+func (er erasureObjects) dedupVersion(ctx context.Context, bucket, object string, fi FileInfo, opts ObjectOptions) (ObjectInfo, bool) {
+	storageDisks := er.getDisks()
+
+	metaArr, errs := readAllFileInfo(ctx, storageDisks, bucket, object, "", false)
+	readQuorum, writeQuorum, err := objectQuorumFromMeta(ctx, metaArr, errs, er.defaultParityCount)
+	if err != nil {
+		return ObjectInfo{}, false
+	}
+
+	onlineDisks, modTime := listOnlineDisks(storageDisks, metaArr, errs)
+	latest, err := pickValidFileInfo(ctx, metaArr, modTime, readQuorum)
+	if err != nil || !sameVersionData(latest, fi) {
+		return ObjectInfo{}, false
+	}
+
+	filterOnlineDisksInplace(latest, metaArr, onlineDisks)
+	onlineDisks, metaArr = shuffleDisksAndPartsMetadataByIndex(onlineDisks, metaArr, latest)
+
+	// Each drive keeps the shard and bitrot checksums of the latest version.
+	files := make([]FileInfo, len(metaArr))
+	for i := range metaArr {
+		if onlineDisks[i] == nil {
+			continue
+		}
+		files[i] = fi
+		files[i].DataDir = latest.DataDir
+		files[i].Erasure = metaArr[i].Erasure
+		files[i].Parts = metaArr[i].Parts
+		files[i].Data = nil
+	}
+
+	onlineDisks, err = writeUniqueFileInfo(ctx, onlineDisks, bucket, object, files, writeQuorum)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return ObjectInfo{}, false
+	}
+
+	fi.DataDir = latest.DataDir
+	fi.Erasure = latest.Erasure
+	fi.Parts = latest.Parts
+	for _, disk := range onlineDisks {
+		if disk == nil || !disk.IsOnline() {
+			er.addPartial(bucket, object, fi.VersionID, fi.Size)
+			break
+		}
+	}
+
+	fi.ReplicationState = opts.PutReplicationState()
+
+	// we are adding a new version to this object under the namespace lock, so this is the latest version.
+	fi.IsLatest = true
+
+	return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), true
+}
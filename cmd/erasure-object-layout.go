@@ -0,0 +1,174 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+)
+
+// ObjectLayoutDrive is the shard of an object expected on a drive.
+type ObjectLayoutDrive struct {
+	Endpoint string `json:"endpoint"`
+	// Index is the erasure index of the shard, indexes above the number
+	// of data blocks are parity shards.
+	Index  int    `json:"index"`
+	Parity bool   `json:"parity"`
+	State  string `json:"state"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ObjectLayout reports how the shards of an object version are laid out
+// across the drives of its erasure set.
+type ObjectLayout struct {
+	Bucket       string `json:"bucket"`
+	Object       string `json:"object"`
+	VersionID    string `json:"versionId,omitempty"`
+	DeleteMarker bool   `json:"deleteMarker,omitempty"`
+	Pool         int    `json:"pool"`
+	Set          int    `json:"set"`
+
+	DataBlocks   int    `json:"dataBlocks"`
+	ParityBlocks int    `json:"parityBlocks"`
+	BlockSize    int64  `json:"blockSize"`
+	Distribution []int  `json:"distribution"`
+	DataDir      string `json:"dataDir,omitempty"`
+
+	// ValidShards is the number of drives holding the latest metadata,
+	// the object can be read as long as it is not below ReadQuorum.
+	ValidShards int                 `json:"validShards"`
+	ReadQuorum  int                 `json:"readQuorum"`
+	Drives      []ObjectLayoutDrive `json:"drives"`
+}
+
+// newObjectLayout returns the layout of the latest metadata read from
+// the drives, metaArr and errs. Drives holding older metadata are
+// reported as stale.
+func newObjectLayout(endpoints []string, metaArr []FileInfo, errs []error) (ObjectLayout, error) {
+	// Same as listOnlineDisks, drives holding valid metadata with the
+	// most common modtime hold the latest shards.
+	modTimes := listObjectModtimes(metaArr, errs)
+	modTime := commonTime(modTimes)
+	latest := make([]bool, len(metaArr))
+	var fi FileInfo
+	found := false
+	for i := range metaArr {
+		latest[i] = metaArr[i].IsValid() && modTimes[i].Equal(modTime)
+		if latest[i] && !found {
+			fi, found = metaArr[i], true
+		}
+	}
+	if !found {
+		return ObjectLayout{}, errFileNotFound
+	}
+
+	layout := ObjectLayout{
+		VersionID:    fi.VersionID,
+		DeleteMarker: fi.Deleted,
+		DataBlocks:   fi.Erasure.DataBlocks,
+		ParityBlocks: fi.Erasure.ParityBlocks,
+		BlockSize:    fi.Erasure.BlockSize,
+		Distribution: fi.Erasure.Distribution,
+		DataDir:      fi.DataDir,
+		ReadQuorum:   fi.Erasure.DataBlocks,
+		Drives:       make([]ObjectLayoutDrive, len(metaArr)),
+	}
+	for i := range metaArr {
+		d := &layout.Drives[i]
+		if i < len(endpoints) {
+			d.Endpoint = endpoints[i]
+		}
+		if i < len(fi.Erasure.Distribution) {
+			d.Index = fi.Erasure.Distribution[i]
+			d.Parity = d.Index > fi.Erasure.DataBlocks
+		}
+
+		var err error
+		if i < len(errs) {
+			err = errs[i]
+		}
+		switch {
+		case errors.Is(err, errDiskNotFound):
+			d.State = scrubDriveOffline
+		case errors.Is(err, errFileNotFound), errors.Is(err, errFileVersionNotFound), errors.Is(err, errVolumeNotFound):
+			d.State = scrubDriveMissing
+		case err != nil:
+			d.State = scrubDriveError
+			d.Error = err.Error()
+		case !latest[i]:
+			d.State = scrubDriveStale
+		default:
+			d.State = scrubDriveOK
+			layout.ValidShards++
+		}
+	}
+	return layout, nil
+}
+
+// ObjectLayout returns how the shards of an object version are laid out
+// across the drives of its erasure set. Unlike reading the object it
+// does not require read quorum, to diagnose objects which lost it.
+func (z *erasureServerPools) ObjectLayout(ctx context.Context, bucket, object, versionID string) (ObjectLayout, error) {
+	if err := checkGetObjArgs(ctx, bucket, object); err != nil {
+		return ObjectLayout{}, err
+	}
+
+	object = encodeDirObject(object)
+
+	lk := z.NewNSLock(bucket, object)
+	lkctx, err := lk.GetRLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return ObjectLayout{}, err
+	}
+	ctx = lkctx.Context()
+	defer lk.RUnlock(lkctx)
+
+	for _, pool := range z.serverPools {
+		set := pool.getHashedSet(object)
+
+		var metaArr []FileInfo
+		var errs []error
+		if versionID != "" {
+			metaArr, errs = readAllFileInfo(ctx, set.getDisks(), bucket, object, versionID, false)
+		} else {
+			metaArr, errs = readAllXL(ctx, set.getDisks(), bucket, object, false, false)
+		}
+		if isAllNotFound(errs) {
+			continue
+		}
+
+		endpoints := set.getEndpoints()
+		names := make([]string, len(endpoints))
+		for i := range endpoints {
+			names[i] = endpoints[i].String()
+		}
+		layout, err := newObjectLayout(names, metaArr, errs)
+		if err != nil {
+			return ObjectLayout{}, toObjectErr(err, bucket, decodeDirObject(object), versionID)
+		}
+		layout.Bucket = bucket
+		layout.Object = decodeDirObject(object)
+		layout.Pool = set.poolIndex
+		layout.Set = set.setIndex
+		return layout, nil
+	}
+	if versionID != "" {
+		return ObjectLayout{}, toObjectErr(errFileVersionNotFound, bucket, decodeDirObject(object), versionID)
+	}
+	return ObjectLayout{}, toObjectErr(errFileNotFound, bucket, decodeDirObject(object))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewObjectLayout(t *testing.T) {
+	now := time.Now()
+	endpoints := []string{"d0", "d1", "d2", "d3"}
+	distribution := []int{2, 3, 4, 1}
+	metaArr := make([]FileInfo, len(endpoints))
+	for i := range metaArr {
+		fi := newFileInfo("object", 2, 2)
+		fi.Erasure.Distribution = distribution
+		fi.Erasure.Index = distribution[i]
+		fi.ModTime = now
+		fi.DataDir = "datadir"
+		metaArr[i] = fi
+	}
+	// An older write on d2.
+	metaArr[2].ModTime = now.Add(-time.Hour)
+	errs := []error{nil, nil, nil, errDiskNotFound}
+
+	layout, err := newObjectLayout(endpoints, metaArr, errs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if layout.DataBlocks != 2 || layout.ParityBlocks != 2 || layout.DataDir != "datadir" || layout.ReadQuorum != 2 {
+		t.Fatalf("unexpected layout %+v", layout)
+	}
+	if layout.ValidShards != 2 {
+		t.Fatalf("expected 2 valid shards, got %d", layout.ValidShards)
+	}
+	wantStates := []string{scrubDriveOK, scrubDriveOK, scrubDriveStale, scrubDriveOffline}
+	for i, d := range layout.Drives {
+		if d.Endpoint != endpoints[i] || d.Index != distribution[i] || d.State != wantStates[i] {
+			t.Fatalf("unexpected drive %d: %+v", i, d)
+		}
+		if d.Parity != (distribution[i] > 2) {
+			t.Fatalf("unexpected parity of drive %d: %+v", i, d)
+		}
+	}
+
+	// No drive holds the object.
+	_, err = newObjectLayout(endpoints, make([]FileInfo, len(endpoints)), []error{errFileNotFound, errFileNotFound, errDiskNotFound, errFileNotFound})
+	if !errors.Is(err, errFileNotFound) {
+		t.Fatalf("expected file not found, got %v", err)
+	}
+}
@@ -20,6 +20,8 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -61,6 +63,15 @@ func countOnlineDisks(onlineDisks []StorageAPI) (online int) {
 	return online
 }
 
+// allOnlineWriteQuorum returns the write quorum of a write which must
+// land on all the online disks, never lower than writeQuorum.
+func allOnlineWriteQuorum(disks []StorageAPI, writeQuorum int) int {
+	if online := countOnlineDisks(disks); online > writeQuorum {
+		return online
+	}
+	return writeQuorum
+}
+
 // CopyObject - copy object source object to destination object.
 // if source object and destination object are same we only
 // update metadata.
@@ -139,9 +150,24 @@ func (er erasureObjects) CopyObject(ctx context.Context, srcBucket, srcObject, d
 		modTime = dstOpts.MTime
 		fi.ModTime = dstOpts.MTime
 	}
+	prevMetadata := fi.Metadata
 	fi.Metadata = srcInfo.UserDefined
 	srcInfo.UserDefined["etag"] = srcInfo.ETag
 
+	// When no new version is created, e.g. only tags are replaced,
+	// patch the version and its modification time in place, unless
+	// metadata keys are removed.
+	if !srcInfo.versionOnly {
+		if updates, ok := metadataUpdates(prevMetadata, srcInfo.UserDefined); ok {
+			ufi := fi
+			ufi.Metadata = updates
+			if err = er.updateObjectMeta(ctx, srcBucket, srcObject, ufi, onlineDisks, writeQuorum); err != nil {
+				return oi, toObjectErr(err, srcBucket, srcObject)
+			}
+			return fi.ToObjectInfo(srcBucket, srcObject, srcOpts.Versioned || srcOpts.VersionSuspended), nil
+		}
+	}
+
 	inlineData := fi.InlineData()
 	freeVersionID := fi.TierFreeVersionID()
 	freeVersionMarker := fi.TierFreeVersion()
@@ -334,6 +360,9 @@ func (er erasureObjects) getObjectWithFileInfo(ctx context.Context, bucket, obje
 		return toObjectErr(err, bucket, object)
 	}
 
+	// Decoding holds up to one shard per drive in memory.
+	defer globalObjectBufferStats.track(int64(len(onlineDisks)) * erasure.ShardSize())()
+
 	var healOnce sync.Once
 
 	// once we have obtained a common FileInfo i.e latest, we should stick
@@ -377,7 +406,9 @@ func (er erasureObjects) getObjectWithFileInfo(ctx context.Context, bucket, obje
 			prefer[index] = disk.Hostname() == ""
 		}
 
+		done := requestTimingPhase(ctx, requestPhaseData)
 		written, err := erasure.Decode(ctx, writer, readers, partOffset, partLength, partSize, prefer)
+		done()
 		// Note: we should not be defer'ing the following closeBitrotReaders() call as
 		// we are inside a for loop i.e if we use defer, we would accumulate a lot of open files by the time
 		// we return from this function.
@@ -398,7 +429,7 @@ func (er erasureObjects) getObjectWithFileInfo(ctx context.Context, bucket, obje
 				switch scan {
 				case madmin.HealNormalScan, madmin.HealDeepScan:
 					healOnce.Do(func() {
-						if _, healing := er.getOnlineDisksWithHealing(); !healing {
+						if _, healing := er.getOnlineDisksWithHealing(); !healing && !isBucketEphemeral(bucket) {
 							go healObject(bucket, object, fi.VersionID, scan)
 						}
 					})
@@ -466,6 +497,15 @@ func (er erasureObjects) deleteIfDangling(ctx context.Context, bucket, object st
 	_, file, line, cok := runtime.Caller(1)
 	var err error
 	m, ok := isObjectDangling(metaArr, errs, dataErrs)
+	if ok && globalQuarantineSys.skip(ctx, quarantineSkipDangling, bucket, object, m.VersionID) {
+		return m, errErasureReadQuorum
+	}
+	if ok && enforceRetentionForDeletion(ctx, m.ToObjectInfo(bucket, object, false)) {
+		// Quorum alone does not override object lock, the version
+		// must persist until its retention or legal hold is lifted.
+		logger.LogIf(ctx, fmt.Errorf("skipping deletion of dangling object %s/%s (%s) under object lock", bucket, object, m.VersionID))
+		return m, errErasureReadQuorum
+	}
 	if ok {
 		tags := make(map[string]interface{}, 4)
 		tags["set"] = er.setIndex
@@ -506,10 +546,11 @@ func (er erasureObjects) deleteIfDangling(ctx context.Context, bucket, object st
 	return m, err
 }
 
-func readAllXL(ctx context.Context, disks []StorageAPI, bucket, object string, readData, inclFreeVers bool) ([]FileInfo, []error) {
+// readAllRawXL reads and decodes `xl.meta` for bucket/object from all
+// disks in parallel, recording the disk modtime of each entry read.
+func readAllRawXL(ctx context.Context, disks []StorageAPI, bucket, object string, readData bool) ([]*xlMetaV2, []FileInfo, []error) {
 	metadataArray := make([]*xlMetaV2, len(disks))
 	metaFileInfos := make([]FileInfo, len(metadataArray))
-	metadataShallowVersions := make([][]xlMetaV2ShallowVersion, len(disks))
 
 	g := errgroup.WithNErrs(len(disks))
 	// Read `xl.meta` in parallel across disks.
@@ -563,6 +604,39 @@ func readAllXL(ctx context.Context, disks []StorageAPI, bucket, object string, r
 		}
 	}
 
+	return metadataArray, metaFileInfos, errs
+}
+
+// readAllVersionsXL returns every version of bucket/object, including free
+// versions, merged across disks with read quorum and sorted newest first.
+func readAllVersionsXL(ctx context.Context, disks []StorageAPI, bucket, object string) ([]FileInfo, error) {
+	metadataArray, _, errs := readAllRawXL(ctx, disks, bucket, object, false)
+
+	readQuorum := (len(disks) + 1) / 2
+	if err := reduceReadQuorumErrs(ctx, errs, objectOpIgnoredErrs, readQuorum); err != nil {
+		return nil, err
+	}
+
+	metadataShallowVersions := make([][]xlMetaV2ShallowVersion, 0, len(disks))
+	for index := range metadataArray {
+		if metadataArray[index] != nil {
+			metadataShallowVersions = append(metadataShallowVersions, metadataArray[index].versions)
+		}
+	}
+
+	meta := &xlMetaV2{versions: mergeXLV2Versions(readQuorum, false, 0, metadataShallowVersions...)}
+	if len(meta.versions) == 0 {
+		return nil, errFileNotFound
+	}
+	return meta.ListVersions(bucket, object)
+}
+
+func readAllXL(ctx context.Context, disks []StorageAPI, bucket, object string, readData, inclFreeVers bool) ([]FileInfo, []error) {
+	defer requestTimingPhase(ctx, requestPhaseMetadata)()
+
+	metadataArray, metaFileInfos, errs := readAllRawXL(ctx, disks, bucket, object, readData)
+	metadataShallowVersions := make([][]xlMetaV2ShallowVersion, len(disks))
+
 	for index := range metadataArray {
 		if metadataArray[index] != nil {
 			metadataShallowVersions[index] = metadataArray[index].versions
@@ -617,6 +691,14 @@ func readAllXL(ctx context.Context, disks []StorageAPI, bucket, object string, r
 }
 
 func (er erasureObjects) getObjectFileInfo(ctx context.Context, bucket, object string, opts ObjectOptions, readData bool) (fi FileInfo, metaArr []FileInfo, onlineDisks []StorageAPI, err error) {
+	fi, metaArr, onlineDisks, _, err = er.getObjectFileInfoAndScrub(ctx, bucket, object, opts, readData)
+	return fi, metaArr, onlineDisks, err
+}
+
+// getObjectFileInfoAndScrub - same as getObjectFileInfo, additionally
+// reports how the metadata agrees across the drives if opts.MetadataScrub
+// is set.
+func (er erasureObjects) getObjectFileInfoAndScrub(ctx context.Context, bucket, object string, opts ObjectOptions, readData bool) (fi FileInfo, metaArr []FileInfo, onlineDisks []StorageAPI, scrub *ObjectMetadataScrub, err error) {
 	disks := er.getDisks()
 
 	var errs []error
@@ -636,7 +718,7 @@ func (er erasureObjects) getObjectFileInfo(ctx context.Context, bucket, object s
 				err = derr
 			}
 		}
-		return fi, nil, nil, toObjectErr(err, bucket, object)
+		return fi, nil, nil, nil, toObjectErr(err, bucket, object)
 	}
 
 	if reducedErr := reduceReadQuorumErrs(ctx, errs, objectOpIgnoredErrs, readQuorum); reducedErr != nil {
@@ -646,7 +728,7 @@ func (er erasureObjects) getObjectFileInfo(ctx context.Context, bucket, object s
 				reducedErr = derr
 			}
 		}
-		return fi, nil, nil, toObjectErr(reducedErr, bucket, object)
+		return fi, nil, nil, nil, toObjectErr(reducedErr, bucket, object)
 	}
 
 	// List all online disks.
@@ -655,14 +737,19 @@ func (er erasureObjects) getObjectFileInfo(ctx context.Context, bucket, object s
 	// Pick latest valid metadata.
 	fi, err = pickValidFileInfo(ctx, metaArr, modTime, readQuorum)
 	if err != nil {
-		return fi, nil, nil, err
+		return fi, nil, nil, nil, err
+	}
+
+	// Report before the metadata of outdated drives is dropped below.
+	if opts.MetadataScrub {
+		scrub = newObjectMetadataScrub(er.getEndpoints(), metaArr, errs, fi, readQuorum)
 	}
 
 	if !fi.Deleted && len(fi.Erasure.Distribution) != len(onlineDisks) {
 		err := fmt.Errorf("unexpected file distribution (%v) from online disks (%v), looks like backend disks have been manually modified refusing to heal %s/%s(%s)",
 			fi.Erasure.Distribution, onlineDisks, bucket, object, opts.VersionID)
 		logger.LogIf(ctx, err)
-		return fi, nil, nil, toObjectErr(err, bucket, object, opts.VersionID)
+		return fi, nil, nil, nil, toObjectErr(err, bucket, object, opts.VersionID)
 	}
 
 	filterOnlineDisksInplace(fi, metaArr, onlineDisks)
@@ -670,7 +757,7 @@ func (er erasureObjects) getObjectFileInfo(ctx context.Context, bucket, object s
 	// if one of the disk is offline, return right here no need
 	// to attempt a heal on the object.
 	if countErrs(errs, errDiskNotFound) > 0 {
-		return fi, metaArr, onlineDisks, nil
+		return fi, metaArr, onlineDisks, scrub, nil
 	}
 
 	var missingBlocks int
@@ -691,21 +778,22 @@ func (er erasureObjects) getObjectFileInfo(ctx context.Context, bucket, object s
 	// additionally do not heal delete markers inline, let them be
 	// healed upon regular heal process.
 	if !fi.Deleted && missingBlocks > 0 && missingBlocks < readQuorum {
-		if _, healing := er.getOnlineDisksWithHealing(); !healing {
+		if _, healing := er.getOnlineDisksWithHealing(); !healing && !isBucketEphemeral(bucket) {
 			go healObject(bucket, object, fi.VersionID, madmin.HealNormalScan)
 		}
 	}
 
-	return fi, metaArr, onlineDisks, nil
+	return fi, metaArr, onlineDisks, scrub, nil
 }
 
 // getObjectInfo - wrapper for reading object metadata and constructs ObjectInfo.
 func (er erasureObjects) getObjectInfo(ctx context.Context, bucket, object string, opts ObjectOptions) (objInfo ObjectInfo, err error) {
-	fi, _, _, err := er.getObjectFileInfo(ctx, bucket, object, opts, false)
+	fi, _, _, scrub, err := er.getObjectFileInfoAndScrub(ctx, bucket, object, opts, false)
 	if err != nil {
 		return objInfo, toObjectErr(err, bucket, object)
 	}
 	objInfo = fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended)
+	objInfo.MetadataScrub = scrub
 	if fi.Deleted {
 		if opts.VersionID == "" || opts.DeleteMarker {
 			return objInfo, toObjectErr(errFileNotFound, bucket, object)
@@ -814,7 +902,7 @@ func (er erasureObjects) putMetacacheObject(ctx context.Context, key string, r *
 
 	storageDisks := er.getDisks()
 	// Get parity and data drive count based on storage class metadata
-	parityDrives := globalStorageClass.GetParityForSC(opts.UserDefined[xhttp.AmzStorageClass])
+	parityDrives := globalStorageClass.GetParityForPoolSC(er.poolIndex, opts.UserDefined[xhttp.AmzStorageClass])
 	if parityDrives < 0 {
 		parityDrives = er.defaultParityCount
 	}
@@ -992,10 +1080,82 @@ func healObjectVersionsDisparity(bucket string, entry metaCacheEntry) error {
 	return nil
 }
 
+// objectParityDrives returns the parity a new object of the storage class
+// is written with, upgraded by the number of offline disks, along with
+// the parity configured for the storage class.
+func (er erasureObjects) objectParityDrives(ctx context.Context, storageDisks []StorageAPI, storageClass string) (parityDrives, parityOrig int) {
+	// Get parity and data drive count based on storage class metadata
+	parityDrives = globalStorageClass.GetParityForPoolSC(er.poolIndex, storageClass)
+	if parityDrives < 0 {
+		parityDrives = er.defaultParityCount
+	}
+
+	// If we have offline disks upgrade the number of erasure codes for this object.
+	parityOrig = parityDrives
+
+	atomicParityDrives := uatomic.NewInt64(0)
+	// Start with current parityDrives
+	atomicParityDrives.Store(int64(parityDrives))
+
+	var wg sync.WaitGroup
+	for _, disk := range storageDisks {
+		if disk == nil {
+			atomicParityDrives.Inc()
+			continue
+		}
+		if !disk.IsOnline() {
+			atomicParityDrives.Inc()
+			continue
+		}
+		wg.Add(1)
+		go func(disk StorageAPI) {
+			defer wg.Done()
+			di, err := disk.DiskInfo(ctx)
+			if err != nil || di.ID == "" {
+				atomicParityDrives.Inc()
+			}
+		}(disk)
+	}
+	wg.Wait()
+
+	parityDrives = int(atomicParityDrives.Load())
+	if parityDrives >= len(storageDisks)/2 {
+		parityDrives = len(storageDisks) / 2
+	}
+	return parityDrives, parityOrig
+}
+
+// skipIdentical returns the latest version of the object and true if
+// opts.SkipIdenticalFn finds it identical to the incoming PUT. The read
+// lock is only held for the comparison, a PUT which is not skipped is
+// written like any other, a concurrent write is ordered after a skipped
+// one.
+func (er erasureObjects) skipIdentical(ctx context.Context, bucket, object string, opts ObjectOptions) (ObjectInfo, bool, error) {
+	if !opts.NoLock {
+		lk := er.NewNSLock(bucket, object)
+		lkctx, err := lk.GetRLock(ctx, globalOperationTimeout)
+		if err != nil {
+			return ObjectInfo{}, false, err
+		}
+		ctx = lkctx.Context()
+		defer lk.RUnlock(lkctx)
+		opts.NoLock = true
+	}
+	obj, err := er.getObjectInfo(ctx, bucket, object, opts)
+	if err != nil || !opts.SkipIdenticalFn(obj) {
+		return ObjectInfo{}, false, nil
+	}
+	return obj, true, nil
+}
+
 // putObject wrapper for erasureObjects PutObject
 func (er erasureObjects) putObject(ctx context.Context, bucket string, object string, r *PutObjReader, opts ObjectOptions) (objInfo ObjectInfo, err error) {
 	auditObjectErasureSet(ctx, object, &er)
 
+	if opts.Append.Enabled {
+		return er.appendObject(ctx, bucket, object, r, opts)
+	}
+
 	if opts.CheckPrecondFn != nil {
 		obj, err := er.getObjectInfo(ctx, bucket, object, opts)
 		if err != nil && !isErrVersionNotFound(err) {
@@ -1006,52 +1166,33 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		}
 	}
 
+	if opts.SkipIdenticalFn != nil {
+		obj, skip, err := er.skipIdentical(ctx, bucket, object, opts)
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		if skip {
+			return obj, nil
+		}
+	}
+
 	data := r.Reader
 
 	userDefined := cloneMSS(opts.UserDefined)
+	if err = preserveClientMetadata(userDefined, opts); err != nil {
+		return ObjectInfo{}, toObjectErr(err, bucket, object)
+	}
+	if opts.ContentHash != "" {
+		userDefined[contentHashKey] = opts.ContentHash
+	}
+	appendable := isAppendObject(userDefined)
 
 	storageDisks := er.getDisks()
 
 	parityDrives := len(storageDisks) / 2
 	if !opts.MaxParity {
-		// Get parity and data drive count based on storage class metadata
-		parityDrives = globalStorageClass.GetParityForSC(userDefined[xhttp.AmzStorageClass])
-		if parityDrives < 0 {
-			parityDrives = er.defaultParityCount
-		}
-
-		// If we have offline disks upgrade the number of erasure codes for this object.
-		parityOrig := parityDrives
-
-		atomicParityDrives := uatomic.NewInt64(0)
-		// Start with current parityDrives
-		atomicParityDrives.Store(int64(parityDrives))
-
-		var wg sync.WaitGroup
-		for _, disk := range storageDisks {
-			if disk == nil {
-				atomicParityDrives.Inc()
-				continue
-			}
-			if !disk.IsOnline() {
-				atomicParityDrives.Inc()
-				continue
-			}
-			wg.Add(1)
-			go func(disk StorageAPI) {
-				defer wg.Done()
-				di, err := disk.DiskInfo(ctx)
-				if err != nil || di.ID == "" {
-					atomicParityDrives.Inc()
-				}
-			}(disk)
-		}
-		wg.Wait()
-
-		parityDrives = int(atomicParityDrives.Load())
-		if parityDrives >= len(storageDisks)/2 {
-			parityDrives = len(storageDisks) / 2
-		}
+		var parityOrig int
+		parityDrives, parityOrig = er.objectParityDrives(ctx, storageDisks, userDefined[xhttp.AmzStorageClass])
 		if parityOrig != parityDrives {
 			userDefined[minIOErasureUpgraded] = strconv.Itoa(parityOrig) + "->" + strconv.Itoa(parityDrives)
 		}
@@ -1071,6 +1212,11 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		return ObjectInfo{}, toObjectErr(errInvalidArgument)
 	}
 
+	// A caller provided data directory must be a well-formed UUID.
+	if opts.DataDir != "" && !isValidDataDir(opts.DataDir) {
+		return ObjectInfo{}, toObjectErr(errInvalidArgument)
+	}
+
 	// Initialize parts metadata
 	partsMetadata := make([]FileInfo, len(storageDisks))
 
@@ -1080,7 +1226,10 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		fi.VersionID = mustGetUUID()
 	}
 
-	fi.DataDir = mustGetUUID()
+	fi.DataDir = opts.DataDir
+	if fi.DataDir == "" {
+		fi.DataDir = mustGetUUID()
+	}
 	fi.Checksum = opts.WantChecksum.AppendTo(nil)
 	if opts.EncryptFn != nil {
 		fi.Checksum = opts.EncryptFn("object-checksum", fi.Checksum)
@@ -1097,6 +1246,12 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 	var onlineDisks []StorageAPI
 	onlineDisks, partsMetadata = shuffleDisksAndPartsMetadata(storageDisks, partsMetadata, fi)
 
+	if opts.WriteAllOnline {
+		// Both the data written and the rename of the object must
+		// succeed on every drive online now.
+		writeQuorum = allOnlineWriteQuorum(onlineDisks, writeQuorum)
+	}
+
 	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
 	if err != nil {
 		return ObjectInfo{}, toObjectErr(err, bucket, object)
@@ -1114,6 +1269,7 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		// No need to allocate fully blockSizeV1 buffer if the incoming data is smaller.
 		buffer = make([]byte, size, 2*size+int64(fi.Erasure.ParityBlocks+fi.Erasure.DataBlocks-1))
 	}
+	defer globalObjectBufferStats.track(int64(cap(buffer)))()
 
 	if len(buffer) > int(fi.Erasure.BlockSize) {
 		buffer = buffer[:fi.Erasure.BlockSize]
@@ -1151,6 +1307,24 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 			}
 		}
 	}
+	if appendable {
+		// Segments appended later are stored next to this one, never inline.
+		inlineBuffers = nil
+	}
+
+	// The SHA-256 of the data written identifies versions which can share it.
+	dedup := len(inlineBuffers) == 0 && !appendable && canDedupVersion(bucket, opts, userDefined)
+	dedupHash := sha256.New()
+
+	// Large objects can be split into parts erasure coded in parallel,
+	// unless the data is transformed or must be stored as a single part.
+	var chunkSize int64
+	var chunkWorkers int
+	if len(inlineBuffers) == 0 && !appendable && !dedup && opts.IndexCB == nil && data.Size() == data.ActualSize() {
+		chunkSize, chunkWorkers = encodeChunkSize(data.Size(), fi.Erasure.BlockSize)
+	}
+
+	var inlineSize int64
 	for i, disk := range onlineDisks {
 		if disk == nil {
 			continue
@@ -1166,29 +1340,53 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 				sz = data.ActualSize()
 			}
 			inlineBuffers[i] = bytes.NewBuffer(make([]byte, 0, sz))
+			inlineSize += sz
 			writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], DefaultBitrotAlgorithm, erasure.ShardSize())
 			continue
 		}
 
+		if chunkSize > 0 {
+			// The writers of every part are created by encodeChunks.
+			continue
+		}
+
 		writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, tempErasureObj, shardFileSize, DefaultBitrotAlgorithm, erasure.ShardSize())
 	}
+	defer globalObjectBufferStats.track(inlineSize)()
 
 	toEncode := io.Reader(data)
-	if data.Size() > bigFileThreshold {
+	if dedup {
+		toEncode = io.TeeReader(data, dedupHash)
+	}
+	if data.Size() > bigFileThreshold && chunkSize == 0 {
 		// We use 2 buffers, so we always have a full buffer of input.
 		bufA := er.bp.Get()
 		bufB := er.bp.Get()
 		defer er.bp.Put(bufA)
 		defer er.bp.Put(bufB)
-		ra, err := readahead.NewReaderBuffer(data, [][]byte{bufA[:fi.Erasure.BlockSize], bufB[:fi.Erasure.BlockSize]})
+		defer globalObjectBufferStats.track(int64(cap(bufA) + cap(bufB)))()
+		ra, err := readahead.NewReaderBuffer(toEncode, [][]byte{bufA[:fi.Erasure.BlockSize], bufB[:fi.Erasure.BlockSize]})
 		if err == nil {
 			toEncode = ra
 			defer ra.Close()
 		}
 		logger.LogIf(ctx, err)
 	}
-	n, erasureErr := erasure.Encode(ctx, toEncode, writers, buffer, writeQuorum)
-	closeBitrotWriters(writers)
+	done := requestTimingPhase(ctx, requestPhaseData)
+	var n int64
+	var erasureErr error
+	var chunks []encodedChunk
+	if chunkSize > 0 {
+		chunks, n, erasureErr = er.encodeChunks(ctx, toEncode, data.Size(), chunkSize, chunkWorkers,
+			erasure, onlineDisks, pathJoin(uniqueID, fi.DataDir), writeQuorum)
+		if len(chunks) > 0 {
+			copy(writers, chunks[0].writers)
+		}
+	} else {
+		n, erasureErr = erasure.Encode(ctx, toEncode, writers, buffer, writeQuorum)
+		closeBitrotWriters(writers)
+	}
+	done()
 	if erasureErr != nil {
 		return ObjectInfo{}, toObjectErr(erasureErr, minioMetaTmpBucket, tempErasureObj)
 	}
@@ -1218,6 +1416,12 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		modTime = UTCNow()
 	}
 
+	// The ETag of appended objects is computed from the ETag of their segments.
+	var partETag string
+	if appendable {
+		partETag = r.MD5CurrentHexString()
+	}
+
 	for i, w := range writers {
 		if w == nil {
 			onlineDisks[i] = nil
@@ -1228,8 +1432,19 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		} else {
 			partsMetadata[i].Data = nil
 		}
+		if len(chunks) > 0 {
+			for c, chunk := range chunks {
+				partsMetadata[i].AddObjectPart(c+1, "", chunk.size, chunk.size, modTime, nil, nil)
+				partsMetadata[i].Erasure.AddChecksumInfo(ChecksumInfo{
+					PartNumber: c + 1,
+					Algorithm:  DefaultBitrotAlgorithm,
+					Hash:       bitrotWriterSum(chunk.writers[i]),
+				})
+			}
+			continue
+		}
 		// No need to add checksum to part. We already have it on the object.
-		partsMetadata[i].AddObjectPart(1, "", n, data.ActualSize(), modTime, compIndex, nil)
+		partsMetadata[i].AddObjectPart(1, partETag, n, data.ActualSize(), modTime, compIndex, nil)
 		partsMetadata[i].Erasure.AddChecksumInfo(ChecksumInfo{
 			PartNumber: 1,
 			Algorithm:  DefaultBitrotAlgorithm,
@@ -1247,12 +1462,17 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		userDefined["content-type"] = mimedb.TypeByExtension(path.Ext(object))
 	}
 
+	if dedup {
+		userDefined[contentSHA256Key] = hex.EncodeToString(dedupHash.Sum(nil))
+	}
+
 	// Fill all the necessary metadata.
 	// Update `xl.meta` content on each disks.
 	for index := range partsMetadata {
 		partsMetadata[index].Metadata = userDefined
 		partsMetadata[index].Size = n
 		partsMetadata[index].ModTime = modTime
+		partsMetadata[index].Durable = opts.Durable
 	}
 
 	if len(inlineBuffers) > 0 {
@@ -1262,6 +1482,19 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		}
 	}
 
+	if dedup {
+		dfi := fi
+		dfi.Metadata = userDefined
+		dfi.Size = n
+		dfi.ModTime = modTime
+		dfi.Durable = opts.Durable
+		// The temporary object is deleted on return, the latest
+		// version already holds the same data.
+		if objInfo, ok := er.dedupVersion(ctx, bucket, object, dfi, opts); ok {
+			return objInfo, nil
+		}
+	}
+
 	// Rename the successfully written temporary object to final location.
 	onlineDisks, versionsDisparity, err := renameData(ctx, onlineDisks, minioMetaTmpBucket, tempObj, partsMetadata, bucket, object, writeQuorum)
 	if err != nil {
@@ -1483,6 +1716,8 @@ func (er erasureObjects) DeleteObjects(ctx context.Context, bucket string, objec
 			continue
 		}
 
+		globalBucketConnStats.incDeletes(bucket, dobj.DeleteMarker)
+
 		// Check if there is any offline disk and add it to the MRF list
 		for _, disk := range storageDisks {
 			if disk != nil && disk.IsOnline() {
@@ -1581,6 +1816,22 @@ func (er erasureObjects) DeleteObject(ctx context.Context, bucket, object string
 		}
 	}
 
+	if opts.DeleteIfLatest && opts.VersionID != "" {
+		// Callers hold the namespace write lock, the latest version read
+		// here cannot change until the delete below has been committed.
+		fi, _, _, err := er.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+		if err != nil {
+			return objInfo, toObjectErr(err, bucket, object)
+		}
+		latestVersionID := fi.VersionID
+		if latestVersionID == "" {
+			latestVersionID = nullVersionID
+		}
+		if latestVersionID != opts.VersionID {
+			return objInfo, PreConditionFailed{}
+		}
+	}
+
 	if opts.Expiration.Expire {
 		if gerr == nil {
 			evt := evalActionFromLifecycle(ctx, *lc, rcfg, goi)
@@ -1676,6 +1927,7 @@ func (er erasureObjects) DeleteObject(ctx context.Context, bucket, object string
 		if err = er.deleteObjectVersion(ctx, bucket, object, fi, opts.DeleteMarker); err != nil {
 			return objInfo, toObjectErr(err, bucket, object)
 		}
+		globalBucketConnStats.incDeletes(bucket, fi.Deleted)
 		return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), nil
 	}
 
@@ -1694,6 +1946,7 @@ func (er erasureObjects) DeleteObject(ctx context.Context, bucket, object string
 	if err = er.deleteObjectVersion(ctx, bucket, object, dfi, opts.DeleteMarker); err != nil {
 		return objInfo, toObjectErr(err, bucket, object)
 	}
+	globalBucketConnStats.incDeletes(bucket, dfi.Deleted)
 
 	for _, disk := range storageDisks {
 		if disk != nil && disk.IsOnline() {
@@ -1707,8 +1960,11 @@ func (er erasureObjects) DeleteObject(ctx context.Context, bucket, object string
 }
 
 // Send the successful but partial upload/delete, however ignore
-// if the channel is blocked by other items.
+// if the channel is blocked by other items or the bucket is ephemeral.
 func (er erasureObjects) addPartial(bucket, object, versionID string, size int64) {
+	if isBucketEphemeral(bucket) {
+		return
+	}
 	globalMRFState.addPartialOp(partialOperation{
 		bucket:    bucket,
 		object:    object,
@@ -1786,7 +2042,7 @@ func (er erasureObjects) PutObjectMetadata(ctx context.Context, bucket, object s
 	fi.ModTime = opts.MTime
 	fi.VersionID = opts.VersionID
 
-	if err = er.updateObjectMeta(ctx, bucket, object, fi, onlineDisks); err != nil {
+	if err = er.updateObjectMeta(ctx, bucket, object, fi, onlineDisks, er.defaultWQuorum()); err != nil {
 		return ObjectInfo{}, toObjectErr(err, bucket, object)
 	}
 
@@ -1850,16 +2106,18 @@ func (er erasureObjects) PutObjectTags(ctx context.Context, bucket, object strin
 		fi.Metadata[k] = v
 	}
 
-	if err = er.updateObjectMeta(ctx, bucket, object, fi, onlineDisks); err != nil {
+	if err = er.updateObjectMeta(ctx, bucket, object, fi, onlineDisks, er.defaultWQuorum()); err != nil {
 		return ObjectInfo{}, toObjectErr(err, bucket, object)
 	}
 
 	return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), nil
 }
 
-// updateObjectMeta will update the metadata of a file.
-func (er erasureObjects) updateObjectMeta(ctx context.Context, bucket, object string, fi FileInfo, onlineDisks []StorageAPI) error {
-	if len(fi.Metadata) == 0 {
+// updateObjectMeta will update the metadata and the modification time,
+// if set, of a version of a file. Only the updated version is re-encoded,
+// disks whose version is already up to date don't rewrite xl.meta.
+func (er erasureObjects) updateObjectMeta(ctx context.Context, bucket, object string, fi FileInfo, onlineDisks []StorageAPI, writeQuorum int) error {
+	if len(fi.Metadata) == 0 && fi.ModTime.IsZero() {
 		return nil
 	}
 
@@ -1879,7 +2137,29 @@ func (er erasureObjects) updateObjectMeta(ctx context.Context, bucket, object st
 	// Wait for all the routines.
 	mErrs := g.Wait()
 
-	return reduceWriteQuorumErrs(ctx, mErrs, objectOpIgnoredErrs, er.defaultWQuorum())
+	return reduceWriteQuorumErrs(ctx, mErrs, objectOpIgnoredErrs, writeQuorum)
+}
+
+// metadataUpdates returns the metadata keys which are new or have
+// changed compared to prev, false if keys of prev were removed.
+func metadataUpdates(prev, metadata map[string]string) (map[string]string, bool) {
+	for k := range prev {
+		if _, ok := metadata[k]; !ok {
+			return nil, false
+		}
+	}
+	updates := make(map[string]string)
+	for k, v := range metadata {
+		switch k {
+		case ReservedMetadataPrefixLower + tierFVID, ReservedMetadataPrefixLower + tierFVMarker:
+			// only used for creating free-version.
+			continue
+		}
+		if cur, ok := prev[k]; !ok || cur != v {
+			updates[k] = v
+		}
+	}
+	return updates, true
 }
 
 // DeleteObjectTags - delete object tags from an existing object
@@ -1905,14 +2185,42 @@ func (er erasureObjects) TransitionObject(ctx context.Context, bucket, object st
 		return err
 	}
 
-	// Acquire write lock before starting to transition the object.
+	// Acquire write lock before starting to transition the object. With
+	// the api transition_reads set to local, the object is copied to the
+	// tier under a read lock instead, so that reads in the meantime are
+	// served from the local copy, switchLock then swaps it for the write
+	// lock to remove the local copy.
+	var switchLock func() (context.Context, error)
+	parentCtx := ctx
 	lk := er.NewNSLock(bucket, object)
-	lkctx, err := lk.GetLock(ctx, globalDeleteOperationTimeout)
-	if err != nil {
-		return err
+	if globalAPIConfig.isTransitionReadsLocal() {
+		lkctx, err := lk.GetRLock(ctx, globalDeleteOperationTimeout)
+		if err != nil {
+			return err
+		}
+		unlock := func() { lk.RUnlock(lkctx) }
+		defer func() { unlock() }()
+
+		ctx = lkctx.Context()
+		switchLock = func() (context.Context, error) {
+			unlock()
+			unlock = func() {}
+			wlk := er.NewNSLock(bucket, object)
+			wlkctx, err := wlk.GetLock(parentCtx, globalDeleteOperationTimeout)
+			if err != nil {
+				return nil, err
+			}
+			unlock = func() { wlk.Unlock(wlkctx) }
+			return wlkctx.Context(), nil
+		}
+	} else {
+		lkctx, err := lk.GetLock(ctx, globalDeleteOperationTimeout)
+		if err != nil {
+			return err
+		}
+		ctx = lkctx.Context()
+		defer lk.Unlock(lkctx)
 	}
-	ctx = lkctx.Context()
-	defer lk.Unlock(lkctx)
 
 	fi, metaArr, onlineDisks, err := er.getObjectFileInfo(ctx, bucket, object, opts, true)
 	if err != nil {
@@ -1935,6 +2243,13 @@ func (er erasureObjects) TransitionObject(ctx context.Context, bucket, object st
 	}
 
 	if fi.XLV1 {
+		// Migrating the object needs the write lock.
+		if switchLock != nil {
+			if ctx, err = switchLock(); err != nil {
+				return err
+			}
+			switchLock = nil
+		}
 		if _, err = er.HealObject(ctx, bucket, object, "", madmin.HealOpts{NoLock: true}); err != nil {
 			return err
 		}
@@ -1965,6 +2280,33 @@ func (er erasureObjects) TransitionObject(ctx context.Context, bucket, object st
 		logger.LogIf(ctx, fmt.Errorf("Unable to transition %s/%s(%s) to %s tier: %w", bucket, object, opts.VersionID, opts.Transition.Tier, err))
 		return err
 	}
+
+	if switchLock != nil {
+		// Wait for the reads of the local copy to complete, the object
+		// may have been changed in the meantime.
+		wctx, err := switchLock()
+		if err == nil {
+			ctx = wctx
+			var cfi FileInfo
+			cfi, _, _, err = er.getObjectFileInfo(ctx, bucket, object, opts, false)
+			switch {
+			case err != nil:
+			case cfi.Deleted || !cfi.ModTime.Equal(fi.ModTime) || cfi.DataDir != fi.DataDir ||
+				cfi.TransitionStatus == lifecycle.TransitionComplete:
+				err = errFileNotFound
+			default:
+				// Keep the metadata updated in the meantime, such
+				// as tags.
+				cfi.Data = nil
+				fi = cfi
+			}
+		}
+		if err != nil {
+			logger.LogIf(parentCtx, tgtClient.Remove(parentCtx, destObj, rv))
+			return toObjectErr(err, bucket, object)
+		}
+	}
+
 	fi.TransitionStatus = lifecycle.TransitionComplete
 	fi.TransitionedObjName = destObj
 	fi.TransitionTier = opts.Transition.Tier
@@ -2043,18 +2385,28 @@ func (er erasureObjects) restoreTransitionedObject(ctx context.Context, bucket s
 
 	oi = actualfi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended)
 	ropts := putRestoreOpts(bucket, object, opts.Transition.RestoreRequest, oi)
-	if len(oi.Parts) == 1 {
+	// Objects split into parts by PutObject are restored with a single
+	// PutObject, to keep their ETag.
+	if len(oi.Parts) == 1 || (len(oi.Parts) > 1 && !oi.isMultipart()) {
 		var rs *HTTPRangeSpec
 		gr, err := getTransitionedObjectReader(ctx, bucket, object, rs, http.Header{}, oi, opts)
 		if err != nil {
 			return setRestoreHeaderFn(oi, toObjectErr(err, bucket, object))
 		}
 		defer gr.Close()
-		hashReader, err := hash.NewReader(gr, gr.ObjInfo.Size, "", "", gr.ObjInfo.Size)
+		actualSize := oi.Parts[0].ActualSize
+		if len(oi.Parts) > 1 {
+			// Split objects are never compressed or encrypted.
+			actualSize = oi.Size
+		}
+		hashReader, err := hash.NewReader(gr, gr.ObjInfo.Size, "", "", actualSize)
 		if err != nil {
 			return setRestoreHeaderFn(oi, toObjectErr(err, bucket, object))
 		}
 		pReader := NewPutObjReader(hashReader)
+		ropts.IndexCB = func() []byte {
+			return oi.Parts[0].Index // Preserve part Index to ensure ranged reads can seek.
+		}
 		_, err = er.PutObject(ctx, bucket, object, pReader, ropts)
 		return setRestoreHeaderFn(oi, toObjectErr(err, bucket, object))
 	}
@@ -2075,11 +2427,16 @@ func (er erasureObjects) restoreTransitionedObject(ctx context.Context, bucket s
 
 	// rehydrate the parts back on disk as per the original xl.meta prior to transition
 	for _, partInfo := range oi.Parts {
-		hr, err := hash.NewReader(gr, partInfo.Size, "", "", partInfo.Size)
+		hr, err := hash.NewReader(gr, partInfo.Size, "", "", partInfo.ActualSize)
 		if err != nil {
 			return setRestoreHeaderFn(oi, err)
 		}
-		pInfo, err := er.PutObjectPart(ctx, bucket, object, res.UploadID, partInfo.Number, NewPutObjReader(hr), ObjectOptions{})
+		partInfo := partInfo
+		pInfo, err := er.PutObjectPart(ctx, bucket, object, res.UploadID, partInfo.Number, NewPutObjReader(hr), ObjectOptions{
+			IndexCB: func() []byte {
+				return partInfo.Index // Preserve part Index to ensure ranged reads can seek.
+			},
+		})
 		if err != nil {
 			return setRestoreHeaderFn(oi, err)
 		}
@@ -2105,7 +2462,7 @@ func (er erasureObjects) DecomTieredObject(ctx context.Context, bucket, object s
 	// overlay Erasure info for this set of disks
 	storageDisks := er.getDisks()
 	// Get parity and data drive count based on storage class metadata
-	parityDrives := globalStorageClass.GetParityForSC(opts.UserDefined[xhttp.AmzStorageClass])
+	parityDrives := globalStorageClass.GetParityForPoolSC(er.poolIndex, opts.UserDefined[xhttp.AmzStorageClass])
 	if parityDrives < 0 {
 		parityDrives = er.defaultParityCount
 	}
@@ -2139,3 +2496,107 @@ func (er erasureObjects) DecomTieredObject(ctx context.Context, bucket, object s
 
 	return nil
 }
+
+// FreeVersionsCompaction - summary of a free-version compaction run.
+type FreeVersionsCompaction struct {
+	Bucket   string `json:"bucket"`
+	DryRun   bool   `json:"dryRun,omitempty"`
+	Found    int64  `json:"found"`    // free-versions found
+	Retained int64  `json:"retained"` // tiered content still present, left to the scanner
+	Orphaned int64  `json:"orphaned"` // free-versions whose tiered content is gone
+	Removed  int64  `json:"removed"`  // orphaned free-versions removed
+	Failed   int64  `json:"failed"`   // free-versions that could not be checked or removed
+}
+
+func (c *FreeVersionsCompaction) merge(o FreeVersionsCompaction) {
+	c.Found += o.Found
+	c.Retained += o.Retained
+	c.Orphaned += o.Orphaned
+	c.Removed += o.Removed
+	c.Failed += o.Failed
+}
+
+// compactFreeVersions removes free-versions in bucket whose tiered content
+// no longer exists on the remote tier. Tiered content is never removed here,
+// its deletion may still be pending in the tier journal of any node, so
+// free-versions with tiered content present are left to the scanner.
+func (er erasureObjects) compactFreeVersions(ctx context.Context, bucket string, dryRun bool) (FreeVersionsCompaction, error) {
+	res := FreeVersionsCompaction{Bucket: bucket, DryRun: dryRun}
+
+	disks, _ := er.getOnlineDisksWithHealing()
+	if len(disks) == 0 {
+		return res, errErasureReadQuorum
+	}
+
+	ignoreNotFoundErr := func(err error) error {
+		switch {
+		case isErrVersionNotFound(err), isErrObjectNotFound(err):
+			return nil
+		}
+		return err
+	}
+
+	compact := func(entry metaCacheEntry) {
+		if entry.isDir() {
+			return
+		}
+		fivs, err := entry.fileInfoVersions(bucket)
+		if err != nil {
+			return
+		}
+		for _, fv := range fivs.FreeVersions {
+			res.Found++
+			exists, err := remoteTierObjectExists(ctx, fv.TransitionedObjName, fv.TransitionVersionID, fv.TransitionTier)
+			if err != nil {
+				logger.LogIf(ctx, fmt.Errorf("unable to check tiered content %s (%s) on %s: %w", fv.TransitionedObjName, fv.TransitionVersionID, fv.TransitionTier, err))
+				res.Failed++
+				continue
+			}
+			if exists {
+				res.Retained++
+				continue
+			}
+			res.Orphaned++
+			if dryRun {
+				continue
+			}
+
+			_, err = er.DeleteObject(ctx, bucket, fv.Name, ObjectOptions{
+				VersionID:        fv.VersionID,
+				InclFreeVersions: true,
+			})
+			if err = ignoreNotFoundErr(err); err != nil {
+				logger.LogIf(ctx, err)
+				res.Failed++
+				continue
+			}
+			res.Removed++
+			auditLogLifecycle(ctx, fv.ToObjectInfo(bucket, fv.Name, false), ILMFreeVersionDelete)
+		}
+	}
+
+	resolver := metadataResolutionParams{
+		dirQuorum: 1,
+		objQuorum: 1,
+		bucket:    bucket,
+	}
+
+	err := listPathRaw(ctx, listPathRawOptions{
+		disks:          disks,
+		bucket:         bucket,
+		recursive:      true,
+		minDisks:       1,
+		reportNotFound: false,
+		agreed:         compact,
+		partial: func(entries metaCacheEntries, _ []error) {
+			entry, ok := entries.resolve(&resolver)
+			if !ok {
+				entry, _ = entries.firstFound()
+			}
+			if entry != nil {
+				compact(*entry)
+			}
+		},
+	})
+	return res, err
+}
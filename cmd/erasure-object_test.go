@@ -25,14 +25,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/minio/minio/internal/config/storageclass"
+	"github.com/minio/minio/internal/etag"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	ioutilx "github.com/minio/minio/internal/ioutil"
 )
 
 func TestRepeatPutObjectPart(t *testing.T) {
@@ -211,6 +218,120 @@ func TestDeleteObjectsVersioned(t *testing.T) {
 	}
 }
 
+func TestDeleteObjectIfLatest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure(ctx, 16)
+	if err != nil {
+		t.Fatal("Unable to initialize 'Erasure' object layer.", err)
+	}
+	// Remove all dirs.
+	for _, dir := range fsDirs {
+		defer os.RemoveAll(dir)
+	}
+
+	bucket, object := "bucket", "dir/obj"
+	err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{
+		VersioningEnabled: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	versions := make([]string, 2)
+	for i := range versions {
+		objInfo, err := obj.PutObject(ctx, bucket, object,
+			mustGetPutObjReader(t, bytes.NewReader([]byte("abcd")), int64(len("abcd")), "", ""), ObjectOptions{
+				Versioned: true,
+			})
+		if err != nil {
+			t.Fatalf("Erasure Object upload failed: <ERROR> %s", err)
+		}
+		versions[i] = objInfo.VersionID
+	}
+
+	// A newer version was written after the first one, delete must be refused.
+	_, err = obj.DeleteObject(ctx, bucket, object, ObjectOptions{
+		Versioned:      true,
+		VersionID:      versions[0],
+		DeleteIfLatest: true,
+	})
+	if _, ok := err.(PreConditionFailed); !ok {
+		t.Fatalf("expected PreConditionFailed, got %v", err)
+	}
+	if _, err = obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{VersionID: versions[0]}); err != nil {
+		t.Fatalf("expected version %s to be retained, got %v", versions[0], err)
+	}
+
+	// The latest version is removed.
+	_, err = obj.DeleteObject(ctx, bucket, object, ObjectOptions{
+		Versioned:      true,
+		VersionID:      versions[1],
+		DeleteIfLatest: true,
+	})
+	if err != nil {
+		t.Fatalf("expected latest version to be removed, got %v", err)
+	}
+	_, err = obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{VersionID: versions[1]})
+	if _, ok := err.(VersionNotFound); !ok {
+		t.Fatalf("expected VersionNotFound, got %v", err)
+	}
+
+	// With the newer version gone, the remaining version is the latest again.
+	_, err = obj.DeleteObject(ctx, bucket, object, ObjectOptions{
+		Versioned:      true,
+		VersionID:      versions[0],
+		DeleteIfLatest: true,
+	})
+	if err != nil {
+		t.Fatalf("expected version %s to be removed, got %v", versions[0], err)
+	}
+}
+
+func TestDeleteObjectCountsDeletes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket-deletes"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{VersioningEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	var versions []string
+	for i := 0; i < 2; i++ {
+		oi, err := obj.PutObject(ctx, bucket, "object", mustGetPutObjReader(t, bytes.NewReader([]byte("abcd")), 4, "", ""), ObjectOptions{Versioned: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, oi.VersionID)
+	}
+
+	// Adds a delete marker.
+	if _, err = obj.DeleteObject(ctx, bucket, "object", ObjectOptions{Versioned: true}); err != nil {
+		t.Fatal(err)
+	}
+	// Deletes the versions.
+	if _, err = obj.DeleteObject(ctx, bucket, "object", ObjectOptions{Versioned: true, VersionID: versions[0]}); err != nil {
+		t.Fatal(err)
+	}
+	_, errs := obj.DeleteObjects(ctx, bucket, []ObjectToDelete{{ObjectV: ObjectV{ObjectName: "object", VersionID: versions[1]}}}, ObjectOptions{Versioned: true})
+	if errs[0] != nil {
+		t.Fatal(errs[0])
+	}
+
+	if deletes, deleteMarkers := globalBucketConnStats.getDeletes(bucket); deletes != 2 || deleteMarkers != 1 {
+		t.Fatalf("expected 2 deletes and 1 delete marker, got %d and %d", deletes, deleteMarkers)
+	}
+}
+
 func TestErasureDeleteObjectsErasureSet(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -869,6 +990,350 @@ func TestPutObjectSmallInlineData(t *testing.T) {
 	}
 }
 
+func TestPutObjectPreserveClientMetadata(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+	initConfigSubsystem(ctx, obj)
+
+	bucket := "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	h := http.Header{}
+	h.Set(xhttp.MinIOPreserveClientMetadata, "true")
+	h.Set("X-Amz-Meta-Source", "nas")
+	h.Set(xhttp.CacheControl, "no-cache")
+	clientMetadata, err := preservedClientMetadata(ctx, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clientMetadata) != 2 || clientMetadata["X-Amz-Meta-Source"] != "nas" || clientMetadata["cache-control"] != "no-cache" {
+		t.Fatalf("unexpected client metadata %v", clientMetadata)
+	}
+	h.Del(xhttp.MinIOPreserveClientMetadata)
+	if m, _ := preservedClientMetadata(ctx, h); m != nil {
+		t.Fatalf("expected no client metadata, got %v", m)
+	}
+
+	checkClientMetadata := func(object string) {
+		t.Helper()
+		oi, err := obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := oi.ClientMetadata()
+		if !ok || len(got) != len(clientMetadata) {
+			t.Fatalf("expected client metadata %v, got %v", clientMetadata, got)
+		}
+		for k, v := range clientMetadata {
+			if got[k] != v {
+				t.Fatalf("expected client metadata %v, got %v", clientMetadata, got)
+			}
+		}
+		// The metadata derived by the server is kept separately.
+		if oi.ContentType == "" || oi.ETag == "" {
+			t.Fatalf("expected server derived metadata, got %+v", oi)
+		}
+	}
+
+	data := []byte("abcd")
+	opts := ObjectOptions{UserDefined: map[string]string{"X-Amz-Meta-Source": "nas"}, ClientMetadata: clientMetadata}
+	if _, err = obj.PutObject(ctx, bucket, "object", mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), opts); err != nil {
+		t.Fatal(err)
+	}
+	checkClientMetadata("object")
+
+	res, err := obj.NewMultipartUpload(ctx, bucket, "multipart", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pi, err := obj.PutObjectPart(ctx, bucket, "multipart", res.UploadID, 1, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = obj.CompleteMultipartUpload(ctx, bucket, "multipart", res.UploadID, []CompletePart{{PartNumber: 1, ETag: pi.ETag}}, ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	checkClientMetadata("multipart")
+
+	// Objects uploaded without the option have no client metadata.
+	if _, err = obj.PutObject(ctx, bucket, "plain", mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	oi, err := obj.GetObjectInfo(ctx, bucket, "plain", ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := oi.ClientMetadata(); ok {
+		t.Fatal("expected no client metadata")
+	}
+}
+
+func TestPutObjectContentHash(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, invalid := range []string{"sha512", "=abcd", "sha512=", "sha 512=abcd", "sha512=ab cd"} {
+		h := http.Header{}
+		h.Set(xhttp.MinIOContentHash, invalid)
+		if _, err = parseContentHash(h); err == nil {
+			t.Fatalf("expected %q to be rejected", invalid)
+		}
+	}
+
+	h := http.Header{}
+	h.Set(xhttp.MinIOContentHash, "sha512=z4PhNX7vuL3xVChQ1m2AB9Yg5AULVxXcg/SpIdNs6c5H0NE8XYXysP+DGNKHfuwvY7kxvUdBeoGlODJ6+SfaPg==")
+	contentHash, err := parseContentHash(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("abcd")
+	opts := ObjectOptions{ContentHash: contentHash}
+	if _, err = obj.PutObject(ctx, bucket, "object", mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), opts); err != nil {
+		t.Fatal(err)
+	}
+	oi, err := obj.GetObjectInfo(ctx, bucket, "object", ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oi.ContentHash() != contentHash {
+		t.Fatalf("expected content hash %q, got %q", contentHash, oi.ContentHash())
+	}
+	// The ETag is still the MD5 of the content.
+	if oi.ETag != getMD5Hash(data) {
+		t.Fatalf("expected ETag %s, got %s", getMD5Hash(data), oi.ETag)
+	}
+}
+
+func TestPutObjectDataDir(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const numberOfDisks = 4
+
+	obj, fsDirs, err := prepareErasure(ctx, numberOfDisks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	object := "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Data bigger than the small file threshold, not inlined.
+	data := bytes.Repeat([]byte{'a'}, smallFileThreshold*numberOfDisks/2)
+
+	for _, dataDir := range []string{"not-a-uuid", "00000000-0000-0000-0000-000000000000", "{" + mustGetUUID() + "}"} {
+		_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{DataDir: dataDir})
+		if !errors.Is(err, errInvalidArgument) {
+			t.Fatalf("expected invalid argument error for dataDir %q, got %v", dataDir, err)
+		}
+	}
+
+	dataDir := mustGetUUID()
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{DataDir: dataDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range fsDirs {
+		if _, err = os.Stat(filepath.Join(dir, bucket, object, dataDir, "part.1")); err != nil {
+			t.Fatalf("expected the object data in %s, got %v", dataDir, err)
+		}
+	}
+
+	// Overwriting with the same dataDir keeps the data readable.
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{DataDir: dataDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, nil, readLock, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gr.Close()
+	got, err := io.ReadAll(gr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("Corrupted data found")
+	}
+}
+
+func TestPutObjectDedupVersions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const numberOfDisks = 4
+
+	obj, fsDirs, err := prepareErasure(ctx, numberOfDisks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	globalAPIConfig.mu.Lock()
+	globalAPIConfig.dedupVersions = true
+	globalAPIConfig.mu.Unlock()
+	defer func() {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.dedupVersions = false
+		globalAPIConfig.mu.Unlock()
+	}()
+
+	bucket := "bucket"
+	object := "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{VersioningEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	dataDirs := func() int {
+		entries, err := os.ReadDir(filepath.Join(fsDirs[0], bucket, object))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var n int
+		for _, entry := range entries {
+			if entry.IsDir() {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Data bigger than the small file threshold, not inlined.
+	data := bytes.Repeat([]byte{'a'}, smallFileThreshold*numberOfDisks/2)
+	other := bytes.Repeat([]byte{'b'}, len(data))
+
+	var versions []string
+	for _, b := range [][]byte{data, data, other} {
+		objInfo, err := obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(b), int64(len(b)), "", ""), ObjectOptions{Versioned: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, objInfo.VersionID)
+	}
+	if n := dataDirs(); n != 2 {
+		t.Fatalf("expected the identical versions to share their data, got %d data dirs", n)
+	}
+
+	// Deleting a version keeps the data shared with another version.
+	if _, err = obj.DeleteObject(ctx, bucket, object, ObjectOptions{Versioned: true, VersionID: versions[0]}); err != nil {
+		t.Fatal(err)
+	}
+	gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, nil, readLock, ObjectOptions{VersionID: versions[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(gr)
+	gr.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("Corrupted data found")
+	}
+
+	// The data is removed with the last version referencing it.
+	if _, err = obj.DeleteObject(ctx, bucket, object, ObjectOptions{Versioned: true, VersionID: versions[1]}); err != nil {
+		t.Fatal(err)
+	}
+	if n := dataDirs(); n != 1 {
+		t.Fatalf("expected the shared data to be removed, got %d data dirs", n)
+	}
+}
+
+// renameDataFaultyDisk fails to rename the data of objects.
+type renameDataFaultyDisk struct {
+	StorageAPI
+}
+
+func (d renameDataFaultyDisk) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (uint64, error) {
+	return 0, errFaultyDisk
+}
+
+func TestPutObjectWriteAllOnline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const numberOfDisks = 4
+
+	obj, fsDirs, err := prepareErasure(ctx, numberOfDisks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	object := "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte{'a'}, smallFileThreshold*numberOfDisks/2)
+
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{WriteAllOnline: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	z := obj.(*erasureServerPools)
+	xl := z.serverPools[0].sets[0]
+	erasureDisks := xl.getDisks()
+	erasureDisks[0] = renameDataFaultyDisk{erasureDisks[0]}
+	z.serverPools[0].erasureDisksMu.Lock()
+	xl.getDisks = func() []StorageAPI {
+		return erasureDisks
+	}
+	z.serverPools[0].erasureDisksMu.Unlock()
+
+	// Write quorum is still met with a single failed drive.
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{WriteAllOnline: true})
+	if !errors.Is(err, errErasureWriteQuorum) {
+		t.Fatalf("expected %v, got %v", errErasureWriteQuorum, err)
+	}
+
+	// Offline drives do not count.
+	if q := allOnlineWriteQuorum([]StorageAPI{nil, erasureDisks[1], erasureDisks[2], erasureDisks[3]}, 2); q != 3 {
+		t.Fatalf("expected a write quorum of 3, got %d", q)
+	}
+	if q := allOnlineWriteQuorum([]StorageAPI{nil, nil, erasureDisks[2], erasureDisks[3]}, 3); q != 3 {
+		t.Fatalf("expected the write quorum to be kept, got %d", q)
+	}
+}
+
 func TestObjectQuorumFromMeta(t *testing.T) {
 	ExecObjectLayerTestWithDirs(t, testObjectQuorumFromMeta)
 }
@@ -1215,3 +1680,424 @@ func TestGetObjectWithOutdatedDisks(t *testing.T) {
 		}
 	}
 }
+
+// putCompressedObject uploads data compressed as the PutObject and
+// PutObjectPart handlers do, in parts of partSize, storing the compression
+// index of the parts if withIndex is set.
+func putCompressedObject(ctx context.Context, obj ObjectLayer, bucket, object string, data io.Reader, size, partSize int64, withIndex bool) (ObjectInfo, error) {
+	compressed := func(r io.Reader, size int64) (*PutObjReader, func() []byte, func(), error) {
+		actualReader, err := hash.NewReader(r, size, "", "", size)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		s2c, idxCB := newS2CompressReader(actualReader, size, false)
+		hr, err := hash.NewReader(etag.Wrap(s2c, actualReader), -1, "", "", size)
+		if err != nil {
+			s2c.Close()
+			return nil, nil, nil, err
+		}
+		if !withIndex {
+			idxCB = nil
+		}
+		return NewPutObjReader(hr), idxCB, func() { s2c.Close() }, nil
+	}
+	metadata := map[string]string{
+		ReservedMetadataPrefix + "compression": compressionAlgorithmV2,
+	}
+
+	if size <= partSize {
+		metadata[ReservedMetadataPrefix+"actual-size"] = strconv.FormatInt(size, 10)
+		pr, idxCB, closer, err := compressed(data, size)
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		defer closer()
+		return obj.PutObject(ctx, bucket, object, pr, ObjectOptions{UserDefined: metadata, IndexCB: idxCB})
+	}
+
+	res, err := obj.NewMultipartUpload(ctx, bucket, object, ObjectOptions{UserDefined: metadata})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	var parts []CompletePart
+	for n := 1; size > 0; n++ {
+		length := partSize
+		if size < length {
+			length = size
+		}
+		size -= length
+		pr, idxCB, closer, err := compressed(io.LimitReader(data, length), length)
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		pi, err := obj.PutObjectPart(ctx, bucket, object, res.UploadID, n, pr, ObjectOptions{IndexCB: idxCB})
+		closer()
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		parts = append(parts, CompletePart{PartNumber: pi.PartNumber, ETag: pi.ETag})
+	}
+	return obj.CompleteMultipartUpload(ctx, bucket, object, res.UploadID, parts, ObjectOptions{})
+}
+
+func TestPutObjectCompressionIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Parts above compMinIndexSize are stored with a compression index.
+	testCases := []struct {
+		object   string
+		size     int64
+		partSize int64
+		indexed  []bool
+	}{
+		{"single", 20 << 20, 20 << 20, []bool{true}},
+		{"single-small", 5 << 20, 20 << 20, []bool{false}},
+		{"multipart", 28<<20 + 13, 12 << 20, []bool{true, true, false}},
+	}
+	for _, tc := range testCases {
+		oi, err := putCompressedObject(ctx, obj, bucket, tc.object, NewDummyDataGen(tc.size, 0), tc.size, tc.partSize, true)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.object, err)
+		}
+
+		// Read back the stored metadata, the parts must keep their index.
+		oi, err = obj.GetObjectInfo(ctx, bucket, tc.object, ObjectOptions{})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.object, err)
+		}
+		if !oi.IsCompressed() {
+			t.Fatalf("%s: expected object to be compressed", tc.object)
+		}
+		if len(oi.Parts) != len(tc.indexed) {
+			t.Fatalf("%s: expected %d parts, got %d", tc.object, len(tc.indexed), len(oi.Parts))
+		}
+		for i, part := range oi.Parts {
+			if tc.indexed[i] != (len(part.Index) > 0) {
+				t.Fatalf("%s: part %d: expected index %v, got %d bytes", tc.object, part.Number, tc.indexed[i], len(part.Index))
+			}
+		}
+
+		for _, offset := range []int64{1, tc.size / 2, tc.size - 4096, tc.size - 1} {
+			compOff, _, _, _, _ := getCompressedOffsets(oi, offset, nil)
+			if offset > compMinIndexSize && tc.indexed[0] && compOff == 0 {
+				t.Fatalf("%s: offset %d: index was not used", tc.object, offset)
+			}
+
+			rs := &HTTPRangeSpec{Start: offset, End: -1}
+			gr, err := obj.GetObjectNInfo(ctx, bucket, tc.object, rs, nil, readLock, ObjectOptions{})
+			if err != nil {
+				t.Fatalf("%s: offset %d: %v", tc.object, offset, err)
+			}
+			ref := ioutilx.NewSkipReader(NewDummyDataGen(tc.size, 0), offset)
+			ok, msg := cmpReaders(ref, gr)
+			gr.Close()
+			if !ok {
+				t.Fatalf("%s: offset %d: data mismatch: %s", tc.object, offset, msg)
+			}
+		}
+	}
+}
+
+// BenchmarkCompressedTailRange reads the last MiB of a multi-GB compressed
+// object stored through the object layer, with and without the compression
+// index, reporting the compressed bytes read from the drives.
+func BenchmarkCompressedTailRange(b *testing.B) {
+	const size = 2 << 30
+	const tail = 1 << 20
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		b.Fatal(err)
+	}
+
+	for _, withIndex := range []bool{false, true} {
+		object := fmt.Sprintf("object-index-%v", withIndex)
+		if _, err = putCompressedObject(ctx, obj, bucket, object, NewDummyDataGen(size, 0), size, 512<<20, withIndex); err != nil {
+			b.Fatal(err)
+		}
+		oi, err := obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+		if err != nil {
+			b.Fatal(err)
+		}
+		compOff, _, _, _, _ := getCompressedOffsets(oi, size-tail, nil)
+
+		b.Run(fmt.Sprintf("index=%v", withIndex), func(b *testing.B) {
+			rs := &HTTPRangeSpec{IsSuffixLength: true, Start: -tail}
+			b.SetBytes(tail)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				gr, err := obj.GetObjectNInfo(ctx, bucket, object, rs, nil, readLock, ObjectOptions{})
+				if err != nil {
+					b.Fatal(err)
+				}
+				n, err := io.Copy(io.Discard, gr)
+				gr.Close()
+				if err != nil {
+					b.Fatal(err)
+				}
+				if n != tail {
+					b.Fatalf("expected %d bytes, got %d", tail, n)
+				}
+			}
+			b.ReportMetric(float64(oi.Size-compOff), "compressed-bytes/op")
+		})
+	}
+}
+
+// blockingWarmBackend holds the copies to the tier until released.
+type blockingWarmBackend struct {
+	memWarmBackend
+	started, release chan struct{}
+}
+
+func (b blockingWarmBackend) Put(ctx context.Context, object string, r io.Reader, length int64) (remoteVersionID, error) {
+	close(b.started)
+	<-b.release
+	return b.memWarmBackend.Put(ctx, object, r, length)
+}
+
+func TestTransitionObjectReadsLocal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	initAllSubsystems(ctx)
+
+	defer func(mgr *TierConfigMgr) { globalTierConfigMgr = mgr }(globalTierConfigMgr)
+	globalTierConfigMgr = NewTierConfigMgr()
+	tier := blockingWarmBackend{memWarmBackend: memWarmBackend{}, started: make(chan struct{}), release: make(chan struct{})}
+	globalTierConfigMgr.drivercache["WARM-1"] = tier
+
+	globalAPIConfig.mu.Lock()
+	globalAPIConfig.transitionReadsLocal = true
+	globalAPIConfig.mu.Unlock()
+	defer func() {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.transitionReadsLocal = false
+		globalAPIConfig.mu.Unlock()
+	}()
+
+	bucket, object := "bucket", "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 1024*1024)
+	oi, err := obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readObject := func() ObjectInfo {
+		t.Helper()
+		gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, nil, readLock, ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer gr.Close()
+		got, err := io.ReadAll(gr)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Fatalf("expected the content of the object, got %d bytes, %v", len(got), err)
+		}
+		return gr.ObjInfo
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- obj.TransitionObject(ctx, bucket, object, ObjectOptions{
+			Transition: TransitionOptions{Tier: "WARM-1", ETag: oi.ETag},
+			MTime:      oi.ModTime,
+		})
+	}()
+
+	// Reads during the transition are served from the local copy.
+	<-tier.started
+	if readObject().IsRemote() {
+		t.Fatal("expected the object to be read from the local copy during the transition")
+	}
+
+	close(tier.release)
+	if err = <-errCh; err != nil {
+		t.Fatal(err)
+	}
+	if !readObject().IsRemote() {
+		t.Fatal("expected the object to be read from the tier once transitioned")
+	}
+}
+
+func TestPutObjectDurable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket, object := "bucket", "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Large enough not to be inlined in xl.meta.
+	data := bytes.Repeat([]byte("a"), 1<<20)
+	syncs := atomic.LoadUint64(&globalOSMetrics.operations[osMetricSync])
+	fdatasyncs := atomic.LoadUint64(&globalOSMetrics.operations[osMetricFdatasync])
+	if _, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{Durable: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The files of the object are synced, not the whole drive.
+	if n := atomic.LoadUint64(&globalOSMetrics.operations[osMetricSync]) - syncs; n != 0 {
+		t.Fatalf("expected no global sync, got %d", n)
+	}
+	// Each drive syncs at least its shard, data dir, xl.meta and object dir.
+	if n := atomic.LoadUint64(&globalOSMetrics.operations[osMetricFdatasync]) - fdatasyncs; n < uint64(4*len(fsDirs)) {
+		t.Fatalf("expected the files of the object to be synced on every drive, got %d fdatasyncs", n)
+	}
+
+	gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, nil, readLock, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(gr)
+	gr.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatal("Corrupted data found")
+	}
+}
+
+func TestPutObjectSkipIdentical(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket, object := "bucket", "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("data")
+	latest, err := obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, identical := range []bool{true, false} {
+		var compared bool
+		opts := ObjectOptions{
+			SkipIdenticalFn: func(oi ObjectInfo) bool {
+				compared = true
+				// The comparison holds a read lock, readers are not blocked.
+				lk := obj.NewNSLock(bucket, object)
+				lkctx, err := lk.GetRLock(ctx, newDynamicTimeout(time.Second, time.Second))
+				if err != nil {
+					t.Fatalf("expected the object to be read locked, got %v", err)
+				}
+				lk.RUnlock(lkctx)
+				return identical
+			},
+		}
+		oi, err := obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !compared {
+			t.Fatal("expected the latest version to be compared")
+		}
+		if skipped := oi.ModTime.Equal(latest.ModTime); skipped != identical {
+			t.Fatalf("expected the write to be skipped %v, got %v", identical, skipped)
+		}
+	}
+}
+
+func TestCopyObjectInPlaceModTime(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket, object := "bucket", "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("data")
+	if _, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// With and without metadata updates, the modification time
+	// returned is the one stored.
+	for i, meta := range []map[string]string{{"X-Amz-Meta-Key": "value"}, nil} {
+		srcInfo, err := obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range meta {
+			srcInfo.UserDefined[k] = v
+		}
+		srcInfo.metadataOnly = true
+		mtime := srcInfo.ModTime.Add(time.Duration(i+1) * time.Second)
+		oi, err := obj.CopyObject(ctx, bucket, object, bucket, object, srcInfo, ObjectOptions{}, ObjectOptions{MTime: mtime})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !oi.ModTime.Equal(mtime) {
+			t.Fatalf("case %d: expected the modification time %v, got %v", i+1, mtime, oi.ModTime)
+		}
+		stored, err := obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !stored.ModTime.Equal(oi.ModTime) {
+			t.Fatalf("case %d: expected the modification time %v to be stored, got %v", i+1, oi.ModTime, stored.ModTime)
+		}
+		if stored.UserDefined["X-Amz-Meta-Key"] != "value" {
+			t.Fatalf("case %d: expected the metadata to be updated, got %v", i+1, stored.UserDefined)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"net/http"
 	"sort"
@@ -65,6 +66,23 @@ type PoolDecommissionInfo struct {
 	ItemsDecommissionFailed int64 `json:"-" msg:"idf"`
 	BytesDone               int64 `json:"-" msg:"bd"`
 	BytesFailed             int64 `json:"-" msg:"bf"`
+
+	// Number of versions in the pool as counted by the scanner when
+	// the decommission started, to report progress.
+	ItemsTotal int64 `json:"-" msg:"it"`
+}
+
+// percentDone returns the percentage of the versions decommissioned,
+// or of the used space when versions were not counted at the start.
+func (pd *PoolDecommissionInfo) percentDone() float64 {
+	done, total := float64(pd.ItemsDecommissioned), float64(pd.ItemsTotal)
+	if total <= 0 {
+		done, total = float64(pd.BytesDone), float64(pd.TotalSize-pd.StartSize)
+	}
+	if total <= 0 {
+		return 0
+	}
+	return math.Min(100*done/total, 100)
 }
 
 // bucketPop should be called when a bucket is done decommissioning.
@@ -142,6 +160,15 @@ func (p *poolMeta) returnResumablePools() []PoolStatus {
 	return newPools
 }
 
+// decommissionStartTime returns when the decommission of the pool at
+// idx started, or zero time if it is not being decommissioned.
+func (p *poolMeta) decommissionStartTime(idx int) time.Time {
+	if d := p.Pools[idx].Decommission; d != nil {
+		return d.StartTime
+	}
+	return time.Time{}
+}
+
 func (p *poolMeta) DecommissionComplete(idx int) bool {
 	if p.Pools[idx].Decommission != nil && !p.Pools[idx].Decommission.Complete {
 		p.Pools[idx].LastUpdate = UTCNow()
@@ -1123,6 +1150,22 @@ func (z *erasureServerPools) getDecommissionPoolSpaceInfo(idx int) (pi poolSpace
 	}, nil
 }
 
+// getPoolUsage returns the usage of the pool as last counted by the
+// scanner, empty if the scanner has not completed a cycle yet.
+func (z *erasureServerPools) getPoolUsage(ctx context.Context, idx int) (usage dataUsageEntry) {
+	for _, set := range z.serverPools[idx].sets {
+		var cache dataUsageCache
+		if err := cache.load(ctx, set, dataUsageCacheName); err != nil {
+			logger.LogIf(ctx, err)
+			continue
+		}
+		if root := cache.root(); root != nil {
+			usage.merge(cache.flatten(*root))
+		}
+	}
+	return usage
+}
+
 func (z *erasureServerPools) Status(ctx context.Context, idx int) (PoolStatus, error) {
 	if idx < 0 {
 		return PoolStatus{}, errInvalidArgument
@@ -1175,6 +1218,7 @@ func (z *erasureServerPools) DecommissionCancel(ctx context.Context, idx int) (e
 	z.poolMetaMutex.Lock()
 	defer z.poolMetaMutex.Unlock()
 
+	start := z.poolMeta.decommissionStartTime(idx)
 	if z.poolMeta.DecommissionCancel(idx) {
 		if fn := z.decommissionCancelers[idx]; fn != nil {
 			defer fn() // cancel any active thread.
@@ -1183,6 +1227,9 @@ func (z *erasureServerPools) DecommissionCancel(ctx context.Context, idx int) (e
 			return err
 		}
 		globalNotificationSys.ReloadPoolMeta(ctx)
+		if !start.IsZero() {
+			go globalClusterJobsHistory.record(z.poolMeta.decommissionClusterJob(idx, start))
+		}
 	}
 	return nil
 }
@@ -1199,6 +1246,7 @@ func (z *erasureServerPools) DecommissionFailed(ctx context.Context, idx int) (e
 	z.poolMetaMutex.Lock()
 	defer z.poolMetaMutex.Unlock()
 
+	start := z.poolMeta.decommissionStartTime(idx)
 	if z.poolMeta.DecommissionFailed(idx) {
 		if fn := z.decommissionCancelers[idx]; fn != nil {
 			defer fn() // cancel any active thread.
@@ -1207,6 +1255,9 @@ func (z *erasureServerPools) DecommissionFailed(ctx context.Context, idx int) (e
 			return err
 		}
 		globalNotificationSys.ReloadPoolMeta(ctx)
+		if !start.IsZero() {
+			go globalClusterJobsHistory.record(z.poolMeta.decommissionClusterJob(idx, start))
+		}
 	}
 	return nil
 }
@@ -1223,6 +1274,7 @@ func (z *erasureServerPools) CompleteDecommission(ctx context.Context, idx int)
 	z.poolMetaMutex.Lock()
 	defer z.poolMetaMutex.Unlock()
 
+	start := z.poolMeta.decommissionStartTime(idx)
 	if z.poolMeta.DecommissionComplete(idx) {
 		if fn := z.decommissionCancelers[idx]; fn != nil {
 			defer fn() // cancel any active thread.
@@ -1231,6 +1283,9 @@ func (z *erasureServerPools) CompleteDecommission(ctx context.Context, idx int)
 			return err
 		}
 		globalNotificationSys.ReloadPoolMeta(ctx)
+		if !start.IsZero() {
+			go globalClusterJobsHistory.record(z.poolMeta.decommissionClusterJob(idx, start))
+		}
 	}
 	return nil
 }
@@ -1307,6 +1362,7 @@ func (z *erasureServerPools) StartDecommission(ctx context.Context, indices ...i
 		if err = z.poolMeta.Decommission(idx, pi); err != nil {
 			return err
 		}
+		z.poolMeta.Pools[idx].Decommission.ItemsTotal = int64(z.getPoolUsage(ctx, idx).Versions)
 
 		z.poolMeta.QueueBuckets(idx, decomBuckets)
 	}
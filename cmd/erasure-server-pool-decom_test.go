@@ -194,3 +194,39 @@ func TestPoolMetaValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolDecommissionPercentDone(t *testing.T) {
+	testCases := []struct {
+		info    PoolDecommissionInfo
+		percent float64
+	}{
+		{PoolDecommissionInfo{ItemsTotal: 200, ItemsDecommissioned: 50}, 25},
+		{PoolDecommissionInfo{ItemsTotal: 200, ItemsDecommissioned: 250}, 100},
+		// Versions not counted, fall back to the bytes moved.
+		{PoolDecommissionInfo{TotalSize: 1000, StartSize: 600, BytesDone: 100}, 25},
+		{PoolDecommissionInfo{TotalSize: 1000, StartSize: 1000}, 0},
+	}
+	for i, tc := range testCases {
+		if percent := tc.info.percentDone(); percent != tc.percent {
+			t.Errorf("case %d: expected %v%%, got %v%%", i, tc.percent, percent)
+		}
+	}
+}
+
+func TestRebalanceProgress(t *testing.T) {
+	// Half the capacity used, a quarter of it to move to reach the goal.
+	rs := &rebalanceStats{InitCapacity: 1000, InitFreeSpace: 500, Bytes: 50}
+	if total := estimateObjectsToRebalance(100, rs, 0.75); total != 50 {
+		t.Errorf("expected 50 objects to rebalance, got %d", total)
+	}
+	if percent := rs.percentDone(0.75); percent != 20 {
+		t.Errorf("expected 20%%, got %v%%", percent)
+	}
+	// Already at the goal.
+	if total := estimateObjectsToRebalance(100, rs, 0.5); total != 0 {
+		t.Errorf("expected no objects to rebalance, got %d", total)
+	}
+	if percent := rs.percentDone(0.5); percent != 100 {
+		t.Errorf("expected 100%%, got %v%%", percent)
+	}
+}
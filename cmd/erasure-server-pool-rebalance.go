@@ -50,12 +50,23 @@ type rebalanceStats struct {
 	Bucket            string        `json:"bucket" msg:"bu"`             // Last rebalanced bucket
 	Object            string        `json:"object" msg:"ob"`             // Last rebalanced object
 	NumObjects        uint64        `json:"numObjects" msg:"no"`         // Number of objects rebalanced
+	NumObjectsTotal   uint64        `json:"numObjectsTotal" msg:"not"`   // Estimated number of objects to rebalance
 	NumVersions       uint64        `json:"numVersions" msg:"nv"`        // Number of versions rebalanced
 	Bytes             uint64        `json:"bytes" msg:"bs"`              // Number of bytes rebalanced
 	Participating     bool          `json:"participating" msg:"par"`
 	Info              rebalanceInfo `json:"info" msg:"inf"`
 }
 
+// percentDone returns the percentage of the bytes to move to reach the
+// free space goal that were rebalanced.
+func (rs *rebalanceStats) percentDone(percentFreeGoal float64) float64 {
+	toMove := float64(rs.InitCapacity)*percentFreeGoal - float64(rs.InitFreeSpace)
+	if toMove <= 0 {
+		return 100
+	}
+	return math.Min(100*float64(rs.Bytes)/toMove, 100)
+}
+
 func (rs *rebalanceStats) update(bucket string, fi FileInfo) {
 	if fi.IsLatest {
 		rs.NumObjects++
@@ -161,6 +172,7 @@ func (z *erasureServerPools) initRebalanceMeta(ctx context.Context, buckets []st
 				StartTime: now,
 				Status:    rebalStarted,
 			}
+			r.PoolStats[idx].NumObjectsTotal = estimateObjectsToRebalance(z.getPoolUsage(ctx, idx).Objects, r.PoolStats[idx], r.PercentFreeGoal)
 		}
 	}
 
@@ -173,6 +185,18 @@ func (z *erasureServerPools) initRebalanceMeta(ctx context.Context, buckets []st
 	return r.ID, nil
 }
 
+// estimateObjectsToRebalance estimates the number of objects a pool
+// holding objects moves to reach the free space goal, assuming objects
+// of the same size on average.
+func estimateObjectsToRebalance(objects uint64, rs *rebalanceStats, percentFreeGoal float64) uint64 {
+	used := float64(rs.InitCapacity) - float64(rs.InitFreeSpace)
+	toMove := float64(rs.InitCapacity)*percentFreeGoal - float64(rs.InitFreeSpace)
+	if used <= 0 || toMove <= 0 {
+		return 0
+	}
+	return uint64(float64(objects) * math.Min(toMove/used, 1))
+}
+
 func (z *erasureServerPools) updatePoolStats(poolIdx int, bucket string, fi FileInfo) {
 	z.rebalMu.Lock()
 	defer z.rebalMu.Unlock()
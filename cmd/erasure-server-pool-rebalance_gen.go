@@ -751,6 +751,12 @@ func (z *rebalanceStats) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "NumObjects")
 				return
 			}
+		case "not":
+			z.NumObjectsTotal, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "NumObjectsTotal")
+				return
+			}
 		case "nv":
 			z.NumVersions, err = dc.ReadUint64()
 			if err != nil {
@@ -788,9 +794,9 @@ func (z *rebalanceStats) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *rebalanceStats) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 11
+	// map header, size 12
 	// write "ifs"
-	err = en.Append(0x8b, 0xa3, 0x69, 0x66, 0x73)
+	err = en.Append(0x8c, 0xa3, 0x69, 0x66, 0x73)
 	if err != nil {
 		return
 	}
@@ -873,6 +879,16 @@ func (z *rebalanceStats) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "NumObjects")
 		return
 	}
+	// write "not"
+	err = en.Append(0xa3, 0x6e, 0x6f, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.NumObjectsTotal)
+	if err != nil {
+		err = msgp.WrapError(err, "NumObjectsTotal")
+		return
+	}
 	// write "nv"
 	err = en.Append(0xa2, 0x6e, 0x76)
 	if err != nil {
@@ -919,9 +935,9 @@ func (z *rebalanceStats) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *rebalanceStats) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 11
+	// map header, size 12
 	// string "ifs"
-	o = append(o, 0x8b, 0xa3, 0x69, 0x66, 0x73)
+	o = append(o, 0x8c, 0xa3, 0x69, 0x66, 0x73)
 	o = msgp.AppendUint64(o, z.InitFreeSpace)
 	// string "ic"
 	o = append(o, 0xa2, 0x69, 0x63)
@@ -947,6 +963,9 @@ func (z *rebalanceStats) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "no"
 	o = append(o, 0xa2, 0x6e, 0x6f)
 	o = msgp.AppendUint64(o, z.NumObjects)
+	// string "not"
+	o = append(o, 0xa3, 0x6e, 0x6f, 0x74)
+	o = msgp.AppendUint64(o, z.NumObjectsTotal)
 	// string "nv"
 	o = append(o, 0xa2, 0x6e, 0x76)
 	o = msgp.AppendUint64(o, z.NumVersions)
@@ -1052,6 +1071,12 @@ func (z *rebalanceStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "NumObjects")
 				return
 			}
+		case "not":
+			z.NumObjectsTotal, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumObjectsTotal")
+				return
+			}
 		case "nv":
 			z.NumVersions, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
@@ -1098,7 +1123,7 @@ func (z *rebalanceStats) Msgsize() (s int) {
 	for za0002 := range z.RebalancedBuckets {
 		s += msgp.StringPrefixSize + len(z.RebalancedBuckets[za0002])
 	}
-	s += 3 + msgp.StringPrefixSize + len(z.Bucket) + 3 + msgp.StringPrefixSize + len(z.Object) + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.BoolSize + 4 + z.Info.Msgsize()
+	s += 3 + msgp.StringPrefixSize + len(z.Bucket) + 3 + msgp.StringPrefixSize + len(z.Object) + 3 + msgp.Uint64Size + 4 + msgp.Uint64Size + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.BoolSize + 4 + z.Info.Msgsize()
 	return
 }
 
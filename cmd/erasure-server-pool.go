@@ -237,6 +237,77 @@ func (z *erasureServerPools) GetRawData(ctx context.Context, volume, file string
 	return nil
 }
 
+// ListObjectVersionsRaw returns all versions of an object, including free
+// versions, read directly from xl.meta on the drives of the erasure set the
+// object hashes to. Pools are consulted in order and the first pool holding
+// the object is returned.
+func (z *erasureServerPools) ListObjectVersionsRaw(ctx context.Context, bucket, object string) ([]FileInfo, error) {
+	if err := checkGetObjArgs(ctx, bucket, object); err != nil {
+		return nil, err
+	}
+
+	object = encodeDirObject(object)
+
+	lk := z.NewNSLock(bucket, object)
+	lkctx, err := lk.GetRLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return nil, err
+	}
+	ctx = lkctx.Context()
+	defer lk.RUnlock(lkctx)
+
+	for _, pool := range z.serverPools {
+		fivs, err := readAllVersionsXL(ctx, pool.getHashedSet(object).getDisks(), bucket, object)
+		if err != nil {
+			if isErrObjectNotFound(toObjectErr(err, bucket, object)) {
+				continue
+			}
+			return nil, toObjectErr(err, bucket, object)
+		}
+		return fivs, nil
+	}
+	return nil, toObjectErr(errFileNotFound, bucket, object)
+}
+
+// CompactFreeVersions removes free-versions in bucket whose tiered content
+// no longer exists on the remote tier. Erasure sets across all pools are
+// compacted in parallel.
+func (z *erasureServerPools) CompactFreeVersions(ctx context.Context, bucket string, dryRun bool) (FreeVersionsCompaction, error) {
+	if err := checkListObjsArgs(ctx, bucket, "", "", z); err != nil {
+		return FreeVersionsCompaction{}, err
+	}
+
+	res := FreeVersionsCompaction{Bucket: bucket, DryRun: dryRun}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs []error
+	)
+	for _, pool := range z.serverPools {
+		for _, set := range pool.sets {
+			set := set
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r, err := set.compactFreeVersions(ctx, bucket, dryRun)
+				mu.Lock()
+				defer mu.Unlock()
+				res.merge(r)
+				if err != nil {
+					errs = append(errs, err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return res, errs[0]
+	}
+	return res, nil
+}
+
 // Return the count of disks in each pool
 func (z *erasureServerPools) SetDriveCounts() []int {
 	setDriveCounts := make([]int, len(z.serverPools))
@@ -525,6 +596,106 @@ func (z *erasureServerPools) getPoolIdx(ctx context.Context, bucket, object stri
 	return idx, nil
 }
 
+// PoolPlacementChance is the chance of a pool to receive a new object.
+type PoolPlacementChance struct {
+	Pool       int     `json:"pool"`
+	Set        int     `json:"set"`
+	Available  uint64  `json:"available"`
+	MaxUsedPct int     `json:"maxUsedPct"`
+	Chance     float64 `json:"chance"`
+}
+
+// ObjectPlacement describes where PutObject would write an object.
+type ObjectPlacement struct {
+	Pool int `json:"pool"`
+	Set  int `json:"set"`
+	// Existing is set when the object exists and is
+	// overwritten in the same pool.
+	Existing     bool     `json:"existing"`
+	StorageClass string   `json:"storageClass"`
+	DataDrives   int      `json:"dataDrives"`
+	ParityDrives int      `json:"parityDrives"`
+	Parity       int      `json:"parity"` // configured for the storage class
+	Drives       []string `json:"drives"`
+	// Chances of each pool to receive a new object, the pool
+	// above is the most likely one.
+	Chances []PoolPlacementChance `json:"chances,omitempty"`
+}
+
+// SimulatePutObject returns where PutObject would write an object of
+// size bytes, without writing anything. New objects are placed in a
+// pool picked at random weighted by the available space, the chance
+// of each pool is returned along with the most likely one.
+func (z *erasureServerPools) SimulatePutObject(ctx context.Context, bucket, object string, size int64, storageClass string) (p ObjectPlacement, err error) {
+	if err = checkPutObjectArgs(ctx, bucket, object, z); err != nil {
+		return p, err
+	}
+	if storageClass == "" {
+		storageClass = storageclass.STANDARD
+	}
+	p.StorageClass = storageClass
+
+	object = encodeDirObject(object)
+
+	if z.SinglePool() {
+		if !isMinioMetaBucketName(bucket) {
+			avail, err := hasSpaceFor(getDiskInfos(ctx, z.serverPools[0].getHashedSet(object).getDisks()...), size)
+			if err != nil {
+				return p, toObjectErr(errErasureWriteQuorum)
+			}
+			if !avail {
+				return p, toObjectErr(errDiskFull)
+			}
+		}
+	} else {
+		idx, err := z.getPoolIdxExistingWithOpts(ctx, bucket, object, ObjectOptions{
+			SkipDecommissioned: true,
+			SkipRebalancing:    true,
+		})
+		switch {
+		case err == nil:
+			p.Pool = idx
+			p.Existing = true
+		case isErrObjectNotFound(err):
+			serverPools := z.getServerPoolsAvailableSpace(ctx, bucket, object, size)
+			serverPools.FilterMaxUsed(100 - (100 * diskReserveFraction))
+			total := serverPools.TotalAvailable()
+			if total == 0 {
+				return p, toObjectErr(errDiskFull)
+			}
+			var most uint64
+			for _, pool := range serverPools {
+				if pool.Available == 0 {
+					continue
+				}
+				p.Chances = append(p.Chances, PoolPlacementChance{
+					Pool:       pool.Index,
+					Set:        z.serverPools[pool.Index].getHashedSetIndex(object),
+					Available:  pool.Available,
+					MaxUsedPct: pool.MaxUsedPct,
+					Chance:     float64(pool.Available) / float64(total),
+				})
+				if pool.Available > most {
+					most = pool.Available
+					p.Pool = pool.Index
+				}
+			}
+		default:
+			return p, err
+		}
+	}
+
+	p.Set = z.serverPools[p.Pool].getHashedSetIndex(object)
+	set := z.serverPools[p.Pool].sets[p.Set]
+	storageDisks := set.getDisks()
+	p.ParityDrives, p.Parity = set.objectParityDrives(ctx, storageDisks, storageClass)
+	p.DataDrives = len(storageDisks) - p.ParityDrives
+	for _, endpoint := range set.getEndpoints() {
+		p.Drives = append(p.Drives, endpoint.String())
+	}
+	return p, nil
+}
+
 func (z *erasureServerPools) Shutdown(ctx context.Context) error {
 	g := errgroup.WithNErrs(len(z.serverPools))
 
@@ -553,10 +724,16 @@ func (z *erasureServerPools) BackendInfo() (b madmin.BackendInfo) {
 	}
 	rrSCParity := globalStorageClass.GetParityForSC(storageclass.RRS)
 
-	// Data blocks can vary per pool, but parity is same.
+	// Data blocks can vary per pool, parity is same unless
+	// overridden for the pool.
 	for i, setDriveCount := range z.SetDriveCounts() {
-		b.StandardSCData = append(b.StandardSCData, setDriveCount-scParity)
-		b.RRSCData = append(b.RRSCData, setDriveCount-rrSCParity)
+		poolSCParity := globalStorageClass.GetParityForPoolSC(i, storageclass.STANDARD)
+		if poolSCParity < 0 {
+			poolSCParity = z.serverPools[i].defaultParityCount
+		}
+		poolRRSCParity := globalStorageClass.GetParityForPoolSC(i, storageclass.RRS)
+		b.StandardSCData = append(b.StandardSCData, setDriveCount-poolSCParity)
+		b.RRSCData = append(b.RRSCData, setDriveCount-poolRRSCParity)
 		b.DrivesPerSet = append(b.DrivesPerSet, setDriveCount)
 		b.TotalSets = append(b.TotalSets, z.serverPools[i].setCount)
 	}
@@ -616,6 +793,9 @@ func (z *erasureServerPools) NSScanner(ctx context.Context, updates chan<- DataU
 		return nil
 	}
 
+	// Drop scan durations of deleted buckets.
+	globalScannerMetrics.pruneBucketScans(allBuckets)
+
 	// Scanner latest allBuckets first.
 	sort.Slice(allBuckets, func(i, j int) bool {
 		return allBuckets[i].Created.After(allBuckets[j].Created)
@@ -847,20 +1027,24 @@ func (z *erasureServerPools) GetObjectNInfo(ctx context.Context, bucket, object
 // getLatestObjectInfoWithIdx returns the objectInfo of the latest object from multiple pools (this function
 // is present in-case there were duplicate writes to both pools, this function also returns the
 // additional index where the latest object exists, that is used to start the GetObject stream.
-func (z *erasureServerPools) getLatestObjectInfoWithIdx(ctx context.Context, bucket, object string, opts ObjectOptions) (ObjectInfo, int, error) {
-	object = encodeDirObject(object)
-	results := make([]struct {
-		zIdx int
-		oi   ObjectInfo
-		err  error
-	}, len(z.serverPools))
+// poolObjInfo is the answer of a single pool to an object lookup.
+type poolObjInfo struct {
+	PoolIndex int
+	ObjInfo   ObjectInfo
+	Err       error
+}
+
+// getPoolObjInfos looks up object in all pools, the answers are sorted
+// latest first.
+func (z *erasureServerPools) getPoolObjInfos(ctx context.Context, bucket, object string, opts ObjectOptions) []poolObjInfo {
+	results := make([]poolObjInfo, len(z.serverPools))
 	var wg sync.WaitGroup
 	for i, pool := range z.serverPools {
 		wg.Add(1)
 		go func(i int, pool *erasureSets) {
 			defer wg.Done()
-			results[i].zIdx = i
-			results[i].oi, results[i].err = pool.GetObjectInfo(ctx, bucket, object, opts)
+			results[i].PoolIndex = i
+			results[i].ObjInfo, results[i].Err = pool.GetObjectInfo(ctx, bucket, object, opts)
 		}(i, pool)
 	}
 	wg.Wait()
@@ -871,29 +1055,43 @@ func (z *erasureServerPools) getLatestObjectInfoWithIdx(ctx context.Context, buc
 	// the latest object.
 	sort.Slice(results, func(i, j int) bool {
 		a, b := results[i], results[j]
-		if a.oi.ModTime.Equal(b.oi.ModTime) {
+		if a.ObjInfo.ModTime.Equal(b.ObjInfo.ModTime) {
 			// On tiebreak, select the lowest pool index.
-			return a.zIdx < b.zIdx
+			return a.PoolIndex < b.PoolIndex
 		}
-		return a.oi.ModTime.After(b.oi.ModTime)
+		return a.ObjInfo.ModTime.After(b.ObjInfo.ModTime)
 	})
+	return results
+}
 
-	for _, res := range results {
-		err := res.err
+// pickPoolObjInfo returns the index of the answer served from results
+// sorted by getPoolObjInfos, -1 when the object is not found.
+func pickPoolObjInfo(results []poolObjInfo, opts ObjectOptions) int {
+	for i, res := range results {
+		err := res.Err
 		if err == nil {
-			return res.oi, res.zIdx, nil
+			return i
 		}
 		if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
 			// some errors such as MethodNotAllowed for delete marker
 			// should be returned upwards.
-			return res.oi, res.zIdx, err
+			return i
 		}
 		// When its a delete marker and versionID is empty
 		// we should simply return the error right away.
-		if res.oi.DeleteMarker && opts.VersionID == "" {
-			return res.oi, res.zIdx, err
+		if res.ObjInfo.DeleteMarker && opts.VersionID == "" {
+			return i
 		}
 	}
+	return -1
+}
+
+func (z *erasureServerPools) getLatestObjectInfoWithIdx(ctx context.Context, bucket, object string, opts ObjectOptions) (ObjectInfo, int, error) {
+	object = encodeDirObject(object)
+	results := z.getPoolObjInfos(ctx, bucket, object, opts)
+	if i := pickPoolObjInfo(results, opts); i >= 0 {
+		return results[i].ObjInfo, results[i].PoolIndex, results[i].Err
+	}
 
 	object = decodeDirObject(object)
 	if opts.VersionID != "" {
@@ -902,6 +1100,142 @@ func (z *erasureServerPools) getLatestObjectInfoWithIdx(ctx context.Context, buc
 	return ObjectInfo{}, -1, ObjectNotFound{Bucket: bucket, Object: object}
 }
 
+// PoolObjectLocation is the answer of a pool to the lookup of an object.
+type PoolObjectLocation struct {
+	Pool int `json:"pool"`
+	Set  int `json:"set"`
+	// Considered is set when the answer of the pool was looked at
+	// to pick the one served, pools are considered latest first.
+	Considered   bool      `json:"considered"`
+	Status       string    `json:"status"` // found, not-found or error
+	Error        string    `json:"error,omitempty"`
+	VersionID    string    `json:"versionId,omitempty"`
+	ModTime      time.Time `json:"modTime,omitempty"`
+	Size         int64     `json:"size,omitempty"`
+	DeleteMarker bool      `json:"deleteMarker,omitempty"`
+	// Drives of the set and how many of them have the object.
+	Drives         int `json:"drives"`
+	DrivesFound    int `json:"drivesFound"`
+	DrivesNotFound int `json:"drivesNotFound"`
+	DrivesFailed   int `json:"drivesFailed"`
+	ReadQuorum     int `json:"readQuorum,omitempty"`
+	WriteQuorum    int `json:"writeQuorum,omitempty"`
+}
+
+// ObjectLocation describes how an object is resolved across pools.
+type ObjectLocation struct {
+	Bucket    string               `json:"bucket"`
+	Object    string               `json:"object"`
+	VersionID string               `json:"versionId,omitempty"`
+	Pools     []PoolObjectLocation `json:"pools"`
+	// Pool the answer is served from, -1 when not found.
+	Pool  int    `json:"pool"`
+	Error string `json:"error,omitempty"`
+	// MultiplePools is set when the object exists in more than one
+	// pool, which is not expected and needs to be reconciled.
+	MultiplePools bool `json:"multiplePools"`
+}
+
+// LocateObject looks up an object in all pools the same way
+// GetObjectInfo does and reports the answer of every pool along with
+// the pool the object is served from.
+func (z *erasureServerPools) LocateObject(ctx context.Context, bucket, object string, opts ObjectOptions) (l ObjectLocation, err error) {
+	if err = checkGetObjArgs(ctx, bucket, object); err != nil {
+		return l, err
+	}
+	l = ObjectLocation{
+		Bucket:    bucket,
+		Object:    object,
+		VersionID: opts.VersionID,
+		Pool:      -1,
+	}
+
+	object = encodeDirObject(object)
+
+	opts.NoLock = true
+	lk := z.NewNSLock(bucket, object)
+	lkctx, err := lk.GetRLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return l, err
+	}
+	ctx = lkctx.Context()
+	defer lk.RUnlock(lkctx)
+
+	l.Pools = make([]PoolObjectLocation, len(z.serverPools))
+	var wg sync.WaitGroup
+	for i, pool := range z.serverPools {
+		wg.Add(1)
+		go func(i int, pool *erasureSets) {
+			defer wg.Done()
+			set := pool.getHashedSet(object)
+			disks := set.getDisks()
+			metaArr, errs := readAllFileInfo(ctx, disks, bucket, object, opts.VersionID, false)
+			pl := PoolObjectLocation{
+				Pool:   i,
+				Set:    pool.getHashedSetIndex(object),
+				Drives: len(disks),
+			}
+			for _, err := range errs {
+				switch err {
+				case nil:
+					pl.DrivesFound++
+				case errFileNotFound, errFileVersionNotFound:
+					pl.DrivesNotFound++
+				default:
+					pl.DrivesFailed++
+				}
+			}
+			if pl.DrivesFound > 0 {
+				pl.ReadQuorum, pl.WriteQuorum, _ = objectQuorumFromMeta(ctx, metaArr, errs, set.defaultParityCount)
+			}
+			l.Pools[i] = pl
+		}(i, pool)
+	}
+	wg.Wait()
+
+	var found int
+	results := z.getPoolObjInfos(ctx, bucket, object, opts)
+	for _, res := range results {
+		pl := &l.Pools[res.PoolIndex]
+		oi := res.ObjInfo
+		pl.VersionID = oi.VersionID
+		pl.ModTime = oi.ModTime
+		pl.Size = oi.Size
+		pl.DeleteMarker = oi.DeleteMarker
+		switch {
+		case res.Err == nil:
+			pl.Status = "found"
+		case isErrObjectNotFound(res.Err) || isErrVersionNotFound(res.Err):
+			pl.Status = "not-found"
+		default:
+			pl.Status = "error"
+		}
+		if res.Err != nil {
+			pl.Error = res.Err.Error()
+		}
+		if res.Err == nil || oi.DeleteMarker {
+			found++
+		}
+	}
+	l.MultiplePools = found > 1
+
+	i := pickPoolObjInfo(results, opts)
+	for j := 0; j < len(results) && (i < 0 || j <= i); j++ {
+		l.Pools[results[j].PoolIndex].Considered = true
+	}
+	if i >= 0 {
+		l.Pool = results[i].PoolIndex
+		if results[i].Err != nil {
+			l.Error = results[i].Err.Error()
+		}
+	} else if opts.VersionID != "" {
+		l.Error = VersionNotFound{Bucket: bucket, Object: l.Object, VersionID: opts.VersionID}.Error()
+	} else {
+		l.Error = ObjectNotFound{Bucket: bucket, Object: l.Object}.Error()
+	}
+	return l, nil
+}
+
 func (z *erasureServerPools) GetObjectInfo(ctx context.Context, bucket, object string, opts ObjectOptions) (objInfo ObjectInfo, err error) {
 	if err = checkGetObjArgs(ctx, bucket, object); err != nil {
 		return objInfo, err
@@ -1334,7 +1668,7 @@ func (z *erasureServerPools) ListObjects(ctx context.Context, bucket, prefix, ma
 
 	merged, err := z.listPath(ctx, &opts)
 	if err != nil && err != io.EOF {
-		if !isErrBucketNotFound(err) {
+		if _, ok := err.(ListingTimeout); !ok && !isErrBucketNotFound(err) {
 			logger.LogIf(ctx, err)
 		}
 		return loi, err
@@ -2083,6 +2417,35 @@ func (z *erasureServerPools) HealObject(ctx context.Context, bucket, object, ver
 	}
 }
 
+// Maximum number of objects reconstructed at once by ReconstructObjects.
+const reconstructObjectsConcurrency = 16
+
+// objectVersion is an object of a bucket, the latest version of the
+// object if VersionID is empty.
+type objectVersion struct {
+	Object    string
+	VersionID string
+}
+
+// ReconstructObjects heals a known list of damaged objects of a bucket,
+// rebuilding their missing or corrupted parts from the other drives. It
+// returns the heal result and the error of each object, in order.
+func (z *erasureServerPools) ReconstructObjects(ctx context.Context, bucket string, objects []objectVersion) ([]madmin.HealResultItem, []error) {
+	results := make([]madmin.HealResultItem, len(objects))
+	g := errgroup.WithNErrs(len(objects)).WithConcurrency(reconstructObjectsConcurrency)
+	for index := range objects {
+		index := index
+		g.Go(func() (err error) {
+			// Deep scan to detect the bitrot of parts as well.
+			results[index], err = z.HealObject(ctx, bucket, objects[index].Object, objects[index].VersionID, madmin.HealOpts{
+				ScanMode: madmin.HealDeepScan,
+			})
+			return err
+		}, index)
+	}
+	return results, g.Wait()
+}
+
 func (z *erasureServerPools) getPoolAndSet(id string) (poolIdx, setIdx, diskIdx int, err error) {
 	for poolIdx := range z.serverPools {
 		format := z.serverPools[poolIdx].format
@@ -0,0 +1,163 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+)
+
+func TestSimulatePutObject(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	objLayer, fsDirs, err := prepareErasurePools()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(objLayer)
+	z := objLayer.(*erasureServerPools)
+
+	bucket, object := "bucket", "object"
+	if err = objLayer.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := z.SimulatePutObject(ctx, bucket, object, 1<<20, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Existing {
+		t.Fatal("expected a new object")
+	}
+	if len(p.Chances) != 2 {
+		t.Fatalf("expected chances for 2 pools, got %d", len(p.Chances))
+	}
+	var sum float64
+	for _, c := range p.Chances {
+		sum += c.Chance
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Fatalf("expected chances to sum up to 1, got %v", sum)
+	}
+	if p.DataDrives+p.ParityDrives != 16 || len(p.Drives) != 16 {
+		t.Fatalf("unexpected erasure layout %+v", p)
+	}
+	if p.ParityDrives != p.Parity {
+		t.Fatalf("expected parity %d with all drives online, got %d", p.Parity, p.ParityDrives)
+	}
+	if _, err = objLayer.GetObjectInfo(ctx, bucket, object, ObjectOptions{}); !isErrObjectNotFound(err) {
+		t.Fatalf("expected nothing to be written, got %v", err)
+	}
+
+	data := bytes.Repeat([]byte("a"), 1024)
+	if _, err = objLayer.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	idx, err := z.getPoolIdxExistingWithOpts(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err = z.SimulatePutObject(ctx, bucket, object, 1<<20, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Existing || p.Pool != idx || len(p.Chances) != 0 {
+		t.Fatalf("expected overwrite in pool %d, got %+v", idx, p)
+	}
+	if want := z.serverPools[idx].getHashedSetIndex(object); p.Set != want {
+		t.Fatalf("expected set %d, got %d", want, p.Set)
+	}
+}
+
+func TestLocateObject(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	objLayer, fsDirs, err := prepareErasurePools()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(objLayer)
+	z := objLayer.(*erasureServerPools)
+
+	bucket, object := "bucket", "object"
+	if err = objLayer.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := z.LocateObject(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Pool != -1 || l.Error == "" || l.MultiplePools {
+		t.Fatalf("expected object not to be found, got %+v", l)
+	}
+	for _, pl := range l.Pools {
+		if pl.Status != "not-found" || !pl.Considered || pl.DrivesNotFound != pl.Drives {
+			t.Fatalf("unexpected answer of pool %d: %+v", pl.Pool, pl)
+		}
+	}
+
+	data := bytes.Repeat([]byte("a"), 1024)
+	if _, err = objLayer.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	idx, err := z.getPoolIdxExistingWithOpts(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err = z.LocateObject(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Pool != idx || l.Error != "" || l.MultiplePools {
+		t.Fatalf("expected object to be served from pool %d, got %+v", idx, l)
+	}
+	pl := l.Pools[idx]
+	if pl.Status != "found" || pl.Size != int64(len(data)) || pl.DrivesFound != pl.Drives || pl.ReadQuorum == 0 {
+		t.Fatalf("unexpected answer of pool %d: %+v", idx, pl)
+	}
+	if want := z.serverPools[idx].getHashedSetIndex(object); pl.Set != want {
+		t.Fatalf("expected set %d, got %d", want, pl.Set)
+	}
+
+	// Write a newer copy of the object in the other pool.
+	other := 1 - idx
+	if _, err = z.serverPools[other].PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	l, err = z.LocateObject(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Pool != other || !l.MultiplePools {
+		t.Fatalf("expected object in multiple pools served from pool %d, got %+v", other, l)
+	}
+	if l.Pools[idx].Status != "found" || l.Pools[idx].Considered {
+		t.Fatalf("expected older copy in pool %d not to be considered, got %+v", idx, l.Pools[idx])
+	}
+}
@@ -103,6 +103,10 @@ func (d byDiskTotal) Less(i, j int) bool {
 	return d[i].TotalSpace < d[j].TotalSpace
 }
 
+// driveStateFaultyHung is the state of drives taken offline as their
+// operations do not complete in time.
+const driveStateFaultyHung = madmin.DriveStateFaulty + " (hung)"
+
 func diskErrToDriveState(err error) (state string) {
 	switch {
 	case errors.Is(err, errDiskNotFound) || errors.Is(err, context.DeadlineExceeded):
@@ -115,6 +119,8 @@ func diskErrToDriveState(err error) (state string) {
 		state = madmin.DriveStatePermission
 	case errors.Is(err, errFaultyDisk):
 		state = madmin.DriveStateFaulty
+	case errors.Is(err, errDriveHung):
+		state = driveStateFaultyHung
 	case err == nil:
 		state = madmin.DriveStateOk
 	default:
@@ -498,6 +504,7 @@ func (er erasureObjects) nsScanner(ctx context.Context, buckets []BucketInfo, wa
 				}(cache.Info.Name)
 				// Calc usage
 				before := cache.Info.LastUpdate
+				scanStart := time.Now()
 				var err error
 				cache, err = disk.NSScanner(ctx, cache, updates, healScanMode)
 				if err != nil {
@@ -515,6 +522,7 @@ func (er erasureObjects) nsScanner(ctx context.Context, buckets []BucketInfo, wa
 				}
 
 				wg.Wait()
+				globalScannerMetrics.setBucketScanDuration(cache.Info.Name, time.Since(scanStart))
 				var root dataUsageEntry
 				if r := cache.root(); r != nil {
 					root = cache.flatten(*r)
@@ -0,0 +1,398 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/minio/minio/internal/event"
+	"github.com/minio/minio/internal/logger"
+)
+
+const (
+	// Journal objects of a bucket are stored under
+	// buckets/<bucket>/events/ in the meta bucket.
+	eventJournalPrefix = "events"
+
+	// Number of events queued for the journal writer, events
+	// published while the queue is full are dropped.
+	eventJournalQueueSize = 10000
+
+	// Maximum number of events of a bucket in a journal object.
+	eventJournalBatchSize = 1000
+
+	// Interval at which partial batches are written.
+	eventJournalFlushInterval = 30 * time.Second
+
+	// Interval at which journal objects past their retention are removed.
+	eventJournalCleanupInterval = time.Hour
+
+	// Retention of journal objects when none is configured.
+	defaultEventJournalRetentionDays = 7
+	maxEventJournalRetentionDays     = 365
+
+	// Response element set on replayed events, consumers can use it
+	// along with the sequencer of the object to dedupe events.
+	eventReplayElement = "x-minio-replay"
+)
+
+// BucketEventJournalConfig - per bucket event journal settings.
+type BucketEventJournalConfig struct {
+	// Enabled when true, the events published for the bucket are
+	// journaled and can be replayed.
+	Enabled bool `json:"enabled"`
+	// RetentionDays is the number of days journaled events are kept,
+	// defaults to 7 days.
+	RetentionDays int `json:"retention-days,omitempty"`
+}
+
+// Retention returns the duration journaled events are kept.
+func (c *BucketEventJournalConfig) Retention() time.Duration {
+	days := defaultEventJournalRetentionDays
+	if c != nil && c.RetentionDays > 0 {
+		days = c.RetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// parseBucketEventJournalConfig parses BucketEventJournalConfig from json
+func parseBucketEventJournalConfig(data []byte) (*BucketEventJournalConfig, error) {
+	cfg := &BucketEventJournalConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return cfg, err
+	}
+	if cfg.RetentionDays < 0 || cfg.RetentionDays > maxEventJournalRetentionDays {
+		return cfg, fmt.Errorf("retention-days must be between 0 and %d", maxEventJournalRetentionDays)
+	}
+	return cfg, nil
+}
+
+// EventReplayResult is the outcome of an event replay.
+type EventReplayResult struct {
+	// Events is the number of journaled events in the time range.
+	Events int `json:"events"`
+	// Replayed is the number of events sent to the target.
+	Replayed int `json:"replayed"`
+	// Failed is the number of events the target failed to accept.
+	Failed int    `json:"failed"`
+	Error  string `json:"error,omitempty"`
+}
+
+type eventJournalEntry struct {
+	bucket string
+	event  event.Event
+}
+
+// eventJournal appends the events published for buckets with the
+// journal enabled to compressed objects in the meta bucket.
+type eventJournal struct {
+	// Number of events journaled, dropped when the queue was full and
+	// lost on failed writes.
+	journaled, dropped, failed uint64
+
+	entries chan eventJournalEntry
+}
+
+var globalEventJournal = newEventJournal()
+
+func newEventJournal() *eventJournal {
+	return &eventJournal{
+		entries: make(chan eventJournalEntry, eventJournalQueueSize),
+	}
+}
+
+// enabled returns true if the events of bucket are journaled.
+func (j *eventJournal) enabled(bucket string) bool {
+	if globalBucketMetadataSys == nil {
+		return false
+	}
+	cfg, _, err := globalBucketMetadataSys.GetEventJournalConfig(GlobalContext, bucket)
+	return err == nil && cfg.Enabled
+}
+
+// append queues an event for the journal of bucket, it never blocks
+// and drops the event if the writer is falling behind.
+func (j *eventJournal) append(bucket string, ev event.Event) {
+	select {
+	case j.entries <- eventJournalEntry{bucket: bucket, event: ev}:
+	default:
+		atomic.AddUint64(&j.dropped, 1)
+	}
+}
+
+func eventJournalBucketPrefix(bucket string) string {
+	return path.Join(bucketMetaPrefix, bucket, eventJournalPrefix) + SlashSeparator
+}
+
+// eventJournalObject returns the name of a journal object, the times
+// of its first and last events are encoded in the name so that replays
+// and retention need not read objects outside of their time range.
+func eventJournalObject(bucket string, first, last time.Time) string {
+	return eventJournalBucketPrefix(bucket) + fmt.Sprintf("%019d-%019d-%s.json.zst", first.UnixNano(), last.UnixNano(), mustGetUUID())
+}
+
+// parseEventJournalObject returns the times of the first and last
+// events of a journal object.
+func parseEventJournalObject(name string) (first, last time.Time, err error) {
+	tokens := strings.SplitN(path.Base(name), "-", 3)
+	if len(tokens) != 3 {
+		return first, last, fmt.Errorf("invalid event journal object %s", name)
+	}
+	f, err := strconv.ParseInt(tokens[0], 10, 64)
+	if err != nil {
+		return first, last, err
+	}
+	l, err := strconv.ParseInt(tokens[1], 10, 64)
+	if err != nil {
+		return first, last, err
+	}
+	return time.Unix(0, f).UTC(), time.Unix(0, l).UTC(), nil
+}
+
+func eventTime(ev event.Event) time.Time {
+	t, err := time.Parse(event.AMZTimeFormat, ev.EventTime)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// encodeEventJournal returns the events as zstd compressed JSON lines.
+func encodeEventJournal(events []event.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	enc, err := zstd.NewWriter(&buf, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	if err != nil {
+		return nil, err
+	}
+	jenc := json.NewEncoder(enc)
+	for _, ev := range events {
+		if err = jenc.Encode(ev); err != nil {
+			enc.Close()
+			return nil, err
+		}
+	}
+	if err = enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeEventJournal returns the events of a journal object.
+func decodeEventJournal(data []byte) ([]event.Event, error) {
+	dec, err := zstd.NewReader(bytes.NewReader(data), zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	defer dec.Close()
+
+	var events []event.Event
+	scanner := bufio.NewScanner(dec)
+	scanner.Buffer(make([]byte, 64<<10), 16<<20)
+	for scanner.Scan() {
+		var ev event.Event
+		if err = json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, scanner.Err()
+}
+
+// write saves a batch of events of bucket as a journal object.
+func (j *eventJournal) write(ctx context.Context, objAPI ObjectLayer, bucket string, events []event.Event) {
+	if len(events) == 0 {
+		return
+	}
+	data, err := encodeEventJournal(events)
+	if err == nil {
+		name := eventJournalObject(bucket, eventTime(events[0]), eventTime(events[len(events)-1]))
+		err = saveConfig(ctx, objAPI, name, data)
+	}
+	if err != nil {
+		atomic.AddUint64(&j.failed, uint64(len(events)))
+		logger.LogOnceIf(ctx, fmt.Errorf("Unable to journal events of bucket %s: %w", bucket, err), "event-journal-"+bucket)
+		return
+	}
+	atomic.AddUint64(&j.journaled, uint64(len(events)))
+}
+
+// run batches the queued events per bucket and writes them until ctx
+// is canceled.
+func (j *eventJournal) run(ctx context.Context, objAPI ObjectLayer) {
+	batches := make(map[string][]event.Event)
+	flush := func() {
+		for bucket, events := range batches {
+			j.write(ctx, objAPI, bucket, events)
+			delete(batches, bucket)
+		}
+	}
+
+	ticker := time.NewTicker(eventJournalFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-j.entries:
+			batches[e.bucket] = append(batches[e.bucket], e.event)
+			if len(batches[e.bucket]) >= eventJournalBatchSize {
+				j.write(ctx, objAPI, e.bucket, batches[e.bucket])
+				delete(batches, e.bucket)
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// listJournalObjects calls fn with the journal objects of bucket
+// holding events between start and end.
+func listJournalObjects(ctx context.Context, objAPI ObjectLayer, bucket string, start, end time.Time, fn func(name string, first, last time.Time) error) error {
+	prefix := eventJournalBucketPrefix(bucket)
+	marker := ""
+	for {
+		res, err := objAPI.ListObjects(ctx, minioMetaBucket, prefix, marker, "", maxObjectList)
+		if err != nil {
+			return err
+		}
+		for _, obj := range res.Objects {
+			first, last, err := parseEventJournalObject(obj.Name)
+			if err != nil {
+				continue
+			}
+			if last.Before(start) || first.After(end) {
+				continue
+			}
+			if err = fn(obj.Name, first, last); err != nil {
+				return err
+			}
+		}
+		if !res.IsTruncated {
+			return nil
+		}
+		marker = res.NextMarker
+	}
+}
+
+// applyRetention removes the journal objects of bucket whose events
+// are all past the retention.
+func (j *eventJournal) applyRetention(ctx context.Context, objAPI ObjectLayer, bucket string, retention time.Duration) error {
+	return listJournalObjects(ctx, objAPI, bucket, time.Time{}, UTCNow().Add(-retention), func(name string, _, last time.Time) error {
+		if UTCNow().Sub(last) < retention {
+			return nil
+		}
+		if err := deleteConfig(ctx, objAPI, name); err != nil && !errors.Is(err, errConfigNotFound) {
+			return err
+		}
+		return nil
+	})
+}
+
+// runRetention removes expired journal objects of all the buckets,
+// only on the node holding the leader lock.
+func (j *eventJournal) runRetention(ctx context.Context, objAPI ObjectLayer) {
+	ctx, cancel := globalLeaderLock.GetLock(ctx)
+	defer cancel()
+
+	ticker := time.NewTicker(eventJournalCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		buckets, err := objAPI.ListBuckets(ctx, BucketOptions{})
+		if err != nil {
+			logger.LogIf(ctx, err)
+			continue
+		}
+		for _, bucket := range buckets {
+			// Journals left behind by buckets which disabled their
+			// journal expire with the default retention.
+			cfg, _, _ := globalBucketMetadataSys.GetEventJournalConfig(ctx, bucket.Name)
+			logger.LogIf(ctx, j.applyRetention(ctx, objAPI, bucket.Name, cfg.Retention()))
+		}
+	}
+}
+
+// replay sends the journaled events of bucket published between start
+// and end to target, marked as replayed.
+func (j *eventJournal) replay(ctx context.Context, objAPI ObjectLayer, bucket string, start, end time.Time, target event.Target) (res EventReplayResult, err error) {
+	err = listJournalObjects(ctx, objAPI, bucket, start, end, func(name string, _, _ time.Time) error {
+		data, err := readConfig(ctx, objAPI, name)
+		if err != nil {
+			if errors.Is(err, errConfigNotFound) {
+				// Removed by retention meanwhile.
+				return nil
+			}
+			return err
+		}
+		events, err := decodeEventJournal(data)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			t := eventTime(ev)
+			if t.Before(start) || t.After(end) {
+				continue
+			}
+			res.Events++
+			if ev.ResponseElements == nil {
+				ev.ResponseElements = make(map[string]string, 1)
+			}
+			ev.ResponseElements[eventReplayElement] = "true"
+			if err = target.Save(ev); err != nil {
+				res.Failed++
+				logger.LogOnceIf(ctx, err, "event-replay-"+target.ID().String())
+				continue
+			}
+			res.Replayed++
+		}
+		return nil
+	})
+	return res, err
+}
+
+// initEventJournal starts the journal writer and the removal of
+// journal objects past their retention.
+func initEventJournal(ctx context.Context, objAPI ObjectLayer) {
+	go globalEventJournal.run(ctx, objAPI)
+	go func() {
+		for {
+			// Returns when the leader lock is lost.
+			globalEventJournal.runRetention(ctx, objAPI)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Minute):
+			}
+		}
+	}()
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/internal/event"
+)
+
+type replayTestTarget struct {
+	events []event.Event
+}
+
+func (t *replayTestTarget) ID() event.TargetID {
+	return event.TargetID{ID: "1", Name: "replay"}
+}
+
+func (t *replayTestTarget) IsActive() (bool, error) { return true, nil }
+
+func (t *replayTestTarget) Save(ev event.Event) error {
+	t.events = append(t.events, ev)
+	return nil
+}
+
+func (t *replayTestTarget) Send(string) error        { return nil }
+func (t *replayTestTarget) Close() error             { return nil }
+func (t *replayTestTarget) Store() event.TargetStore { return nil }
+
+func TestEventJournalReplay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	base := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	newEvent := func(key string, t time.Time) event.Event {
+		return event.Event{
+			EventName: event.ObjectCreatedPut,
+			EventTime: t.Format(event.AMZTimeFormat),
+			S3: event.Metadata{
+				Bucket: event.Bucket{Name: "bucket"},
+				Object: event.Object{Key: key},
+			},
+		}
+	}
+
+	j := newEventJournal()
+	j.write(ctx, obj, "bucket", []event.Event{
+		newEvent("a", base),
+		newEvent("b", base.Add(time.Minute)),
+	})
+	j.write(ctx, obj, "bucket", []event.Event{
+		newEvent("c", base.Add(time.Hour)),
+		newEvent("d", base.Add(2*time.Hour)),
+	})
+	j.write(ctx, obj, "other", []event.Event{
+		newEvent("e", base.Add(time.Minute)),
+	})
+	if j.journaled != 5 || j.failed != 0 {
+		t.Fatalf("expected 5 journaled events, got %d journaled and %d failed", j.journaled, j.failed)
+	}
+
+	target := &replayTestTarget{}
+	res, err := j.replay(ctx, obj, "bucket", base.Add(time.Minute), base.Add(time.Hour), target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Events != 2 || res.Replayed != 2 || res.Failed != 0 {
+		t.Fatalf("unexpected replay result %+v", res)
+	}
+	for i, key := range []string{"b", "c"} {
+		ev := target.events[i]
+		if ev.S3.Object.Key != key {
+			t.Errorf("expected event of %s, got %s", key, ev.S3.Object.Key)
+		}
+		if ev.ResponseElements[eventReplayElement] != "true" {
+			t.Errorf("expected event of %s to be marked as replayed", key)
+		}
+	}
+
+	// The first journal object is past a one hour retention.
+	if err = j.applyRetention(ctx, obj, "bucket", UTCNow().Sub(base.Add(90*time.Minute))); err != nil {
+		t.Fatal(err)
+	}
+	target = &replayTestTarget{}
+	if res, err = j.replay(ctx, obj, "bucket", base, base.Add(3*time.Hour), target); err != nil {
+		t.Fatal(err)
+	}
+	if res.Replayed != 2 || target.events[0].S3.Object.Key != "c" {
+		t.Fatalf("unexpected replay result %+v after retention", res)
+	}
+}
+
+func TestEventJournalDrops(t *testing.T) {
+	j := newEventJournal()
+	for i := 0; i < eventJournalQueueSize+10; i++ {
+		j.append("bucket", event.Event{})
+	}
+	if j.dropped != 10 {
+		t.Fatalf("expected 10 dropped events, got %d", j.dropped)
+	}
+}
+
+func TestParseBucketEventJournalConfig(t *testing.T) {
+	testCases := []struct {
+		data      string
+		retention time.Duration
+		success   bool
+	}{
+		{`{"enabled":true}`, 7 * 24 * time.Hour, true},
+		{`{"enabled":true,"retention-days":30}`, 30 * 24 * time.Hour, true},
+		{`{"enabled":true,"retention-days":-1}`, 0, false},
+		{`{"enabled":true,"retention-days":366}`, 0, false},
+		{`{"enabled":`, 0, false},
+	}
+	for i, tc := range testCases {
+		cfg, err := parseBucketEventJournalConfig([]byte(tc.data))
+		if (err == nil) != tc.success {
+			t.Fatalf("case %d: expected success %v, got %v", i+1, tc.success, err)
+		}
+		if tc.success && cfg.Retention() != tc.retention {
+			t.Fatalf("case %d: expected retention %v, got %v", i+1, tc.retention, cfg.Retention())
+		}
+	}
+}
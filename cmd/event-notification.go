@@ -224,11 +224,33 @@ func (evnot *EventNotifier) send(args eventArgs) {
 	targetIDSet := evnot.bucketRulesMap[args.BucketName].Match(args.EventName, args.Object.Name)
 	evnot.RUnlock()
 
+	journaled := globalEventJournal.enabled(args.BucketName)
+	if len(targetIDSet) == 0 && !journaled {
+		return
+	}
+
+	// The journaled event is the one sent to the targets, so that
+	// replays carry the same event time and sequencer.
+	ev := args.ToEvent(true)
+	if journaled {
+		globalEventJournal.append(args.BucketName, ev)
+	}
 	if len(targetIDSet) == 0 {
 		return
 	}
 
-	evnot.targetList.Send(args.ToEvent(true), targetIDSet, evnot.targetResCh)
+	evnot.targetList.Send(ev, targetIDSet, evnot.targetResCh)
+}
+
+// targetByARN returns the notification target with the ARN arn.
+func (evnot *EventNotifier) targetByARN(arn string) (event.Target, bool) {
+	region := globalSite.Region
+	for targetID, target := range evnot.targetList.TargetMap() {
+		if targetID.ToARN(region).String() == arn {
+			return target, true
+		}
+	}
+	return nil, false
 }
 
 type eventArgs struct {
@@ -0,0 +1,269 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	xioutil "github.com/minio/minio/internal/ioutil"
+)
+
+// Placement of a drive relative to the position it is mounted into.
+const (
+	// The drive carries the format of its position.
+	formatDriveOK = "ok"
+	// The drive carries the format of another drive of the same set.
+	formatDriveSwappedWithinSet = "swapped-within-set"
+	// The drive carries the format of a drive of another set or pool.
+	formatDriveSwappedAcrossSet = "swapped-across-set"
+	// The drive carries the format of another deployment, or a drive ID
+	// unknown to this deployment.
+	formatDriveForeign = "foreign"
+	// The drive or its format could not be read.
+	formatDriveMissing = "missing"
+)
+
+// FormatCheckDrive is the placement of a local drive. Pool, Set and
+// Drive are the position the drive is mounted into, derived from the
+// order of the endpoints, FormatPool, FormatSet and FormatDrive the
+// position its format.json assigns it, -1 if unknown.
+type FormatCheckDrive struct {
+	Endpoint        string `json:"endpoint"`
+	Pool            int    `json:"pool"`
+	Set             int    `json:"set"`
+	Drive           int    `json:"drive"`
+	ExpectedDriveID string `json:"expectedDriveId,omitempty"`
+	DriveID         string `json:"driveId,omitempty"`
+	DeploymentID    string `json:"deploymentId,omitempty"`
+	FormatPool      int    `json:"formatPool"`
+	FormatSet       int    `json:"formatSet"`
+	FormatDrive     int    `json:"formatDrive"`
+	State           string `json:"state"`
+	Error           string `json:"error,omitempty"`
+	Remediation     string `json:"remediation,omitempty"`
+}
+
+// FormatCheckNodeStatus is the placement of the local drives of a node.
+type FormatCheckNodeStatus struct {
+	Node   string             `json:"node"`
+	Drives []FormatCheckDrive `json:"drives,omitempty"`
+	Error  string             `json:"error,omitempty"`
+}
+
+// FormatCheckSet counts the drives of a set that are not where the
+// format expects them.
+type FormatCheckSet struct {
+	Pool              int `json:"pool"`
+	Set               int `json:"set"`
+	SwappedWithinSet  int `json:"swappedWithinSet,omitempty"`
+	SwappedAcrossSets int `json:"swappedAcrossSets,omitempty"`
+	Foreign           int `json:"foreign,omitempty"`
+	Missing           int `json:"missing,omitempty"`
+}
+
+func (s FormatCheckSet) misplaced() bool {
+	return s.SwappedWithinSet+s.SwappedAcrossSets+s.Foreign > 0
+}
+
+// FormatCheckInfo is the placement of all drives of the cluster.
+// Misplaced is set if any set operates with drives swapped or foreign
+// to it, Sets lists the sets with such or missing drives.
+type FormatCheckInfo struct {
+	DeploymentID string                  `json:"deploymentId"`
+	Misplaced    bool                    `json:"misplaced"`
+	Summary      string                  `json:"summary"`
+	Sets         []FormatCheckSet        `json:"sets,omitempty"`
+	Nodes        []FormatCheckNodeStatus `json:"nodes"`
+}
+
+// newFormatCheckInfo summarizes the placement reported by the nodes.
+func newFormatCheckInfo(deploymentID string, nodes []FormatCheckNodeStatus) FormatCheckInfo {
+	info := FormatCheckInfo{
+		DeploymentID: deploymentID,
+		Nodes:        nodes,
+	}
+
+	type setIdx struct{ pool, set int }
+	sets := make(map[setIdx]*FormatCheckSet)
+	var order []setIdx
+	var offline, misplacedSets int
+	for _, node := range nodes {
+		if node.Error != "" {
+			offline++
+		}
+		for _, d := range node.Drives {
+			if d.State == formatDriveOK {
+				continue
+			}
+			k := setIdx{d.Pool, d.Set}
+			s, ok := sets[k]
+			if !ok {
+				s = &FormatCheckSet{Pool: d.Pool, Set: d.Set}
+				sets[k] = s
+				order = append(order, k)
+			}
+			switch d.State {
+			case formatDriveSwappedWithinSet:
+				s.SwappedWithinSet++
+			case formatDriveSwappedAcrossSet:
+				s.SwappedAcrossSets++
+			case formatDriveForeign:
+				s.Foreign++
+			default:
+				s.Missing++
+			}
+		}
+	}
+	for _, k := range order {
+		s := *sets[k]
+		if s.misplaced() {
+			misplacedSets++
+		}
+		info.Sets = append(info.Sets, s)
+	}
+
+	info.Misplaced = misplacedSets > 0
+	switch {
+	case info.Misplaced:
+		info.Summary = fmt.Sprintf("%d set(s) operate with misplaced drives", misplacedSets)
+	case len(info.Sets) > 0:
+		info.Summary = fmt.Sprintf("no misplaced drives, %d set(s) have missing drives", len(info.Sets))
+	default:
+		info.Summary = "all drives are where the format expects them"
+	}
+	if offline > 0 {
+		info.Summary += fmt.Sprintf(", %d node(s) could not be checked", offline)
+	}
+	return info
+}
+
+// checkDriveFormat compares the format read from the drive mounted at
+// position pool, set, drive with the reference formats of the pools.
+func checkDriveFormat(refFormats []*formatErasureV3, deploymentID string, endpoint string, pool, set, drive int, format *formatErasureV3, err error) FormatCheckDrive {
+	d := FormatCheckDrive{
+		Endpoint:    endpoint,
+		Pool:        pool,
+		Set:         set,
+		Drive:       drive,
+		FormatPool:  -1,
+		FormatSet:   -1,
+		FormatDrive: -1,
+	}
+	if ref := refFormats[pool]; ref != nil && set < len(ref.Erasure.Sets) && drive < len(ref.Erasure.Sets[set]) {
+		d.ExpectedDriveID = ref.Erasure.Sets[set][drive]
+	}
+
+	if err != nil {
+		d.State = formatDriveMissing
+		d.Error = err.Error()
+		if errors.Is(err, errUnformattedDisk) {
+			d.Remediation = "The drive has no format.json. A fresh replacement drive is healed automatically, otherwise mount the drive that belongs to " + endpoint + "."
+		} else {
+			d.Remediation = "Check that the drive is mounted at " + endpoint + " and readable by the server."
+		}
+		return d
+	}
+
+	d.DriveID = format.Erasure.This
+	d.DeploymentID = format.ID
+	if format.ID != deploymentID {
+		d.State = formatDriveForeign
+		d.Remediation = fmt.Sprintf("The drive is formatted for deployment %s. Unmount it and mount the drive that belongs to %s, do not reformat it before checking whether it holds data of another deployment.", format.ID, endpoint)
+		return d
+	}
+
+	for p, ref := range refFormats {
+		if ref == nil {
+			continue
+		}
+		if s, i, err := findDiskIndexByDiskID(ref, format.Erasure.This); err == nil {
+			d.FormatPool, d.FormatSet, d.FormatDrive = p, s, i
+			break
+		}
+	}
+
+	switch {
+	case d.FormatPool < 0:
+		d.State = formatDriveForeign
+		d.Remediation = "The drive ID is not part of the format of this deployment. Unmount the drive and mount the drive that belongs to " + endpoint + "."
+	case d.FormatPool == pool && d.FormatSet == set && d.FormatDrive == drive:
+		d.State = formatDriveOK
+	case d.FormatPool == pool && d.FormatSet == set:
+		d.State = formatDriveSwappedWithinSet
+		d.Remediation = fmt.Sprintf("The drive belongs to drive %d of this set. Restore the mounts so that each drive is mounted at the path of its format position, preferably using filesystem labels or UUIDs in /etc/fstab.", d.FormatDrive)
+	default:
+		d.State = formatDriveSwappedAcrossSet
+		d.Remediation = fmt.Sprintf("The drive belongs to pool %d, set %d, drive %d. Restore the mounts so that each drive is mounted at the path of its format position, preferably using filesystem labels or UUIDs in /etc/fstab.", d.FormatPool, d.FormatSet, d.FormatDrive)
+	}
+	return d
+}
+
+// readDriveFormat reads format.json directly from the drive mounted at
+// the given path, regardless of the drive the server placed there.
+func readDriveFormat(drivePath string) (*formatErasureV3, error) {
+	buf, err := xioutil.ReadFile(pathJoin(drivePath, minioMetaBucket, formatConfigFile))
+	if err != nil {
+		if osIsNotExist(err) {
+			if err = Access(drivePath); err == nil {
+				return nil, errUnformattedDisk
+			}
+			return nil, errDiskNotFound
+		}
+		return nil, err
+	}
+	format := &formatErasureV3{}
+	if err = json.Unmarshal(buf, format); err != nil {
+		return nil, fmt.Errorf("%w: %v", errCorruptedFormat, err)
+	}
+	return format, nil
+}
+
+// localFormatCheck checks the format of every local drive against the
+// position it is mounted into.
+func localFormatCheck(objAPI ObjectLayer) FormatCheckNodeStatus {
+	st := FormatCheckNodeStatus{Node: globalLocalNodeName}
+	z, ok := objAPI.(*erasureServerPools)
+	if !ok {
+		st.Error = NotImplemented{}.Error()
+		return st
+	}
+
+	refFormats := make([]*formatErasureV3, len(z.serverPools))
+	for i, pool := range z.serverPools {
+		pool.erasureDisksMu.RLock()
+		refFormats[i] = pool.format
+		pool.erasureDisksMu.RUnlock()
+	}
+
+	for poolIdx, ep := range globalEndpoints {
+		if poolIdx >= len(refFormats) || ep.DrivesPerSet == 0 {
+			continue
+		}
+		for i, endpoint := range ep.Endpoints {
+			if !endpoint.IsLocal {
+				continue
+			}
+			format, err := readDriveFormat(endpoint.Path)
+			st.Drives = append(st.Drives, checkDriveFormat(refFormats, globalDeploymentID, endpoint.String(),
+				poolIdx, i/ep.DrivesPerSet, i%ep.DrivesPerSet, format, err))
+		}
+	}
+	return st
+}
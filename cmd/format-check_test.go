@@ -0,0 +1,132 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestCheckDriveFormat(t *testing.T) {
+	deploymentID := mustGetUUID()
+	refFormats := []*formatErasureV3{newFormatErasureV3(2, 4), newFormatErasureV3(1, 4)}
+	for _, ref := range refFormats {
+		ref.ID = deploymentID
+	}
+	driveFormat := func(pool, set, drive int) *formatErasureV3 {
+		format := refFormats[pool].Clone()
+		format.Erasure.This = format.Erasure.Sets[set][drive]
+		return format
+	}
+	foreign := newFormatErasureV3(2, 4)
+	foreign.ID = mustGetUUID()
+	foreign.Erasure.This = foreign.Erasure.Sets[0][1]
+	unknown := driveFormat(0, 0, 1)
+	unknown.Erasure.This = mustGetUUID()
+
+	testCases := []struct {
+		format *formatErasureV3
+		err    error
+		state  string
+		at     [3]int
+	}{
+		{driveFormat(0, 1, 2), nil, formatDriveOK, [3]int{0, 1, 2}},
+		{driveFormat(0, 1, 3), nil, formatDriveSwappedWithinSet, [3]int{0, 1, 3}},
+		{driveFormat(0, 0, 2), nil, formatDriveSwappedAcrossSet, [3]int{0, 0, 2}},
+		{driveFormat(1, 0, 2), nil, formatDriveSwappedAcrossSet, [3]int{1, 0, 2}},
+		{foreign, nil, formatDriveForeign, [3]int{-1, -1, -1}},
+		{unknown, nil, formatDriveForeign, [3]int{-1, -1, -1}},
+		{nil, errUnformattedDisk, formatDriveMissing, [3]int{-1, -1, -1}},
+		{nil, errDiskNotFound, formatDriveMissing, [3]int{-1, -1, -1}},
+	}
+	for i, tc := range testCases {
+		d := checkDriveFormat(refFormats, deploymentID, "/mnt/drive", 0, 1, 2, tc.format, tc.err)
+		if d.State != tc.state {
+			t.Errorf("case %d: expected state %s, got %s", i, tc.state, d.State)
+		}
+		if at := [3]int{d.FormatPool, d.FormatSet, d.FormatDrive}; at != tc.at {
+			t.Errorf("case %d: expected format position %v, got %v", i, tc.at, at)
+		}
+		if d.ExpectedDriveID != refFormats[0].Erasure.Sets[1][2] {
+			t.Errorf("case %d: expected drive ID %s, got %s", i, refFormats[0].Erasure.Sets[1][2], d.ExpectedDriveID)
+		}
+		if (d.State == formatDriveOK) != (d.Remediation == "") {
+			t.Errorf("case %d: unexpected remediation %q for state %s", i, d.Remediation, d.State)
+		}
+	}
+}
+
+func TestNewFormatCheckInfo(t *testing.T) {
+	info := newFormatCheckInfo("id", []FormatCheckNodeStatus{
+		{Node: "node1", Drives: []FormatCheckDrive{
+			{Pool: 0, Set: 0, State: formatDriveOK},
+			{Pool: 0, Set: 0, State: formatDriveMissing},
+			{Pool: 0, Set: 1, State: formatDriveSwappedWithinSet},
+			{Pool: 0, Set: 1, State: formatDriveSwappedWithinSet},
+		}},
+		{Node: "node2", Error: "offline"},
+	})
+	if !info.Misplaced || len(info.Sets) != 2 {
+		t.Fatalf("unexpected summary %+v", info)
+	}
+	if info.Sets[0].Missing != 1 || info.Sets[1].SwappedWithinSet != 2 {
+		t.Errorf("unexpected sets %+v", info.Sets)
+	}
+	if info.Summary != "1 set(s) operate with misplaced drives, 1 node(s) could not be checked" {
+		t.Errorf("unexpected summary %q", info.Summary)
+	}
+
+	info = newFormatCheckInfo("id", []FormatCheckNodeStatus{
+		{Node: "node1", Drives: []FormatCheckDrive{{State: formatDriveOK}}},
+	})
+	if info.Misplaced || len(info.Sets) != 0 {
+		t.Errorf("unexpected summary %+v", info)
+	}
+}
+
+func TestReadDriveFormat(t *testing.T) {
+	drive := t.TempDir()
+	if _, err := readDriveFormat(drive); !errors.Is(err, errUnformattedDisk) {
+		t.Fatalf("expected %v, got %v", errUnformattedDisk, err)
+	}
+	if _, err := readDriveFormat(pathJoin(drive, "missing")); !errors.Is(err, errDiskNotFound) {
+		t.Fatalf("expected %v, got %v", errDiskNotFound, err)
+	}
+
+	format := newFormatErasureV3(1, 4)
+	format.Erasure.This = format.Erasure.Sets[0][3]
+	buf, err := json.Marshal(format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(pathJoin(drive, minioMetaBucket), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(pathJoin(drive, minioMetaBucket, formatConfigFile), buf, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readDriveFormat(drive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Erasure.This != format.Erasure.This {
+		t.Errorf("expected drive ID %s, got %s", format.Erasure.This, got.Erasure.This)
+	}
+}
@@ -112,6 +112,12 @@ const (
 	// Maximum size of default bucket encryption configuration allowed
 	maxBucketSSEConfigSize = 1 * humanize.MiByte
 
+	// Maximum size of bucket CORS configuration allowed
+	maxBucketCorsConfigSize = 64 * humanize.KiByte
+
+	// Maximum size of bucket website configuration allowed
+	maxBucketWebsiteConfigSize = 1 * humanize.MiByte
+
 	// diskFillFraction is the fraction of a disk we allow to be filled.
 	diskFillFraction = 0.99
 
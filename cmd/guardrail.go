@@ -0,0 +1,282 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/minio/internal/config/guardrail"
+	"github.com/minio/minio/internal/logger"
+	"github.com/prometheus/procfs"
+)
+
+const (
+	// Interval between two samples of the resources used by the server.
+	guardrailSampleInterval = 5 * time.Second
+
+	// Delay added before each object processed by a batch job while
+	// the server sheds load.
+	guardrailBatchDelay = time.Second
+)
+
+// guardrailLevel is how much load a node sheds, each level also sheds
+// the work of the levels below it.
+type guardrailLevel int32
+
+const (
+	// guardrailNormal sheds nothing.
+	guardrailNormal guardrailLevel = iota
+	// guardrailLowPriority pauses the scanner and throttles batch jobs.
+	guardrailLowPriority
+	// guardrailShedRequests rejects a fraction of the new S3 requests
+	// with SlowDown.
+	guardrailShedRequests
+)
+
+func (l guardrailLevel) String() string {
+	switch l {
+	case guardrailLowPriority:
+		return "low-priority"
+	case guardrailShedRequests:
+		return "shed-requests"
+	default:
+		return "normal"
+	}
+}
+
+// GuardrailStatus is the load shedding state of a node.
+type GuardrailStatus struct {
+	Node    string `json:"node"`
+	Enabled bool   `json:"enabled"`
+	// Level is normal, low-priority or shed-requests.
+	Level string    `json:"level"`
+	Since time.Time `json:"since"`
+	// Exceeded lists the thresholds exceeded at the last sample.
+	Exceeded     []string `json:"exceeded,omitempty"`
+	CPUPercent   float64  `json:"cpuPercent"`
+	MemoryBytes  uint64   `json:"memoryBytes"`
+	Goroutines   int      `json:"goroutines"`
+	ShedRequests uint64   `json:"shedRequests"`
+}
+
+type guardrailSample struct {
+	cpuPercent float64
+	memory     uint64
+	goroutines int
+}
+
+// guardrailState sheds the load of this node while the resources it
+// uses stay above the thresholds of the guardrail sub-system.
+type guardrailState struct {
+	level        int32 // guardrailLevel, read on every S3 request
+	shedRequests uint64
+
+	mu         sync.Mutex
+	cfg        guardrail.Config
+	since      time.Time // of the current level
+	overloaded bool
+	changedAt  time.Time // of the last change of overloaded, or level
+	exceeded   []string
+	last       guardrailSample
+
+	// CPU time of the process at the previous sample.
+	cpuTime   float64
+	cpuTimeAt time.Time
+}
+
+var globalGuardrail = &guardrailState{since: UTCNow()}
+
+func (g *guardrailState) getLevel() guardrailLevel {
+	return guardrailLevel(atomic.LoadInt32(&g.level))
+}
+
+func (g *guardrailState) update(cfg guardrail.Config) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.cfg = cfg
+	if !cfg.Enabled() {
+		g.overloaded = false
+		g.exceeded = nil
+		g.setLevel(UTCNow(), guardrailNormal)
+	}
+}
+
+// setLevel must be called with g.mu held.
+func (g *guardrailState) setLevel(now time.Time, level guardrailLevel) {
+	prev := g.getLevel()
+	if level == prev {
+		return
+	}
+	atomic.StoreInt32(&g.level, int32(level))
+	g.since = now
+	g.changedAt = now
+	if level > prev {
+		logger.Info("Resource guardrail: %s exceeded for %s, shedding %s work", strings.Join(g.exceeded, ", "), g.cfg.Sustain, level)
+	} else {
+		logger.Info("Resource guardrail: load shedding relaxed to %s", level)
+	}
+}
+
+// observe updates the shedding level with a sample of the resources
+// used by the server. The level escalates, or relaxes, by one once the
+// thresholds stayed exceeded, or not, for the sustain duration.
+func (g *guardrailState) observe(now time.Time, s guardrailSample) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.last = s
+	if !g.cfg.Enabled() {
+		return
+	}
+
+	var exceeded []string
+	if g.cfg.CPUThreshold > 0 && s.cpuPercent > g.cfg.CPUThreshold {
+		exceeded = append(exceeded, fmt.Sprintf("cpu %.1f%% > %.1f%%", s.cpuPercent, g.cfg.CPUThreshold))
+	}
+	if g.cfg.MemoryThreshold > 0 && s.memory > g.cfg.MemoryThreshold {
+		exceeded = append(exceeded, fmt.Sprintf("memory %s > %s", humanize.IBytes(s.memory), humanize.IBytes(g.cfg.MemoryThreshold)))
+	}
+	if g.cfg.GoroutineThreshold > 0 && s.goroutines > g.cfg.GoroutineThreshold {
+		exceeded = append(exceeded, fmt.Sprintf("goroutines %d > %d", s.goroutines, g.cfg.GoroutineThreshold))
+	}
+	g.exceeded = exceeded
+
+	overloaded := len(exceeded) > 0
+	if overloaded != g.overloaded {
+		g.overloaded = overloaded
+		g.changedAt = now
+	}
+	if now.Sub(g.changedAt) < g.cfg.Sustain {
+		return
+	}
+
+	level := g.getLevel()
+	switch {
+	case overloaded && level < guardrailShedRequests:
+		g.setLevel(now, level+1)
+	case !overloaded && level > guardrailNormal:
+		g.setLevel(now, level-1)
+	}
+}
+
+// sample returns the resources used by the server, the CPU usage is
+// averaged since the previous sample.
+func (g *guardrailState) sample(now time.Time) guardrailSample {
+	s := guardrailSample{goroutines: runtime.NumGoroutine()}
+	if runtime.GOOS == "windows" {
+		return s
+	}
+	p, err := procfs.Self()
+	if err != nil {
+		return s
+	}
+	stat, err := p.Stat()
+	if err != nil {
+		return s
+	}
+	s.memory = uint64(stat.ResidentMemory())
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if elapsed := now.Sub(g.cpuTimeAt).Seconds(); !g.cpuTimeAt.IsZero() && elapsed > 0 {
+		s.cpuPercent = 100 * (stat.CPUTime() - g.cpuTime) / elapsed / float64(runtime.NumCPU())
+	}
+	g.cpuTime, g.cpuTimeAt = stat.CPUTime(), now
+	return s
+}
+
+// run samples the resources used by the server until ctx is canceled.
+func (g *guardrailState) run(ctx context.Context) {
+	t := time.NewTicker(guardrailSampleInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			now := UTCNow()
+			g.observe(now, g.sample(now))
+		}
+	}
+}
+
+// shedRequest returns true if a new S3 request must be rejected.
+func (g *guardrailState) shedRequest() bool {
+	if g.getLevel() < guardrailShedRequests {
+		return false
+	}
+	g.mu.Lock()
+	fraction := g.cfg.ShedFraction
+	g.mu.Unlock()
+	if rand.Float64() >= fraction {
+		return false
+	}
+	atomic.AddUint64(&g.shedRequests, 1)
+	return true
+}
+
+// waitLowPriority blocks low-priority work while the server sheds it.
+func (g *guardrailState) waitLowPriority(ctx context.Context) {
+	for g.getLevel() >= guardrailLowPriority {
+		t := time.NewTimer(guardrailSampleInterval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// throttleLowPriority slows down low-priority work while the server
+// sheds it.
+func (g *guardrailState) throttleLowPriority(ctx context.Context) {
+	if g.getLevel() < guardrailLowPriority {
+		return
+	}
+	t := time.NewTimer(guardrailBatchDelay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
+
+func (g *guardrailState) status() GuardrailStatus {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return GuardrailStatus{
+		Node:         globalLocalNodeName,
+		Enabled:      g.cfg.Enabled(),
+		Level:        g.getLevel().String(),
+		Since:        g.since,
+		Exceeded:     append([]string(nil), g.exceeded...),
+		CPUPercent:   g.last.cpuPercent,
+		MemoryBytes:  g.last.memory,
+		Goroutines:   g.last.goroutines,
+		ShedRequests: atomic.LoadUint64(&g.shedRequests),
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio/internal/config/guardrail"
+)
+
+func TestGuardrailLevels(t *testing.T) {
+	g := &guardrailState{}
+	now := time.Now()
+	overloaded := guardrailSample{goroutines: 200}
+	healthy := guardrailSample{goroutines: 50}
+
+	// Disabled without thresholds.
+	g.observe(now, overloaded)
+	if level := g.getLevel(); level != guardrailNormal {
+		t.Fatalf("expected %s, got %s", guardrailNormal, level)
+	}
+
+	g.update(guardrail.Config{GoroutineThreshold: 100, Sustain: time.Minute, ShedFraction: 1})
+	steps := []struct {
+		after  time.Duration
+		sample guardrailSample
+		level  guardrailLevel
+	}{
+		{0, overloaded, guardrailNormal},
+		{30 * time.Second, overloaded, guardrailNormal},
+		{time.Minute, overloaded, guardrailLowPriority},
+		{90 * time.Second, overloaded, guardrailLowPriority},
+		{2 * time.Minute, overloaded, guardrailShedRequests},
+		{3 * time.Minute, overloaded, guardrailShedRequests},
+		// A single healthy sample does not relax shedding.
+		{3*time.Minute + 5*time.Second, healthy, guardrailShedRequests},
+		{3*time.Minute + 10*time.Second, overloaded, guardrailShedRequests},
+		{3*time.Minute + 15*time.Second, healthy, guardrailShedRequests},
+		{4*time.Minute + 15*time.Second, healthy, guardrailLowPriority},
+		{5*time.Minute + 15*time.Second, healthy, guardrailNormal},
+	}
+	for i, step := range steps {
+		g.observe(now.Add(step.after), step.sample)
+		if level := g.getLevel(); level != step.level {
+			t.Fatalf("step %d: expected %s, got %s", i, step.level, level)
+		}
+	}
+
+	g.observe(now.Add(6*time.Minute), overloaded)
+	g.observe(now.Add(7*time.Minute), overloaded)
+	g.observe(now.Add(8*time.Minute), overloaded)
+	if !g.shedRequest() {
+		t.Fatal("expected the request to be shed")
+	}
+	st := g.status()
+	if !st.Enabled || st.Level != guardrailShedRequests.String() || st.ShedRequests != 1 || len(st.Exceeded) != 1 || st.Goroutines != 200 {
+		t.Fatalf("unexpected status %+v", st)
+	}
+
+	// Disabling the guardrail stops shedding at once.
+	g.update(guardrail.Config{})
+	if level := g.getLevel(); level != guardrailNormal || g.shedRequest() {
+		t.Fatalf("expected no shedding once disabled, got %s", level)
+	}
+}
@@ -23,11 +23,13 @@ import (
 	"runtime"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/mem"
 
 	"github.com/minio/minio/internal/config/api"
+	xhttp "github.com/minio/minio/internal/http"
 	xioutil "github.com/minio/minio/internal/ioutil"
 	"github.com/minio/minio/internal/logger"
 )
@@ -50,6 +52,28 @@ type apiConfig struct {
 	deleteCleanupInterval       time.Duration
 	disableODirect              bool
 	gzipObjects                 bool
+	encodeChunkSize             int64
+	encodeChunkWorkers          int
+	maxObjectKeyDepth           int
+	bufferMemoryWatermark       int64
+	strictBucketConfig          bool
+	multipartWeakETag           bool
+	dedupVersions               bool
+	listMaxScanned              int64
+	listMaxDuration             time.Duration
+	transitionReadsLocal        bool
+
+	// internode connection pool settings, the internode transport
+	// is recreated when they change.
+	internodeConn internodeConnConfig
+}
+
+type internodeConnConfig struct {
+	maxIdleConnsPerHost int
+	maxConnsPerHost     int
+	idleConnTimeout     time.Duration
+	tlsHandshakeTimeout time.Duration
+	dialTimeout         time.Duration
 }
 
 const cgroupLimitFile = "/sys/fs/cgroup/memory/memory.limit_in_bytes"
@@ -151,6 +175,30 @@ func (t *apiConfig) init(cfg api.Config, setDriveCounts []int) {
 	t.deleteCleanupInterval = cfg.DeleteCleanupInterval
 	t.disableODirect = cfg.DisableODirect
 	t.gzipObjects = cfg.GzipObjects
+	t.encodeChunkSize = int64(cfg.EncodeChunkSize)
+	t.encodeChunkWorkers = cfg.EncodeChunkWorkers
+	t.maxObjectKeyDepth = cfg.MaxObjectKeyDepth
+	t.bufferMemoryWatermark = int64(cfg.BufferMemoryWatermark)
+	t.strictBucketConfig = cfg.StrictBucketConfig
+	t.multipartWeakETag = cfg.MultipartWeakETag
+	t.dedupVersions = cfg.DedupVersions
+	t.listMaxScanned = cfg.ListMaxScanned
+	t.listMaxDuration = cfg.ListMaxDuration
+	t.transitionReadsLocal = cfg.TransitionReads == "local"
+
+	internodeConn := internodeConnConfig{
+		maxIdleConnsPerHost: cfg.InternodeMaxIdleConnsPerHost,
+		maxConnsPerHost:     cfg.InternodeMaxConnsPerHost,
+		idleConnTimeout:     cfg.InternodeIdleConnTimeout,
+		tlsHandshakeTimeout: cfg.InternodeTLSHandshakeTimeout,
+		dialTimeout:         cfg.InternodeDialTimeout,
+	}
+	if pool, ok := globalInternodeTransport.(*xhttp.ConnPool); ok && internodeConn != t.internodeConn {
+		// Requests in flight complete on the previous transport,
+		// its connections are closed once idle.
+		pool.Update(internodeConnSettings(cfg))
+	}
+	t.internodeConn = internodeConn
 }
 
 func (t *apiConfig) isDisableODirect() bool {
@@ -167,6 +215,81 @@ func (t *apiConfig) shouldGzipObjects() bool {
 	return t.gzipObjects
 }
 
+// getEncodeChunks returns the size of the chunks large objects are
+// split into to be erasure coded in parallel, 0 means disabled, and the
+// maximum number of chunks of an object encoded in parallel.
+func (t *apiConfig) getEncodeChunks() (size int64, workers int) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.encodeChunkSize, t.encodeChunkWorkers
+}
+
+// isStrictBucketConfig returns true if bucket CORS, website and
+// accelerate APIs are not implemented.
+func (t *apiConfig) isStrictBucketConfig() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.strictBucketConfig
+}
+
+// isMultipartWeakETag returns true if a weak ETag is stored for
+// multipart objects when their upload completes.
+func (t *apiConfig) isMultipartWeakETag() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.multipartWeakETag
+}
+
+// isDedupVersions returns true if new versions of objects share the
+// data of the latest version when their content is identical.
+func (t *apiConfig) isDedupVersions() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.dedupVersions
+}
+
+// getListLimits returns the maximum number of entries scanned and the
+// maximum duration of a single listing request, 0 means unlimited.
+func (t *apiConfig) getListLimits() (maxScanned int64, maxDuration time.Duration) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.listMaxScanned, t.listMaxDuration
+}
+
+// isTransitionReadsLocal returns true if objects are read from their
+// local copy while they are transitioned to a remote tier, instead of
+// reads waiting for the transition to complete.
+func (t *apiConfig) isTransitionReadsLocal() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.transitionReadsLocal
+}
+
+// getMaxObjectKeyDepth returns the maximum number of path
+// components allowed in new object names, 0 means unlimited.
+func (t *apiConfig) getMaxObjectKeyDepth() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.maxObjectKeyDepth
+}
+
+// getBufferMemoryWatermark returns the memory held by in-flight
+// object request buffers above which new requests are rejected,
+// 0 means no limit.
+func (t *apiConfig) getBufferMemoryWatermark() int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.bufferMemoryWatermark
+}
+
 func (t *apiConfig) getListQuorum() string {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -234,6 +357,18 @@ func (t *apiConfig) getRequestsPoolCapacity() int {
 	return cap(t.requestsPool)
 }
 
+// getRequestsPoolTokens returns the maximum number of concurrent S3
+// requests on this node and how many more can be admitted right now.
+func (t *apiConfig) getRequestsPoolTokens() (limit, available int, ok bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.requestsPool == nil {
+		return 0, 0, false
+	}
+	return cap(t.requestsPool), cap(t.requestsPool) - len(t.requestsPool), true
+}
+
 func (t *apiConfig) getRequestsPool() (chan struct{}, time.Duration) {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -250,6 +385,21 @@ func maxClients(f http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		globalHTTPStats.incS3RequestsIncoming()
 
+		if globalNodeDrain.draining() {
+			// Shed new requests while draining, closing the connection
+			// lets clients retry on another node.
+			w.Header().Set(xhttp.Connection, "close")
+			writeErrorResponse(r.Context(), w, errorCodes.ToAPIErr(ErrBusy), r.URL)
+			return
+		}
+
+		if globalGuardrail.shedRequest() {
+			// The node is overloaded, reject some of the new requests
+			// so that those admitted and health checks are served.
+			writeErrorResponse(r.Context(), w, errorCodes.ToAPIErr(ErrSlowDown), r.URL)
+			return
+		}
+
 		if r.Header.Get(globalObjectPerfUserMetadata) == "" {
 			if val := globalServiceFreeze.Load(); val != nil {
 				if unlock, ok := val.(chan struct{}); ok && unlock != nil {
@@ -281,6 +431,7 @@ func maxClients(f http.HandlerFunc) http.HandlerFunc {
 			globalHTTPStats.addRequestsInQueue(-1)
 			f.ServeHTTP(w, r)
 		case <-deadlineTimer.C:
+			atomic.AddUint64(&globalHTTPStats.rejectedRequestsLimit, 1)
 			// Send a http timeout message
 			writeErrorResponse(r.Context(), w,
 				errorCodes.ToAPIErr(ErrOperationMaxedOut),
@@ -294,6 +445,23 @@ func maxClients(f http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
+// maxBuffers rejects GET and PUT object requests with SlowDown while the
+// memory held by in-flight object requests is over the api
+// buffer_memory_watermark. It must be wrapped by maxClients, so that
+// requests waiting in the requests pool are checked once admitted.
+func maxBuffers(f http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if globalObjectBufferStats.overWatermark() {
+			globalObjectBufferStats.incRejected()
+			writeErrorResponse(r.Context(), w,
+				errorCodes.ToAPIErr(ErrSlowDown),
+				r.URL)
+			return
+		}
+		f.ServeHTTP(w, r)
+	}
+}
+
 func (t *apiConfig) getReplicationPriority() string {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
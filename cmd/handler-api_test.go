@@ -0,0 +1,77 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxClientsRejectedLimit(t *testing.T) {
+	globalAPIConfig.mu.Lock()
+	globalAPIConfig.requestsPool = make(chan struct{}, 2)
+	globalAPIConfig.requestsDeadline = 10 * time.Millisecond
+	globalAPIConfig.mu.Unlock()
+	defer func() {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.requestsPool = nil
+		globalAPIConfig.requestsDeadline = 0
+		globalAPIConfig.mu.Unlock()
+	}()
+
+	limit, available, ok := globalAPIConfig.getRequestsPoolTokens()
+	if !ok || limit != 2 || available != 2 {
+		t.Fatalf("expected 2 of 2 tokens available, got %d of %d (%v)", available, limit, ok)
+	}
+
+	// Both tokens are held by requests in flight.
+	pool, _ := globalAPIConfig.getRequestsPool()
+	pool <- struct{}{}
+	pool <- struct{}{}
+	if _, available, _ = globalAPIConfig.getRequestsPoolTokens(); available != 0 {
+		t.Fatalf("expected no tokens available, got %d", available)
+	}
+
+	rejected := atomic.LoadUint64(&globalHTTPStats.rejectedRequestsLimit)
+	handler := maxClients(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected the request to be rejected")
+	})
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/bucket/object", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected the request to fail with %d, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+	if got := atomic.LoadUint64(&globalHTTPStats.rejectedRequestsLimit); got != rejected+1 {
+		t.Errorf("expected %d requests rejected by the limit, got %d", rejected+1, got)
+	}
+
+	<-pool
+	values := make(map[MetricName]float64)
+	for _, m := range getHTTPMetrics().Get() {
+		switch m.Description.Subsystem {
+		case rateLimitSubsystem, requestsRejectedSubsystem:
+			values[m.Description.Name] = m.Value
+		}
+	}
+	if values["limit"] != 2 || values["tokens_available"] != 1 || values[limitTotal] != float64(rejected+1) {
+		t.Errorf("unexpected request limit metrics %v", values)
+	}
+}
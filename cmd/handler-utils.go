@@ -28,6 +28,7 @@ import (
 	"net/textproto"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio/internal/auth"
@@ -357,9 +358,15 @@ func collectAPIStats(api string, f http.HandlerFunc) http.HandlerFunc {
 
 		statsWriter := xhttp.NewResponseRecorder(w)
 
+		r, timing := globalRequestTimings.sample(r)
+		start := time.Now()
+
 		f.ServeHTTP(statsWriter, r)
 
 		globalHTTPStats.updateStats(api, r, statsWriter)
+		if timing != nil {
+			globalRequestTimings.add(api, r, statsWriter.StatusCode, start, timing)
+		}
 	}
 }
 
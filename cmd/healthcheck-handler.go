@@ -97,8 +97,14 @@ func ClusterReadCheckHandler(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, http.StatusOK, nil, mimeNone)
 }
 
-// ReadinessCheckHandler Checks if the process is up. Always returns success.
+// ReadinessCheckHandler Checks if the process is up and not draining.
 func ReadinessCheckHandler(w http.ResponseWriter, r *http.Request) {
+	if globalNodeDrain.draining() {
+		// Drained for maintenance, load balancers must stop
+		// sending requests to this node.
+		writeResponse(w, http.StatusServiceUnavailable, nil, mimeNone)
+		return
+	}
 	LivenessCheckHandler(w, r)
 }
 
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	xhttp "github.com/minio/minio/internal/http"
 	"github.com/prometheus/client_golang/prometheus"
@@ -119,6 +120,23 @@ func newConnStats() *ConnStats {
 type bucketS3RXTX struct {
 	s3InputBytes  uint64
 	s3OutputBytes uint64
+
+	// PUTs short-circuited as identical overwrites.
+	skippedOverwrites     uint64
+	skippedOverwriteBytes uint64
+
+	// Reads served by the read-through remote and bytes populated
+	// locally from it.
+	readThroughProxied        uint64
+	readThroughPopulated      uint64
+	readThroughPopulatedBytes uint64
+
+	// Listings stopped at their scan limit.
+	listingsCapped uint64
+
+	// Object versions deleted and delete markers created.
+	deletes       uint64
+	deleteMarkers uint64
 }
 
 type bucketConnStats struct {
@@ -186,6 +204,125 @@ func (s *bucketConnStats) getS3OutputBytes(bucket string) uint64 {
 	return stats.s3OutputBytes
 }
 
+// Increase the number of identical overwrites skipped for input bucket
+func (s *bucketConnStats) incSkippedOverwrites(bucket string, n int64) {
+	s.Lock()
+	defer s.Unlock()
+	stats, ok := s.stats[bucket]
+	if !ok {
+		stats = &bucketS3RXTX{}
+	}
+	stats.skippedOverwrites++
+	stats.skippedOverwriteBytes += uint64(n)
+	s.stats[bucket] = stats
+}
+
+// Return the number of identical overwrites skipped and the bytes
+// that were not written for input bucket
+func (s *bucketConnStats) getSkippedOverwrites(bucket string) (count, bytes uint64) {
+	s.RLock()
+	defer s.RUnlock()
+
+	stats := s.stats[bucket]
+	if stats == nil {
+		return 0, 0
+	}
+	return stats.skippedOverwrites, stats.skippedOverwriteBytes
+}
+
+// Increase the number of object versions deleted, or of delete markers
+// created, for input bucket
+func (s *bucketConnStats) incDeletes(bucket string, deleteMarker bool) {
+	s.Lock()
+	defer s.Unlock()
+	stats, ok := s.stats[bucket]
+	if !ok {
+		stats = &bucketS3RXTX{}
+	}
+	if deleteMarker {
+		stats.deleteMarkers++
+	} else {
+		stats.deletes++
+	}
+	s.stats[bucket] = stats
+}
+
+// Return the number of object versions deleted and of delete markers
+// created for input bucket
+func (s *bucketConnStats) getDeletes(bucket string) (deletes, deleteMarkers uint64) {
+	s.RLock()
+	defer s.RUnlock()
+
+	stats := s.stats[bucket]
+	if stats == nil {
+		return 0, 0
+	}
+	return stats.deletes, stats.deleteMarkers
+}
+
+// Increase the number of listings stopped at their scan limit for input bucket
+func (s *bucketConnStats) incListingsCapped(bucket string) {
+	s.Lock()
+	defer s.Unlock()
+	stats, ok := s.stats[bucket]
+	if !ok {
+		stats = &bucketS3RXTX{}
+	}
+	stats.listingsCapped++
+	s.stats[bucket] = stats
+}
+
+// Return the number of listings stopped at their scan limit for input bucket
+func (s *bucketConnStats) getListingsCapped(bucket string) uint64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	stats := s.stats[bucket]
+	if stats == nil {
+		return 0
+	}
+	return stats.listingsCapped
+}
+
+// Increase the number of reads proxied to the read-through remote of input bucket
+func (s *bucketConnStats) incReadThroughProxied(bucket string) {
+	s.Lock()
+	defer s.Unlock()
+	stats, ok := s.stats[bucket]
+	if !ok {
+		stats = &bucketS3RXTX{}
+	}
+	stats.readThroughProxied++
+	s.stats[bucket] = stats
+}
+
+// Increase the number of objects and bytes populated locally from the
+// read-through remote of input bucket
+func (s *bucketConnStats) incReadThroughPopulated(bucket string, n int64) {
+	s.Lock()
+	defer s.Unlock()
+	stats, ok := s.stats[bucket]
+	if !ok {
+		stats = &bucketS3RXTX{}
+	}
+	stats.readThroughPopulated++
+	stats.readThroughPopulatedBytes += uint64(n)
+	s.stats[bucket] = stats
+}
+
+// Return the number of reads proxied to the read-through remote, the
+// number of objects and bytes populated locally from it for input bucket
+func (s *bucketConnStats) getReadThrough(bucket string) (proxied, populated, populatedBytes uint64) {
+	s.RLock()
+	defer s.RUnlock()
+
+	stats := s.stats[bucket]
+	if stats == nil {
+		return 0, 0, 0
+	}
+	return stats.readThroughProxied, stats.readThroughPopulated, stats.readThroughPopulatedBytes
+}
+
 // delete metrics once bucket is deleted.
 func (s *bucketConnStats) delete(bucket string) {
 	s.Lock()
@@ -237,6 +374,55 @@ func (stats *HTTPAPIStats) Load() map[string]int {
 	return apiStats
 }
 
+// authFailureReason is the reason an S3 request failed authentication.
+type authFailureReason int
+
+const (
+	authFailureBadSignature authFailureReason = iota
+	authFailureExpired
+	authFailureUnknownKey
+	authFailurePolicyDeny
+
+	authFailureReasons = iota
+)
+
+func (r authFailureReason) String() string {
+	switch r {
+	case authFailureBadSignature:
+		return "bad_signature"
+	case authFailureExpired:
+		return "expired"
+	case authFailureUnknownKey:
+		return "unknown_key"
+	case authFailurePolicyDeny:
+		return "policy_deny"
+	}
+	return "unknown"
+}
+
+// getAuthFailureReason returns the reason of the authentication
+// failure, false if the error is not an authentication failure.
+func getAuthFailureReason(s3Err APIErrorCode) (authFailureReason, bool) {
+	switch s3Err {
+	case ErrSignatureDoesNotMatch, ErrSignatureVersionNotSupported, ErrContentSHA256Mismatch,
+		ErrMissingFields, ErrMissingCredTag, ErrCredMalformed, ErrMissingSignTag,
+		ErrMissingSignHeadersTag, ErrUnsignedHeaders, ErrAuthorizationHeaderMalformed,
+		ErrAuthHeaderEmpty, ErrMissingDateHeader, ErrMalformedPresignedDate,
+		ErrMalformedCredentialDate, ErrInvalidQuerySignatureAlgo, ErrInvalidQueryParams,
+		ErrInvalidServiceS3, ErrInvalidRequestVersion, ErrMissingSecurityHeader,
+		ErrNegativeExpires, ErrMaximumExpires:
+		return authFailureBadSignature, true
+	case ErrExpiredPresignRequest, ErrRequestNotReadyYet, ErrRequestTimeTooSkewed, ErrInvalidToken:
+		// Session tokens are mostly invalid when they expired.
+		return authFailureExpired, true
+	case ErrInvalidAccessKeyID, ErrAccessKeyDisabled, ErrNoAccessKey:
+		return authFailureUnknownKey, true
+	case ErrAccessDenied:
+		return authFailurePolicyDeny, true
+	}
+	return 0, false
+}
+
 // HTTPStats holds statistics information about
 // HTTP requests made by all clients
 type HTTPStats struct {
@@ -247,12 +433,23 @@ type HTTPStats struct {
 	rejectedRequestsTime    uint64
 	rejectedRequestsHeader  uint64
 	rejectedRequestsInvalid uint64
+	rejectedRequestsLimit   uint64
+	authFailures            [authFailureReasons]uint64
 	currentS3Requests       HTTPAPIStats
 	totalS3Requests         HTTPAPIStats
 	totalS3Errors           HTTPAPIStats
 	totalS34xxErrors        HTTPAPIStats
 	totalS35xxErrors        HTTPAPIStats
 	totalS3Canceled         HTTPAPIStats
+	totalS3SlowDown         HTTPAPIStats
+}
+
+// incAuthFailure counts a failed authentication by its reason,
+// errors which are not authentication failures are ignored.
+func (st *HTTPStats) incAuthFailure(s3Err APIErrorCode) {
+	if reason, ok := getAuthFailureReason(s3Err); ok {
+		atomic.AddUint64(&st.authFailures[reason], 1)
+	}
 }
 
 func (st *HTTPStats) loadRequestsInQueue() int32 {
@@ -277,6 +474,11 @@ func (st *HTTPStats) toServerHTTPStats() ServerHTTPStats {
 	serverStats.TotalS3RejectedTime = atomic.LoadUint64(&st.rejectedRequestsTime)
 	serverStats.TotalS3RejectedHeader = atomic.LoadUint64(&st.rejectedRequestsHeader)
 	serverStats.TotalS3RejectedInvalid = atomic.LoadUint64(&st.rejectedRequestsInvalid)
+	serverStats.TotalS3RejectedLimit = atomic.LoadUint64(&st.rejectedRequestsLimit)
+	serverStats.TotalS3AuthFailures = make(map[string]uint64, authFailureReasons)
+	for reason := range st.authFailures {
+		serverStats.TotalS3AuthFailures[authFailureReason(reason).String()] = atomic.LoadUint64(&st.authFailures[reason])
+	}
 	serverStats.CurrentS3Requests = ServerHTTPAPIStats{
 		APIStats: st.currentS3Requests.Load(),
 	}
@@ -295,6 +497,9 @@ func (st *HTTPStats) toServerHTTPStats() ServerHTTPStats {
 	serverStats.TotalS3Canceled = ServerHTTPAPIStats{
 		APIStats: st.totalS3Canceled.Load(),
 	}
+	serverStats.TotalS3SlowDown = ServerHTTPAPIStats{
+		APIStats: st.totalS3SlowDown.Load(),
+	}
 	return serverStats
 }
 
@@ -309,6 +514,7 @@ func (st *HTTPStats) updateStats(api string, r *http.Request, w *xhttp.ResponseR
 
 	// Increment the prometheus http request response histogram with appropriate label
 	httpRequestsDuration.With(prometheus.Labels{"api": api}).Observe(w.TimeToFirstByte.Seconds())
+	httpRequestsLatency.With(prometheus.Labels{"api": api}).Observe(time.Since(w.StartTime).Seconds())
 
 	code := w.StatusCode
 
@@ -324,6 +530,11 @@ func (st *HTTPStats) updateStats(api string, r *http.Request, w *xhttp.ResponseR
 		} else {
 			st.totalS34xxErrors.Inc(api)
 		}
+		if code == http.StatusServiceUnavailable {
+			// SlowDown and the other 503 errors are the requests
+			// shed by the server.
+			st.totalS3SlowDown.Inc(api)
+		}
 	}
 }
 
@@ -0,0 +1,140 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func TestHTTPStatsSlowDown(t *testing.T) {
+	st := newHTTPStats()
+	for _, code := range []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusServiceUnavailable} {
+		w := xhttp.NewResponseRecorder(httptest.NewRecorder())
+		w.WriteHeader(code)
+		st.updateStats("PutObject", httptest.NewRequest(http.MethodPut, "/bucket/object", nil), w)
+	}
+
+	stats := st.toServerHTTPStats()
+	if got := stats.TotalS3SlowDown.APIStats["PutObject"]; got != 2 {
+		t.Errorf("expected 2 requests answered with SlowDown, got %d", got)
+	}
+	if got := stats.TotalS35xxErrors.APIStats["PutObject"]; got != 3 {
+		t.Errorf("expected 3 server errors, got %d", got)
+	}
+
+	defer func(st *HTTPStats) { globalHTTPStats = st }(globalHTTPStats)
+	globalHTTPStats = st
+
+	var found bool
+	for _, m := range getHTTPMetrics().Get() {
+		if m.Description.Name != slowDownTotal {
+			continue
+		}
+		found = true
+		if m.VariableLabels["api"] != "PutObject" || m.Value != 2 {
+			t.Errorf("unexpected metric %+v", m)
+		}
+	}
+	if !found {
+		t.Errorf("expected the %s metric", slowDownTotal)
+	}
+}
+
+func TestHTTPStatsRequestsLatency(t *testing.T) {
+	const api = "TestRequestsLatency"
+
+	st := newHTTPStats()
+	for _, latency := range []time.Duration{300 * time.Millisecond, 2 * time.Second} {
+		w := xhttp.NewResponseRecorder(httptest.NewRecorder())
+		w.StartTime = time.Now().Add(-latency)
+		st.updateStats(api, httptest.NewRequest(http.MethodGet, "/bucket/object", nil), w)
+	}
+
+	counts := make(map[string]float64)
+	for _, m := range getHistogramVecMetrics(httpRequestsLatency, getS3RequestsLatencyMD()) {
+		if m.VariableLabels["api"] == api {
+			counts[m.VariableLabels["le"]] = m.Value
+		}
+	}
+	for le, want := range map[string]float64{
+		"0.250":  0,
+		"0.500":  1,
+		"1.000":  1,
+		"2.500":  2,
+		"60.000": 2,
+	} {
+		if got, ok := counts[le]; !ok || got != want {
+			t.Errorf("expected %v requests under %s seconds, got %v", want, le, got)
+		}
+	}
+}
+
+func TestHTTPStatsAuthFailures(t *testing.T) {
+	st := newHTTPStats()
+	for _, s3Err := range []APIErrorCode{
+		ErrSignatureDoesNotMatch,
+		ErrSignatureVersionNotSupported,
+		ErrExpiredPresignRequest,
+		ErrInvalidAccessKeyID,
+		ErrAccessDenied,
+		ErrAccessDenied,
+		// Not authentication failures.
+		ErrNone,
+		ErrNoSuchBucket,
+	} {
+		st.incAuthFailure(s3Err)
+	}
+
+	want := map[string]uint64{
+		"bad_signature": 2,
+		"expired":       1,
+		"unknown_key":   1,
+		"policy_deny":   2,
+	}
+	stats := st.toServerHTTPStats()
+	if len(stats.TotalS3AuthFailures) != len(want) {
+		t.Fatalf("expected %d reasons, got %v", len(want), stats.TotalS3AuthFailures)
+	}
+	for reason, n := range want {
+		if got := stats.TotalS3AuthFailures[reason]; got != n {
+			t.Errorf("expected %d failures for %s, got %d", n, reason, got)
+		}
+	}
+
+	defer func(st *HTTPStats) { globalHTTPStats = st }(globalHTTPStats)
+	globalHTTPStats = st
+
+	for _, m := range getHTTPMetrics().Get() {
+		if m.Description.Subsystem != authSubsystem {
+			continue
+		}
+		reason := m.VariableLabels["reason"]
+		if m.Description.Name != failuresTotal || m.Value != float64(want[reason]) {
+			t.Errorf("unexpected metric %+v", m)
+		}
+		delete(want, reason)
+	}
+	if len(want) != 0 {
+		t.Errorf("expected metrics for the reasons %v", want)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/internal/logger"
+)
+
+const (
+	// Bounds of the duration of a policy elevation.
+	minElevationDuration = time.Minute
+	maxElevationDuration = 24 * time.Hour
+
+	// Interval at which expired policy elevations are removed.
+	elevationSweepInterval = time.Minute
+
+	// Audit records of policy elevations are kept in the backend
+	// independently of the audit targets, under this prefix.
+	iamElevationAuditPrefix = minioConfigPrefix + "/audit/iam-elevation/"
+
+	// Maximum number of audit records returned by a listing.
+	maxElevationAuditRecords = 1000
+)
+
+// Policy elevation audit events.
+const (
+	elevationEventElevate = "elevate"
+	elevationEventRevoke  = "revoke"
+	elevationEventExpire  = "expire"
+)
+
+// srIAMItemElevatedPolicy is the site replication IAM item type of a
+// policy elevation, the item name is the user and the item policy the
+// JSON encoded elevation, empty when it was revoked.
+const srIAMItemElevatedPolicy = "elevated-policy"
+
+var errElevationReasonRequired = errors.New("a reason is required to elevate a user")
+
+// ElevationRequest is the body of a policy elevation request.
+type ElevationRequest struct {
+	User     string `json:"user"`
+	Policy   string `json:"policy"`
+	Duration string `json:"duration"`
+	Reason   string `json:"reason"`
+}
+
+// Elevation is a time-bound policy elevation of a user.
+type Elevation struct {
+	User        string    `json:"user"`
+	Policies    []string  `json:"policies"`
+	Reason      string    `json:"reason"`
+	RequestedBy string    `json:"requestedBy,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+func newElevation(user string, mp MappedPolicy) Elevation {
+	return Elevation{
+		User:        user,
+		Policies:    mp.toSlice(),
+		Reason:      mp.Reason,
+		RequestedBy: mp.RequestedBy,
+		UpdatedAt:   mp.UpdatedAt,
+		ExpiresAt:   mp.ExpiresAt,
+	}
+}
+
+// ElevationAuditRecord records the elevation, revocation or expiry of a
+// policy elevation.
+type ElevationAuditRecord struct {
+	Time        time.Time `json:"time"`
+	Event       string    `json:"event"`
+	User        string    `json:"user"`
+	Policies    []string  `json:"policies"`
+	Reason      string    `json:"reason,omitempty"`
+	RequestedBy string    `json:"requestedBy,omitempty"`
+	RevokedBy   string    `json:"revokedBy,omitempty"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	Replicated  bool      `json:"replicated,omitempty"`
+	Node        string    `json:"node"`
+}
+
+func newElevationAuditRecord(event, user string, mp MappedPolicy) ElevationAuditRecord {
+	return ElevationAuditRecord{
+		Time:        UTCNow(),
+		Event:       event,
+		User:        user,
+		Policies:    mp.toSlice(),
+		Reason:      mp.Reason,
+		RequestedBy: mp.RequestedBy,
+		ExpiresAt:   mp.ExpiresAt,
+		Node:        globalLocalNodeName,
+	}
+}
+
+// parseElevationRequest validates an elevation request and returns the
+// time-bound mapping it asks for.
+func parseElevationRequest(req ElevationRequest, requestedBy string, now time.Time) (MappedPolicy, error) {
+	if req.User == "" || req.Policy == "" {
+		return MappedPolicy{}, errInvalidArgument
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return MappedPolicy{}, errElevationReasonRequired
+	}
+	d, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		return MappedPolicy{}, fmt.Errorf("invalid duration %q: %w", req.Duration, err)
+	}
+	if d < minElevationDuration || d > maxElevationDuration {
+		return MappedPolicy{}, fmt.Errorf("duration must be between %s and %s", minElevationDuration, maxElevationDuration)
+	}
+	mp := newMappedPolicy(req.Policy)
+	mp.UpdatedAt = now
+	mp.ExpiresAt = now.Add(d)
+	mp.Reason = strings.TrimSpace(req.Reason)
+	mp.RequestedBy = requestedBy
+	return mp, nil
+}
+
+// ElevatePolicy - attaches the time-bound mapping mp to the given user,
+// replacing any existing elevation of the user.
+func (sys *IAMSys) ElevatePolicy(ctx context.Context, user string, mp MappedPolicy, replicated bool) (updatedAt time.Time, err error) {
+	if !sys.Initialized() {
+		return updatedAt, errServerNotInitialized
+	}
+
+	updatedAt, err = sys.store.SetElevatedPolicy(ctx, user, mp)
+	if err != nil {
+		return updatedAt, err
+	}
+
+	sys.notifyElevatedPolicy(ctx, user)
+
+	rec := newElevationAuditRecord(elevationEventElevate, user, mp)
+	rec.Replicated = replicated
+	logElevationEvent(ctx, rec)
+	return updatedAt, nil
+}
+
+// RevokeElevatedPolicy - removes the elevation of the given user before
+// it expires.
+func (sys *IAMSys) RevokeElevatedPolicy(ctx context.Context, user, revokedBy string, replicated bool) (MappedPolicy, error) {
+	if !sys.Initialized() {
+		return MappedPolicy{}, errServerNotInitialized
+	}
+
+	mp, err := sys.store.DeleteElevatedPolicy(ctx, user, time.Time{})
+	if err != nil {
+		return mp, err
+	}
+
+	sys.notifyElevatedPolicy(ctx, user)
+
+	rec := newElevationAuditRecord(elevationEventRevoke, user, mp)
+	rec.RevokedBy = revokedBy
+	rec.Replicated = replicated
+	logElevationEvent(ctx, rec)
+	return mp, nil
+}
+
+// ListElevations - returns the elevations that have not expired,
+// optionally only that of the given user.
+func (sys *IAMSys) ListElevations(user string) ([]Elevation, error) {
+	if !sys.Initialized() {
+		return nil, errServerNotInitialized
+	}
+
+	res := []Elevation{}
+	for u, mp := range sys.store.ListElevatedPolicies(user, time.Now()) {
+		res = append(res, newElevation(u, mp))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].User < res[j].User
+	})
+	return res, nil
+}
+
+// GetElevation - returns the elevation of the given user, nil if it has
+// none or it expired.
+func (sys *IAMSys) GetElevation(user string) *Elevation {
+	if !sys.Initialized() {
+		return nil
+	}
+	mp, ok := sys.store.GetElevatedPolicy(user)
+	if !ok || mp.isExpired(time.Now()) {
+		return nil
+	}
+	e := newElevation(user, mp)
+	return &e
+}
+
+// LoadElevatedPolicy - reloads the elevation of the given user from
+// storage. This is called only via IAM notifications.
+func (sys *IAMSys) LoadElevatedPolicy(ctx context.Context, user string) error {
+	if !sys.Initialized() {
+		return errServerNotInitialized
+	}
+	return sys.store.ElevatedPolicyNotificationHandler(ctx, user)
+}
+
+func (sys *IAMSys) notifyElevatedPolicy(ctx context.Context, user string) {
+	if sys.HasWatcher() {
+		return
+	}
+	for _, nerr := range globalNotificationSys.LoadElevatedPolicy(user) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+}
+
+// elevationSweeper removes expired elevations until ctx is canceled.
+// Expired elevations grant nothing, the sweeper only cleans them up and
+// records their expiry.
+func (sys *IAMSys) elevationSweeper(ctx context.Context) {
+	t := time.NewTicker(elevationSweepInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			sys.sweepElevatedPolicies(ctx, time.Now())
+		}
+	}
+}
+
+// sweepElevatedPolicies removes the elevations expired at now. Every
+// node sweeps, only the node removing an elevation from storage records
+// its expiry, the others just update their cache.
+func (sys *IAMSys) sweepElevatedPolicies(ctx context.Context, now time.Time) {
+	for user, mp := range sys.store.ListExpiredElevatedPolicies(now) {
+		_, err := sys.store.DeleteElevatedPolicy(ctx, user, mp.ExpiresAt)
+		switch {
+		case err == nil:
+			logElevationEvent(ctx, newElevationAuditRecord(elevationEventExpire, user, mp))
+		case !errors.Is(err, errNoSuchPolicy):
+			logger.LogIf(ctx, fmt.Errorf("unable to remove expired policy elevation of %s: %w", user, err))
+		}
+	}
+}
+
+// logElevationEvent records rec in the backend and on the console, so
+// that it is kept regardless of the audit configuration, and sends it
+// to the audit targets.
+func logElevationEvent(ctx context.Context, rec ElevationAuditRecord) {
+	logger.Info("IAM policy elevation %s: user %s, policies %s, expires at %s, reason %q",
+		rec.Event, rec.User, strings.Join(rec.Policies, ","), rec.ExpiresAt.Format(time.RFC3339), rec.Reason)
+
+	auditLogInternal(ctx, AuditLogOptions{
+		Event:   "iam:elevation:" + rec.Event,
+		APIName: "IAMPolicyElevation",
+		Status:  rec.Event,
+		Tags: map[string]interface{}{
+			"user":        rec.User,
+			"policies":    rec.Policies,
+			"reason":      rec.Reason,
+			"requestedBy": rec.RequestedBy,
+			"revokedBy":   rec.RevokedBy,
+			"expiresAt":   rec.ExpiresAt,
+			"replicated":  rec.Replicated,
+		},
+	})
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		logger.LogIf(ctx, fmt.Errorf("unable to save policy elevation audit record: %w", errServerNotInitialized))
+		return
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	// Records are named by time, listing returns them in order.
+	name := fmt.Sprintf("%020d-%s.json", rec.Time.UnixNano(), mustGetUUID())
+	logger.LogIf(ctx, saveConfig(ctx, objAPI, iamElevationAuditPrefix+name, data))
+}
+
+// listElevationAuditRecords returns the most recent audit records of
+// policy elevations, optionally only those of the given user.
+func listElevationAuditRecords(ctx context.Context, objAPI ObjectLayer, user string) ([]ElevationAuditRecord, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var names []string
+	for item := range listIAMConfigItems(ctx, objAPI, iamElevationAuditPrefix) {
+		if item.Err != nil {
+			return nil, item.Err
+		}
+		names = append(names, item.Item)
+	}
+	sort.Strings(names)
+
+	res := []ElevationAuditRecord{}
+	for i := len(names) - 1; i >= 0 && len(res) < maxElevationAuditRecords; i-- {
+		data, err := readConfig(ctx, objAPI, iamElevationAuditPrefix+names[i])
+		if err != nil {
+			if errors.Is(err, errConfigNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		var rec ElevationAuditRecord
+		if err = json.Unmarshal(data, &rec); err != nil {
+			return nil, err
+		}
+		if user == "" || rec.User == user {
+			res = append(res, rec)
+		}
+	}
+
+	// Oldest first.
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	return res, nil
+}
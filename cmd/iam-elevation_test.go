@@ -0,0 +1,92 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseElevationRequest(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mp, err := parseElevationRequest(ElevationRequest{
+		User:     "alice",
+		Policy:   "consoleAdmin",
+		Duration: "2h",
+		Reason:   " INC-1234 ",
+	}, "admin", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mp.ExpiresAt.Equal(now.Add(2*time.Hour)) || mp.Reason != "INC-1234" || mp.RequestedBy != "admin" {
+		t.Fatalf("unexpected elevation %+v", mp)
+	}
+
+	for _, req := range []ElevationRequest{
+		{User: "alice", Policy: "consoleAdmin", Duration: "2h"},
+		{User: "alice", Policy: "consoleAdmin", Duration: "2h", Reason: "  "},
+		{User: "alice", Policy: "consoleAdmin", Duration: "25h", Reason: "INC-1234"},
+		{User: "alice", Policy: "consoleAdmin", Duration: "10s", Reason: "INC-1234"},
+		{User: "alice", Policy: "consoleAdmin", Duration: "2", Reason: "INC-1234"},
+		{Policy: "consoleAdmin", Duration: "2h", Reason: "INC-1234"},
+	} {
+		if _, err := parseElevationRequest(req, "admin", now); err == nil {
+			t.Errorf("expected %+v to be rejected", req)
+		}
+	}
+}
+
+func TestPolicyDBGetElevated(t *testing.T) {
+	now := time.Now()
+	mapped := MappedPolicy{Version: 1, Policies: "readonly", UpdatedAt: now.Add(-2 * time.Hour)}
+	c := newIamCache()
+	c.iamUserPolicyMap["alice"] = mapped
+	c.iamUserPolicyMap["bob"] = mapped
+	c.iamUserPolicyMap["carol"] = mapped
+
+	elevated := MappedPolicy{Version: 1, Policies: "consoleAdmin", UpdatedAt: now.Add(-time.Hour)}
+	elevated.ExpiresAt = now.Add(time.Hour)
+	c.iamElevatedPolicyMap["alice"] = elevated
+
+	expired := elevated
+	expired.ExpiresAt = now.Add(-time.Second)
+	c.iamElevatedPolicyMap["bob"] = expired
+
+	testCases := map[string]struct {
+		policies  []string
+		updatedAt time.Time
+	}{
+		"alice": {[]string{"readonly", "consoleAdmin"}, elevated.UpdatedAt},
+		"bob":   {[]string{"readonly"}, expired.ExpiresAt},
+		"carol": {[]string{"readonly"}, mapped.UpdatedAt},
+	}
+	for user, want := range testCases {
+		policies, updatedAt, err := c.policyDBGet(MinIOUsersSysType, user, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(policies, want.policies) {
+			t.Errorf("%s: expected policies %v, got %v", user, want.policies, policies)
+		}
+		if !updatedAt.Equal(want.updatedAt) {
+			t.Errorf("%s: expected updatedAt %v, got %v", user, want.updatedAt, updatedAt)
+		}
+	}
+}
@@ -397,6 +397,22 @@ func (ies *IAMEtcdStore) loadMappedPolicies(ctx context.Context, userType IAMUse
 	return nil
 }
 
+func (ies *IAMEtcdStore) loadElevatedPolicies(ctx context.Context, m map[string]MappedPolicy) error {
+	cctx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
+	defer cancel()
+	r, err := ies.client.Get(cctx, iamConfigPolicyDBElevatedPrefix, etcd.WithPrefix())
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range r.Kvs {
+		if err = getMappedPolicy(ctx, kv, regUser, false, m, iamConfigPolicyDBElevatedPrefix); err != nil && !errors.Is(err, errNoSuchPolicy) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (ies *IAMEtcdStore) savePolicyDoc(ctx context.Context, policyName string, p PolicyDoc) error {
 	return ies.saveIAMConfig(ctx, &p, getPolicyDocPath(policyName))
 }
@@ -0,0 +1,261 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/logger"
+)
+
+const (
+	// IAM file recording when users and service accounts last authenticated.
+	iamLastUsedFile = iamConfigPrefix + "/last-used.json"
+
+	// Interval at which last-used timestamps are flushed to the backend,
+	// a random jitter of up to a fifth of it is added.
+	lastUsedFlushInterval = 5 * time.Minute
+
+	// Last-used timestamps are only updated in memory when they are
+	// older than this, to avoid taking the write lock on every request.
+	lastUsedGranularity = time.Minute
+)
+
+// accessKeysLastUsed is the persisted last-used state of access keys.
+type accessKeysLastUsed struct {
+	// Since is when tracking started, credentials without a
+	// last-used time have not authenticated since then.
+	Since    time.Time            `json:"since"`
+	LastUsed map[string]time.Time `json:"lastUsed"`
+}
+
+// merge takes the latest last-used time of each access key and
+// the earliest tracking start.
+func (a *accessKeysLastUsed) merge(b accessKeysLastUsed) (changed bool) {
+	if a.LastUsed == nil {
+		a.LastUsed = make(map[string]time.Time, len(b.LastUsed))
+	}
+	if !b.Since.IsZero() && (a.Since.IsZero() || b.Since.Before(a.Since)) {
+		a.Since = b.Since
+		changed = true
+	}
+	for k, t := range b.LastUsed {
+		if t.After(a.LastUsed[k]) {
+			a.LastUsed[k] = t
+			changed = true
+		}
+	}
+	return changed
+}
+
+// lastUsedTracker records in memory when access keys last authenticated.
+type lastUsedTracker struct {
+	mu    sync.RWMutex
+	state accessKeysLastUsed
+}
+
+func newLastUsedTracker() *lastUsedTracker {
+	return &lastUsedTracker{
+		state: accessKeysLastUsed{
+			Since:    UTCNow(),
+			LastUsed: make(map[string]time.Time),
+		},
+	}
+}
+
+func (t *lastUsedTracker) record(accessKey string, now time.Time) {
+	t.mu.RLock()
+	last, ok := t.state.LastUsed[accessKey]
+	t.mu.RUnlock()
+	if ok && now.Sub(last) < lastUsedGranularity {
+		return
+	}
+
+	t.mu.Lock()
+	if now.After(t.state.LastUsed[accessKey]) {
+		t.state.LastUsed[accessKey] = now
+	}
+	t.mu.Unlock()
+}
+
+func (t *lastUsedTracker) merge(state accessKeysLastUsed) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.state.merge(state)
+}
+
+// snapshot returns a copy of the tracked state, only keeping the
+// access keys for which keep returns true, if set.
+func (t *lastUsedTracker) snapshot(keep func(accessKey string) bool) accessKeysLastUsed {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state := accessKeysLastUsed{
+		Since:    t.state.Since,
+		LastUsed: make(map[string]time.Time, len(t.state.LastUsed)),
+	}
+	for k, v := range t.state.LastUsed {
+		if keep != nil && !keep(k) {
+			delete(t.state.LastUsed, k)
+			continue
+		}
+		state.LastUsed[k] = v
+	}
+	return state
+}
+
+// RecordAccessKeyUsed - records a successful authentication of a user
+// or service account. Root and temporary credentials are not tracked.
+func (sys *IAMSys) RecordAccessKeyUsed(cred auth.Credentials) {
+	if sys == nil || sys.lastUsed == nil {
+		return
+	}
+	if cred.AccessKey == "" || cred.AccessKey == globalActiveCred.AccessKey || cred.IsTemp() {
+		return
+	}
+	sys.lastUsed.record(cred.AccessKey, UTCNow())
+}
+
+// loadLastUsed loads the last-used state persisted by all nodes.
+func (sys *IAMSys) loadLastUsed(ctx context.Context) (accessKeysLastUsed, error) {
+	var state accessKeysLastUsed
+	if err := sys.store.loadIAMConfig(ctx, &state, iamLastUsedFile); err != nil && !errors.Is(err, errConfigNotFound) {
+		return state, err
+	}
+	return state, nil
+}
+
+// flushLastUsed merges the last-used state of this node with the
+// persisted one, taking the latest time of each access key, and saves
+// it back. Removed users and service accounts are dropped.
+func (sys *IAMSys) flushLastUsed(ctx context.Context) error {
+	stored, err := sys.loadLastUsed(ctx)
+	if err != nil {
+		return err
+	}
+	exists := func(accessKey string) bool {
+		_, ok := sys.store.GetUser(accessKey)
+		return ok
+	}
+
+	var merged accessKeysLastUsed
+	merged.merge(stored)
+
+	var changed bool
+	for k := range merged.LastUsed {
+		if !exists(k) {
+			delete(merged.LastUsed, k)
+			changed = true
+		}
+	}
+	if merged.merge(sys.lastUsed.snapshot(exists)) {
+		changed = true
+	}
+
+	// Learn about the access keys used on other nodes.
+	sys.lastUsed.merge(merged)
+	if !changed {
+		return nil
+	}
+	return sys.store.saveIAMConfig(ctx, merged, iamLastUsedFile)
+}
+
+// AccessKeysLastUsed - returns when users and service accounts last
+// authenticated, across all nodes. Times are accurate within
+// lastUsedFlushInterval for access keys used on other nodes.
+func (sys *IAMSys) AccessKeysLastUsed(ctx context.Context) (accessKeysLastUsed, error) {
+	if !sys.Initialized() {
+		return accessKeysLastUsed{}, errServerNotInitialized
+	}
+
+	state, err := sys.loadLastUsed(ctx)
+	if err != nil {
+		return state, err
+	}
+	state.merge(sys.lastUsed.snapshot(nil))
+	return state, nil
+}
+
+// StaleCredentials - returns the users and service accounts that did
+// not authenticate since the cutoff, sorted by access key. Credentials
+// never seen authenticating are only returned if they were created or
+// updated before the cutoff, and tracking started before it.
+func (sys *IAMSys) StaleCredentials(ctx context.Context, cutoff time.Time) ([]StaleCredential, accessKeysLastUsed, error) {
+	state, err := sys.AccessKeysLastUsed(ctx)
+	if err != nil {
+		return nil, state, err
+	}
+
+	var stale []StaleCredential
+	for _, u := range sys.store.GetUserIdentities() {
+		cred := u.Credentials
+		c := StaleCredential{
+			AccessKey: cred.AccessKey,
+			Type:      "user",
+			Status:    cred.Status,
+		}
+		if cred.IsServiceAccount() {
+			c.Type = "service-account"
+			c.ParentUser = cred.ParentUser
+		}
+		if lastUsed, ok := state.LastUsed[cred.AccessKey]; ok {
+			if !lastUsed.Before(cutoff) {
+				continue
+			}
+			c.LastUsed = &lastUsed
+		} else if !state.Since.Before(cutoff) || !u.UpdatedAt.Before(cutoff) {
+			continue
+		}
+		stale = append(stale, c)
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		return stale[i].AccessKey < stale[j].AccessKey
+	})
+	return stale, state, nil
+}
+
+// periodically flushes the last-used state to the backend.
+func (sys *IAMSys) lastUsedFlusher(ctx context.Context) {
+	if stored, err := sys.loadLastUsed(ctx); err == nil {
+		sys.lastUsed.merge(stored)
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	jitter := func() time.Duration {
+		return lastUsedFlushInterval + time.Duration(r.Int63n(int64(lastUsedFlushInterval/5)))
+	}
+
+	timer := time.NewTimer(jitter())
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			logger.LogIf(ctx, sys.flushLastUsed(ctx))
+			timer.Reset(jitter())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
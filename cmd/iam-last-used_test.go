@@ -0,0 +1,117 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccessKeysLastUsedMerge(t *testing.T) {
+	t0 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	a := accessKeysLastUsed{
+		Since: t0.Add(time.Hour),
+		LastUsed: map[string]time.Time{
+			"alice": t0.Add(2 * time.Hour),
+			"bob":   t0.Add(5 * time.Hour),
+		},
+	}
+	b := accessKeysLastUsed{
+		Since: t0,
+		LastUsed: map[string]time.Time{
+			"alice": t0.Add(3 * time.Hour),
+			"bob":   t0.Add(4 * time.Hour),
+			"carol": t0.Add(time.Hour),
+		},
+	}
+	if !a.merge(b) {
+		t.Fatal("expected merge to report changes")
+	}
+	if !a.Since.Equal(t0) {
+		t.Errorf("expected earliest tracking start %v, got %v", t0, a.Since)
+	}
+	want := map[string]time.Time{
+		"alice": t0.Add(3 * time.Hour),
+		"bob":   t0.Add(5 * time.Hour),
+		"carol": t0.Add(time.Hour),
+	}
+	for k, v := range want {
+		if !a.LastUsed[k].Equal(v) {
+			t.Errorf("%s: expected %v, got %v", k, v, a.LastUsed[k])
+		}
+	}
+	if a.merge(b) {
+		t.Error("merging the same state twice must not report changes")
+	}
+}
+
+func TestLastUsedTracker(t *testing.T) {
+	tr := newLastUsedTracker()
+	now := UTCNow()
+
+	tr.record("alice", now)
+	// Updates within the granularity are skipped.
+	tr.record("alice", now.Add(lastUsedGranularity/2))
+	tr.record("bob", now)
+
+	state := tr.snapshot(nil)
+	if !state.LastUsed["alice"].Equal(now) {
+		t.Errorf("expected %v, got %v", now, state.LastUsed["alice"])
+	}
+
+	tr.record("alice", now.Add(2*lastUsedGranularity))
+	state = tr.snapshot(func(accessKey string) bool {
+		return accessKey != "bob"
+	})
+	if !state.LastUsed["alice"].Equal(now.Add(2 * lastUsedGranularity)) {
+		t.Errorf("expected %v, got %v", now.Add(2*lastUsedGranularity), state.LastUsed["alice"])
+	}
+	if _, ok := state.LastUsed["bob"]; ok {
+		t.Error("expected bob to be dropped")
+	}
+	if _, ok := tr.snapshot(nil).LastUsed["bob"]; ok {
+		t.Error("expected bob to be dropped from the tracker")
+	}
+}
+
+func TestParseUnusedFor(t *testing.T) {
+	testCases := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "90d", want: 90 * 24 * time.Hour},
+		{input: "0d", want: 0},
+		{input: "36h", want: 36 * time.Hour},
+		{input: "d", wantErr: true},
+		{input: "-1d", wantErr: true},
+		{input: "-1h", wantErr: true},
+		{input: "ninety", wantErr: true},
+	}
+	for _, tc := range testCases {
+		got, err := parseUnusedFor(tc.input)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: unexpected error %v", tc.input, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: expected %v, got %v", tc.input, tc.want, got)
+		}
+	}
+}
@@ -304,6 +304,27 @@ func (iamOS *IAMObjectStore) loadMappedPolicies(ctx context.Context, userType IA
 	return nil
 }
 
+func (iamOS *IAMObjectStore) loadElevatedPolicies(ctx context.Context, m map[string]MappedPolicy) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for item := range listIAMConfigItems(ctx, iamOS.objAPI, iamConfigPolicyDBElevatedPrefix) {
+		if item.Err != nil {
+			return item.Err
+		}
+
+		user := strings.TrimSuffix(item.Item, ".json")
+		var mp MappedPolicy
+		if err := iamOS.loadIAMConfig(ctx, &mp, getElevatedPolicyPath(user)); err != nil {
+			if err == errConfigNotFound {
+				continue
+			}
+			return err
+		}
+		m[user] = mp
+	}
+	return nil
+}
+
 var (
 	usersListKey                   = "users/"
 	svcAccListKey                  = "service-accounts/"
@@ -314,6 +335,7 @@ var (
 	policyDBSTSUsersListKey        = "policydb/sts-users/"
 	policyDBServiceAccountsListKey = "policydb/service-accounts/"
 	policyDBGroupsListKey          = "policydb/groups/"
+	policyDBElevatedListKey        = "policydb/elevated/"
 
 	allListKeys = []string{
 		usersListKey,
@@ -325,6 +347,7 @@ var (
 		policyDBSTSUsersListKey,
 		policyDBServiceAccountsListKey,
 		policyDBGroupsListKey,
+		policyDBElevatedListKey,
 	}
 )
 
@@ -443,6 +466,20 @@ func (iamOS *IAMObjectStore) loadAllFromObjStore(ctx context.Context, cache *iam
 		}
 	}
 
+	bootstrapTrace("loading elevated policy mapping")
+	elevatedPolicyMappingsList := listedConfigItems[policyDBElevatedListKey]
+	for _, item := range elevatedPolicyMappingsList {
+		user := strings.TrimSuffix(item, ".json")
+		var mp MappedPolicy
+		if err := iamOS.loadIAMConfig(ctx, &mp, getElevatedPolicyPath(user)); err != nil {
+			if err == errConfigNotFound {
+				continue
+			}
+			return err
+		}
+		cache.iamElevatedPolicyMap[user] = mp
+	}
+
 	cache.buildUserGroupMemberships()
 	return nil
 }
@@ -63,6 +63,7 @@ const (
 	iamConfigPolicyDBSTSUsersPrefix        = iamConfigPolicyDBPrefix + "sts-users/"
 	iamConfigPolicyDBServiceAccountsPrefix = iamConfigPolicyDBPrefix + "service-accounts/"
 	iamConfigPolicyDBGroupsPrefix          = iamConfigPolicyDBPrefix + "groups/"
+	iamConfigPolicyDBElevatedPrefix        = iamConfigPolicyDBPrefix + "elevated/"
 
 	// IAM identity file which captures identity credentials.
 	iamIdentityFile = "identity.json"
@@ -160,6 +161,10 @@ func getMappedPolicyPath(name string, userType IAMUserType, isGroup bool) string
 	}
 }
 
+func getElevatedPolicyPath(user string) string {
+	return pathJoin(iamConfigPolicyDBElevatedPrefix, user+".json")
+}
+
 // UserIdentity represents a user's secret key and their status
 type UserIdentity struct {
 	Version     int              `json:"version"`
@@ -188,6 +193,11 @@ type MappedPolicy struct {
 	Version   int       `json:"version"`
 	Policies  string    `json:"policy"`
 	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+
+	// Set only for time-bound policy elevations.
+	ExpiresAt   time.Time `json:"expiresAt,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+	RequestedBy string    `json:"requestedBy,omitempty"`
 }
 
 // converts a mapped policy into a slice of distinct policies
@@ -206,6 +216,11 @@ func (mp MappedPolicy) policySet() set.StringSet {
 	return set.CreateStringSet(mp.toSlice()...)
 }
 
+// isExpired returns if a time-bound mapping has expired at now.
+func (mp MappedPolicy) isExpired(now time.Time) bool {
+	return !mp.ExpiresAt.IsZero() && !now.Before(mp.ExpiresAt)
+}
+
 func newMappedPolicy(policy string) MappedPolicy {
 	return MappedPolicy{Version: 1, Policies: policy, UpdatedAt: UTCNow()}
 }
@@ -294,6 +309,8 @@ type iamCache struct {
 	iamUserPolicyMap map[string]MappedPolicy
 	// map of group names to policy names
 	iamGroupPolicyMap map[string]MappedPolicy
+	// map of user names to time-bound policy elevations
+	iamElevatedPolicyMap map[string]MappedPolicy
 }
 
 func newIamCache() *iamCache {
@@ -304,6 +321,7 @@ func newIamCache() *iamCache {
 		iamUserGroupMemberships: map[string]set.StringSet{},
 		iamUserPolicyMap:        map[string]MappedPolicy{},
 		iamGroupPolicyMap:       map[string]MappedPolicy{},
+		iamElevatedPolicyMap:    map[string]MappedPolicy{},
 	}
 }
 
@@ -387,6 +405,22 @@ func (c *iamCache) policyDBGet(mode UsersSysType, name string, isGroup bool) ([]
 	// returned policy could be empty
 	policies := mp.toSlice()
 
+	// Expired elevations are ignored until they are swept. Granting
+	// or expiring an elevation changes the policies of the user, so
+	// it counts as an update of the mapping.
+	updatedAt := mp.UpdatedAt
+	if ep, ok := c.iamElevatedPolicyMap[name]; ok {
+		changedAt := ep.UpdatedAt
+		if ep.isExpired(time.Now()) {
+			changedAt = ep.ExpiresAt
+		} else {
+			policies = append(policies, ep.toSlice()...)
+		}
+		if changedAt.After(updatedAt) {
+			updatedAt = changedAt
+		}
+	}
+
 	for _, group := range c.iamUserGroupMemberships[name].ToSlice() {
 		// Skip missing or disabled groups
 		gi, ok := c.iamGroupsMap[group]
@@ -397,7 +431,7 @@ func (c *iamCache) policyDBGet(mode UsersSysType, name string, isGroup bool) ([]
 		policies = append(policies, c.iamGroupPolicyMap[group].toSlice()...)
 	}
 
-	return policies, mp.UpdatedAt, nil
+	return policies, updatedAt, nil
 }
 
 func (c *iamCache) updateUserWithClaims(key string, u UserIdentity) error {
@@ -432,6 +466,7 @@ type IAMStorageAPI interface {
 	loadGroups(ctx context.Context, m map[string]GroupInfo) error
 	loadMappedPolicy(ctx context.Context, name string, userType IAMUserType, isGroup bool, m map[string]MappedPolicy) error
 	loadMappedPolicies(ctx context.Context, userType IAMUserType, isGroup bool, m map[string]MappedPolicy) error
+	loadElevatedPolicies(ctx context.Context, m map[string]MappedPolicy) error
 	saveIAMConfig(ctx context.Context, item interface{}, path string, opts ...options) error
 	loadIAMConfig(ctx context.Context, item interface{}, path string) error
 	deleteIAMConfig(ctx context.Context, path string) error
@@ -525,6 +560,11 @@ func (store *IAMStoreSys) LoadIAMCache(ctx context.Context) error {
 			return err
 		}
 
+		bootstrapTrace("loading elevated policy mapping")
+		if err := store.loadElevatedPolicies(ctx, newCache.iamElevatedPolicyMap); err != nil {
+			return err
+		}
+
 		newCache.buildUserGroupMemberships()
 	}
 
@@ -547,6 +587,7 @@ func (store *IAMStoreSys) LoadIAMCache(ctx context.Context) error {
 		cache.iamPolicyDocsMap = newCache.iamPolicyDocsMap
 		cache.iamUserGroupMemberships = newCache.iamUserGroupMemberships
 		cache.iamUserPolicyMap = newCache.iamUserPolicyMap
+		cache.iamElevatedPolicyMap = newCache.iamElevatedPolicyMap
 		cache.iamUsersMap = newCache.iamUsersMap
 		cache.updatedAt = time.Now()
 	}
@@ -1657,6 +1698,129 @@ func (store *IAMStoreSys) PolicyMappingNotificationHandler(ctx context.Context,
 	return err
 }
 
+// SetElevatedPolicy - saves a time-bound policy elevation of the given
+// user to storage and cache, replacing any existing elevation.
+func (store *IAMStoreSys) SetElevatedPolicy(ctx context.Context, user string, mp MappedPolicy) (updatedAt time.Time, err error) {
+	if user == "" || mp.ExpiresAt.IsZero() {
+		return updatedAt, errInvalidArgument
+	}
+
+	cache := store.lock()
+	defer store.unlock()
+
+	for _, p := range mp.toSlice() {
+		if _, found := cache.iamPolicyDocsMap[p]; !found {
+			return updatedAt, errNoSuchPolicy
+		}
+	}
+
+	if err := store.saveIAMConfig(ctx, mp, getElevatedPolicyPath(user)); err != nil {
+		return updatedAt, err
+	}
+	cache.iamElevatedPolicyMap[user] = mp
+	cache.updatedAt = time.Now()
+	return mp.UpdatedAt, nil
+}
+
+// DeleteElevatedPolicy - removes the policy elevation of the given user
+// from storage and cache and returns it. If expiresAt is not zero, the
+// elevation is only removed if it expires at expiresAt, so that a renewed
+// elevation is not removed by the sweeper.
+func (store *IAMStoreSys) DeleteElevatedPolicy(ctx context.Context, user string, expiresAt time.Time) (MappedPolicy, error) {
+	if user == "" {
+		return MappedPolicy{}, errInvalidArgument
+	}
+
+	cache := store.lock()
+	defer store.unlock()
+
+	mp, ok := cache.iamElevatedPolicyMap[user]
+	if !ok {
+		return mp, errNoSuchPolicy
+	}
+	if !expiresAt.IsZero() && !mp.ExpiresAt.Equal(expiresAt) {
+		return mp, errNoSuchPolicy
+	}
+
+	err := store.deleteIAMConfig(ctx, getElevatedPolicyPath(user))
+	if err != nil && err != errConfigNotFound {
+		return mp, err
+	}
+	delete(cache.iamElevatedPolicyMap, user)
+	cache.updatedAt = time.Now()
+	if err == errConfigNotFound {
+		// Removed concurrently, by a peer or another site.
+		return mp, errNoSuchPolicy
+	}
+	return mp, nil
+}
+
+// GetElevatedPolicy - returns the policy elevation of the given user.
+func (store *IAMStoreSys) GetElevatedPolicy(user string) (MappedPolicy, bool) {
+	cache := store.rlock()
+	defer store.runlock()
+
+	mp, ok := cache.iamElevatedPolicyMap[user]
+	return mp, ok
+}
+
+// ListElevatedPolicies - returns the policy elevations not expired at now,
+// optionally only that of the given user.
+func (store *IAMStoreSys) ListElevatedPolicies(user string, now time.Time) map[string]MappedPolicy {
+	cache := store.rlock()
+	defer store.runlock()
+
+	res := make(map[string]MappedPolicy)
+	for u, mp := range cache.iamElevatedPolicyMap {
+		if (user == "" || u == user) && !mp.isExpired(now) {
+			res[u] = mp
+		}
+	}
+	return res
+}
+
+// ListExpiredElevatedPolicies - returns the policy elevations expired at
+// now.
+func (store *IAMStoreSys) ListExpiredElevatedPolicies(now time.Time) map[string]MappedPolicy {
+	cache := store.rlock()
+	defer store.runlock()
+
+	res := make(map[string]MappedPolicy)
+	for u, mp := range cache.iamElevatedPolicyMap {
+		if mp.isExpired(now) {
+			res[u] = mp
+		}
+	}
+	return res
+}
+
+// ElevatedPolicyNotificationHandler - handles updating a policy elevation
+// from storage.
+func (store *IAMStoreSys) ElevatedPolicyNotificationHandler(ctx context.Context, user string) error {
+	if user == "" {
+		return errInvalidArgument
+	}
+
+	cache := store.lock()
+	defer store.unlock()
+
+	var mp MappedPolicy
+	err := store.loadIAMConfig(ctx, &mp, getElevatedPolicyPath(user))
+	switch err {
+	case nil:
+		cache.iamElevatedPolicyMap[user] = mp
+	case errConfigNotFound:
+		// This means that the elevation was removed, so we update
+		// the cache.
+		delete(cache.iamElevatedPolicyMap, user)
+		err = nil
+	default:
+		return err
+	}
+	cache.updatedAt = time.Now()
+	return nil
+}
+
 // UserNotificationHandler - handles updating a user/STS account/service account
 // from storage.
 func (store *IAMStoreSys) UserNotificationHandler(ctx context.Context, accessKey string, userType IAMUserType) error {
@@ -1784,6 +1948,11 @@ func (store *IAMStoreSys) DeleteUser(ctx context.Context, accessKey string, user
 	store.deleteMappedPolicy(ctx, accessKey, userType, false)
 	delete(cache.iamUserPolicyMap, accessKey)
 
+	if userType == regUser {
+		store.deleteIAMConfig(ctx, getElevatedPolicyPath(accessKey))
+		delete(cache.iamElevatedPolicyMap, accessKey)
+	}
+
 	err := store.deleteUserIdentity(ctx, accessKey, userType)
 	if err == errNoSuchUser {
 		// ignore if user is already deleted.
@@ -2402,6 +2571,22 @@ func (store *IAMStoreSys) GetSTSAndServiceAccounts() []auth.Credentials {
 	return res
 }
 
+// GetUserIdentities - returns the identities of all users and service
+// accounts, temporary accounts are skipped.
+func (store *IAMStoreSys) GetUserIdentities() []UserIdentity {
+	cache := store.rlock()
+	defer store.runlock()
+
+	var res []UserIdentity
+	for _, u := range cache.iamUsersMap {
+		if u.Credentials.IsTemp() {
+			continue
+		}
+		res = append(res, u)
+	}
+	return res
+}
+
 // UpdateUserIdentity - updates a user credential.
 func (store *IAMStoreSys) UpdateUserIdentity(ctx context.Context, cred auth.Credentials) error {
 	cache := store.lock()
@@ -102,6 +102,9 @@ type IAMSys struct {
 
 	// configLoaded will be closed and remain so after first load.
 	configLoaded chan struct{}
+
+	// lastUsed records when users and service accounts last authenticated.
+	lastUsed *lastUsedTracker
 }
 
 // IAMUserType represents a user type inside MinIO server
@@ -372,6 +375,12 @@ func (sys *IAMSys) Init(ctx context.Context, objAPI ObjectLayer, etcdClient *etc
 	// Start watching changes to storage.
 	go sys.watch(ctx)
 
+	// Start flushing last-used times of access keys.
+	go sys.lastUsedFlusher(ctx)
+
+	// Start removing expired policy elevations.
+	go sys.elevationSweeper(ctx)
+
 	// Load RoleARNs
 	sys.rolesMap = make(map[arn.ARN]string)
 
@@ -488,6 +497,7 @@ func (sys *IAMSys) loadWatchedEvent(ctx context.Context, event iamWatchEvent) (e
 	policyDBUsersPrefix := strings.HasPrefix(event.keyPath, iamConfigPolicyDBUsersPrefix)
 	policyDBSTSUsersPrefix := strings.HasPrefix(event.keyPath, iamConfigPolicyDBSTSUsersPrefix)
 	policyDBGroupsPrefix := strings.HasPrefix(event.keyPath, iamConfigPolicyDBGroupsPrefix)
+	policyDBElevatedPrefix := strings.HasPrefix(event.keyPath, iamConfigPolicyDBElevatedPrefix)
 
 	ctx, cancel := context.WithTimeout(ctx, defaultContextTimeout)
 	defer cancel()
@@ -520,6 +530,10 @@ func (sys *IAMSys) loadWatchedEvent(ctx context.Context, event iamWatchEvent) (e
 		policyMapFile := strings.TrimPrefix(event.keyPath, iamConfigPolicyDBGroupsPrefix)
 		user := strings.TrimSuffix(policyMapFile, ".json")
 		err = sys.store.PolicyMappingNotificationHandler(ctx, user, true, regUser)
+	case policyDBElevatedPrefix:
+		policyMapFile := strings.TrimPrefix(event.keyPath, iamConfigPolicyDBElevatedPrefix)
+		user := strings.TrimSuffix(policyMapFile, ".json")
+		err = sys.store.ElevatedPolicyNotificationHandler(ctx, user)
 	}
 	return err
 }
@@ -1954,5 +1968,6 @@ func NewIAMSys() *IAMSys {
 	return &IAMSys{
 		usersSysType: MinIOUsersSysType,
 		configLoaded: make(chan struct{}),
+		lastUsed:     newLastUsedTracker(),
 	}
 }
@@ -0,0 +1,177 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/internal/logger"
+)
+
+// LockHolder is the context of a namespace lock held by a node, to find
+// out which request holds a lock and what it is waiting for.
+type LockHolder struct {
+	Node     string    `json:"node"`
+	Resource string    `json:"resource"`
+	UID      string    `json:"uid"`
+	Writer   bool      `json:"writer"`
+	Source   string    `json:"source"`
+	Since    time.Time `json:"since"`
+	// Context of the S3 or admin request which took the lock, empty
+	// for background operations.
+	RequestID  string `json:"requestID,omitempty"`
+	API        string `json:"api,omitempty"`
+	Bucket     string `json:"bucket,omitempty"`
+	Object     string `json:"object,omitempty"`
+	RemoteHost string `json:"remoteHost,omitempty"`
+	AccessKey  string `json:"accessKey,omitempty"`
+	// Stack is the current stack of the goroutine which took the lock,
+	// empty if the goroutine exited without releasing it.
+	Stack string `json:"stack,omitempty"`
+}
+
+type lockHolder struct {
+	LockHolder
+	resources   []string
+	goroutineID uint64
+}
+
+// lockHolders tracks the namespace locks held by this node, by lock
+// UID, with the context of the goroutine which took them.
+type lockHolders struct {
+	mu      sync.Mutex
+	holders map[string]*lockHolder
+}
+
+var globalLockHolders = &lockHolders{holders: make(map[string]*lockHolder)}
+
+// curGoroutineID returns the ID of the calling goroutine, parsed from
+// the header of its stack trace, "goroutine <id> [running]:".
+func curGoroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i > 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
+
+// add records a lock taken on the resources by the calling goroutine.
+func (l *lockHolders) add(ctx context.Context, uid, source string, writer bool, resources []string) {
+	h := &lockHolder{
+		LockHolder: LockHolder{
+			UID:    uid,
+			Writer: writer,
+			Source: source,
+			Since:  UTCNow(),
+		},
+		resources:   resources,
+		goroutineID: curGoroutineID(),
+	}
+	if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+		h.RequestID = reqInfo.RequestID
+		h.API = reqInfo.API
+		h.Bucket = reqInfo.BucketName
+		h.Object = reqInfo.ObjectName
+		h.RemoteHost = reqInfo.RemoteHost
+		h.AccessKey = reqInfo.Cred.AccessKey
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.holders[uid] = h
+}
+
+// remove forgets a released lock.
+func (l *lockHolders) remove(uid string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.holders, uid)
+}
+
+// get returns the holders of the lock on the resource, with the
+// current stack of their goroutines, oldest first.
+func (l *lockHolders) get(resource string) []LockHolder {
+	var (
+		holders    []LockHolder
+		goroutines []uint64
+	)
+	l.mu.Lock()
+	for _, h := range l.holders {
+		for _, r := range h.resources {
+			if r == resource {
+				lh := h.LockHolder
+				lh.Node = globalLocalNodeName
+				lh.Resource = resource
+				holders = append(holders, lh)
+				goroutines = append(goroutines, h.goroutineID)
+				break
+			}
+		}
+	}
+	l.mu.Unlock()
+
+	if len(holders) > 0 {
+		stacks := goroutineStacks()
+		for i := range holders {
+			holders[i].Stack = stacks[goroutines[i]]
+		}
+	}
+	sort.Slice(holders, func(i, j int) bool {
+		return holders[i].Since.Before(holders[j].Since)
+	})
+	return holders
+}
+
+// Maximum size of the stacks of all goroutines, the stacks past it are
+// not returned.
+const maxGoroutineStacksSize = 64 << 20
+
+// goroutineStacks returns the stacks of all goroutines by ID.
+func goroutineStacks() map[uint64]string {
+	buf := make([]byte, 1<<20)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) || len(buf) >= maxGoroutineStacksSize {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	stacks := make(map[uint64]string)
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		b := bytes.TrimPrefix(stack, []byte("goroutine "))
+		i := bytes.IndexByte(b, ' ')
+		if i <= 0 {
+			continue
+		}
+		if id, err := strconv.ParseUint(string(b[:i]), 10, 64); err == nil {
+			stacks[id] = string(stack)
+		}
+	}
+	return stacks
+}
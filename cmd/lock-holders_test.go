@@ -0,0 +1,60 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio/internal/logger"
+)
+
+func TestLockHolders(t *testing.T) {
+	l := &lockHolders{holders: make(map[string]*lockHolder)}
+	ctx := logger.SetReqInfo(context.Background(), &logger.ReqInfo{RequestID: "req-1", API: "PutObject"})
+
+	held := make(chan struct{})
+	release := make(chan struct{})
+	go func() {
+		l.add(ctx, "uid-1", "[namespace-lock.go:1:GetLock()]", true, []string{"bucket/object"})
+		close(held)
+		<-release
+	}()
+	<-held
+	defer close(release)
+
+	l.add(context.Background(), "uid-2", "[namespace-lock.go:1:GetRLock()]", false, []string{"bucket/other"})
+
+	holders := l.get("bucket/object")
+	if len(holders) != 1 {
+		t.Fatalf("expected 1 lock holder, got %d", len(holders))
+	}
+	h := holders[0]
+	if h.UID != "uid-1" || !h.Writer || h.RequestID != "req-1" || h.API != "PutObject" || h.Resource != "bucket/object" {
+		t.Fatalf("unexpected lock holder %+v", h)
+	}
+	if !strings.Contains(h.Stack, "TestLockHolders") {
+		t.Fatalf("expected the stack of the goroutine holding the lock, got %q", h.Stack)
+	}
+
+	l.remove("uid-1")
+	if holders = l.get("bucket/object"); len(holders) != 0 {
+		t.Fatalf("expected the lock to be released, got %+v", holders)
+	}
+}
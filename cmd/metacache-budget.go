@@ -0,0 +1,141 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// errListingCapped is returned by the listers once a listing request
+// exhausted its budget.
+var errListingCapped = errors.New("listing stopped at its scan limit")
+
+// Number of entries scanned between two checks of the deadline.
+const listBudgetDeadlineCheckInterval = 64
+
+// listBudget is the work a single listing request may consume, set by
+// api list_max_scanned and list_max_duration. The scanned entries
+// include the entries filtered out and never returned, such as the
+// objects whose latest version is a delete marker.
+type listBudget struct {
+	maxScanned int64
+	deadline   time.Time
+
+	mu       sync.Mutex
+	scanned  int64
+	last     string // name of the last entry scanned within the budget
+	exceeded bool
+}
+
+type listBudgetKey struct{}
+
+// withListBudget returns ctx carrying the budget of a listing request,
+// ctx is returned as is when listings are not limited.
+func withListBudget(ctx context.Context) context.Context {
+	maxScanned, maxDuration := globalAPIConfig.getListLimits()
+	if maxScanned <= 0 && maxDuration <= 0 {
+		return ctx
+	}
+	b := &listBudget{maxScanned: maxScanned}
+	if maxDuration > 0 {
+		b.deadline = time.Now().Add(maxDuration)
+	}
+	return context.WithValue(ctx, listBudgetKey{}, b)
+}
+
+// listBudgetFromContext returns the budget of the listing request, nil
+// if it is not limited.
+func listBudgetFromContext(ctx context.Context) *listBudget {
+	b, _ := ctx.Value(listBudgetKey{}).(*listBudget)
+	return b
+}
+
+// scan records an entry scanned by the listing, it returns false once
+// the budget is exhausted, the entry is then not part of the listing.
+func (b *listBudget) scan(name string) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.exceeded {
+		return false
+	}
+	if b.maxScanned > 0 && b.scanned >= b.maxScanned {
+		b.exceeded = true
+		return false
+	}
+	if !b.deadline.IsZero() && b.scanned%listBudgetDeadlineCheckInterval == 0 && time.Now().After(b.deadline) {
+		b.exceeded = true
+		return false
+	}
+	b.scanned++
+	b.last = name
+	return true
+}
+
+// expired returns true once the budget is exhausted or the listing ran
+// past its deadline, for listers waiting without scanning entries.
+func (b *listBudget) expired() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.exceeded && !b.deadline.IsZero() && time.Now().After(b.deadline) {
+		b.exceeded = true
+	}
+	return b.exceeded
+}
+
+// lastScanned returns the name of the last entry scanned within the
+// budget, empty if none was.
+func (b *listBudget) lastScanned() string {
+	if b == nil {
+		return ""
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.last
+}
+
+// cappedListing returns the entries found by a listing which exhausted
+// its budget as a truncated page, resumed after the last entry. When no
+// entry was found ListingTimeout is returned instead, with the marker
+// to resume from past the entries scanned, all filtered out.
+func (o *listPathOptions) cappedListing(entries metaCacheEntriesSorted) (metaCacheEntriesSorted, error) {
+	globalBucketConnStats.incListingsCapped(o.Bucket)
+	if entries.len() > 0 {
+		entries.truncate(o.Limit)
+		return entries, nil
+	}
+
+	err := ListingTimeout{Bucket: o.Bucket}
+	marker := o.budget.lastScanned()
+	if marker < o.Marker {
+		// Entries before the marker were already returned.
+		marker = o.Marker
+	}
+	if marker != "" {
+		err.NextMarker = o.encodeMarker(marker)
+	}
+	return entries, err
+}
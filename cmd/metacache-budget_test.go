@@ -0,0 +1,122 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+func setListLimits(maxScanned int64, maxDuration time.Duration) {
+	globalAPIConfig.mu.Lock()
+	defer globalAPIConfig.mu.Unlock()
+	globalAPIConfig.listMaxScanned = maxScanned
+	globalAPIConfig.listMaxDuration = maxDuration
+}
+
+func TestListBudget(t *testing.T) {
+	var nilBudget *listBudget
+	if !nilBudget.scan("a") || nilBudget.expired() {
+		t.Fatal("expected an unlimited listing")
+	}
+
+	b := &listBudget{maxScanned: 2}
+	for _, name := range []string{"a", "b"} {
+		if !b.scan(name) {
+			t.Fatalf("expected %s to be scanned", name)
+		}
+	}
+	if b.scan("c") || !b.expired() {
+		t.Fatal("expected the budget to be exhausted")
+	}
+	if last := b.lastScanned(); last != "b" {
+		t.Fatalf("expected b to be the last entry scanned, got %q", last)
+	}
+
+	b = &listBudget{deadline: time.Now().Add(-time.Second)}
+	if b.scan("a") || !b.expired() {
+		t.Fatal("expected the deadline to be exceeded")
+	}
+
+	setListLimits(0, 0)
+	if listBudgetFromContext(withListBudget(context.Background())) != nil {
+		t.Fatal("expected no budget without limits")
+	}
+}
+
+func TestListObjectsBudget(t *testing.T) {
+	ExecObjectLayerTest(t, testListObjectsBudget)
+}
+
+func testListObjectsBudget(obj ObjectLayer, instanceType string, t TestErrHandler) {
+	ctx := context.Background()
+	bucket := "list-budget"
+	if err := obj.MakeBucket(ctx, bucket, MakeBucketOptions{VersioningEnabled: true}); err != nil {
+		t.Fatalf("%s: %s", instanceType, err)
+	}
+	data := []byte("data")
+	for _, object := range []string{"a", "b", "c", "d", "e"} {
+		if _, err := obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{Versioned: true}); err != nil {
+			t.Fatalf("%s: %s", instanceType, err)
+		}
+	}
+	// The latest versions of a and b are delete markers, scanned but not listed.
+	for _, object := range []string{"a", "b"} {
+		if _, err := obj.DeleteObject(ctx, bucket, object, ObjectOptions{Versioned: true}); err != nil {
+			t.Fatalf("%s: %s", instanceType, err)
+		}
+	}
+
+	defer setListLimits(0, 0)
+	setListLimits(2, 0)
+
+	capped := globalBucketConnStats.getListingsCapped(bucket)
+	_, err := obj.ListObjects(withListBudget(ctx), bucket, "", "", "", 1000)
+	lt, ok := err.(ListingTimeout)
+	if !ok {
+		t.Fatalf("%s: expected ListingTimeout, got %v", instanceType, err)
+	}
+	o := listPathOptions{Marker: lt.NextMarker}
+	o.parseMarker()
+	if o.Marker != "b" {
+		t.Fatalf("%s: expected the listing to resume after b, got %q", instanceType, lt.NextMarker)
+	}
+
+	// Resuming returns the entries found within the budget as a truncated page.
+	loi, err := obj.ListObjects(withListBudget(ctx), bucket, "", lt.NextMarker, "", 1000)
+	if err != nil {
+		t.Fatalf("%s: %s", instanceType, err)
+	}
+	if len(loi.Objects) != 2 || loi.Objects[0].Name != "c" || loi.Objects[1].Name != "d" || !loi.IsTruncated {
+		t.Fatalf("%s: unexpected listing %+v", instanceType, loi)
+	}
+	if got := globalBucketConnStats.getListingsCapped(bucket) - capped; got != 2 {
+		t.Fatalf("%s: expected capped listings to be counted, got %d", instanceType, got)
+	}
+
+	// Listings without a budget are not limited.
+	loi, err = obj.ListObjects(ctx, bucket, "", "", "", 1000)
+	if err != nil {
+		t.Fatalf("%s: %s", instanceType, err)
+	}
+	if len(loi.Objects) != 3 || loi.IsTruncated {
+		t.Fatalf("%s: unexpected listing %+v", instanceType, loi)
+	}
+}
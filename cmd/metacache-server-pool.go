@@ -22,12 +22,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	pathutil "path"
 	"strings"
 	"sync"
 	"time"
 
+	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
 )
 
@@ -49,6 +51,30 @@ func renameAllBucketMetacache(epPath string) error {
 	})
 }
 
+// listConsistencyStrong is the xhttp.MinIOListConsistency value
+// requesting strongly consistent listings.
+const listConsistencyStrong = "strong"
+
+// strongListConsistencyKey marks the context of a listing which must
+// reflect all writes completed before it.
+type strongListConsistencyKey struct{}
+
+// withListConsistency returns ctx marked for strongly consistent
+// listings, if requested by the headers.
+func withListConsistency(ctx context.Context, h http.Header) context.Context {
+	if !strings.EqualFold(strings.TrimSpace(h.Get(xhttp.MinIOListConsistency)), listConsistencyStrong) {
+		return ctx
+	}
+	return context.WithValue(ctx, strongListConsistencyKey{}, true)
+}
+
+// isStrongListConsistency returns true if the listing must reflect all
+// writes completed before it.
+func isStrongListConsistency(ctx context.Context) bool {
+	strong, _ := ctx.Value(strongListConsistencyKey{}).(bool)
+	return strong
+}
+
 // listPath will return the requested entries.
 // If no more entries are in the listing io.EOF is returned,
 // otherwise nil or an unexpected error is returned.
@@ -61,6 +87,13 @@ func (z *erasureServerPools) listPath(ctx context.Context, o *listPathOptions) (
 		return entries, err
 	}
 
+	o.budget = listBudgetFromContext(ctx)
+	defer func() {
+		if errors.Is(err, errListingCapped) {
+			entries, err = o.cappedListing(entries)
+		}
+	}()
+
 	// Marker is set validate pre-condition.
 	if o.Marker != "" && o.Prefix != "" {
 		// Marker not common with prefix is not implemented. Send an empty response
@@ -99,6 +132,12 @@ func (z *erasureServerPools) listPath(ctx context.Context, o *listPathOptions) (
 		o.BaseDir = baseDirFromPrefix(o.Prefix)
 	}
 	o.Transient = o.Transient || isReservedOrInvalidBucket(o.Bucket, false)
+	if isStrongListConsistency(ctx) {
+		// Never stream from a listing cache, which may predate recent
+		// writes, walk the prefix on all drives of each set instead.
+		o.Transient = true
+		o.AskDisks = "strict"
+	}
 	o.SetFilter()
 	if o.Transient {
 		o.Create = false
@@ -168,7 +207,7 @@ func (z *erasureServerPools) listPath(ctx context.Context, o *listPathOptions) (
 		if o.Create {
 			o.debugln("Creating", o)
 			entries, err = z.listAndSave(ctx, o)
-			if err == nil || err == io.EOF {
+			if err == nil || err == io.EOF || errors.Is(err, errListingCapped) {
 				return entries, err
 			}
 			entries.truncate(0)
@@ -191,6 +230,7 @@ func (z *erasureServerPools) listPath(ctx context.Context, o *listPathOptions) (
 			context.DeadlineExceeded,
 			// io.EOF is expected and should be returned but no need to log it.
 			io.EOF,
+			errListingCapped,
 		}...) {
 			// Expected good errors we don't need to return error.
 			return entries, err
@@ -241,6 +281,9 @@ func (z *erasureServerPools) listPath(ctx context.Context, o *listPathOptions) (
 		return entries, listErr
 	}
 	entries.reuse = true
+	if errors.Is(err, errListingCapped) {
+		return entries, err
+	}
 	truncated := entries.len() > o.Limit || err == nil
 	entries.truncate(o.Limit)
 	if !o.Transient && truncated {
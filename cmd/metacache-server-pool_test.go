@@ -0,0 +1,219 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func strongListContext(ctx context.Context) context.Context {
+	h := http.Header{}
+	h.Set(xhttp.MinIOListConsistency, listConsistencyStrong)
+	return withListConsistency(ctx, h)
+}
+
+// listAllObjects lists all objects under prefix, maxKeys per page.
+func listAllObjects(ctx context.Context, obj ObjectLayer, bucket, prefix string, maxKeys int, afterPage func(last string)) (map[string]struct{}, error) {
+	names := make(map[string]struct{})
+	marker := ""
+	for {
+		loi, err := obj.ListObjects(ctx, bucket, prefix, marker, "", maxKeys)
+		if err != nil {
+			return nil, err
+		}
+		for _, oi := range loi.Objects {
+			names[oi.Name] = struct{}{}
+		}
+		if !loi.IsTruncated {
+			return names, nil
+		}
+		if afterPage != nil && len(loi.Objects) > 0 {
+			afterPage(loi.Objects[len(loi.Objects)-1].Name)
+		}
+		marker = loi.NextMarker
+	}
+}
+
+func TestWithListConsistency(t *testing.T) {
+	ctx := context.Background()
+	for _, v := range []string{"", "eventual", "strongest"} {
+		h := http.Header{}
+		h.Set(xhttp.MinIOListConsistency, v)
+		if isStrongListConsistency(withListConsistency(ctx, h)) {
+			t.Errorf("%q: expected default consistency", v)
+		}
+	}
+	for _, v := range []string{"strong", " Strong "} {
+		h := http.Header{}
+		h.Set(xhttp.MinIOListConsistency, v)
+		if !isStrongListConsistency(withListConsistency(ctx, h)) {
+			t.Errorf("%q: expected strong consistency", v)
+		}
+	}
+}
+
+func TestListObjectsStrongConsistency(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+	initConfigSubsystem(ctx, obj)
+
+	bucket := "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(object string) error {
+		data := []byte(object)
+		_, err := obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+		return err
+	}
+
+	// Objects written while paginating, sorting after the page
+	// returned, are listed by the following pages.
+	expected := make(map[string]struct{})
+	for i := 0; i < 100; i += 2 {
+		object := fmt.Sprintf("paged/%03d", i)
+		if err = put(object); err != nil {
+			t.Fatal(err)
+		}
+		expected[object] = struct{}{}
+	}
+	var putErr error
+	names, err := listAllObjects(strongListContext(ctx), obj, bucket, "paged/", 10, func(last string) {
+		for i := 1; i < 100; i += 2 {
+			object := fmt.Sprintf("paged/%03d", i)
+			if _, ok := expected[object]; ok || object < last {
+				continue
+			}
+			if err := put(object); err != nil {
+				putErr = err
+			}
+			expected[object] = struct{}{}
+			return
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if putErr != nil {
+		t.Fatal(putErr)
+	}
+	for object := range expected {
+		if _, ok := names[object]; !ok {
+			t.Errorf("object %s written while listing is missing", object)
+		}
+	}
+
+	// Concurrent writers list their prefix right after each write.
+	const writers, writes = 8, 10
+	var wg sync.WaitGroup
+	errs := make(chan error, writers*writes)
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			lctx := strongListContext(ctx)
+			for i := 0; i < writes; i++ {
+				object := fmt.Sprintf("hammer/%d/%03d", w, i)
+				if err := put(object); err != nil {
+					errs <- err
+					return
+				}
+				names, err := listAllObjects(lctx, obj, bucket, "hammer/", 7, nil)
+				if err != nil {
+					errs <- err
+					return
+				}
+				for j := 0; j <= i; j++ {
+					if _, ok := names[fmt.Sprintf("hammer/%d/%03d", w, j)]; !ok {
+						errs <- fmt.Errorf("object hammer/%d/%03d missing from listing after write", w, j)
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func BenchmarkListObjectsConsistency(b *testing.B) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+	initConfigSubsystem(ctx, obj)
+
+	bucket := "bucket"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		b.Fatal(err)
+	}
+	const objects = 1000
+	for i := 0; i < objects; i++ {
+		data := []byte("data")
+		if _, err = obj.PutObject(ctx, bucket, fmt.Sprintf("prefix/%04d", i), mustGetPutObjReader(b, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	for _, bc := range []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"default", ctx},
+		{"strong", strongListContext(ctx)},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				names, err := listAllObjects(bc.ctx, obj, bucket, "prefix/", 100, nil)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(names) != objects {
+					b.Fatalf("expected %d objects, got %d", objects, len(names))
+				}
+			}
+		})
+	}
+}
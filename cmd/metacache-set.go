@@ -116,6 +116,9 @@ type listPathOptions struct {
 
 	// pool and set of where the cache is located.
 	pool, set int
+
+	// budget of the listing request, nil if not limited.
+	budget *listBudget
 }
 
 func init() {
@@ -202,6 +205,21 @@ func (o *listPathOptions) gatherResults(ctx context.Context, in <-chan metaCache
 			if o.Marker != "" && entry.name < o.Marker {
 				continue
 			}
+			// Entries up to the marker are not counted, the
+			// listing must progress past the marker.
+			if entry.name != o.Marker && !o.budget.scan(entry.name) {
+				// Return what was found so far.
+				if resCh != nil {
+					resErr = errListingCapped
+					select {
+					case <-ctx.Done():
+					case resCh <- results:
+					}
+					resCh = nil
+					returned = true
+				}
+				continue
+			}
 			if !strings.HasPrefix(entry.name, o.Prefix) {
 				continue
 			}
@@ -362,12 +380,17 @@ func (r *metacacheReader) filter(o listPathOptions) (entries metaCacheEntriesSor
 	if !o.Recursive {
 		entries.o = make(metaCacheEntries, 0, o.Limit)
 		pastPrefix := false
+		capped := false
 		err := r.readFn(func(entry metaCacheEntry) bool {
 			if o.Prefix != "" && !strings.HasPrefix(entry.name, o.Prefix) {
 				// We are past the prefix, don't continue.
 				pastPrefix = true
 				return false
 			}
+			if entry.name != o.Marker && !o.budget.scan(entry.name) {
+				capped = true
+				return false
+			}
 			if !o.IncludeDirectories && (entry.isDir() || (!o.Versioned && entry.isObjectDir() && entry.isLatestDeletemarker())) {
 				return true
 			}
@@ -383,6 +406,9 @@ func (r *metacacheReader) filter(o listPathOptions) (entries metaCacheEntriesSor
 			entries.o = append(entries.o, entry)
 			return entries.len() < o.Limit
 		})
+		if capped {
+			return entries, errListingCapped
+		}
 		if (err != nil && errors.Is(err, io.EOF)) || pastPrefix || r.nextEOF() {
 			return entries, io.EOF
 		}
@@ -390,6 +416,7 @@ func (r *metacacheReader) filter(o listPathOptions) (entries metaCacheEntriesSor
 	}
 
 	// We should not need to filter more.
+	r.budget = o.budget
 	return r.readN(o.Limit, o.InclDeleted, o.IncludeDirectories, o.Versioned, o.Prefix)
 }
 
@@ -406,6 +433,9 @@ func (er *erasureObjects) streamMetadataParts(ctx context.Context, o listPathOpt
 		if contextCanceled(ctx) {
 			return entries, ctx.Err()
 		}
+		if o.budget.expired() {
+			return entries, errListingCapped
+		}
 
 		// If many failures, check the cache state.
 		if retries > 10 {
@@ -487,6 +517,9 @@ func (er *erasureObjects) streamMetadataParts(ctx context.Context, o listPathOpt
 			if contextCanceled(ctx) {
 				return entries, ctx.Err()
 			}
+			if o.budget.expired() {
+				return entries, errListingCapped
+			}
 
 			if partN != loadedPart {
 				if retries > 10 {
@@ -550,6 +583,9 @@ func (er *erasureObjects) streamMetadataParts(ctx context.Context, o listPathOpt
 				entries.truncate(o.Limit)
 				return entries, nil
 			}
+			if errors.Is(err, errListingCapped) {
+				return entries, err
+			}
 			if err == nil {
 				// We stopped within the listing, we are done for now...
 				return entries, nil
@@ -791,7 +827,7 @@ func (er *erasureObjects) saveMetaCacheStream(ctx context.Context, mc *metaCache
 			for k, v := range meta {
 				fi.Metadata[k] = v
 			}
-			err := er.updateObjectMeta(ctx, minioMetaBucket, o.objectPath(0), fi, er.getDisks())
+			err := er.updateObjectMeta(ctx, minioMetaBucket, o.objectPath(0), fi, er.getDisks(), er.defaultWQuorum())
 			if err == nil {
 				break
 			}
@@ -248,6 +248,7 @@ type metacacheReader struct {
 	err     error // stateful error
 	closer  func()
 	creator func() error
+	budget  *listBudget // entries read by readN are scanned from it, if set
 }
 
 // newMetacacheReader creates a new cache reader.
@@ -531,6 +532,10 @@ func (r *metacacheReader) readN(n int, inclDeleted, inclDirs, inclVersions bool,
 			metaDataPoolPut(meta.metadata)
 			meta.metadata = nil
 		}
+		if !r.budget.scan(meta.name) {
+			r.current = meta
+			return metaCacheEntriesSorted{o: res}, errListingCapped
+		}
 		if !inclDirs && (meta.isDir() || (!inclVersions && meta.isObjectDir() && meta.isLatestDeletemarker())) {
 			continue
 		}
@@ -159,7 +159,10 @@ func (s *xlStorage) WalkDir(ctx context.Context, opts WalkDirOptions, wr io.Writ
 		if len(entries) == 0 {
 			return nil
 		}
-		dirObjects := make(map[string]struct{})
+		// Deep keys produce one level per path component, most
+		// levels have no directory objects or sub-directories so
+		// only allocate when they are actually seen.
+		var dirObjects map[string]struct{}
 		for i, entry := range entries {
 			if opts.Limit > 0 && objsReturned >= opts.Limit {
 				return nil
@@ -180,6 +183,9 @@ func (s *xlStorage) WalkDir(ctx context.Context, opts WalkDirOptions, wr io.Writ
 				if strings.HasSuffix(entry, globalDirSuffixWithSlash) {
 					// Add without extension so it is sorted correctly.
 					entry = strings.TrimSuffix(entry, globalDirSuffixWithSlash) + slashSeparator
+					if dirObjects == nil {
+						dirObjects = make(map[string]struct{})
+					}
 					dirObjects[entry] = struct{}{}
 					entries[i] = entry
 					continue
@@ -245,7 +251,7 @@ func (s *xlStorage) WalkDir(ctx context.Context, opts WalkDirOptions, wr io.Writ
 
 		// Process in sort order.
 		sort.Strings(entries)
-		dirStack := make([]string, 0, 5)
+		var dirStack []string
 		prefix = "" // Remove prefix after first level as we have already filtered the list.
 		if len(forward) > 0 {
 			// Conservative forwarding. Entries may be either objects or prefixes.
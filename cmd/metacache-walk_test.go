@@ -0,0 +1,82 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// benchmarkWalkDir lists a bucket holding a few shallow objects
+// next to objects nested depth path components deep.
+func benchmarkWalkDir(b *testing.B, depth int, recursive bool) {
+	disk, _, err := newXLStorageTestSetup(b)
+	if err != nil {
+		b.Fatalf("Unable to create xlStorage test setup, %s", err)
+	}
+	ctx := context.Background()
+
+	volume := "walk-bucket"
+	if err = disk.MakeVol(ctx, volume); err != nil {
+		b.Fatalf("Unable to create volume, %s", err)
+	}
+
+	deepPrefix := strings.Repeat("a/", depth-1)
+	for i := 0; i < 10; i++ {
+		for _, object := range []string{fmt.Sprintf("object-%d", i), fmt.Sprintf("%sobject-%d", deepPrefix, i)} {
+			fi := FileInfo{
+				Name: object, Volume: volume, VersionID: uuid.NewString(), ModTime: UTCNow(), DataDir: uuid.NewString(), Size: 10000,
+				Erasure: ErasureInfo{
+					Algorithm:    erasureAlgorithm,
+					DataBlocks:   4,
+					ParityBlocks: 4,
+					BlockSize:    blockSizeV2,
+					Index:        1,
+					Distribution: []int{0, 1, 2, 3, 4, 5, 6, 7},
+				},
+			}
+			if err = disk.WriteMetadata(ctx, volume, object, fi); err != nil {
+				b.Fatalf("Unable to create object, %s", err)
+			}
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err = disk.WalkDir(ctx, WalkDirOptions{Bucket: volume, Recursive: recursive}, io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkWalkDirDeepKeys(b *testing.B) {
+	for _, depth := range []int{1, 10, 100, 500} {
+		b.Run(fmt.Sprintf("recursive-depth-%d", depth), func(b *testing.B) {
+			benchmarkWalkDir(b, depth, true)
+		})
+		b.Run(fmt.Sprintf("delimited-depth-%d", depth), func(b *testing.B) {
+			benchmarkWalkDir(b, depth, false)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/minio/madmin-go/v2"
+)
+
+const (
+	// Maximum number of metrics subscribers of a node.
+	maxMetricsSubscribers = 128
+
+	// Interval of the pings sent to subscribers, a subscriber which
+	// doesn't answer within metricsWSPongWait is disconnected.
+	metricsWSPingInterval = 30 * time.Second
+	metricsWSPongWait     = 2 * metricsWSPingInterval
+	metricsWSWriteWait    = 10 * time.Second
+)
+
+var errTooManyMetricsSubscribers = errors.New("too many metrics subscribers")
+
+// metricsFeed collects the metrics once per interval for all the
+// subscribers with the same options.
+type metricsFeed struct {
+	subs   map[chan *madmin.RealtimeMetrics]struct{}
+	cancel context.CancelFunc
+}
+
+// metricsHub fans out realtime metrics snapshots to the subscribers of
+// the metrics WebSocket endpoint.
+type metricsHub struct {
+	mu          sync.Mutex
+	feeds       map[string]*metricsFeed
+	subscribers int
+
+	maxSubscribers int
+	collect        func(ctx context.Context, opts realtimeMetricsOpts) madmin.RealtimeMetrics
+}
+
+func newMetricsHub() *metricsHub {
+	return &metricsHub{
+		feeds:          make(map[string]*metricsFeed),
+		maxSubscribers: maxMetricsSubscribers,
+		collect:        collectRealtimeMetrics,
+	}
+}
+
+var globalMetricsHub = newMetricsHub()
+
+// feedKey returns the key of the feed collecting the metrics of the
+// options, the options only applied to a snapshot are not part of it.
+func (opts realtimeMetricsOpts) feedKey() string {
+	keys := func(m map[string]struct{}) string {
+		s := make([]string, 0, len(m))
+		for k := range m {
+			s = append(s, k)
+		}
+		sort.Strings(s)
+		return strings.Join(s, ",")
+	}
+	return fmt.Sprintf("%s/%d/%s/%s/%s/%s", opts.interval, opts.types, keys(opts.hosts), keys(opts.disks), opts.jobID, opts.depID)
+}
+
+// subscribe returns a channel receiving the metrics snapshots of the
+// options, and the function to call once done.
+func (h *metricsHub) subscribe(opts realtimeMetricsOpts) (<-chan *madmin.RealtimeMetrics, func(), error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subscribers >= h.maxSubscribers {
+		return nil, nil, errTooManyMetricsSubscribers
+	}
+	h.subscribers++
+
+	key := opts.feedKey()
+	f, ok := h.feeds[key]
+	if !ok {
+		var ctx context.Context
+		f = &metricsFeed{subs: make(map[chan *madmin.RealtimeMetrics]struct{})}
+		ctx, f.cancel = context.WithCancel(GlobalContext)
+		h.feeds[key] = f
+		go h.run(ctx, f, opts)
+	}
+	ch := make(chan *madmin.RealtimeMetrics, 1)
+	f.subs[ch] = struct{}{}
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(f.subs, ch)
+		h.subscribers--
+		if len(f.subs) == 0 {
+			f.cancel()
+			delete(h.feeds, key)
+		}
+	}, nil
+}
+
+// run collects the metrics of a feed once per interval, until its
+// last subscriber is gone.
+func (h *metricsHub) run(ctx context.Context, f *metricsFeed, opts realtimeMetricsOpts) {
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+
+	for {
+		m := h.collect(ctx, opts)
+		h.mu.Lock()
+		for ch := range f.subs {
+			// A slow subscriber only gets the latest snapshot.
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- &m
+		}
+		h.mu.Unlock()
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+var metricsWSUpgrader = websocket.Upgrader{
+	ReadBufferSize:    1024,
+	WriteBufferSize:   4096,
+	EnableCompression: true,
+	// Requests are authenticated by their signature, not by cookies,
+	// cross origin requests don't need to be rejected.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// serveMetricsWS sends the metrics snapshots to the WebSocket connection
+// until n snapshots were sent or the connection is closed.
+func serveMetricsWS(ctx context.Context, conn *websocket.Conn, snapshots <-chan *madmin.RealtimeMetrics, opts realtimeMetricsOpts) error {
+	// Subscribers are not expected to send anything, reading
+	// processes the pongs and the close of the connection.
+	closed := make(chan struct{})
+	conn.SetReadLimit(512)
+	conn.SetReadDeadline(time.Now().Add(metricsWSPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(metricsWSPongWait))
+	})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(metricsWSPingInterval)
+	defer ping.Stop()
+
+	for n := opts.n; n > 0; {
+		select {
+		case snapshot := <-snapshots:
+			// Snapshots are shared by all the subscribers.
+			m := *snapshot
+			opts.filter(&m)
+			m.Final = n <= 1
+			conn.SetWriteDeadline(time.Now().Add(metricsWSWriteWait))
+			if err := conn.WriteJSON(&m); err != nil {
+				return err
+			}
+			n--
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(metricsWSWriteWait)); err != nil {
+				return err
+			}
+		case <-closed:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(metricsWSWriteWait))
+}
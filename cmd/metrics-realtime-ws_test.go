@@ -0,0 +1,91 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+)
+
+func TestMetricsHub(t *testing.T) {
+	var collected int32
+	h := newMetricsHub()
+	h.maxSubscribers = 3
+	h.collect = func(ctx context.Context, opts realtimeMetricsOpts) madmin.RealtimeMetrics {
+		atomic.AddInt32(&collected, 1)
+		return madmin.RealtimeMetrics{Hosts: []string{"node"}}
+	}
+
+	opts := realtimeMetricsOpts{interval: time.Hour, types: madmin.MetricsAll}
+	ch1, unsubscribe1, err := h.subscribe(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m1 := <-ch1
+
+	// Subscribers with the same options share the feed.
+	_, unsubscribe2, err := h.subscribe(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&collected); n != 1 || len(h.feeds) != 1 {
+		t.Fatalf("expected a single collection for a single feed, got %d for %d feeds", n, len(h.feeds))
+	}
+
+	opts.types = madmin.MetricsDisk
+	ch3, unsubscribe3, err := h.subscribe(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m3 := <-ch3; m3 == m1 || len(h.feeds) != 2 {
+		t.Fatalf("expected a new feed, got %d feeds", len(h.feeds))
+	}
+
+	if _, _, err = h.subscribe(opts); !errors.Is(err, errTooManyMetricsSubscribers) {
+		t.Fatalf("expected errTooManyMetricsSubscribers, got %v", err)
+	}
+
+	unsubscribe3()
+	unsubscribe2()
+	if len(h.feeds) != 1 {
+		t.Fatalf("expected the feed without subscribers to stop, got %d feeds", len(h.feeds))
+	}
+	unsubscribe1()
+	if len(h.feeds) != 0 || h.subscribers != 0 {
+		t.Fatalf("expected no feed left, got %d feeds and %d subscribers", len(h.feeds), h.subscribers)
+	}
+}
+
+func TestRealtimeMetricsOptsFeedKey(t *testing.T) {
+	a := realtimeMetricsOpts{interval: time.Second, byHost: true}
+	a.hosts = parseMetricsFilter("node1,node2")
+	b := realtimeMetricsOpts{interval: time.Second}
+	b.hosts = parseMetricsFilter("node2,,node1")
+	if a.feedKey() != b.feedKey() {
+		t.Fatalf("expected the same feed, got %s and %s", a.feedKey(), b.feedKey())
+	}
+	b.interval = 2 * time.Second
+	if a.feedKey() == b.feedKey() {
+		t.Fatal("expected different feeds for different intervals")
+	}
+}
@@ -19,6 +19,10 @@ package cmd
 
 import (
 	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/minio/madmin-go/v2"
@@ -164,3 +168,88 @@ func collectRemoteMetrics(ctx context.Context, types madmin.MetricType, opts col
 	}
 	return m
 }
+
+// realtimeMetricsOpts are the options of a realtime metrics request.
+type realtimeMetricsOpts struct {
+	interval time.Duration
+	// n is the number of snapshots to send.
+	n      int
+	types  madmin.MetricType
+	byHost bool
+	byDisk bool
+	collectMetricsOpts
+}
+
+// parseRealtimeMetricsOpts parses the query parameters of a realtime
+// metrics request.
+func parseRealtimeMetricsOpts(r *http.Request) realtimeMetricsOpts {
+	const defaultMetricsInterval = time.Second
+
+	opts := realtimeMetricsOpts{
+		byDisk: strings.EqualFold(r.Form.Get("by-disk"), "true"),
+		byHost: strings.EqualFold(r.Form.Get("by-host"), "true"),
+		collectMetricsOpts: collectMetricsOpts{
+			jobID: r.Form.Get("by-jobID"),
+			depID: r.Form.Get("by-depID"),
+		},
+	}
+
+	interval, err := time.ParseDuration(r.Form.Get("interval"))
+	if err != nil || interval < time.Second {
+		interval = defaultMetricsInterval
+	}
+	opts.interval = interval
+
+	n, err := strconv.Atoi(r.Form.Get("n"))
+	if err != nil || n <= 0 {
+		n = math.MaxInt32
+	}
+	opts.n = n
+
+	if t, _ := strconv.ParseUint(r.Form.Get("types"), 10, 64); t != 0 {
+		opts.types = madmin.MetricType(t)
+	} else {
+		opts.types = madmin.MetricsAll
+	}
+
+	opts.disks = parseMetricsFilter(r.Form.Get("disks"))
+	opts.hosts = parseMetricsFilter(r.Form.Get("hosts"))
+	return opts
+}
+
+// parseMetricsFilter parses a comma separated list of disks or hosts.
+func parseMetricsFilter(list string) map[string]struct{} {
+	values := strings.Split(list, ",")
+	if len(values) == 0 || values[0] == "" {
+		return nil
+	}
+	m := make(map[string]struct{}, len(values))
+	for _, k := range values {
+		if k != "" {
+			m[k] = struct{}{}
+		}
+	}
+	return m
+}
+
+// filter removes the metrics by host or disk of a snapshot unless requested.
+func (opts realtimeMetricsOpts) filter(m *madmin.RealtimeMetrics) {
+	if !opts.byHost {
+		m.ByHost = nil
+	}
+	if !opts.byDisk {
+		m.ByDisk = nil
+	}
+}
+
+// collectRealtimeMetrics collects a snapshot of the metrics of all nodes.
+func collectRealtimeMetrics(ctx context.Context, opts realtimeMetricsOpts) (m madmin.RealtimeMetrics) {
+	mLocal := collectLocalMetrics(opts.types, opts.collectMetricsOpts)
+	m.Merge(&mLocal)
+	// Allow half the interval for collecting remote...
+	cctx, cancel := context.WithTimeout(ctx, opts.interval/2)
+	mRemote := collectRemoteMetrics(cctx, opts.types, opts.collectMetricsOpts)
+	cancel()
+	m.Merge(&mRemote)
+	return m
+}
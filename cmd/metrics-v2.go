@@ -20,8 +20,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,6 +32,8 @@ import (
 	"github.com/minio/kes-go"
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio/internal/bucket/lifecycle"
+	"github.com/minio/minio/internal/dsync"
+	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/minio/internal/mcontext"
 	"github.com/minio/minio/internal/rest"
@@ -48,10 +52,13 @@ var (
 func init() {
 	clusterMetricsGroups := []*MetricsGroup{
 		getBucketUsageMetrics(),
+		getBucketReplicationBandwidthMetrics(),
 		getNodeHealthMetrics(),
 		getClusterStorageMetrics(),
 		getClusterTierMetrics(),
 		getKMSMetrics(),
+		getPoolOpsMetrics(),
+		getErasureSetMetrics(),
 	}
 
 	peerMetricsGroups = []*MetricsGroup{
@@ -69,6 +76,12 @@ func init() {
 		getIAMNodeMetrics(),
 		getKMSNodeMetrics(),
 		getMinioHealingMetrics(),
+		getSubscriberNodeMetrics(),
+		getObjectBufferNodeMetrics(),
+		getQuarantineNodeMetrics(),
+		getConfigNodeMetrics(),
+		getLockRefreshNodeMetrics(),
+		getGuardrailNodeMetrics(),
 	}
 
 	allMetricsGroups := func() (allMetrics []*MetricsGroup) {
@@ -86,6 +99,7 @@ func init() {
 		getMinioVersionMetrics(),
 		getS3TTFBMetric(),
 		getNotificationMetrics(),
+		getConfigNodeMetrics(),
 	})
 	clusterCollector = newMinioClusterCollector(allMetricsGroups)
 }
@@ -107,10 +121,12 @@ const (
 )
 
 const (
+	authSubsystem             MetricSubsystem = "auth"
 	cacheSubsystem            MetricSubsystem = "cache"
 	capacityRawSubsystem      MetricSubsystem = "capacity_raw"
 	capacityUsableSubsystem   MetricSubsystem = "capacity_usable"
 	diskSubsystem             MetricSubsystem = "disk"
+	fdSubsystem               MetricSubsystem = "fd"
 	fileDescriptorSubsystem   MetricSubsystem = "file_descriptor"
 	goRoutines                MetricSubsystem = "go_routine"
 	ioSubsystem               MetricSubsystem = "io"
@@ -133,6 +149,22 @@ const (
 	notifySubsystem           MetricSubsystem = "notify"
 	lambdaSubsystem           MetricSubsystem = "lambda"
 	auditSubsystem            MetricSubsystem = "audit"
+	traceSubsystem            MetricSubsystem = "trace"
+	consoleLogSubsystem       MetricSubsystem = "consolelog"
+	overwriteSubsystem        MetricSubsystem = "overwrite"
+	objectBuffersSubsystem    MetricSubsystem = "object_buffers"
+	rateLimitSubsystem        MetricSubsystem = "ratelimit"
+	poolSubsystem             MetricSubsystem = "pool"
+	connectionsSubsystem      MetricSubsystem = "connections"
+	erasureSetSubsystem       MetricSubsystem = "erasure_set"
+	readThroughSubsystem      MetricSubsystem = "readthrough"
+	quarantineSubsystem       MetricSubsystem = "quarantine"
+	stateSubsystem            MetricSubsystem = "state"
+	listingSubsystem          MetricSubsystem = "listing"
+	locksSubsystem            MetricSubsystem = "locks"
+	configSubsystem           MetricSubsystem = "config"
+	guardrailSubsystem        MetricSubsystem = "guardrail"
+	tcpSubsystem              MetricSubsystem = "tcp"
 )
 
 // MetricName are the individual names for the metric.
@@ -142,6 +174,7 @@ const (
 	authTotal      MetricName = "auth_total"
 	canceledTotal  MetricName = "canceled_total"
 	errorsTotal    MetricName = "errors_total"
+	failuresTotal  MetricName = "failures_total"
 	headerTotal    MetricName = "header_total"
 	healTotal      MetricName = "heal_total"
 	hitsTotal      MetricName = "hits_total"
@@ -158,6 +191,10 @@ const (
 	readTotal      MetricName = "read_total"
 	timestampTotal MetricName = "timestamp_total"
 	writeTotal     MetricName = "write_total"
+	skippedTotal   MetricName = "skipped_total"
+	slowDownTotal  MetricName = "slowdown_total"
+	retransmits    MetricName = "retransmits_total"
+	segmentsSent   MetricName = "segments_sent_total"
 	total          MetricName = "total"
 	freeInodes     MetricName = "free_inodes"
 
@@ -172,12 +209,14 @@ const (
 	totalBytes      MetricName = "total_bytes"
 	usedBytes       MetricName = "used_bytes"
 	writeBytes      MetricName = "write_bytes"
+	skippedBytes    MetricName = "skipped_bytes"
 	wcharBytes      MetricName = "wchar_bytes"
 
 	latencyMicroSec MetricName = "latency_us"
 	latencyNanoSec  MetricName = "latency_ns"
 
-	usagePercent MetricName = "update_percent"
+	usagePercent       MetricName = "update_percent"
+	utilizationPercent MetricName = "utilization_percent"
 
 	commitInfo  MetricName = "commit_info"
 	usageInfo   MetricName = "usage_info"
@@ -186,14 +225,21 @@ const (
 	sizeDistribution    = "size_distribution"
 	versionDistribution = "version_distribution"
 	ttfbDistribution    = "ttfb_seconds_distribution"
+	latencySeconds      = "latency_seconds"
+	ageDistribution     = "object_age_days"
 
 	lastActivityTime = "last_activity_nano_seconds"
+	lastScanDuration = "last_scan_duration_seconds"
 	startTime        = "starttime_seconds"
 	upTime           = "uptime_seconds"
 	memory           = "resident_memory_bytes"
 	cpu              = "cpu_total_seconds"
+	restartsTotal    = "restarts_total"
 
 	expiryPendingTasks     MetricName = "expiry_pending_tasks"
+	expiryBucketPending    MetricName = "expiry_bucket_pending_tasks"
+	expiryDeletesPerSec    MetricName = "expiry_deletes_per_second"
+	expiryWorkers          MetricName = "expiry_workers"
 	transitionPendingTasks MetricName = "transition_pending_tasks"
 	transitionActiveTasks  MetricName = "transition_active_tasks"
 
@@ -206,6 +252,36 @@ const (
 	kmsRequestsError   = "request_error"
 	kmsRequestsFail    = "request_failure"
 	kmsUptime          = "uptime"
+
+	decommissionObjectsTotal MetricName = "decommission_objects_total"
+	decommissionObjectsDone  MetricName = "decommission_objects_done"
+	decommissionPercent      MetricName = "decommission_percent"
+	rebalanceObjectsTotal    MetricName = "rebalance_objects_total"
+	rebalanceObjectsDone     MetricName = "rebalance_objects_done"
+	rebalancePercent         MetricName = "rebalance_percent"
+
+	objectsTotal  MetricName = "objects_total"
+	versionsTotal MetricName = "versions_total"
+
+	proxiedTotal   MetricName = "proxied_total"
+	populatedTotal MetricName = "populated_total"
+	populatedBytes MetricName = "populated_bytes"
+
+	inlinedTotal    MetricName = "object_inlined_total"
+	notInlinedTotal MetricName = "object_not_inlined_total"
+
+	legalHoldTotal MetricName = "legal_hold_total"
+	encryptedTotal MetricName = "encrypted_total"
+
+	noncurrentVersionsRatio MetricName = "noncurrent_versions_ratio"
+
+	deletesTotal       MetricName = "deletes_total"
+	deleteMarkersTotal MetricName = "delete_markers_total"
+
+	byStorageClassBytes   MetricName = "by_storage_class_bytes"
+	byStorageClassObjects MetricName = "by_storage_class_objects"
+
+	subscribers MetricName = "subscribers"
 )
 
 const (
@@ -346,6 +422,36 @@ func getNodeDriveAPILatencyMD() MetricDescription {
 	}
 }
 
+func getNodeDriveTimeoutsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      "timeouts_total",
+		Help:      "Total number of drive operations which did not complete within their timeout",
+		Type:      counterMetric,
+	}
+}
+
+func getNodeDriveHungMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      "hung",
+		Help:      "1 if the drive is offline as its operations time out, 0 otherwise",
+		Type:      gaugeMetric,
+	}
+}
+
+func getNodeDriveStateTransitionsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      "state_transitions_total",
+		Help:      "Total number of drive health state transitions by state transitioned to",
+		Type:      counterMetric,
+	}
+}
+
 func getNodeDriveUsedBytesMD() MetricDescription {
 	return MetricDescription{
 		Namespace: nodeMetricNamespace,
@@ -476,6 +582,94 @@ func getBucketTrafficReceivedBytes() MetricDescription {
 	}
 }
 
+func getBucketLastScanDurationMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Name:      lastScanDuration,
+		Help:      "Time taken by the last completed scan of this bucket on an erasure set, in seconds",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketNoncurrentVersionsRatioMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Name:      noncurrentVersionsRatio,
+		Help:      "Number of noncurrent object versions per current object version",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketOverwriteSkippedTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: overwriteSubsystem,
+		Name:      skippedTotal,
+		Help:      "Total number of PUTs skipped as identical overwrites for this bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketStateRejectionsTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: stateSubsystem,
+		Name:      "rejections_total",
+		Help:      "Total number of requests rejected by the read-only or write-once state of this bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketListingCappedTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: listingSubsystem,
+		Name:      "capped_total",
+		Help:      "Total number of listings of this bucket stopped at their scan limit",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketOverwriteSkippedBytesMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: overwriteSubsystem,
+		Name:      skippedBytes,
+		Help:      "Total number of bytes not written due to skipped identical overwrites for this bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketReadThroughProxiedTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: readThroughSubsystem,
+		Name:      proxiedTotal,
+		Help:      "Total number of GET/HEAD requests served by the read-through remote for this bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketReadThroughPopulatedTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: readThroughSubsystem,
+		Name:      populatedTotal,
+		Help:      "Total number of objects populated locally from the read-through remote for this bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketReadThroughPopulatedBytesMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: readThroughSubsystem,
+		Name:      populatedBytes,
+		Help:      "Total number of bytes populated locally from the read-through remote for this bucket",
+		Type:      counterMetric,
+	}
+}
+
 func getBucketTrafficSentBytes() MetricDescription {
 	return MetricDescription{
 		Namespace: bucketMetricNamespace,
@@ -506,6 +700,86 @@ func getBucketUsageObjectsTotalMD() MetricDescription {
 	}
 }
 
+func getBucketUsageObjectsInlinedTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: usageSubsystem,
+		Name:      inlinedTotal,
+		Help:      "Total number of object versions with data inlined in metadata",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketUsageObjectsNotInlinedTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: usageSubsystem,
+		Name:      notInlinedTotal,
+		Help:      "Total number of object versions with data stored apart from metadata",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketUsageByStorageClassBytesMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: usageSubsystem,
+		Name:      byStorageClassBytes,
+		Help:      "Total bucket size in bytes by storage class",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketUsageByStorageClassObjectsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: usageSubsystem,
+		Name:      byStorageClassObjects,
+		Help:      "Total number of objects by storage class",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketUsageDeletesTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: usageSubsystem,
+		Name:      deletesTotal,
+		Help:      "Total number of object versions deleted from this bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketUsageDeleteMarkersTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: usageSubsystem,
+		Name:      deleteMarkersTotal,
+		Help:      "Total number of delete markers created in this bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketObjectsLegalHoldTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: objectsSubsystem,
+		Name:      legalHoldTotal,
+		Help:      "Total number of object versions under legal hold",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketObjectsEncryptedTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: objectsSubsystem,
+		Name:      encryptedTotal,
+		Help:      "Total number of objects encrypted by SSE type",
+		Type:      gaugeMetric,
+	}
+}
+
 func getBucketRepLatencyMD() MetricDescription {
 	return MetricDescription{
 		Namespace: bucketMetricNamespace,
@@ -556,6 +830,26 @@ func getBucketRepFailedOperationsMD() MetricDescription {
 	}
 }
 
+func getBucketRepBandwidthLimitMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: replicationSubsystem,
+		Name:      "bandwidth_limit_bytes",
+		Help:      "Replication bandwidth limit in bytes per second, 0 if not limited",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketRepBandwidthConsumedMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: replicationSubsystem,
+		Name:      "bandwidth_consumed_bytes",
+		Help:      "Replication bandwidth currently consumed in bytes per second",
+		Type:      gaugeMetric,
+	}
+}
+
 func getBucketObjectDistributionMD() MetricDescription {
 	return MetricDescription{
 		Namespace: bucketMetricNamespace,
@@ -566,6 +860,15 @@ func getBucketObjectDistributionMD() MetricDescription {
 	}
 }
 
+func getBucketObjectAgeMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Name:      ageDistribution,
+		Help:      "Distribution of object ages in days since creation of the latest version, includes label for the bucket name",
+		Type:      histogramMetric,
+	}
+}
+
 func getBucketObjectVersionsMD() MetricDescription {
 	return MetricDescription{
 		Namespace: bucketMetricNamespace,
@@ -596,6 +899,46 @@ func getInternodeTCPDialTimeout() MetricDescription {
 	}
 }
 
+func getInternodeConnActiveMD() MetricDescription {
+	return MetricDescription{
+		Namespace: interNodeMetricNamespace,
+		Subsystem: connectionsSubsystem,
+		Name:      "active",
+		Help:      "Number of internode connections serving a request to the peer",
+		Type:      gaugeMetric,
+	}
+}
+
+func getInternodeConnIdleMD() MetricDescription {
+	return MetricDescription{
+		Namespace: interNodeMetricNamespace,
+		Subsystem: connectionsSubsystem,
+		Name:      "idle",
+		Help:      "Number of idle internode connections to the peer",
+		Type:      gaugeMetric,
+	}
+}
+
+func getInternodeConnDialsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: interNodeMetricNamespace,
+		Subsystem: connectionsSubsystem,
+		Name:      "dials_total",
+		Help:      "Total number of internode connections dialed to the peer",
+		Type:      counterMetric,
+	}
+}
+
+func getInternodeConnDialErrorsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: interNodeMetricNamespace,
+		Subsystem: connectionsSubsystem,
+		Name:      "dial_errors_total",
+		Help:      "Total number of failed internode dials to the peer",
+		Type:      counterMetric,
+	}
+}
+
 func getInternodeTCPAvgDuration() MetricDescription {
 	return MetricDescription{
 		Namespace: interNodeMetricNamespace,
@@ -606,6 +949,36 @@ func getInternodeTCPAvgDuration() MetricDescription {
 	}
 }
 
+func getNodeTCPRetransmitsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: tcpSubsystem,
+		Name:      retransmits,
+		Help:      "Total number of TCP segments retransmitted by the host of this node",
+		Type:      counterMetric,
+	}
+}
+
+func getNodeTCPSegmentsSentMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: tcpSubsystem,
+		Name:      segmentsSent,
+		Help:      "Total number of TCP segments sent by the host of this node",
+		Type:      counterMetric,
+	}
+}
+
+func getNodeTCPErrorsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: tcpSubsystem,
+		Name:      errorsTotal,
+		Help:      "Total number of TCP segments received in error by the host of this node",
+		Type:      counterMetric,
+	}
+}
+
 func getInterNodeSentBytesMD() MetricDescription {
 	return MetricDescription{
 		Namespace: interNodeMetricNamespace,
@@ -666,6 +1039,36 @@ func getS3RequestsInQueueMD() MetricDescription {
 	}
 }
 
+func getS3RejectedLimitRequestsTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: s3MetricNamespace,
+		Subsystem: requestsRejectedSubsystem,
+		Name:      limitTotal,
+		Help:      "Total number S3 requests rejected for waiting longer than the requests deadline",
+		Type:      counterMetric,
+	}
+}
+
+func getS3RateLimitMD() MetricDescription {
+	return MetricDescription{
+		Namespace: s3MetricNamespace,
+		Subsystem: rateLimitSubsystem,
+		Name:      "limit",
+		Help:      "Maximum number of concurrent S3 requests on this node",
+		Type:      gaugeMetric,
+	}
+}
+
+func getS3RateLimitTokensAvailableMD() MetricDescription {
+	return MetricDescription{
+		Namespace: s3MetricNamespace,
+		Subsystem: rateLimitSubsystem,
+		Name:      "tokens_available",
+		Help:      "Number of S3 requests that can be admitted on this node without waiting",
+		Type:      gaugeMetric,
+	}
+}
+
 func getIncomingS3RequestsMD() MetricDescription {
 	return MetricDescription{
 		Namespace: s3MetricNamespace,
@@ -726,6 +1129,25 @@ func getS3RequestsCanceledMD() MetricDescription {
 	}
 }
 
+func getS3SlowDownTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: s3MetricNamespace,
+		Name:      slowDownTotal,
+		Help:      "Total number of S3 requests answered with SlowDown or another 503 error",
+		Type:      counterMetric,
+	}
+}
+
+func getS3AuthFailuresTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: s3MetricNamespace,
+		Subsystem: authSubsystem,
+		Name:      failuresTotal,
+		Help:      "Total number of failed S3 authentications by reason",
+		Type:      counterMetric,
+	}
+}
+
 func getS3RejectedAuthRequestsTotalMD() MetricDescription {
 	return MetricDescription{
 		Namespace: s3MetricNamespace,
@@ -926,6 +1348,16 @@ func getS3TTFBDistributionMD() MetricDescription {
 	}
 }
 
+func getS3RequestsLatencyMD() MetricDescription {
+	return MetricDescription{
+		Namespace: s3MetricNamespace,
+		Subsystem: requestsSubsystem,
+		Name:      latencySeconds,
+		Help:      "Distribution of the total time taken by S3 requests per API",
+		Type:      gaugeMetric,
+	}
+}
+
 func getMinioFDOpenMD() MetricDescription {
 	return MetricDescription{
 		Namespace: nodeMetricNamespace,
@@ -946,6 +1378,16 @@ func getMinioFDLimitMD() MetricDescription {
 	}
 }
 
+func getMinioFDUtilizationMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: fdSubsystem,
+		Name:      utilizationPercent,
+		Help:      "Percentage of the open file descriptors limit used by the MinIO Server process",
+		Type:      gaugeMetric,
+	}
+}
+
 func getMinioProcessIOWriteBytesMD() MetricDescription {
 	return MetricDescription{
 		Namespace: nodeMetricNamespace,
@@ -1036,6 +1478,15 @@ func getMinIOProcessUptimeMD() MetricDescription {
 	}
 }
 
+func getMinIONodeRestartsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Name:      restartsTotal,
+		Help:      "Total number of restarts of MinIO per node",
+		Type:      counterMetric,
+	}
+}
+
 func getMinIOProcessResidentMemory() MetricDescription {
 	return MetricDescription{
 		Namespace: nodeMetricNamespace,
@@ -1092,6 +1543,13 @@ func getMinioProcMetrics() *MetricsGroup {
 					Description: getMinioFDLimitMD(),
 					Value:       float64(l.OpenFiles),
 				})
+			if openFDs > 0 {
+				metrics = append(metrics,
+					Metric{
+						Description: getMinioFDUtilizationMD(),
+						Value:       100 * float64(openFDs) / float64(l.OpenFiles),
+					})
+			}
 		}
 
 		if io.SyscR > 0 {
@@ -1158,6 +1616,14 @@ func getMinioProcMetrics() *MetricsGroup {
 				})
 		}
 
+		if restarts := atomic.LoadInt64(&globalNodeRestarts); restarts >= 0 {
+			metrics = append(metrics,
+				Metric{
+					Description: getMinIONodeRestartsMD(),
+					Value:       float64(restarts),
+				})
+		}
+
 		if stat.ResidentMemory() > 0 {
 			metrics = append(metrics,
 				Metric{
@@ -1196,45 +1662,51 @@ func getS3TTFBMetric() *MetricsGroup {
 	mg := &MetricsGroup{
 		cacheInterval: 10 * time.Second,
 	}
-	mg.RegisterRead(func(ctx context.Context) (metrics []Metric) {
-		// Read prometheus metric on this channel
-		ch := make(chan prometheus.Metric)
-		var wg sync.WaitGroup
-		wg.Add(1)
+	mg.RegisterRead(func(ctx context.Context) []Metric {
+		return getHistogramVecMetrics(httpRequestsDuration, getS3TTFBDistributionMD())
+	})
+	return mg
+}
 
-		// Read prometheus histogram data and convert it to internal metric data
-		go func() {
-			defer wg.Done()
-			for promMetric := range ch {
-				dtoMetric := &dto.Metric{}
-				err := promMetric.Write(dtoMetric)
-				if err != nil {
-					logger.LogIf(GlobalContext, err)
-					return
+// getHistogramVecMetrics reads the buckets of a prometheus histogram as
+// internal metric data, labelled by their upper bound as "le".
+func getHistogramVecMetrics(hv *prometheus.HistogramVec, md MetricDescription) (metrics []Metric) {
+	// Read prometheus metric on this channel
+	ch := make(chan prometheus.Metric)
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	// Read prometheus histogram data and convert it to internal metric data
+	go func() {
+		defer wg.Done()
+		for promMetric := range ch {
+			dtoMetric := &dto.Metric{}
+			err := promMetric.Write(dtoMetric)
+			if err != nil {
+				logger.LogIf(GlobalContext, err)
+				return
+			}
+			h := dtoMetric.GetHistogram()
+			for _, b := range h.Bucket {
+				labels := make(map[string]string)
+				for _, lp := range dtoMetric.GetLabel() {
+					labels[*lp.Name] = *lp.Value
 				}
-				h := dtoMetric.GetHistogram()
-				for _, b := range h.Bucket {
-					labels := make(map[string]string)
-					for _, lp := range dtoMetric.GetLabel() {
-						labels[*lp.Name] = *lp.Value
-					}
-					labels["le"] = fmt.Sprintf("%.3f", *b.UpperBound)
-					metric := Metric{
-						Description:    getS3TTFBDistributionMD(),
-						VariableLabels: labels,
-						Value:          float64(b.GetCumulativeCount()),
-					}
-					metrics = append(metrics, metric)
+				labels["le"] = fmt.Sprintf("%.3f", *b.UpperBound)
+				metric := Metric{
+					Description:    md,
+					VariableLabels: labels,
+					Value:          float64(b.GetCumulativeCount()),
 				}
+				metrics = append(metrics, metric)
 			}
-		}()
+		}
+	}()
 
-		httpRequestsDuration.Collect(ch)
-		close(ch)
-		wg.Wait()
-		return
-	})
-	return mg
+	hv.Collect(ch)
+	close(ch)
+	wg.Wait()
+	return metrics
 }
 
 func getTransitionPendingTasksMD() MetricDescription {
@@ -1267,6 +1739,36 @@ func getExpiryPendingTasksMD() MetricDescription {
 	}
 }
 
+func getExpiryBucketPendingTasksMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: ilmSubsystem,
+		Name:      expiryBucketPending,
+		Help:      "Number of object versions pending ILM expiry per bucket",
+		Type:      gaugeMetric,
+	}
+}
+
+func getExpiryDeletesPerSecMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: ilmSubsystem,
+		Name:      expiryDeletesPerSec,
+		Help:      "Average number of object versions expired per second in the last minute",
+		Type:      gaugeMetric,
+	}
+}
+
+func getExpiryWorkersMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: ilmSubsystem,
+		Name:      expiryWorkers,
+		Help:      "Number of ILM expiry workers",
+		Type:      gaugeMetric,
+	}
+}
+
 func getILMNodeMetrics() *MetricsGroup {
 	mg := &MetricsGroup{
 		cacheInterval: 10 * time.Second,
@@ -1281,18 +1783,35 @@ func getILMNodeMetrics() *MetricsGroup {
 		trActiveTasks := Metric{
 			Description: getTransitionActiveTasksMD(),
 		}
+		metrics := make([]Metric, 0, 5)
 		if globalExpiryState != nil {
-			expPendingTasks.Value = float64(globalExpiryState.PendingTasks())
+			st := globalExpiryState.status()
+			expPendingTasks.Value = float64(st.PendingTasks)
+			metrics = append(metrics, Metric{
+				Description: getExpiryDeletesPerSecMD(),
+				Value:       st.DeletesPerSec,
+			})
+			metrics = append(metrics, Metric{
+				Description: getExpiryWorkersMD(),
+				Value:       float64(st.Workers),
+			})
+			for bucket, n := range st.Backlog {
+				metrics = append(metrics, Metric{
+					Description:    getExpiryBucketPendingTasksMD(),
+					Value:          float64(n),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
 		}
 		if globalTransitionState != nil {
 			trPendingTasks.Value = float64(globalTransitionState.PendingTasks())
 			trActiveTasks.Value = float64(globalTransitionState.ActiveTasks())
 		}
-		return []Metric{
+		return append(metrics,
 			expPendingTasks,
 			trPendingTasks,
 			trActiveTasks,
-		}
+		)
 	})
 	return mg
 }
@@ -1313,6 +1832,16 @@ func getScannerNodeMetrics() *MetricsGroup {
 				},
 				Value: float64(globalScannerMetrics.lifetime(scannerMetricScanObject)),
 			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: scannerSubsystem,
+					Name:      "objects_skipped",
+					Help:      "Total number of objects skipped since they were scanned in a previous cycle, since server start",
+					Type:      counterMetric,
+				},
+				Value: float64(globalScannerMetrics.lifetimeSkipped()),
+			},
 			{
 				Description: MetricDescription{
 					Namespace: nodeMetricNamespace,
@@ -1351,37 +1880,401 @@ func getScannerNodeMetrics() *MetricsGroup {
 					Help:      "Total number of bucket scans finished since server start",
 					Type:      counterMetric,
 				},
-				Value: float64(globalScannerMetrics.lifetime(scannerMetricScanBucketDrive)),
+				Value: float64(globalScannerMetrics.lifetime(scannerMetricScanBucketDrive)),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: ilmSubsystem,
+					Name:      "versions_scanned",
+					Help:      "Total number of object versions checked for ilm actions since server start",
+					Type:      counterMetric,
+				},
+				Value: float64(globalScannerMetrics.lifetime(scannerMetricILM)),
+			},
+		}
+		drives := globalScannerDrives.status()
+		metrics = append(metrics, Metric{
+			Description: MetricDescription{
+				Namespace: nodeMetricNamespace,
+				Subsystem: scannerSubsystem,
+				Name:      "drives_scanning",
+				Help:      "Number of drives currently scanned in parallel on this node",
+				Type:      gaugeMetric,
+			},
+			Value: float64(drives.EffectiveConcurrency),
+		}, Metric{
+			Description: MetricDescription{
+				Namespace: nodeMetricNamespace,
+				Subsystem: scannerSubsystem,
+				Name:      "max_drives_in_parallel",
+				Help:      "Maximum number of drives scanned in parallel on this node, 0 for no limit",
+				Type:      gaugeMetric,
+			},
+			Value: float64(drives.MaxDrivesInParallel),
+		})
+		for _, d := range drives.Drives {
+			var backedOff float64
+			if d.State == scannerDriveBackedOff {
+				backedOff = 1
+			}
+			metrics = append(metrics, Metric{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: scannerSubsystem,
+					Name:      "drive_backed_off",
+					Help:      "1 if scanning of the drive is backed off due to high drive latency, 0 otherwise",
+					Type:      gaugeMetric,
+				},
+				Value:          backedOff,
+				VariableLabels: map[string]string{"drive": d.Drive},
+			})
+		}
+
+		current, last := globalScannerMetrics.getCycleObjects()
+		for _, c := range []struct {
+			label  string
+			counts scannerCycleObjects
+		}{{"current", current}, {"last", last}} {
+			labels := map[string]string{"cycle": c.label}
+			metrics = append(metrics, Metric{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: scannerSubsystem,
+					Name:      "cycle_objects_scanned",
+					Help:      "Number of objects scanned by this node in the current or last scanner cycle",
+					Type:      gaugeMetric,
+				},
+				Value:          float64(c.counts.scanned),
+				VariableLabels: labels,
+			}, Metric{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: scannerSubsystem,
+					Name:      "cycle_objects_skipped",
+					Help:      "Number of objects skipped by this node in the current or last scanner cycle",
+					Type:      gaugeMetric,
+				},
+				Value:          float64(c.counts.skipped),
+				VariableLabels: labels,
+			})
+		}
+		for i := range globalScannerMetrics.actions {
+			action := lifecycle.Action(i)
+			v := globalScannerMetrics.lifetimeActions(action)
+			if v == 0 {
+				continue
+			}
+			metrics = append(metrics, Metric{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: ilmSubsystem,
+					Name:      MetricName("action_count_" + toSnake(action.String())),
+					Help:      "Total action outcome of lifecycle checks since server start",
+					Type:      counterMetric,
+				},
+				Value: float64(v),
+			})
+		}
+		for bucket, d := range globalScannerMetrics.getBucketScanDurations() {
+			metrics = append(metrics, Metric{
+				Description:    getBucketLastScanDurationMD(),
+				Value:          d.Seconds(),
+				VariableLabels: map[string]string{"bucket": bucket},
+			})
+		}
+		return metrics
+	})
+	return mg
+}
+
+func getSubscriberNodeMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(_ context.Context) []Metric {
+		traceSubs := Metric{
+			Description: MetricDescription{
+				Namespace: nodeMetricNamespace,
+				Subsystem: traceSubsystem,
+				Name:      subscribers,
+				Help:      "Number of active trace subscribers on this node",
+				Type:      gaugeMetric,
+			},
+			Value: float64(globalTrace.Subscribers()),
+		}
+		consoleSubs := Metric{
+			Description: MetricDescription{
+				Namespace: nodeMetricNamespace,
+				Subsystem: consoleLogSubsystem,
+				Name:      subscribers,
+				Help:      "Number of active console log subscribers on this node",
+				Type:      gaugeMetric,
+			},
+		}
+		if globalConsoleSys != nil {
+			consoleSubs.Value = float64(globalConsoleSys.NumSubscribers())
+		}
+		return []Metric{
+			traceSubs,
+			consoleSubs,
+		}
+	})
+	return mg
+}
+
+func getObjectBufferNodeMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(_ context.Context) []Metric {
+		return []Metric{
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: objectBuffersSubsystem,
+					Name:      "inuse_bytes",
+					Help:      "Memory held by buffers of in-flight object requests on this node",
+					Type:      gaugeMetric,
+				},
+				Value: float64(globalObjectBufferStats.bytesInUse()),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: objectBuffersSubsystem,
+					Name:      "rejected_requests_total",
+					Help:      "Total number of requests rejected with SlowDown for exceeding the buffer memory watermark since server start",
+					Type:      counterMetric,
+				},
+				Value: float64(globalObjectBufferStats.rejectedRequests()),
+			},
+		}
+	})
+	return mg
+}
+
+func getQuarantineNodeMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(_ context.Context) []Metric {
+		metrics := []Metric{
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: quarantineSubsystem,
+					Name:      "objects",
+					Help:      "Number of quarantined object versions",
+					Type:      gaugeMetric,
+				},
+				Value: float64(globalQuarantineSys.Count()),
+			},
+		}
+		for op, n := range globalQuarantineSys.Skipped() {
+			metrics = append(metrics, Metric{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: quarantineSubsystem,
+					Name:      "skipped_total",
+					Help:      "Total number of heal, dangling object removal, MRF and lifecycle operations skipped on quarantined object versions since server start",
+					Type:      counterMetric,
+				},
+				VariableLabels: map[string]string{"operation": op},
+				Value:          float64(n),
+			})
+		}
+		return metrics
+	})
+	return mg
+}
+
+// getLockRefreshNodeMetrics reports the health of the refresh of the
+// distributed locks held by this node.
+func getLockRefreshNodeMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(_ context.Context) []Metric {
+		stats := dsync.GetRefreshStats()
+		metrics := []Metric{
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: locksSubsystem,
+					Name:      "refresh_active",
+					Help:      "Number of distributed locks held by this node being refreshed",
+					Type:      gaugeMetric,
+				},
+				Value: float64(stats.Active),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: locksSubsystem,
+					Name:      "refresh_success_total",
+					Help:      "Total number of lock refreshes acknowledged by a quorum of lockers since server start",
+					Type:      counterMetric,
+				},
+				Value: float64(stats.Successes),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: locksSubsystem,
+					Name:      "refresh_failure_total",
+					Help:      "Total number of lock refreshes not acknowledged by a quorum of lockers since server start",
+					Type:      counterMetric,
+				},
+				Value: float64(stats.Failures),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: locksSubsystem,
+					Name:      "lost_total",
+					Help:      "Total number of locks lost after failed refreshes since server start, the operations holding them are canceled",
+					Type:      counterMetric,
+				},
+				Value: float64(stats.Lost),
+			},
+		}
+		for endpoint, latency := range stats.Latency {
+			metrics = append(metrics, Metric{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: locksSubsystem,
+					Name:      "refresh_latency_seconds",
+					Help:      "Round trip of the last lock refresh acknowledged by the locker endpoint, in seconds",
+					Type:      gaugeMetric,
+				},
+				VariableLabels: map[string]string{"endpoint": endpoint},
+				Value:          latency.Seconds(),
+			})
+		}
+		return metrics
+	})
+	return mg
+}
+
+func getGuardrailNodeMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(_ context.Context) []Metric {
+		return []Metric{
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: guardrailSubsystem,
+					Name:      "level",
+					Help:      "Load shed by this node, 0 for none, 1 for low-priority work, 2 for low-priority work and a fraction of the new S3 requests",
+					Type:      gaugeMetric,
+				},
+				Value: float64(globalGuardrail.getLevel()),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: guardrailSubsystem,
+					Name:      "shed_requests_total",
+					Help:      "Total number of S3 requests rejected with SlowDown by the resource guardrail since server start",
+					Type:      counterMetric,
+				},
+				Value: float64(atomic.LoadUint64(&globalGuardrail.shedRequests)),
+			},
+		}
+	})
+	return mg
+}
+
+// getConfigNodeMetrics reports the values of the dynamic tunables in
+// effect on this node, to confirm a config change applied on every node.
+func getConfigNodeMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(_ context.Context) []Metric {
+		speed := scannerSpeed.Load()
+		if speed == "" {
+			speed = "custom"
+		}
+		delay, maxWait := scannerSleeper.Get()
+		healIO, healSleep, bitrot := globalHealConfig.Clone()
+		return []Metric{
+			{
+				Description: MetricDescription{
+					Namespace: minioMetricNamespace,
+					Subsystem: configSubsystem,
+					Name:      "scanner_speed",
+					Help:      "Scanner speed in effect",
+					Type:      gaugeMetric,
+				},
+				VariableLabels: map[string]string{"speed": speed},
+				Value:          1,
+			},
+			{
+				Description: MetricDescription{
+					Namespace: minioMetricNamespace,
+					Subsystem: configSubsystem,
+					Name:      "scanner_delay",
+					Help:      "Scanner sleep multiplier in effect",
+					Type:      gaugeMetric,
+				},
+				Value: delay,
+			},
+			{
+				Description: MetricDescription{
+					Namespace: minioMetricNamespace,
+					Subsystem: configSubsystem,
+					Name:      "scanner_max_wait_seconds",
+					Help:      "Scanner maximum wait between operations in effect",
+					Type:      gaugeMetric,
+				},
+				Value: maxWait.Seconds(),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: minioMetricNamespace,
+					Subsystem: configSubsystem,
+					Name:      "scanner_cycle_seconds",
+					Help:      "Scanner interval between cycles in effect",
+					Type:      gaugeMetric,
+				},
+				Value: scannerCycle.Load().Seconds(),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: minioMetricNamespace,
+					Subsystem: configSubsystem,
+					Name:      "heal_max_io",
+					Help:      "Heal maximum concurrent requests in effect",
+					Type:      gaugeMetric,
+				},
+				Value: float64(healIO),
 			},
 			{
 				Description: MetricDescription{
-					Namespace: nodeMetricNamespace,
-					Subsystem: ilmSubsystem,
-					Name:      "versions_scanned",
-					Help:      "Total number of object versions checked for ilm actions since server start",
-					Type:      counterMetric,
+					Namespace: minioMetricNamespace,
+					Subsystem: configSubsystem,
+					Name:      "heal_max_sleep_seconds",
+					Help:      "Heal maximum sleep between objects in effect",
+					Type:      gaugeMetric,
 				},
-				Value: float64(globalScannerMetrics.lifetime(scannerMetricILM)),
+				Value: healSleep.Seconds(),
 			},
-		}
-		for i := range globalScannerMetrics.actions {
-			action := lifecycle.Action(i)
-			v := globalScannerMetrics.lifetimeActions(action)
-			if v == 0 {
-				continue
-			}
-			metrics = append(metrics, Metric{
+			{
 				Description: MetricDescription{
-					Namespace: nodeMetricNamespace,
-					Subsystem: ilmSubsystem,
-					Name:      MetricName("action_count_" + toSnake(action.String())),
-					Help:      "Total action outcome of lifecycle checks since server start",
-					Type:      counterMetric,
+					Namespace: minioMetricNamespace,
+					Subsystem: configSubsystem,
+					Name:      "heal_bitrotscan",
+					Help:      "Heal bitrot scan setting in effect",
+					Type:      gaugeMetric,
 				},
-				Value: float64(v),
-			})
+				VariableLabels: map[string]string{"bitrotscan": bitrot},
+				Value:          1,
+			},
 		}
-		return metrics
 	})
 	return mg
 }
@@ -1669,7 +2562,7 @@ func getNotificationMetrics() *MetricsGroup {
 	}
 	mg.RegisterRead(func(ctx context.Context) []Metric {
 		nstats := globalNotifyTargetList.Stats()
-		metrics := make([]Metric, 0, 1+len(nstats.TargetStats))
+		metrics := make([]Metric, 0, 4+2*len(nstats.TargetStats))
 		metrics = append(metrics, Metric{
 			Description: MetricDescription{
 				Namespace: minioNamespace,
@@ -1680,6 +2573,36 @@ func getNotificationMetrics() *MetricsGroup {
 			},
 			Value: float64(nstats.CurrentSendCalls),
 		})
+		metrics = append(metrics, Metric{
+			Description: MetricDescription{
+				Namespace: minioNamespace,
+				Subsystem: notifySubsystem,
+				Name:      "journal_events_total",
+				Help:      "Total number of events written to bucket event journals since server start",
+				Type:      counterMetric,
+			},
+			Value: float64(atomic.LoadUint64(&globalEventJournal.journaled)),
+		})
+		metrics = append(metrics, Metric{
+			Description: MetricDescription{
+				Namespace: minioNamespace,
+				Subsystem: notifySubsystem,
+				Name:      "journal_dropped_events_total",
+				Help:      "Total number of events not journaled since server start, as the journal writer was falling behind",
+				Type:      counterMetric,
+			},
+			Value: float64(atomic.LoadUint64(&globalEventJournal.dropped)),
+		})
+		metrics = append(metrics, Metric{
+			Description: MetricDescription{
+				Namespace: minioNamespace,
+				Subsystem: notifySubsystem,
+				Name:      "journal_failed_events_total",
+				Help:      "Total number of events lost on failed journal writes since server start",
+				Type:      counterMetric,
+			},
+			Value: float64(atomic.LoadUint64(&globalEventJournal.failed)),
+		})
 		for _, st := range nstats.TargetStats {
 			metrics = append(metrics, Metric{
 				Description: MetricDescription{
@@ -1692,6 +2615,17 @@ func getNotificationMetrics() *MetricsGroup {
 				VariableLabels: map[string]string{"target_id": st.ID.ID, "target_name": st.ID.Name},
 				Value:          float64(st.CurrentQueue),
 			})
+			metrics = append(metrics, Metric{
+				Description: MetricDescription{
+					Namespace: minioNamespace,
+					Subsystem: notifySubsystem,
+					Name:      "target_success_rate",
+					Help:      "Ratio of the attempts to deliver an event to target which succeeded in the last 5 minutes, 1 if none was made",
+					Type:      gaugeMetric,
+				},
+				VariableLabels: map[string]string{"target_id": st.ID.ID, "target_name": st.ID.Name},
+				Value:          st.SuccessRate,
+			})
 		}
 
 		lstats := globalLambdaTargetList.Stats()
@@ -1788,6 +2722,13 @@ func getHTTPMetrics() *MetricsGroup {
 			Description: getS3RejectedAuthRequestsTotalMD(),
 			Value:       float64(httpStats.TotalS3RejectedAuth),
 		})
+		for reason, value := range httpStats.TotalS3AuthFailures {
+			metrics = append(metrics, Metric{
+				Description:    getS3AuthFailuresTotalMD(),
+				VariableLabels: map[string]string{"reason": reason},
+				Value:          float64(value),
+			})
+		}
 		metrics = append(metrics, Metric{
 			Description: getS3RejectedTimestampRequestsTotalMD(),
 			Value:       float64(httpStats.TotalS3RejectedTime),
@@ -1808,6 +2749,20 @@ func getHTTPMetrics() *MetricsGroup {
 			Description: getIncomingS3RequestsMD(),
 			Value:       float64(httpStats.S3RequestsIncoming),
 		})
+		metrics = append(metrics, Metric{
+			Description: getS3RejectedLimitRequestsTotalMD(),
+			Value:       float64(httpStats.TotalS3RejectedLimit),
+		})
+		if limit, available, ok := globalAPIConfig.getRequestsPoolTokens(); ok {
+			metrics = append(metrics, Metric{
+				Description: getS3RateLimitMD(),
+				Value:       float64(limit),
+			})
+			metrics = append(metrics, Metric{
+				Description: getS3RateLimitTokensAvailableMD(),
+				Value:       float64(available),
+			})
+		}
 
 		for api, value := range httpStats.CurrentS3Requests.APIStats {
 			metrics = append(metrics, Metric{
@@ -1851,6 +2806,14 @@ func getHTTPMetrics() *MetricsGroup {
 				VariableLabels: map[string]string{"api": api},
 			})
 		}
+		for api, value := range httpStats.TotalS3SlowDown.APIStats {
+			metrics = append(metrics, Metric{
+				Description:    getS3SlowDownTotalMD(),
+				Value:          float64(value),
+				VariableLabels: map[string]string{"api": api},
+			})
+		}
+		metrics = append(metrics, getHistogramVecMetrics(httpRequestsLatency, getS3RequestsLatencyMD())...)
 		return
 	})
 	return mg
@@ -1885,6 +2848,31 @@ func getNetworkMetrics() *MetricsGroup {
 				Description: getInterNodeReceivedBytesMD(),
 				Value:       float64(connStats.TotalInputBytes),
 			})
+			if pool, ok := globalInternodeTransport.(*xhttp.ConnPool); ok {
+				for peer, st := range pool.Stats() {
+					labels := map[string]string{"peer": peer}
+					metrics = append(metrics, Metric{
+						Description:    getInternodeConnActiveMD(),
+						Value:          float64(st.Active),
+						VariableLabels: labels,
+					})
+					metrics = append(metrics, Metric{
+						Description:    getInternodeConnIdleMD(),
+						Value:          float64(st.Idle()),
+						VariableLabels: labels,
+					})
+					metrics = append(metrics, Metric{
+						Description:    getInternodeConnDialsMD(),
+						Value:          float64(st.Dials),
+						VariableLabels: labels,
+					})
+					metrics = append(metrics, Metric{
+						Description:    getInternodeConnDialErrorsMD(),
+						Value:          float64(st.DialErrs),
+						VariableLabels: labels,
+					})
+				}
+			}
 		}
 		metrics = append(metrics, Metric{
 			Description: getS3SentBytesMD(),
@@ -1894,6 +2882,63 @@ func getNetworkMetrics() *MetricsGroup {
 			Description: getS3ReceivedBytesMD(),
 			Value:       float64(connStats.S3InputBytes),
 		})
+		metrics = append(metrics, getNodeTCPMetrics(ctx)...)
+		return
+	})
+	return mg
+}
+
+// getNodeTCPMetrics returns the TCP retransmits and errors of the host,
+// read from /proc/net/snmp.
+func getNodeTCPMetrics(ctx context.Context) (metrics []Metric) {
+	if runtime.GOOS != "linux" {
+		return nil
+	}
+	p, err := procfs.Self()
+	if err != nil {
+		logger.LogOnceIf(ctx, err, string(nodeMetricNamespace))
+		return nil
+	}
+	snmp, err := p.Snmp()
+	if err != nil {
+		logger.LogOnceIf(ctx, err, string(tcpSubsystem))
+		return nil
+	}
+	for _, m := range []struct {
+		md    MetricDescription
+		value *float64
+	}{
+		{getNodeTCPRetransmitsMD(), snmp.Tcp.RetransSegs},
+		{getNodeTCPSegmentsSentMD(), snmp.Tcp.OutSegs},
+		{getNodeTCPErrorsMD(), snmp.Tcp.InErrs},
+	} {
+		if m.value != nil {
+			metrics = append(metrics, Metric{Description: m.md, Value: *m.value})
+		}
+	}
+	return metrics
+}
+
+func getBucketReplicationBandwidthMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(ctx context.Context) (metrics []Metric) {
+		if newObjectLayerFn() == nil || globalNotificationSys == nil {
+			return
+		}
+		for _, bw := range getReplicationBandwidth(ctx, "") {
+			metrics = append(metrics, Metric{
+				Description:    getBucketRepBandwidthLimitMD(),
+				Value:          float64(bw.LimitBytesPerSec),
+				VariableLabels: map[string]string{"bucket": bw.Bucket, "targetArn": bw.ARN},
+			})
+			metrics = append(metrics, Metric{
+				Description:    getBucketRepBandwidthConsumedMD(),
+				Value:          bw.ConsumedBytesPerSec,
+				VariableLabels: map[string]string{"bucket": bw.Bucket, "targetArn": bw.ARN},
+			})
+		}
 		return
 	})
 	return mg
@@ -1945,6 +2990,56 @@ func getBucketUsageMetrics() *MetricsGroup {
 				VariableLabels: map[string]string{"bucket": bucket},
 			})
 
+			for sc, size := range usage.StorageClassSize {
+				metrics = append(metrics, Metric{
+					Description:    getBucketUsageByStorageClassBytesMD(),
+					Value:          float64(size),
+					VariableLabels: map[string]string{"bucket": bucket, "storage_class": sc},
+				})
+			}
+
+			for sc, count := range usage.StorageClassObjects {
+				metrics = append(metrics, Metric{
+					Description:    getBucketUsageByStorageClassObjectsMD(),
+					Value:          float64(count),
+					VariableLabels: map[string]string{"bucket": bucket, "storage_class": sc},
+				})
+			}
+
+			metrics = append(metrics, Metric{
+				Description:    getBucketUsageObjectsInlinedTotalMD(),
+				Value:          float64(usage.InlinedCount),
+				VariableLabels: map[string]string{"bucket": bucket},
+			})
+
+			metrics = append(metrics, Metric{
+				Description:    getBucketUsageObjectsNotInlinedTotalMD(),
+				Value:          float64(usage.NotInlinedCount),
+				VariableLabels: map[string]string{"bucket": bucket},
+			})
+
+			metrics = append(metrics, Metric{
+				Description:    getBucketObjectsLegalHoldTotalMD(),
+				Value:          float64(usage.LegalHoldCount),
+				VariableLabels: map[string]string{"bucket": bucket},
+			})
+
+			// Buckets holding only noncurrent versions report the
+			// number of noncurrent versions.
+			metrics = append(metrics, Metric{
+				Description:    getBucketNoncurrentVersionsRatioMD(),
+				Value:          float64(usage.NoncurrentVersionsCount) / math.Max(float64(usage.CurrentVersionsCount), 1),
+				VariableLabels: map[string]string{"bucket": bucket},
+			})
+
+			for sseType, count := range usage.EncryptedCount {
+				metrics = append(metrics, Metric{
+					Description:    getBucketObjectsEncryptedTotalMD(),
+					Value:          float64(count),
+					VariableLabels: map[string]string{"bucket": bucket, "sse_type": sseType},
+				})
+			}
+
 			metrics = append(metrics, Metric{
 				Description:    getBucketRepReceivedBytesMD(),
 				Value:          float64(stats.ReplicaSize),
@@ -1977,6 +3072,73 @@ func getBucketUsageMetrics() *MetricsGroup {
 				})
 			}
 
+			skippedCount, skippedSize := globalBucketConnStats.getSkippedOverwrites(bucket)
+			if skippedCount > 0 {
+				metrics = append(metrics, Metric{
+					Description:    getBucketOverwriteSkippedTotalMD(),
+					Value:          float64(skippedCount),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+				metrics = append(metrics, Metric{
+					Description:    getBucketOverwriteSkippedBytesMD(),
+					Value:          float64(skippedSize),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
+
+			if rejected := globalBucketStateRejections.get(bucket); rejected > 0 {
+				metrics = append(metrics, Metric{
+					Description:    getBucketStateRejectionsTotalMD(),
+					Value:          float64(rejected),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
+
+			deletes, deleteMarkers := globalBucketConnStats.getDeletes(bucket)
+			if deletes > 0 {
+				metrics = append(metrics, Metric{
+					Description:    getBucketUsageDeletesTotalMD(),
+					Value:          float64(deletes),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
+			if deleteMarkers > 0 {
+				metrics = append(metrics, Metric{
+					Description:    getBucketUsageDeleteMarkersTotalMD(),
+					Value:          float64(deleteMarkers),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
+
+			if capped := globalBucketConnStats.getListingsCapped(bucket); capped > 0 {
+				metrics = append(metrics, Metric{
+					Description:    getBucketListingCappedTotalMD(),
+					Value:          float64(capped),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
+
+			rtProxied, rtPopulated, rtPopulatedSize := globalBucketConnStats.getReadThrough(bucket)
+			if rtProxied > 0 {
+				metrics = append(metrics, Metric{
+					Description:    getBucketReadThroughProxiedTotalMD(),
+					Value:          float64(rtProxied),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
+			if rtPopulated > 0 {
+				metrics = append(metrics, Metric{
+					Description:    getBucketReadThroughPopulatedTotalMD(),
+					Value:          float64(rtPopulated),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+				metrics = append(metrics, Metric{
+					Description:    getBucketReadThroughPopulatedBytesMD(),
+					Value:          float64(rtPopulatedSize),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
+
 			if stats.hasReplicationUsage() {
 				for arn, stat := range stats.Stats {
 					metrics = append(metrics, Metric{
@@ -2016,6 +3178,14 @@ func getBucketUsageMetrics() *MetricsGroup {
 				HistogramBucketLabel: "range",
 				VariableLabels:       map[string]string{"bucket": bucket},
 			})
+			if len(usage.ObjectAgesHistogram) > 0 {
+				metrics = append(metrics, Metric{
+					Description:          getBucketObjectAgeMD(),
+					Histogram:            usage.ObjectAgesHistogram,
+					HistogramBucketLabel: "range",
+					VariableLabels:       map[string]string{"bucket": bucket},
+				})
+			}
 		}
 		return
 	})
@@ -2170,6 +3340,38 @@ func getLocalDriveStorageMetrics() *MetricsGroup {
 				})
 			}
 		}
+
+		globalLocalDrivesMu.RLock()
+		localDrives := globalLocalDrives
+		globalLocalDrivesMu.RUnlock()
+		for _, drive := range localDrives {
+			d, ok := drive.(*xlStorageDiskIDCheck)
+			if !ok {
+				continue
+			}
+			drivePath := d.storage.diskPath
+			metrics = append(metrics, Metric{
+				Description:    getNodeDriveTimeoutsMD(),
+				Value:          float64(atomic.LoadUint64(&d.health.timeouts)),
+				VariableLabels: map[string]string{"disk": drivePath},
+			})
+			var hung float64
+			if d.health.isHung() {
+				hung = 1
+			}
+			metrics = append(metrics, Metric{
+				Description:    getNodeDriveHungMD(),
+				Value:          hung,
+				VariableLabels: map[string]string{"disk": drivePath},
+			})
+			for status, name := range diskHealthStatusNames {
+				metrics = append(metrics, Metric{
+					Description:    getNodeDriveStateTransitionsMD(),
+					Value:          float64(atomic.LoadUint64(&d.health.transitions[status])),
+					VariableLabels: map[string]string{"disk": drivePath, "state": name},
+				})
+			}
+		}
 		return
 	})
 	return mg
@@ -2189,6 +3391,7 @@ func getClusterStorageMetrics() *MetricsGroup {
 		// Fetch disk space info, ignore errors
 		metrics = make([]Metric, 0, 10)
 		storageInfo := objLayer.StorageInfo(ctx)
+		globalStorageInfoCache.set(storageInfo)
 		onlineDrives, offlineDrives := getOnlineOfflineDisksStats(storageInfo.Disks)
 		totalDrives := onlineDrives.Merge(offlineDrives)
 
@@ -2269,6 +3472,121 @@ func getKMSNodeMetrics() *MetricsGroup {
 	return mg
 }
 
+func getPoolOpsMetric(name MetricName, help string, pool int, value float64) Metric {
+	return Metric{
+		Description: MetricDescription{
+			Namespace: minioMetricNamespace,
+			Subsystem: poolSubsystem,
+			Name:      name,
+			Help:      help,
+			Type:      gaugeMetric,
+		},
+		VariableLabels: map[string]string{"pool": strconv.Itoa(pool)},
+		Value:          value,
+	}
+}
+
+// getPoolOpsMetrics reports the progress of the running decommission
+// and rebalance operations of each pool.
+func getPoolOpsMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(ctx context.Context) (metrics []Metric) {
+		z, ok := newObjectLayerFn().(*erasureServerPools)
+		if !ok || z.SinglePool() {
+			return
+		}
+
+		z.poolMetaMutex.RLock()
+		for idx, pool := range z.poolMeta.Pools {
+			pd := pool.Decommission
+			if pd == nil || pd.Complete || pd.Failed || pd.Canceled {
+				continue
+			}
+			metrics = append(metrics,
+				getPoolOpsMetric(decommissionObjectsTotal, "Number of versions in the pool when the decommission started, as counted by the scanner", idx, float64(pd.ItemsTotal)),
+				getPoolOpsMetric(decommissionObjectsDone, "Number of versions decommissioned", idx, float64(pd.ItemsDecommissioned)),
+				getPoolOpsMetric(decommissionPercent, "Percentage of the decommission completed", idx, pd.percentDone()),
+			)
+		}
+		z.poolMetaMutex.RUnlock()
+
+		if !z.IsRebalanceStarted() {
+			return
+		}
+		// Rebalance progress is only up to date in the backend, every
+		// pool is rebalanced by a different node.
+		meta := &rebalanceMeta{}
+		if err := meta.load(ctx, z.serverPools[0]); err != nil {
+			logger.LogIf(ctx, err)
+			return
+		}
+		if !meta.StoppedAt.IsZero() {
+			return
+		}
+		for idx, ps := range meta.PoolStats {
+			if !ps.Participating || ps.Info.Status != rebalStarted {
+				continue
+			}
+			metrics = append(metrics,
+				getPoolOpsMetric(rebalanceObjectsTotal, "Estimated number of objects to rebalance from the pool", idx, float64(ps.NumObjectsTotal)),
+				getPoolOpsMetric(rebalanceObjectsDone, "Number of objects rebalanced from the pool", idx, float64(ps.NumObjects)),
+				getPoolOpsMetric(rebalancePercent, "Percentage of the bytes to rebalance from the pool that were moved", idx, ps.percentDone(meta.PercentFreeGoal)),
+			)
+		}
+		return
+	})
+	return mg
+}
+
+// getErasureSetMetrics reports the number of objects and versions of
+// each erasure set, as counted by the last scanner cycle.
+func getErasureSetMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: time.Minute,
+	}
+	mg.RegisterRead(func(ctx context.Context) (metrics []Metric) {
+		z, ok := newObjectLayerFn().(*erasureServerPools)
+		if !ok {
+			return
+		}
+
+		for poolIdx, pool := range z.serverPools {
+			for setIdx, set := range pool.sets {
+				dui, err := loadErasureSetUsage(ctx, set)
+				if err != nil {
+					continue
+				}
+				labels := map[string]string{"pool": strconv.Itoa(poolIdx), "set": strconv.Itoa(setIdx)}
+				metrics = append(metrics, Metric{
+					Description: MetricDescription{
+						Namespace: minioMetricNamespace,
+						Subsystem: erasureSetSubsystem,
+						Name:      objectsTotal,
+						Help:      "Number of objects in the erasure set, as counted by the scanner",
+						Type:      gaugeMetric,
+					},
+					VariableLabels: labels,
+					Value:          float64(dui.ObjectsTotalCount),
+				}, Metric{
+					Description: MetricDescription{
+						Namespace: minioMetricNamespace,
+						Subsystem: erasureSetSubsystem,
+						Name:      versionsTotal,
+						Help:      "Number of object versions in the erasure set, as counted by the scanner",
+						Type:      gaugeMetric,
+					},
+					VariableLabels: labels,
+					Value:          float64(dui.VersionsTotalCount),
+				})
+			}
+		}
+		return
+	})
+	return mg
+}
+
 func getKMSMetrics() *MetricsGroup {
 	mg := &MetricsGroup{
 		cacheInterval: 10 * time.Second,
@@ -2403,6 +3721,33 @@ func (c *minioClusterCollector) Collect(out chan<- prometheus.Metric) {
 	wg.Wait()
 }
 
+// collectMetrics returns the metrics of the collector from all nodes,
+// as they are exposed by Collect.
+func (c *minioClusterCollector) collectMetrics(ctx context.Context) []Metric {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		metrics []Metric
+	)
+	gather := func(in <-chan Metric) {
+		defer wg.Done()
+		for metric := range in {
+			mu.Lock()
+			metrics = append(metrics, metric)
+			mu.Unlock()
+		}
+	}
+
+	wg.Add(1)
+	go gather(ReportMetrics(ctx, c.metricsGroups))
+	if peers := globalNotificationSys.GetClusterMetrics(ctx); peers != nil {
+		wg.Add(1)
+		go gather(peers)
+	}
+	wg.Wait()
+	return metrics
+}
+
 // ReportMetrics reports serialized metrics to the channel passed for the metrics generated.
 func ReportMetrics(ctx context.Context, metricsGroups []*MetricsGroup) <-chan Metric {
 	ch := make(chan Metric)
@@ -0,0 +1,231 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/minio/internal/config/heal"
+	"github.com/minio/minio/internal/config/scanner"
+)
+
+func TestGetSubscriberNodeMetrics(t *testing.T) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	subCh := make(chan madmin.TraceInfo, 1)
+	if err := globalTrace.Subscribe(madmin.TraceS3, subCh, doneCh, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[MetricSubsystem]float64{
+		traceSubsystem:      float64(globalTrace.Subscribers()),
+		consoleLogSubsystem: float64(globalConsoleSys.NumSubscribers()),
+	}
+	if want[traceSubsystem] < 1 {
+		t.Fatalf("expected at least 1 trace subscriber, got %v", want[traceSubsystem])
+	}
+
+	metrics := getSubscriberNodeMetrics().Get()
+	if len(metrics) != len(want) {
+		t.Fatalf("expected %d metrics, got %d", len(want), len(metrics))
+	}
+	for _, m := range metrics {
+		d := m.Description
+		if d.Namespace != nodeMetricNamespace || d.Name != subscribers || d.Type != gaugeMetric {
+			t.Errorf("unexpected metric description %+v", d)
+		}
+		v, ok := want[d.Subsystem]
+		if !ok {
+			t.Errorf("unexpected subsystem %s", d.Subsystem)
+			continue
+		}
+		if m.Value != v {
+			t.Errorf("expected %s_%s to be %v, got %v", d.Subsystem, d.Name, v, m.Value)
+		}
+	}
+}
+
+func TestGetNodeTCPMetrics(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		if metrics := getNodeTCPMetrics(context.Background()); len(metrics) != 0 {
+			t.Fatalf("expected no TCP metrics on %s, got %d", runtime.GOOS, len(metrics))
+		}
+		return
+	}
+	if _, err := os.Stat("/proc/self/net/snmp"); err != nil {
+		t.Skip(err)
+	}
+
+	values := make(map[MetricName]float64)
+	for _, m := range getNodeTCPMetrics(context.Background()) {
+		if m.Description.Namespace != nodeMetricNamespace || m.Description.Subsystem != tcpSubsystem || m.Description.Type != counterMetric {
+			t.Errorf("unexpected metric description %+v", m.Description)
+		}
+		values[m.Description.Name] = m.Value
+	}
+	for _, name := range []MetricName{retransmits, segmentsSent, errorsTotal} {
+		if _, ok := values[name]; !ok {
+			t.Errorf("expected the %s_%s metric", tcpSubsystem, name)
+		}
+	}
+	if values[retransmits] > values[segmentsSent] {
+		t.Errorf("expected at most %v retransmitted segments, got %v", values[segmentsSent], values[retransmits])
+	}
+}
+
+func TestGetErasureSetMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	setObjectLayer(obj)
+	defer setObjectLayer(nil)
+
+	// Nothing is counted before the first scanner cycle.
+	for _, m := range getErasureSetMetrics().Get() {
+		if m.Value != 0 {
+			t.Fatalf("expected no objects and versions, got %+v", m)
+		}
+	}
+
+	cache := dataUsageCache{Info: dataUsageCacheInfo{Name: dataUsageRoot}}
+	cache.replace(dataUsageRoot, "", dataUsageEntry{Objects: 3, Versions: 5})
+	set := obj.(*erasureServerPools).serverPools[0].sets[0]
+	if err = cache.save(ctx, set, dataUsageCacheName); err != nil {
+		t.Fatal(err)
+	}
+
+	values := make(map[MetricName]float64)
+	for _, m := range getErasureSetMetrics().Get() {
+		if m.Description.Subsystem != erasureSetSubsystem || m.VariableLabels["pool"] != "0" || m.VariableLabels["set"] != "0" {
+			t.Errorf("unexpected metric %+v", m)
+		}
+		values[m.Description.Name] = m.Value
+	}
+	if len(values) != 2 || values[objectsTotal] != 3 || values[versionsTotal] != 5 {
+		t.Errorf("expected 3 objects and 5 versions, got %v", values)
+	}
+}
+
+func TestGetConfigNodeMetrics(t *testing.T) {
+	cfg, err := scanner.LookupConfig(config.KVS{config.KV{Key: scanner.Speed, Value: "slow"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Speed != "slow" {
+		t.Fatalf("expected the slow scanner speed, got %q", cfg.Speed)
+	}
+
+	speed, cycle := scannerSpeed.Load(), scannerCycle.Load()
+	delay, maxWait := scannerSleeper.Get()
+	healIO, healSleep, bitrot := globalHealConfig.Clone()
+	defer func() {
+		scannerSpeed.Store(speed)
+		scannerCycle.Store(cycle)
+		scannerSleeper.Update(delay, maxWait)
+		globalHealConfig.Update(heal.Config{IOCount: healIO, Sleep: healSleep, Bitrot: bitrot})
+	}()
+
+	scannerSpeed.Store(cfg.Speed)
+	scannerCycle.Store(cfg.Cycle)
+	if err = scannerSleeper.Update(cfg.Delay, cfg.MaxWait); err != nil {
+		t.Fatal(err)
+	}
+	globalHealConfig.Update(heal.Config{IOCount: 20, Sleep: 2 * time.Second, Bitrot: "on"})
+
+	values := make(map[MetricName]Metric)
+	for _, m := range getConfigNodeMetrics().Get() {
+		if m.Description.Namespace != minioMetricNamespace || m.Description.Subsystem != configSubsystem {
+			t.Errorf("unexpected metric description %+v", m.Description)
+		}
+		values[m.Description.Name] = m
+	}
+	for name, want := range map[MetricName]float64{
+		"scanner_speed":            1,
+		"scanner_delay":            10,
+		"scanner_max_wait_seconds": 15,
+		"scanner_cycle_seconds":    60,
+		"heal_max_io":              20,
+		"heal_max_sleep_seconds":   2,
+		"heal_bitrotscan":          1,
+	} {
+		if m, ok := values[name]; !ok || m.Value != want {
+			t.Errorf("expected %s to be %v, got %v", name, want, m.Value)
+		}
+	}
+	if got := values["scanner_speed"].VariableLabels["speed"]; got != "slow" {
+		t.Errorf("expected the slow scanner speed, got %q", got)
+	}
+	if got := values["heal_bitrotscan"].VariableLabels["bitrotscan"]; got != "on" {
+		t.Errorf("expected the bitrot scan to be on, got %q", got)
+	}
+
+	// Speeds set with the deprecated keys are reported as custom.
+	scannerSpeed.Store("")
+	for _, m := range getConfigNodeMetrics().Get() {
+		if m.Description.Name == "scanner_speed" && m.VariableLabels["speed"] != "custom" {
+			t.Errorf("expected a custom scanner speed, got %q", m.VariableLabels["speed"])
+		}
+	}
+}
+
+func TestGetMinioProcMetricsFDUtilization(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("file descriptors are not read on %s", runtime.GOOS)
+	}
+	if _, err := os.Stat("/proc/self/fd"); err != nil {
+		t.Skip(err)
+	}
+
+	values := make(map[MetricSubsystem]map[MetricName]float64)
+	for _, m := range getMinioProcMetrics().Get() {
+		d := m.Description
+		if values[d.Subsystem] == nil {
+			values[d.Subsystem] = make(map[MetricName]float64)
+		}
+		values[d.Subsystem][d.Name] = m.Value
+	}
+
+	open, limit := values[fileDescriptorSubsystem][openTotal], values[fileDescriptorSubsystem][limitTotal]
+	if open <= 0 || limit <= 0 {
+		t.Fatalf("expected the open file descriptors and their limit, got %v", values[fileDescriptorSubsystem])
+	}
+	utilization, ok := values[fdSubsystem][utilizationPercent]
+	if !ok {
+		t.Fatalf("expected the %s_%s metric", fdSubsystem, utilizationPercent)
+	}
+	if want := 100 * open / limit; utilization != want {
+		t.Errorf("expected %v%% of the file descriptors to be used, got %v%%", want, utilization)
+	}
+	if utilization <= 0 || utilization > 100 {
+		t.Errorf("expected a percentage, got %v", utilization)
+	}
+}
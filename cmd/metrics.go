@@ -39,6 +39,14 @@ var (
 		},
 		[]string{"api"},
 	)
+	httpRequestsLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "s3_requests_latency_seconds",
+			Help:    "Total time taken by requests served by current MinIO server instance",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+		},
+		[]string{"api"},
+	)
 	minioVersionInfo = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "minio",
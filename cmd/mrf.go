@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -43,6 +44,18 @@ type partialOperation struct {
 	poolIndex int
 }
 
+// MRFEntry - a queued partial operation waiting to be healed
+// once the offline drives of its erasure set reconnect.
+type MRFEntry struct {
+	Node      string `json:"node"`
+	Bucket    string `json:"bucket"`
+	Object    string `json:"object"`
+	VersionID string `json:"versionId,omitempty"`
+	Size      int64  `json:"size"`
+	PoolIndex int    `json:"poolIndex"`
+	SetIndex  int    `json:"setIndex"`
+}
+
 type setInfo struct {
 	index, pool int
 }
@@ -140,6 +153,40 @@ func (m *mrfState) getCurrentMRFRoundInfo() madmin.MRFStatus {
 	}
 }
 
+// listPendingOps returns the partial operations currently queued
+// on this node, sorted by bucket, object and version.
+func (m *mrfState) listPendingOps() []MRFEntry {
+	if !m.initialized() {
+		return nil
+	}
+
+	m.mu.Lock()
+	entries := make([]MRFEntry, 0, len(m.pendingOps))
+	for op := range m.pendingOps {
+		entries = append(entries, MRFEntry{
+			Node:      globalLocalNodeName,
+			Bucket:    op.bucket,
+			Object:    op.object,
+			VersionID: op.versionID,
+			Size:      op.size,
+			PoolIndex: op.poolIndex,
+			SetIndex:  op.setIndex,
+		})
+	}
+	m.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Bucket != entries[j].Bucket {
+			return entries[i].Bucket < entries[j].Bucket
+		}
+		if entries[i].Object != entries[j].Object {
+			return entries[i].Object < entries[j].Object
+		}
+		return entries[i].VersionID < entries[j].VersionID
+	})
+	return entries
+}
+
 // maintainMRFList gathers the list of successful partial uploads
 // from all underlying er.sets and puts them in a global map which
 // should not have more than 10000 entries.
@@ -218,7 +265,10 @@ func (m *mrfState) healRoutine() {
 
 			// Heal objects
 			for _, u := range mrfOperations {
-				_, err := m.objectAPI.HealObject(m.ctx, u.bucket, u.object, u.versionID, mrfHealingOpts)
+				var err error
+				if !globalQuarantineSys.skip(m.ctx, quarantineSkipMRF, u.bucket, u.object, u.versionID) {
+					_, err = m.objectAPI.HealObject(m.ctx, u.bucket, u.object, u.versionID, mrfHealingOpts)
+				}
 				m.mu.Lock()
 				if err == nil {
 					m.itemsHealed++
@@ -0,0 +1,55 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestMRFListPendingOps(t *testing.T) {
+	var m mrfState
+	if entries := m.listPendingOps(); entries != nil {
+		t.Fatalf("expected no entries before init, got %v", entries)
+	}
+
+	ops := []partialOperation{
+		{bucket: "bucket-b", object: "obj", size: 10, poolIndex: 1, setIndex: 2},
+		{bucket: "bucket-a", object: "obj", versionID: "v2", size: 20},
+		{bucket: "bucket-a", object: "obj", versionID: "v1", size: 30},
+	}
+	m.pendingOps = make(map[partialOperation]setInfo)
+	for _, op := range ops {
+		m.pendingOps[op] = setInfo{index: op.setIndex, pool: op.poolIndex}
+	}
+	m.ready = 1
+
+	entries := m.listPendingOps()
+	if len(entries) != len(ops) {
+		t.Fatalf("expected %d entries, got %d", len(ops), len(entries))
+	}
+	expected := []MRFEntry{
+		{Node: globalLocalNodeName, Bucket: "bucket-a", Object: "obj", VersionID: "v1", Size: 30},
+		{Node: globalLocalNodeName, Bucket: "bucket-a", Object: "obj", VersionID: "v2", Size: 20},
+		{Node: globalLocalNodeName, Bucket: "bucket-b", Object: "obj", Size: 10, PoolIndex: 1, SetIndex: 2},
+	}
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Fatalf("entry %d: expected %+v, got %+v", i, expected[i], entries[i])
+		}
+	}
+}
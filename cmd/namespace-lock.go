@@ -159,13 +159,26 @@ type distLockInstance struct {
 	opsID   string
 }
 
+// lockLost returns the callback of the loss of the lock after failed
+// refreshes, the operation holding the lock is canceled through the
+// context of the lock.
+func (di *distLockInstance) lockLost(ctx context.Context, lockSource string, cancel context.CancelFunc) func() {
+	return func() {
+		logger.LogIf(ctx, fmt.Errorf("lock on %s taken at %s lost, unable to refresh it on a quorum of lockers, canceling the operation",
+			strings.Join(di.rwMutex.Names, ","), lockSource))
+		cancel()
+	}
+}
+
 // Lock - block until write lock is taken or timeout has occurred.
 func (di *distLockInstance) GetLock(ctx context.Context, timeout *dynamicTimeout) (LockContext, error) {
+	defer requestTimingPhase(ctx, requestPhaseLock)()
+
 	lockSource := getSource(2)
 	start := UTCNow()
 
 	newCtx, cancel := context.WithCancel(ctx)
-	if !di.rwMutex.GetLock(newCtx, cancel, di.opsID, lockSource, dsync.Options{
+	if !di.rwMutex.GetLock(newCtx, di.lockLost(ctx, lockSource, cancel), di.opsID, lockSource, dsync.Options{
 		Timeout:       timeout.Timeout(),
 		RetryInterval: timeout.RetryInterval(),
 	}) {
@@ -177,6 +190,7 @@ func (di *distLockInstance) GetLock(ctx context.Context, timeout *dynamicTimeout
 		return LockContext{ctx: ctx, cancel: func() {}}, OperationTimedOut{}
 	}
 	timeout.LogSuccess(UTCNow().Sub(start))
+	globalLockHolders.add(ctx, di.opsID, lockSource, true, di.rwMutex.Names)
 	return LockContext{ctx: newCtx, cancel: cancel}, nil
 }
 
@@ -185,16 +199,19 @@ func (di *distLockInstance) Unlock(lc LockContext) {
 	if lc.cancel != nil {
 		lc.cancel()
 	}
+	globalLockHolders.remove(di.opsID)
 	di.rwMutex.Unlock(lc.ctx)
 }
 
 // RLock - block until read lock is taken or timeout has occurred.
 func (di *distLockInstance) GetRLock(ctx context.Context, timeout *dynamicTimeout) (LockContext, error) {
+	defer requestTimingPhase(ctx, requestPhaseLock)()
+
 	lockSource := getSource(2)
 	start := UTCNow()
 
 	newCtx, cancel := context.WithCancel(ctx)
-	if !di.rwMutex.GetRLock(ctx, cancel, di.opsID, lockSource, dsync.Options{
+	if !di.rwMutex.GetRLock(ctx, di.lockLost(ctx, lockSource, cancel), di.opsID, lockSource, dsync.Options{
 		Timeout:       timeout.Timeout(),
 		RetryInterval: timeout.RetryInterval(),
 	}) {
@@ -206,6 +223,7 @@ func (di *distLockInstance) GetRLock(ctx context.Context, timeout *dynamicTimeou
 		return LockContext{ctx: ctx, cancel: func() {}}, OperationTimedOut{}
 	}
 	timeout.LogSuccess(UTCNow().Sub(start))
+	globalLockHolders.add(ctx, di.opsID, lockSource, false, di.rwMutex.Names)
 	return LockContext{ctx: newCtx, cancel: cancel}, nil
 }
 
@@ -214,6 +232,7 @@ func (di *distLockInstance) RUnlock(lc LockContext) {
 	if lc.cancel != nil {
 		lc.cancel()
 	}
+	globalLockHolders.remove(di.opsID)
 	di.rwMutex.RUnlock(lc.ctx)
 }
 
@@ -243,6 +262,8 @@ func (n *nsLockMap) NewNSLock(lockers func() ([]dsync.NetLocker, string), volume
 
 // Lock - block until write lock is taken or timeout has occurred.
 func (li *localLockInstance) GetLock(ctx context.Context, timeout *dynamicTimeout) (_ LockContext, timedOutErr error) {
+	defer requestTimingPhase(ctx, requestPhaseLock)()
+
 	lockSource := getSource(2)
 	start := UTCNow()
 	const readLock = false
@@ -263,6 +284,7 @@ func (li *localLockInstance) GetLock(ctx context.Context, timeout *dynamicTimeou
 		success[i] = 1
 	}
 	timeout.LogSuccess(UTCNow().Sub(start))
+	globalLockHolders.add(ctx, li.opsID, lockSource, true, pathsJoinPrefix(li.volume, li.paths...))
 	return LockContext{ctx: ctx, cancel: func() {}}, nil
 }
 
@@ -271,6 +293,7 @@ func (li *localLockInstance) Unlock(lc LockContext) {
 	if lc.cancel != nil {
 		lc.cancel()
 	}
+	globalLockHolders.remove(li.opsID)
 	const readLock = false
 	for _, path := range li.paths {
 		li.ns.unlock(li.volume, path, readLock)
@@ -279,6 +302,8 @@ func (li *localLockInstance) Unlock(lc LockContext) {
 
 // RLock - block until read lock is taken or timeout has occurred.
 func (li *localLockInstance) GetRLock(ctx context.Context, timeout *dynamicTimeout) (_ LockContext, timedOutErr error) {
+	defer requestTimingPhase(ctx, requestPhaseLock)()
+
 	lockSource := getSource(2)
 	start := UTCNow()
 	const readLock = true
@@ -299,6 +324,7 @@ func (li *localLockInstance) GetRLock(ctx context.Context, timeout *dynamicTimeo
 		success[i] = 1
 	}
 	timeout.LogSuccess(UTCNow().Sub(start))
+	globalLockHolders.add(ctx, li.opsID, lockSource, false, pathsJoinPrefix(li.volume, li.paths...))
 	return LockContext{ctx: ctx, cancel: func() {}}, nil
 }
 
@@ -307,6 +333,7 @@ func (li *localLockInstance) RUnlock(lc LockContext) {
 	if lc.cancel != nil {
 		lc.cancel()
 	}
+	globalLockHolders.remove(li.opsID)
 	const readLock = true
 	for _, path := range li.paths {
 		li.ns.unlock(li.volume, path, readLock)
@@ -0,0 +1,74 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+var errNodeNotFound = errors.New("node is not part of the cluster")
+
+// NodeDrainStatus is the drain state of a node.
+type NodeDrainStatus struct {
+	Node     string    `json:"node"`
+	Draining bool      `json:"draining"`
+	Since    time.Time `json:"since,omitempty"`
+	// InFlight is the number of S3 requests still being served, the
+	// node can be taken down once it drops to zero.
+	InFlight int `json:"inFlight"`
+}
+
+// nodeDrain is the drain state of this node. While draining new S3
+// requests are rejected, the requests in flight complete normally and
+// the readiness check fails so load balancers stop routing to the node.
+type nodeDrain struct {
+	// since is the start of the drain in unix nanoseconds, zero when
+	// the node is not draining. Use atomics to read without locking.
+	since int64
+}
+
+var globalNodeDrain nodeDrain
+
+// set starts or stops draining this node.
+func (d *nodeDrain) set(drain bool) {
+	if !drain {
+		atomic.StoreInt64(&d.since, 0)
+		return
+	}
+	atomic.CompareAndSwapInt64(&d.since, 0, UTCNow().UnixNano())
+}
+
+// draining returns true if this node is draining.
+func (d *nodeDrain) draining() bool {
+	return atomic.LoadInt64(&d.since) != 0
+}
+
+// status returns the drain state of this node.
+func (d *nodeDrain) status() NodeDrainStatus {
+	s := NodeDrainStatus{Node: globalLocalNodeName}
+	if since := atomic.LoadInt64(&d.since); since != 0 {
+		s.Draining = true
+		s.Since = time.Unix(0, since).UTC()
+	}
+	for _, n := range globalHTTPStats.currentS3Requests.Load() {
+		s.InFlight += n
+	}
+	return s
+}
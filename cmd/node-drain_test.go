@@ -0,0 +1,64 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNodeDrain(t *testing.T) {
+	var d nodeDrain
+	if d.draining() || d.status().Draining {
+		t.Fatal("expected the node not to be draining")
+	}
+	d.set(true)
+	since := d.status().Since
+	if !d.draining() || since.IsZero() {
+		t.Fatal("expected the node to be draining")
+	}
+	// Draining again keeps the start of the drain.
+	d.set(true)
+	if !d.status().Since.Equal(since) {
+		t.Fatalf("expected the drain to start at %v, got %v", since, d.status().Since)
+	}
+	d.set(false)
+	if d.draining() || !d.status().Since.IsZero() {
+		t.Fatal("expected the drain to be canceled")
+	}
+}
+
+func TestMaxClientsNodeDrain(t *testing.T) {
+	globalNodeDrain.set(true)
+	defer globalNodeDrain.set(false)
+
+	called := false
+	h := maxClients(func(w http.ResponseWriter, r *http.Request) { called = true })
+	rec := httptest.NewRecorder()
+	h(rec, httptest.NewRequest(http.MethodGet, "/bucket/object", nil))
+	if called || rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected the request to be rejected with 503, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	ReadinessCheckHandler(rec, httptest.NewRequest(http.MethodGet, "/minio/health/ready", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected the readiness check to fail with 503, got %d", rec.Code)
+	}
+}
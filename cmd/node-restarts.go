@@ -0,0 +1,70 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+const nodeRestartsPrefix = minioConfigPrefix + "/node-restarts"
+
+// nodeRestarts is the number of times the server was restarted on a
+// node, persisted in the meta bucket so that it survives restarts.
+type nodeRestarts struct {
+	Node      string    `json:"node"`
+	Restarts  uint64    `json:"restarts"`
+	LastStart time.Time `json:"lastStart"`
+}
+
+// Number of restarts of the server on this node, -1 until known.
+var globalNodeRestarts int64 = -1
+
+func nodeRestartsPath() string {
+	return pathJoin(nodeRestartsPrefix, globalLocalNodeNameHex+".json")
+}
+
+// recordNodeRestart counts a start of the server on this node, the
+// first start of a node is not a restart.
+func recordNodeRestart(ctx context.Context, objAPI ObjectLayer) error {
+	nr := nodeRestarts{Node: globalLocalNodeName}
+	data, err := readConfig(ctx, objAPI, nodeRestartsPath())
+	switch {
+	case errors.Is(err, errConfigNotFound):
+	case err != nil:
+		return err
+	default:
+		if err = json.Unmarshal(data, &nr); err != nil {
+			return err
+		}
+		nr.Restarts++
+	}
+	nr.LastStart = globalBootTime
+
+	if data, err = json.Marshal(nr); err != nil {
+		return err
+	}
+	if err = saveConfig(ctx, objAPI, nodeRestartsPath(), data); err != nil {
+		return err
+	}
+	atomic.StoreInt64(&globalNodeRestarts, int64(nr.Restarts))
+	return nil
+}
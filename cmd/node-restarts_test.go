@@ -0,0 +1,61 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRecordNodeRestart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+	defer atomic.StoreInt64(&globalNodeRestarts, -1)
+
+	// The first start of a node is not a restart.
+	for restarts := int64(0); restarts < 3; restarts++ {
+		globalBootTime = UTCNow()
+		if err = recordNodeRestart(ctx, obj); err != nil {
+			t.Fatal(err)
+		}
+		if got := atomic.LoadInt64(&globalNodeRestarts); got != restarts {
+			t.Fatalf("expected %d restarts, got %d", restarts, got)
+		}
+
+		data, err := readConfig(ctx, obj, nodeRestartsPath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var nr nodeRestarts
+		if err = json.Unmarshal(data, &nr); err != nil {
+			t.Fatal(err)
+		}
+		if nr.Restarts != uint64(restarts) || !nr.LastStart.Equal(globalBootTime) {
+			t.Fatalf("unexpected persisted restarts %+v", nr)
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"sync"
 	"time"
 
@@ -159,6 +160,22 @@ func (sys *NotificationSys) LoadPolicyMapping(userOrGroup string, userType IAMUs
 	return ng.Wait()
 }
 
+// LoadElevatedPolicy - reloads the policy elevation of a user across all
+// peers
+func (sys *NotificationSys) LoadElevatedPolicy(user string) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients)).WithRetries(1)
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(GlobalContext, func() error {
+			return client.LoadElevatedPolicy(user)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
 // DeleteUser - deletes a specific user across all peers
 func (sys *NotificationSys) DeleteUser(accessKey string) []NotificationPeerErr {
 	ng := WithNPeers(len(sys.peerClients)).WithRetries(1)
@@ -567,6 +584,22 @@ func (sys *NotificationSys) ReloadPoolMeta(ctx context.Context) {
 	}
 }
 
+// RebuildDataUsage notifies all MinIO nodes to rebuild the data usage cache,
+// only the node running the data scanner acts on it.
+func (sys *NotificationSys) RebuildDataUsage(ctx context.Context) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(ctx, func() error {
+			return client.RebuildDataUsage(ctx)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
 // StopRebalance notifies all MinIO nodes to signal any ongoing rebalance
 // goroutine to stop.
 func (sys *NotificationSys) StopRebalance(ctx context.Context) {
@@ -640,6 +673,26 @@ func (sys *NotificationSys) LoadTransitionTierConfig(ctx context.Context) {
 	}
 }
 
+// LoadQuarantine notifies remote peers to reload the quarantine list.
+func (sys *NotificationSys) LoadQuarantine(ctx context.Context) {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(ctx, func() error {
+			return client.LoadQuarantine(ctx)
+		}, idx, *client.host)
+	}
+	for _, nErr := range ng.Wait() {
+		reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress", nErr.Host.String())
+		if nErr.Err != nil {
+			logger.LogIf(logger.SetReqInfo(ctx, reqInfo), nErr.Err)
+		}
+	}
+}
+
 // GetCPUs - Get all CPU information.
 func (sys *NotificationSys) GetCPUs(ctx context.Context) []madmin.CPUs {
 	reply := make([]madmin.CPUs, len(sys.peerClients))
@@ -1357,6 +1410,500 @@ func (sys *NotificationSys) ReloadSiteReplicationConfig(ctx context.Context) []e
 	return errs
 }
 
+// GetClusterJobs - returns the long-running cluster jobs reported by all
+// nodes, de-duplicated and sorted with the most recently started first.
+func (sys *NotificationSys) GetClusterJobs(ctx context.Context, history bool) []ClusterJobInfo {
+	errs := make([]error, len(sys.peerClients))
+	peerJobs := make([][]ClusterJobInfo, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			peerJobs[index], errs[index] = sys.peerClients[index].GetClusterJobs(ctx, history)
+		}(index)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch cluster jobs: %w", err), sys.peerClients[i].host.String())
+		}
+	}
+	return mergeClusterJobs(append(peerJobs, localClusterJobs(history))...)
+}
+
+// GetMRFEntries - returns the partial operations queued for healing on all nodes.
+func (sys *NotificationSys) GetMRFEntries(ctx context.Context) []MRFEntry {
+	errs := make([]error, len(sys.peerClients))
+	peerEntries := make([][]MRFEntry, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			peerEntries[index], errs[index] = sys.peerClients[index].GetMRFEntries(ctx)
+		}(index)
+	}
+	wg.Wait()
+
+	entries := globalMRFState.listPendingOps()
+	for i, err := range errs {
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch MRF entries: %w", err), sys.peerClients[i].host.String())
+			continue
+		}
+		entries = append(entries, peerEntries[i]...)
+	}
+	return entries
+}
+
+// GetHealSequences - returns the heal sequences of all nodes, sorted with
+// the most recently started first. Ended sequences which have not been
+// cleaned up yet are included if inclEnded is set.
+func (sys *NotificationSys) GetHealSequences(ctx context.Context, inclEnded bool) []HealSequenceSummary {
+	errs := make([]error, len(sys.peerClients))
+	peerSeqs := make([][]HealSequenceSummary, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			peerSeqs[index], errs[index] = sys.peerClients[index].GetHealSequences(ctx, inclEnded)
+		}(index)
+	}
+	wg.Wait()
+
+	seqs := globalAllHealState.getHealSequences(inclEnded)
+	for i, err := range errs {
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch heal sequences: %w", err), sys.peerClients[i].host.String())
+			continue
+		}
+		seqs = append(seqs, peerSeqs[i]...)
+	}
+	sort.Slice(seqs, func(i, j int) bool {
+		return seqs[i].StartTime.After(seqs[j].StartTime)
+	})
+	return seqs
+}
+
+// GetLockHolders - returns the holders of the lock on a resource taken
+// by all nodes, oldest first.
+func (sys *NotificationSys) GetLockHolders(ctx context.Context, resource string) []LockHolder {
+	errs := make([]error, len(sys.peerClients))
+	peerHolders := make([][]LockHolder, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			peerHolders[index], errs[index] = sys.peerClients[index].GetLockHolders(ctx, resource)
+		}(index)
+	}
+	wg.Wait()
+
+	holders := globalLockHolders.get(resource)
+	for i, err := range errs {
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch lock holders: %w", err), sys.peerClients[i].host.String())
+			continue
+		}
+		holders = append(holders, peerHolders[i]...)
+	}
+	sort.Slice(holders, func(i, j int) bool {
+		return holders[i].Since.Before(holders[j].Since)
+	})
+	return holders
+}
+
+// GetObjectReadStreams - returns the GetObject responses being streamed
+// by all nodes, oldest first.
+func (sys *NotificationSys) GetObjectReadStreams(ctx context.Context) []ObjectReadStream {
+	errs := make([]error, len(sys.peerClients))
+	peerStreams := make([][]ObjectReadStream, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			peerStreams[index], errs[index] = sys.peerClients[index].GetObjectReadStreams(ctx)
+		}(index)
+	}
+	wg.Wait()
+
+	streams := globalObjectReadStreams.list()
+	for i, err := range errs {
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch object read streams: %w", err), sys.peerClients[i].host.String())
+			continue
+		}
+		streams = append(streams, peerStreams[i]...)
+	}
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].Started.Before(streams[j].Started)
+	})
+	return streams
+}
+
+// StopHealSequence - stops the heal sequence with the client token on
+// any node, it returns false if no node has such a sequence.
+func (sys *NotificationSys) StopHealSequence(ctx context.Context, token string) bool {
+	if globalAllHealState.stopHealSequenceByToken(token) {
+		return true
+	}
+
+	stopped := make([]bool, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			var err error
+			stopped[index], err = sys.peerClients[index].StopHealSequence(ctx, token)
+			if err != nil {
+				logger.LogOnceIf(ctx, fmt.Errorf("failed to stop heal sequence: %w", err), sys.peerClients[index].host.String())
+			}
+		}(index)
+	}
+	wg.Wait()
+
+	for _, ok := range stopped {
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// CancelObjectReadStream - aborts the GetObject response being streamed
+// with the ID by any node, it returns false if no node streams it.
+func (sys *NotificationSys) CancelObjectReadStream(ctx context.Context, id string) bool {
+	if globalObjectReadStreams.cancel(id) {
+		return true
+	}
+
+	canceled := make([]bool, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			var err error
+			canceled[index], err = sys.peerClients[index].CancelObjectReadStream(ctx, id)
+			if err != nil {
+				logger.LogOnceIf(ctx, fmt.Errorf("failed to cancel object read stream: %w", err), sys.peerClients[index].host.String())
+			}
+		}(index)
+	}
+	wg.Wait()
+
+	for _, ok := range canceled {
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBootstrapTrace - returns the startup events recorded by all nodes,
+// with clear the recorded events are dropped.
+func (sys *NotificationSys) GetBootstrapTrace(ctx context.Context, clear bool) []madmin.TraceInfo {
+	peerEvents := make([][]madmin.TraceInfo, len(sys.peerClients))
+	errs := make([]error, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			peerEvents[index], errs[index] = sys.peerClients[index].GetBootstrapTrace(ctx, clear)
+		}(index)
+	}
+	wg.Wait()
+
+	events := globalBootstrapTracer.Timeline(clear)
+	for i, err := range errs {
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch bootstrap trace: %w", err), sys.peerClients[i].host.String())
+			continue
+		}
+		events = append(events, peerEvents[i]...)
+	}
+	return events
+}
+
+// GetServerFeatures - returns the features enabled on all nodes, the
+// nodes which could not be reached are returned with an error.
+func (sys *NotificationSys) GetServerFeatures(ctx context.Context) []ServerFeatures {
+	features := make([]ServerFeatures, len(sys.peerClients))
+	errs := make([]error, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			features[index], errs[index] = sys.peerClients[index].GetServerFeatures(ctx)
+		}(index)
+	}
+	wg.Wait()
+
+	all := []ServerFeatures{localServerFeatures()}
+	for i, err := range errs {
+		if sys.peerClients[i] == nil {
+			continue
+		}
+		if err != nil {
+			host := sys.peerClients[i].host.String()
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch server features: %w", err), host)
+			all = append(all, ServerFeatures{Node: host, Error: err.Error()})
+			continue
+		}
+		all = append(all, features[i])
+	}
+	return all
+}
+
+// GetGuardrailStatus - returns the load shedding state of all nodes.
+func (sys *NotificationSys) GetGuardrailStatus(ctx context.Context) []GuardrailStatus {
+	statuses := make([]GuardrailStatus, len(sys.peerClients))
+	errs := make([]error, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			statuses[index], errs[index] = sys.peerClients[index].GetGuardrailStatus(ctx)
+		}(index)
+	}
+	wg.Wait()
+
+	all := []GuardrailStatus{globalGuardrail.status()}
+	for i, err := range errs {
+		if sys.peerClients[i] == nil {
+			continue
+		}
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch guardrail status: %w", err), sys.peerClients[i].host.String())
+			continue
+		}
+		all = append(all, statuses[i])
+	}
+	return all
+}
+
+// GetRequestTimings - returns the timing breakdown of the recent sampled
+// requests of all nodes, sorted with the most recent first.
+func (sys *NotificationSys) GetRequestTimings(ctx context.Context) []RequestTiming {
+	errs := make([]error, len(sys.peerClients))
+	peerTimings := make([][]RequestTiming, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			peerTimings[index], errs[index] = sys.peerClients[index].GetRequestTimings(ctx)
+		}(index)
+	}
+	wg.Wait()
+
+	timings := globalRequestTimings.recent(0)
+	for i, err := range errs {
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("failed to fetch request timings: %w", err), sys.peerClients[i].host.String())
+			continue
+		}
+		timings = append(timings, peerTimings[i]...)
+	}
+	sort.Slice(timings, func(i, j int) bool {
+		return timings[i].Time.After(timings[j].Time)
+	})
+	return timings
+}
+
+// GetClocks - returns the current time of all nodes, with their skew
+// relative to this node.
+func (sys *NotificationSys) GetClocks(ctx context.Context) ClusterClock {
+	nodes := make([]NodeClock, len(sys.peerClients)+1)
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			nc := NodeClock{Node: sys.peerClients[index].host.String()}
+			sent := UTCNow()
+			t, err := sys.peerClients[index].GetClock(ctx)
+			if err != nil {
+				nc.Error = err.Error()
+			} else {
+				nc.Time = t
+				nc.Skew, nc.RoundTrip = peerClockSkew(sent, UTCNow(), t)
+			}
+			nodes[index] = nc
+		}(index)
+	}
+	wg.Wait()
+
+	nodes[len(sys.peerClients)] = NodeClock{Node: globalLocalNodeName, Time: UTCNow()}
+	res := nodes[:0]
+	for _, nc := range nodes {
+		if nc.Node != "" {
+			res = append(res, nc)
+		}
+	}
+	return newClusterClock(res)
+}
+
+// DrainNode - starts or stops draining the given node, the node
+// serving the request included.
+func (sys *NotificationSys) DrainNode(ctx context.Context, node string, drain bool) (NodeDrainStatus, error) {
+	if node == globalLocalNodeName {
+		globalNodeDrain.set(drain)
+		return globalNodeDrain.status(), nil
+	}
+	for _, client := range sys.peerClients {
+		if client != nil && client.host.String() == node {
+			return client.Drain(ctx, drain)
+		}
+	}
+	return NodeDrainStatus{}, errNodeNotFound
+}
+
+// GetScannerStatus - returns the scanner state of the drives of all nodes.
+func (sys *NotificationSys) GetScannerStatus(ctx context.Context) []ScannerNodeStatus {
+	status := make([]ScannerNodeStatus, len(sys.peerClients)+1)
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			st, err := sys.peerClients[index].GetScannerStatus(ctx)
+			if err != nil {
+				st = ScannerNodeStatus{
+					Node:  sys.peerClients[index].host.String(),
+					Error: err.Error(),
+				}
+			}
+			status[index] = st
+		}(index)
+	}
+	wg.Wait()
+
+	status[len(sys.peerClients)] = globalScannerDrives.status()
+	res := status[:0]
+	for _, st := range status {
+		if st.Node != "" {
+			res = append(res, st)
+		}
+	}
+	return res
+}
+
+// GetExpiryStatus - returns the state of the ILM expiry workers of all nodes.
+func (sys *NotificationSys) GetExpiryStatus(ctx context.Context) []ExpiryStatus {
+	status := make([]ExpiryStatus, len(sys.peerClients)+1)
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			st, err := sys.peerClients[index].GetExpiryStatus(ctx)
+			if err != nil {
+				st = ExpiryStatus{
+					Node:  sys.peerClients[index].host.String(),
+					Error: err.Error(),
+				}
+			}
+			status[index] = st
+		}(index)
+	}
+	wg.Wait()
+
+	status[len(sys.peerClients)] = globalExpiryState.status()
+	res := status[:0]
+	for _, st := range status {
+		if st.Node != "" {
+			res = append(res, st)
+		}
+	}
+	return res
+}
+
+// FormatCheck - returns the placement of the local drives of all nodes
+// against their format.
+func (sys *NotificationSys) FormatCheck(ctx context.Context, objAPI ObjectLayer) []FormatCheckNodeStatus {
+	status := make([]FormatCheckNodeStatus, len(sys.peerClients)+1)
+	var wg sync.WaitGroup
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			st, err := sys.peerClients[index].FormatCheck(ctx)
+			if err != nil {
+				st = FormatCheckNodeStatus{
+					Node:  sys.peerClients[index].host.String(),
+					Error: err.Error(),
+				}
+			}
+			status[index] = st
+		}(index)
+	}
+	wg.Wait()
+
+	status[len(sys.peerClients)] = localFormatCheck(objAPI)
+	res := status[:0]
+	for _, st := range status {
+		if st.Node != "" {
+			res = append(res, st)
+		}
+	}
+	return res
+}
+
 // GetLastDayTierStats fetches per-tier stats of the last 24hrs from all peers
 func (sys *NotificationSys) GetLastDayTierStats(ctx context.Context) DailyAllTierStats {
 	errs := make([]error, len(sys.allPeerClients))
@@ -18,6 +18,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"io"
 	"math"
 	"time"
@@ -56,6 +57,8 @@ const (
 	// dataUsageBucketLen must be length of ObjectsHistogramIntervals
 	dataUsageBucketLen  = 7
 	dataUsageVersionLen = 7
+	// dataUsageAgeLen must be length of ObjectsAgeHistogramIntervals
+	dataUsageAgeLen = 9
 )
 
 // ObjectsHistogramIntervals is the list of all intervals
@@ -82,6 +85,20 @@ var ObjectsVersionCountIntervals = [dataUsageVersionLen]objectHistogramInterval{
 	{"GREATER_THAN_10000", 10000, math.MaxInt64},
 }
 
+// ObjectsAgeHistogramIntervals is the list of all intervals
+// of object ages in days to be included in objects histogram.
+var ObjectsAgeHistogramIntervals = [dataUsageAgeLen]objectHistogramInterval{
+	{"LESS_THAN_1_DAY", 0, 0},
+	{"BETWEEN_1_AND_7_DAYS", 1, 6},
+	{"BETWEEN_7_AND_30_DAYS", 7, 29},
+	{"BETWEEN_30_AND_90_DAYS", 30, 89},
+	{"BETWEEN_90_AND_180_DAYS", 90, 179},
+	{"BETWEEN_180_AND_365_DAYS", 180, 364},
+	{"BETWEEN_1_AND_2_YEARS", 365, 729},
+	{"BETWEEN_2_AND_5_YEARS", 730, 1824},
+	{"GREATER_THAN_5_YEARS", 1825, math.MaxInt64},
+}
+
 // BucketInfo - represents bucket metadata.
 type BucketInfo struct {
 	// Name of the bucket.
@@ -193,6 +210,10 @@ type ObjectInfo struct {
 	// Checksums added on upload.
 	// Encoded, maybe encrypted.
 	Checksum []byte
+
+	// MetadataScrub is only set by GetObjectInfo if requested with
+	// ObjectOptions.MetadataScrub.
+	MetadataScrub *ObjectMetadataScrub `json:"-"`
 }
 
 // ArchiveInfo returns any saved zip archive meta information.
@@ -217,6 +238,48 @@ func (o *ObjectInfo) ArchiveInfo() []byte {
 	return data
 }
 
+// clientMetadataKey holds the metadata as supplied by the client, when
+// it asked to preserve it, as JSON.
+const clientMetadataKey = ReservedMetadataPrefixLower + "client-metadata"
+
+// ClientMetadata returns the metadata as supplied by the client on upload,
+// if the client asked to preserve it.
+func (o *ObjectInfo) ClientMetadata() (map[string]string, bool) {
+	z, ok := o.UserDefined[clientMetadataKey]
+	if !ok {
+		return nil, false
+	}
+	var metadata map[string]string
+	if err := json.Unmarshal([]byte(z), &metadata); err != nil {
+		logger.LogIf(GlobalContext, err)
+		return nil, false
+	}
+	return metadata, true
+}
+
+// preserveClientMetadata stores the client supplied metadata of opts,
+// if any, in the metadata of the object.
+func preserveClientMetadata(userDefined map[string]string, opts ObjectOptions) error {
+	if opts.ClientMetadata == nil {
+		return nil
+	}
+	buf, err := json.Marshal(opts.ClientMetadata)
+	if err != nil {
+		return err
+	}
+	userDefined[clientMetadataKey] = string(buf)
+	return nil
+}
+
+// contentHashKey holds the content hash computed by the client, as
+// <algorithm>=<value>.
+const contentHashKey = ReservedMetadataPrefixLower + "content-hash"
+
+// ContentHash returns the content hash supplied by the client on upload.
+func (o *ObjectInfo) ContentHash() string {
+	return o.UserDefined[contentHashKey]
+}
+
 // Clone - Returns a cloned copy of current objectInfo
 func (o *ObjectInfo) Clone() (cinfo ObjectInfo) {
 	cinfo = ObjectInfo{
@@ -255,6 +318,7 @@ func (o *ObjectInfo) Clone() (cinfo ObjectInfo) {
 		SuccessorModTime:           o.SuccessorModTime,
 		ReplicationStatusInternal:  o.ReplicationStatusInternal,
 		VersionPurgeStatusInternal: o.VersionPurgeStatusInternal,
+		MetadataScrub:              o.MetadataScrub,
 	}
 	cinfo.UserDefined = make(map[string]string, len(o.UserDefined))
 	for k, v := range o.UserDefined {
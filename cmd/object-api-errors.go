@@ -320,6 +320,17 @@ func (e ObjectExistsAsDirectory) Error() string {
 	return "Object exists on : " + e.Bucket + " as directory " + e.Object
 }
 
+// ObjectNotAppendable object can't be appended to, it wasn't created
+// with append or has reached its maximum number of segments.
+type ObjectNotAppendable GenericError
+
+func (e ObjectNotAppendable) Error() string {
+	if e.Err != nil {
+		return "Object: " + e.Bucket + "/" + e.Object + " is not appendable: " + e.Err.Error()
+	}
+	return "Object: " + e.Bucket + "/" + e.Object + " is not appendable"
+}
+
 // PrefixAccessDenied object access is denied.
 type PrefixAccessDenied GenericError
 
@@ -373,6 +384,20 @@ func (e BucketSSEConfigNotFound) Error() string {
 	return "No bucket encryption configuration found for bucket: " + e.Bucket
 }
 
+// BucketCorsNotFound - no bucket CORS configuration found
+type BucketCorsNotFound GenericError
+
+func (e BucketCorsNotFound) Error() string {
+	return "No bucket CORS configuration found for bucket: " + e.Bucket
+}
+
+// BucketWebsiteNotFound - no bucket website configuration found
+type BucketWebsiteNotFound GenericError
+
+func (e BucketWebsiteNotFound) Error() string {
+	return "No bucket website configuration found for bucket: " + e.Bucket
+}
+
 // BucketTaggingNotFound - no bucket tags found
 type BucketTaggingNotFound GenericError
 
@@ -521,6 +546,9 @@ type ObjectNameTooLong GenericError
 // ObjectNamePrefixAsSlash - object name has a slash as prefix.
 type ObjectNamePrefixAsSlash GenericError
 
+// ObjectNameTooDeep - object name has too many path components.
+type ObjectNameTooDeep GenericError
+
 // Error returns string an error formatted as the given text.
 func (e ObjectNameInvalid) Error() string {
 	return "Object name invalid: " + e.Bucket + "/" + e.Object
@@ -531,6 +559,11 @@ func (e ObjectNameTooLong) Error() string {
 	return "Object name too long: " + e.Bucket + "/" + e.Object
 }
 
+// Error returns string an error formatted as the given text.
+func (e ObjectNameTooDeep) Error() string {
+	return "Object name has too many path components: " + e.Bucket + "/" + e.Object
+}
+
 // Error returns string an error formatted as the given text.
 func (e ObjectNamePrefixAsSlash) Error() string {
 	return "Object name contains forward slash as pefix: " + e.Bucket + "/" + e.Object
@@ -584,6 +617,18 @@ func (e OperationTimedOut) Error() string {
 	return "Operation timed out"
 }
 
+// ListingTimeout - a listing scanned the maximum number of entries or
+// ran for the maximum duration allowed before finding entries to
+// return, it resumes from NextMarker.
+type ListingTimeout struct {
+	Bucket     string
+	NextMarker string
+}
+
+func (e ListingTimeout) Error() string {
+	return "Listing of bucket " + e.Bucket + " stopped at its scan limit"
+}
+
 // Multipart related errors.
 
 // MalformedUploadID malformed upload id.
@@ -35,6 +35,10 @@ import (
 // CheckPreconditionFn returns true if precondition check failed.
 type CheckPreconditionFn func(o ObjectInfo) bool
 
+// SkipIdenticalFn returns true if the latest version is identical to
+// the incoming PUT and the write may be skipped.
+type SkipIdenticalFn func(o ObjectInfo) bool
+
 // EvalMetadataFn validates input objInfo and returns an updated metadata
 type EvalMetadataFn func(o *ObjectInfo) error
 
@@ -57,6 +61,7 @@ type ObjectOptions struct {
 	PartNumber        int                 // only useful in case of GetObject/HeadObject
 	CheckPrecondFn    CheckPreconditionFn // only set during GetObject/HeadObject/CopyObjectPart preconditional valuation
 	EvalMetadataFn    EvalMetadataFn      // only set for retention settings, meant to be used only when updating metadata in-place.
+	SkipIdenticalFn   SkipIdenticalFn     // only set during PutObject when the bucket skips identical overwrites.
 	DeleteReplication ReplicationState    // Represents internal replication state needed for Delete replication
 	Transition        TransitionOptions
 	Expiration        ExpirationOptions
@@ -64,6 +69,7 @@ type ObjectOptions struct {
 	WantChecksum *hash.Checksum // x-amz-checksum-XXX checksum sent to PutObject/ CompleteMultipartUpload.
 
 	NoDecryption                        bool      // indicates if the stream must be decrypted.
+	NoDecompression                     bool      // indicates if the stream of a compressed object must not be decompressed.
 	PreserveETag                        string    // preserves this etag during a PUT call.
 	NoLock                              bool      // indicates to lower layers if the caller is expecting to hold locks.
 	ProxyRequest                        bool      // only set for GET/HEAD in active-active replication scenario
@@ -73,6 +79,7 @@ type ObjectOptions struct {
 	ReplicationSourceLegalholdTimestamp time.Time // set if MinIOSourceObjectLegalholdTimestamp received
 	ReplicationSourceRetentionTimestamp time.Time // set if MinIOSourceObjectRetentionTimestamp received
 	DeletePrefix                        bool      // set true to enforce a prefix deletion, only application for DeleteObject API,
+	DeleteIfLatest                      bool      // set true to delete VersionID only if it is still the latest version, only applicable for DeleteObject API
 
 	Speedtest bool // object call specifically meant for SpeedTest code, set to 'true' when invoked by SpeedtestHandler.
 
@@ -98,6 +105,44 @@ type ObjectOptions struct {
 	IndexCB func() []byte
 
 	InclFreeVersions bool
+
+	// Durable makes PUT and CompleteMultipartUpload wait until the
+	// object is synced to stable storage on write quorum of drives,
+	// regardless of the MINIO_FS_OSYNC cluster default.
+	Durable bool
+
+	// WriteAllOnline makes PutObject fail unless the object is written
+	// to all the drives of its erasure set online when it starts, instead
+	// of write quorum of them.
+	WriteAllOnline bool
+
+	// ClientMetadata is the metadata as supplied by the client, stored
+	// verbatim alongside the metadata the server derives for PUT and
+	// NewMultipartUpload when set.
+	ClientMetadata map[string]string
+
+	// ContentHash is a content hash computed by the client, as
+	// <algorithm>=<value>, stored verbatim for PUT and NewMultipartUpload
+	// when set.
+	ContentHash string
+
+	// MetadataScrub makes GetObjectInfo report how the metadata of the
+	// object agrees across the drives, in ObjectInfo.MetadataScrub.
+	MetadataScrub bool
+
+	// DataDir is the data directory PutObject writes the object to,
+	// instead of a random one, when set. Must be a well-formed UUID.
+	DataDir string
+
+	// Append makes PutObject append to the latest version of an object
+	// created with append, or create it, a MinIO extension.
+	Append AppendOptions
+}
+
+// AppendOptions represents object options for appending to objects.
+type AppendOptions struct {
+	Enabled     bool
+	MaxSegments int // maximum number of segments of an appended object
 }
 
 // ExpirationOptions represents object options for object expiration at objectLayer.
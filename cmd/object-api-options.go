@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/textproto"
 	"strconv"
 	"strings"
 	"time"
@@ -188,6 +189,28 @@ func delOpts(ctx context.Context, r *http.Request, bucket, object string) (opts
 		}
 	}
 
+	ifLatest := strings.TrimSpace(r.Header.Get(xhttp.MinIODeleteIfLatest))
+	if ifLatest != "" {
+		switch ifLatest {
+		case "true":
+			opts.DeleteIfLatest = true
+		case "false":
+		default:
+			return opts, InvalidArgument{
+				Bucket: bucket,
+				Object: object,
+				Err:    fmt.Errorf("Unable to parse %s, failed with %w", xhttp.MinIODeleteIfLatest, fmt.Errorf("value should be true or false")),
+			}
+		}
+		if opts.DeleteIfLatest && opts.VersionID == "" {
+			return opts, InvalidArgument{
+				Bucket: bucket,
+				Object: object,
+				Err:    fmt.Errorf("%s requires a versionId", xhttp.MinIODeleteIfLatest),
+			}
+		}
+	}
+
 	mtime := strings.TrimSpace(r.Header.Get(xhttp.MinIOSourceMTime))
 	if mtime != "" {
 		opts.MTime, err = time.Parse(time.RFC3339Nano, mtime)
@@ -293,6 +316,20 @@ func putOpts(ctx context.Context, r *http.Request, bucket, object string, metada
 	}
 	etag := strings.TrimSpace(r.Header.Get(xhttp.MinIOSourceETag))
 
+	clientMetadata, err := preservedClientMetadata(ctx, r.Header)
+	if err != nil {
+		return opts, err
+	}
+
+	contentHash, err := parseContentHash(r.Header)
+	if err != nil {
+		return opts, InvalidArgument{
+			Bucket: bucket,
+			Object: object,
+			Err:    err,
+		}
+	}
+
 	if crypto.S3KMS.IsRequested(r.Header) {
 		keyID, context, err := crypto.S3KMS.ParseHTTP(r.Header)
 		if err != nil {
@@ -311,6 +348,10 @@ func putOpts(ctx context.Context, r *http.Request, bucket, object string, metada
 			MTime:                mtime,
 			WantChecksum:         wantCRC,
 			PreserveETag:         etag,
+			Durable:              isDurableRequested(r.Header),
+			WriteAllOnline:       isWriteAllOnlineRequested(r.Header),
+			ClientMetadata:       clientMetadata,
+			ContentHash:          contentHash,
 		}, nil
 	}
 	// default case of passing encryption headers and UserDefined metadata to backend
@@ -327,10 +368,79 @@ func putOpts(ctx context.Context, r *http.Request, bucket, object string, metada
 	opts.ReplicationSourceTaggingTimestamp = taggingtimestmp
 	opts.PreserveETag = etag
 	opts.WantChecksum = wantCRC
+	opts.Durable = isDurableRequested(r.Header)
+	opts.WriteAllOnline = isWriteAllOnlineRequested(r.Header)
+	opts.ClientMetadata = clientMetadata
+	opts.ContentHash = contentHash
 
 	return opts, nil
 }
 
+// isDurableRequested returns true if the client asked for the object
+// to be synced to stable storage before the write returns.
+func isDurableRequested(h http.Header) bool {
+	return strings.EqualFold(strings.TrimSpace(h.Get(xhttp.MinIODurable)), "true")
+}
+
+// isWriteAllOnlineRequested returns true if the client asked for the
+// object to be written to all the online drives before the write returns.
+func isWriteAllOnlineRequested(h http.Header) bool {
+	return strings.EqualFold(strings.TrimSpace(h.Get(xhttp.MinIOWriteAllOnline)), "true")
+}
+
+// preservedClientMetadata returns the metadata headers as sent by the
+// client, without the defaults the server adds, if the client asked to
+// preserve them.
+func preservedClientMetadata(ctx context.Context, h http.Header) (map[string]string, error) {
+	if !strings.EqualFold(strings.TrimSpace(h.Get(xhttp.MinIOPreserveClientMetadata)), "true") {
+		return nil, nil
+	}
+	metadata := make(map[string]string)
+	if err := extractMetadataFromMime(ctx, textproto.MIMEHeader(h), metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// Maximum lengths of the algorithm and of the value of a content hash.
+const (
+	maxContentHashAlgorithmLen = 32
+	maxContentHashValueLen     = 1024
+)
+
+// parseContentHash returns the content hash sent by the client, as
+// <algorithm>=<value>, if any.
+func parseContentHash(h http.Header) (string, error) {
+	contentHash := strings.TrimSpace(h.Get(xhttp.MinIOContentHash))
+	if contentHash == "" {
+		return "", nil
+	}
+	algorithm, value, ok := strings.Cut(contentHash, "=")
+	if !ok || algorithm == "" || value == "" {
+		return "", fmt.Errorf("%s must be <algorithm>=<value>", xhttp.MinIOContentHash)
+	}
+	if len(algorithm) > maxContentHashAlgorithmLen || len(value) > maxContentHashValueLen {
+		return "", fmt.Errorf("%s is too long", xhttp.MinIOContentHash)
+	}
+	for _, c := range algorithm {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return "", fmt.Errorf("invalid algorithm %q in %s", algorithm, xhttp.MinIOContentHash)
+		}
+	}
+	for _, c := range value {
+		if c <= ' ' || c > '~' {
+			return "", fmt.Errorf("invalid value in %s", xhttp.MinIOContentHash)
+		}
+	}
+	return contentHash, nil
+}
+
+// isNoDecompressionRequested returns true if the client asked for the
+// stored compressed stream of an object.
+func isNoDecompressionRequested(h http.Header) bool {
+	return strings.EqualFold(strings.TrimSpace(h.Get(xhttp.MinIONoDecompression)), "true")
+}
+
 // get ObjectOptions for Copy calls with encryption headers provided on the target side and source side metadata
 func copyDstOpts(ctx context.Context, r *http.Request, bucket, object string, metadata map[string]string) (opts ObjectOptions, err error) {
 	return putOpts(ctx, r, bucket, object, metadata)
@@ -371,6 +481,7 @@ func completeMultipartOpts(ctx context.Context, r *http.Request, bucket, object
 		}
 	}
 	opts.MTime = mtime
+	opts.Durable = isDurableRequested(r.Header)
 	opts.UserDefined = make(map[string]string)
 
 	// Transfer SSEC key in opts.EncryptFn
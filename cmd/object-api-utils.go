@@ -206,6 +206,22 @@ func checkObjectNameForLengthAndSlash(bucket, object string) error {
 	return nil
 }
 
+// checkObjectNameDepth - check the number of path components of a new
+// object name against the configured api max_object_key_depth.
+func checkObjectNameDepth(bucket, object string) error {
+	maxDepth := globalAPIConfig.getMaxObjectKeyDepth()
+	if maxDepth <= 0 {
+		return nil
+	}
+	if strings.Count(strings.TrimSuffix(object, SlashSeparator), SlashSeparator)+1 > maxDepth {
+		return ObjectNameTooDeep{
+			Bucket: bucket,
+			Object: object,
+		}
+	}
+	return nil
+}
+
 // SlashSeparator - slash separator.
 const SlashSeparator = "/"
 
@@ -248,6 +264,13 @@ func mustGetUUID() string {
 	return u.String()
 }
 
+// isValidDataDir - returns true if dataDir is a non-nil UUID in its
+// canonical form, as generated by mustGetUUID.
+func isValidDataDir(dataDir string) bool {
+	u, err := uuid.Parse(dataDir)
+	return err == nil && u != uuid.Nil && u.String() == dataDir
+}
+
 // Create an s3 compatible MD5sum for complete multipart transaction.
 func getCompleteMultipartMD5(parts []CompletePart) string {
 	var finalMD5Bytes []byte
@@ -263,6 +286,19 @@ func getCompleteMultipartMD5(parts []CompletePart) string {
 	return s3MD5
 }
 
+// Internal metadata of multipart objects holding their weak ETag.
+const multipartWeakETagKey = ReservedMetadataPrefixLower + "weak-etag"
+
+// getMultipartWeakETag returns a weak validator for the ETag of a
+// multipart object, without the parts count suffix, for caches which
+// assume a strong ETag is the MD5 of the content.
+func getMultipartWeakETag(etag string) string {
+	if i := strings.LastIndexByte(etag, '-'); i > 0 {
+		etag = etag[:i]
+	}
+	return "W/\"" + etag + "\""
+}
+
 // Clean unwanted fields from metadata
 func cleanMetadata(metadata map[string]string) map[string]string {
 	// Remove STANDARD StorageClass
@@ -508,6 +544,11 @@ func partNumberToRangeSpec(oi ObjectInfo, partNumber int) *HTTPRangeSpec {
 		return nil
 	}
 
+	// Objects split into parts by PutObject are a single part to clients.
+	if len(oi.Parts) > 1 && !oi.isMultipart() {
+		return &HTTPRangeSpec{Start: 0, End: -1}
+	}
+
 	var start int64
 	end := int64(-1)
 	for i := 0; i < len(oi.Parts) && i < partNumber; i++ {
@@ -654,6 +695,12 @@ func NewGetObjectReader(rs *HTTPRangeSpec, oi ObjectInfo, opts ObjectOptions) (
 		isCompressed = false
 	}
 
+	// if NoDecompression was requested, fetch the stored compressed
+	// stream, it is still decrypted if needed.
+	if opts.NoDecompression {
+		isCompressed = false
+	}
+
 	// Calculate range to read (different for encrypted/compressed objects)
 	switch {
 	case isCompressed:
@@ -20,6 +20,7 @@ package cmd
 import (
 	"bytes"
 	"context"
+	crand "crypto/rand"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,13 +28,17 @@ import (
 	"reflect"
 	"runtime"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/klauspost/compress/s2"
 	"github.com/minio/minio/internal/auth"
 	"github.com/minio/minio/internal/config/compress"
 	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/fips"
+	ioutilx "github.com/minio/minio/internal/ioutil"
 	"github.com/minio/pkg/trie"
+	"github.com/minio/sio"
 )
 
 // Wrapper
@@ -208,6 +213,45 @@ func TestIsValidObjectName(t *testing.T) {
 	}
 }
 
+func TestCheckObjectNameDepth(t *testing.T) {
+	defer func(depth int) {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.maxObjectKeyDepth = depth
+		globalAPIConfig.mu.Unlock()
+	}(globalAPIConfig.getMaxObjectKeyDepth())
+
+	testCases := []struct {
+		maxDepth   int
+		objectName string
+		shouldPass bool
+	}{
+		{0, strings.Repeat("a/", 500) + "object", true},
+		{3, "object", true},
+		{3, "a/b/object", true},
+		{3, "a/b/c/", true},
+		{3, "a/b/c/object", false},
+		{3, "a/b/c/d/", false},
+		{1, "a/", true},
+		{1, "a/object", false},
+	}
+
+	for i, testCase := range testCases {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.maxObjectKeyDepth = testCase.maxDepth
+		globalAPIConfig.mu.Unlock()
+
+		err := checkObjectNameDepth("bucket", testCase.objectName)
+		if testCase.shouldPass && err != nil {
+			t.Errorf("Test case %d: Expected \"%s\" to be allowed, got %v", i+1, testCase.objectName, err)
+		}
+		if !testCase.shouldPass {
+			if _, ok := err.(ObjectNameTooDeep); !ok {
+				t.Errorf("Test case %d: Expected \"%s\" to be rejected, got %v", i+1, testCase.objectName, err)
+			}
+		}
+	}
+}
+
 // Tests getCompleteMultipartMD5
 func TestGetCompleteMultipartMD5(t *testing.T) {
 	testCases := []struct {
@@ -233,6 +277,26 @@ func TestGetCompleteMultipartMD5(t *testing.T) {
 	}
 }
 
+// Tests getMultipartWeakETag and isWeakETagEqual
+func TestMultipartWeakETag(t *testing.T) {
+	weak := getMultipartWeakETag("0239a86b5266bb624f0ac60ba2aed6c8-2")
+	if weak != `W/"0239a86b5266bb624f0ac60ba2aed6c8"` {
+		t.Fatalf("unexpected weak etag %s", weak)
+	}
+	objInfo := ObjectInfo{UserDefined: map[string]string{multipartWeakETagKey: weak}}
+	for _, etag := range []string{weak, `"0239a86b5266bb624f0ac60ba2aed6c8"`, " " + weak} {
+		if !isWeakETagEqual(objInfo, etag) {
+			t.Fatalf("expected %s to match %s", etag, weak)
+		}
+	}
+	if isWeakETagEqual(objInfo, `W/"0239a86b5266bb624f0ac60ba2aed6c8-2"`) {
+		t.Fatal("expected the multipart etag to not match the weak etag")
+	}
+	if isWeakETagEqual(ObjectInfo{}, weak) {
+		t.Fatal("expected no match without a weak etag")
+	}
+}
+
 // TestIsMinioBucketName - Tests isMinioBucketName helper function.
 func TestIsMinioMetaBucketName(t *testing.T) {
 	testCases := []struct {
@@ -665,6 +729,147 @@ func TestGetCompressedOffsets(t *testing.T) {
 	}
 }
 
+func TestGetCompressedOffsetsIndex(t *testing.T) {
+	// Every line is unique, so a misplaced seek cannot go unnoticed.
+	var buf bytes.Buffer
+	for i := 0; buf.Len() < 24<<20; i++ {
+		fmt.Fprintf(&buf, "%012d\n", i)
+	}
+	data := buf.Bytes()
+
+	r, idxCB := newS2CompressReader(bytes.NewReader(data), int64(len(data)), false)
+	comp, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+	oi := ObjectInfo{
+		Parts: []ObjectPartInfo{{
+			Number:     1,
+			Size:       int64(len(comp)),
+			ActualSize: int64(len(data)),
+			Index:      idxCB(),
+		}},
+	}
+	if len(oi.Parts[0].Index) == 0 {
+		t.Fatal("no index returned")
+	}
+
+	size := int64(len(data))
+	for _, offset := range []int64{0, 1, 4<<20 + 7, size / 2, size - 1000, size - 1} {
+		compOff, partSkip, firstPart, _, _ := getCompressedOffsets(oi, offset, nil)
+		if firstPart != 0 {
+			t.Fatalf("offset %d: expected first part 0, got %d", offset, firstPart)
+		}
+		if offset > 2<<20 && compOff == 0 {
+			t.Fatalf("offset %d: index was not used", offset)
+		}
+		if partSkip > offset {
+			t.Fatalf("offset %d: skipping %d uncompressed bytes", offset, partSkip)
+		}
+
+		rd := s2.NewReader(bytes.NewReader(comp[compOff:]), s2.ReaderIgnoreStreamIdentifier())
+		if err := rd.Skip(partSkip); err != nil {
+			t.Fatalf("offset %d: %v", offset, err)
+		}
+		got, err := io.ReadAll(rd)
+		if err != nil {
+			t.Fatalf("offset %d: %v", offset, err)
+		}
+		if !bytes.Equal(got, data[offset:]) {
+			t.Fatalf("offset %d: decompressed data mismatch", offset)
+		}
+	}
+
+	// Without an index we start from the beginning of the part.
+	oi.Parts[0].Index = nil
+	compOff, partSkip, _, _, _ := getCompressedOffsets(oi, size-1, nil)
+	if compOff != 0 || partSkip != size-1 {
+		t.Fatalf("expected (0, %d) without index, got (%d, %d)", size-1, compOff, partSkip)
+	}
+}
+
+func TestGetCompressedOffsetsIndexEncrypted(t *testing.T) {
+	var buf bytes.Buffer
+	for i := 0; buf.Len() < 24<<20; i++ {
+		fmt.Fprintf(&buf, "%012d\n", i)
+	}
+	data := buf.Bytes()
+
+	r, idxCB := newS2CompressReader(bytes.NewReader(data), int64(len(data)), true)
+	comp, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+
+	var key [32]byte
+	if _, err = io.ReadFull(crand.Reader, key[:]); err != nil {
+		t.Fatal(err)
+	}
+	encReader, err := sio.EncryptReader(bytes.NewReader(comp), sio.Config{Key: key[:], MinVersion: sio.Version20, CipherSuites: fips.DARECiphers()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := io.ReadAll(encReader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oi := ObjectInfo{
+		UserDefined: map[string]string{crypto.MetaIV: "iv"},
+		Parts: []ObjectPartInfo{{
+			Number:     1,
+			Size:       int64(len(enc)),
+			ActualSize: int64(len(data)),
+			Index:      idxCB(),
+		}},
+	}
+	decrypt := func(b []byte) ([]byte, error) {
+		return b, nil
+	}
+
+	const encBlockSize = SSEDAREPackageBlockSize + SSEDAREPackageMetaSize
+	size := int64(len(data))
+	for _, offset := range []int64{1, 4<<20 + 7, size / 2, size - 1000, size - 1} {
+		encOff, partSkip, _, decryptSkip, seqNum := getCompressedOffsets(oi, offset, decrypt)
+		if offset > 2<<20 && encOff == 0 {
+			t.Fatalf("offset %d: index was not used", offset)
+		}
+		// Decryption must start at a package boundary.
+		if encOff%encBlockSize != 0 || encOff != int64(seqNum)*encBlockSize {
+			t.Fatalf("offset %d: encrypted offset %d is not aligned to package %d", offset, encOff, seqNum)
+		}
+		if decryptSkip >= SSEDAREPackageBlockSize {
+			t.Fatalf("offset %d: skipping %d decrypted bytes", offset, decryptSkip)
+		}
+
+		dec, err := sio.DecryptReader(bytes.NewReader(enc[encOff:]), sio.Config{Key: key[:], SequenceNumber: seqNum, CipherSuites: fips.DARECiphers()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rd := s2.NewReader(ioutilx.NewSkipReader(dec, decryptSkip), s2.ReaderIgnoreStreamIdentifier())
+		if err := rd.Skip(partSkip); err != nil {
+			t.Fatalf("offset %d: %v", offset, err)
+		}
+		got, err := io.ReadAll(rd)
+		if err != nil {
+			t.Fatalf("offset %d: %v", offset, err)
+		}
+		if !bytes.Equal(got, data[offset:]) {
+			t.Fatalf("offset %d: decrypted data mismatch", offset)
+		}
+	}
+
+	// An index which cannot be decrypted is ignored.
+	encOff, partSkip, _, decryptSkip, seqNum := getCompressedOffsets(oi, size-1, func([]byte) ([]byte, error) {
+		return nil, errObjectTampered
+	})
+	if encOff != 0 || partSkip != size-1 || decryptSkip != 0 || seqNum != 0 {
+		t.Fatalf("expected to start at the beginning, got (%d, %d, %d, %d)", encOff, partSkip, decryptSkip, seqNum)
+	}
+}
+
 func TestS2CompressReader(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -740,3 +945,49 @@ func TestS2CompressReader(t *testing.T) {
 		})
 	}
 }
+
+func TestGetObjectReaderNoDecompression(t *testing.T) {
+	data := bytes.Repeat([]byte("hello, world"), 10000)
+	r, _ := newS2CompressReader(bytes.NewReader(data), int64(len(data)), false)
+	compressed, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oi := ObjectInfo{
+		Size: int64(len(compressed)),
+		UserDefined: map[string]string{
+			ReservedMetadataPrefix + "compression": compressionAlgorithmV2,
+			ReservedMetadataPrefix + "actual-size": strconv.Itoa(len(data)),
+		},
+	}
+
+	for _, tt := range []struct {
+		noDecompression bool
+		want            []byte
+	}{
+		{noDecompression: false, want: data},
+		{noDecompression: true, want: compressed},
+	} {
+		fn, off, length, err := NewGetObjectReader(nil, oi, ObjectOptions{NoDecompression: tt.noDecompression})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if off != 0 || length != int64(len(compressed)) {
+			t.Fatalf("expected to read the stored stream, got offset %d length %d", off, length)
+		}
+		gr, err := fn(bytes.NewReader(compressed), http.Header{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(gr)
+		gr.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("NoDecompression %v: expected %d bytes, got %d", tt.noDecompression, len(tt.want), len(got))
+		}
+	}
+}
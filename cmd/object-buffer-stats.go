@@ -0,0 +1,67 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"sync/atomic"
+)
+
+// objectBufferStats tracks the memory held by the erasure coding,
+// readahead and inline data buffers of in-flight object requests
+// on this node.
+type objectBufferStats struct {
+	// All fields must be accessed atomically and aligned.
+	inUse    int64
+	rejected uint64
+}
+
+var globalObjectBufferStats objectBufferStats
+
+// track accounts n bytes as held until the returned function is called.
+func (s *objectBufferStats) track(n int64) func() {
+	if n <= 0 {
+		return func() {}
+	}
+	atomic.AddInt64(&s.inUse, n)
+	return func() {
+		atomic.AddInt64(&s.inUse, -n)
+	}
+}
+
+// bytesInUse returns the bytes currently held by in-flight object requests.
+func (s *objectBufferStats) bytesInUse() int64 {
+	return atomic.LoadInt64(&s.inUse)
+}
+
+// overWatermark returns true if the memory held by in-flight object
+// requests is at or above the configured api buffer_memory_watermark.
+func (s *objectBufferStats) overWatermark() bool {
+	watermark := globalAPIConfig.getBufferMemoryWatermark()
+	return watermark > 0 && s.bytesInUse() >= watermark
+}
+
+// incRejected counts a request rejected for being over the watermark.
+func (s *objectBufferStats) incRejected() {
+	atomic.AddUint64(&s.rejected, 1)
+}
+
+// rejectedRequests returns the number of requests rejected for
+// being over the watermark since server start.
+func (s *objectBufferStats) rejectedRequests() uint64 {
+	return atomic.LoadUint64(&s.rejected)
+}
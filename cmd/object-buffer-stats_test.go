@@ -0,0 +1,149 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func setBufferMemoryWatermark(watermark int64) {
+	globalAPIConfig.mu.Lock()
+	globalAPIConfig.bufferMemoryWatermark = watermark
+	globalAPIConfig.mu.Unlock()
+}
+
+func TestObjectBufferStatsTrack(t *testing.T) {
+	var s objectBufferStats
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release := s.track(1 << 20)
+			release()
+		}()
+	}
+	wg.Wait()
+	if n := s.bytesInUse(); n != 0 {
+		t.Fatalf("expected all buffers to be released, got %d bytes in use", n)
+	}
+
+	releaseA := s.track(10)
+	releaseB := s.track(20)
+	s.track(0)()
+	s.track(-1)()
+	if n := s.bytesInUse(); n != 30 {
+		t.Fatalf("expected 30 bytes in use, got %d", n)
+	}
+	releaseA()
+	if n := s.bytesInUse(); n != 20 {
+		t.Fatalf("expected 20 bytes in use, got %d", n)
+	}
+	releaseB()
+	if n := s.bytesInUse(); n != 0 {
+		t.Fatalf("expected 0 bytes in use, got %d", n)
+	}
+}
+
+func TestMaxBuffersWatermark(t *testing.T) {
+	defer setBufferMemoryWatermark(globalAPIConfig.getBufferMemoryWatermark())
+
+	testCases := []struct {
+		watermark    int64
+		inUse        int64
+		expectReject bool
+	}{
+		{0, 0, false},
+		{0, 1 << 40, false},
+		{1 << 20, 0, false},
+		{1 << 20, 1<<20 - 1, false},
+		{1 << 20, 1 << 20, true},
+		{1 << 20, 1 << 30, true},
+	}
+
+	for i, testCase := range testCases {
+		setBufferMemoryWatermark(testCase.watermark)
+		release := globalObjectBufferStats.track(testCase.inUse)
+		rejectedBefore := globalObjectBufferStats.rejectedRequests()
+
+		var called bool
+		handler := maxClients(maxBuffers(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodPut, "/bucket/object", nil))
+		release()
+
+		rejected := globalObjectBufferStats.rejectedRequests() - rejectedBefore
+		if !testCase.expectReject {
+			if !called || rec.Code != http.StatusOK || rejected != 0 {
+				t.Errorf("Test %d: expected request to be served, got status %d", i+1, rec.Code)
+			}
+			continue
+		}
+
+		if called {
+			t.Errorf("Test %d: expected request to be rejected before reaching the handler", i+1)
+		}
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Errorf("Test %d: expected status %d, got %d", i+1, http.StatusServiceUnavailable, rec.Code)
+		}
+		if rec.Header().Get(xhttp.RetryAfter) == "" {
+			t.Errorf("Test %d: expected %s header to be set", i+1, xhttp.RetryAfter)
+		}
+		var errResp APIErrorResponse
+		if err := xml.Unmarshal(rec.Body.Bytes(), &errResp); err != nil {
+			t.Fatalf("Test %d: unable to parse error response: %v", i+1, err)
+		}
+		if errResp.Code != "SlowDown" {
+			t.Errorf("Test %d: expected SlowDown, got %s", i+1, errResp.Code)
+		}
+		if rejected != 1 {
+			t.Errorf("Test %d: expected 1 rejected request, got %d", i+1, rejected)
+		}
+	}
+
+	// Requests which don't hold object buffers are never rejected.
+	setBufferMemoryWatermark(1 << 20)
+	release := globalObjectBufferStats.track(1 << 30)
+	var called bool
+	handler := maxClients(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/bucket/object?tagging", nil))
+	release()
+	if !called || rec.Code != http.StatusOK {
+		t.Errorf("expected request without buffers to be served, got status %d", rec.Code)
+	}
+
+	// Requests are admitted again once buffers are released.
+	if globalObjectBufferStats.overWatermark() {
+		t.Fatal("expected usage to be below the watermark after release")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/minio/minio/internal/amztime"
@@ -232,6 +233,7 @@ func checkPreconditions(ctx context.Context, w http.ResponseWriter, r *http.Requ
 		if objInfo.ETag != "" {
 			w.Header()[xhttp.ETag] = []string{"\"" + objInfo.ETag + "\""}
 		}
+		setWeakETagHeader(w, objInfo)
 	}
 
 	// Check if the part number is correct.
@@ -285,7 +287,7 @@ func checkPreconditions(ctx context.Context, w http.ResponseWriter, r *http.Requ
 	// one specified otherwise, return a 304 (not modified).
 	ifNoneMatchETagHeader := r.Header.Get(xhttp.IfNoneMatch)
 	if ifNoneMatchETagHeader != "" {
-		if isETagEqual(objInfo.ETag, ifNoneMatchETagHeader) {
+		if isETagEqual(objInfo.ETag, ifNoneMatchETagHeader) || isWeakETagEqual(objInfo, ifNoneMatchETagHeader) {
 			// If the object ETag matches with the specified ETag.
 			writeHeaders()
 			w.WriteHeader(http.StatusNotModified)
@@ -309,6 +311,16 @@ func canonicalizeETag(etag string) string {
 	return etagRegex.ReplaceAllString(etag, "$1")
 }
 
+// isWeakETagEqual returns true if etag matches the weak ETag stored
+// for a multipart object, using the weak comparison of If-None-Match.
+func isWeakETagEqual(objInfo ObjectInfo, etag string) bool {
+	weak := objInfo.UserDefined[multipartWeakETagKey]
+	if weak == "" {
+		return false
+	}
+	return isETagEqual(strings.TrimPrefix(weak, "W/"), strings.TrimPrefix(strings.TrimSpace(etag), "W/"))
+}
+
 // isETagEqual return true if the canonical representations of two ETag strings
 // are equal, false otherwise
 func isETagEqual(left, right string) bool {
@@ -379,3 +391,66 @@ func deleteObjectVersions(ctx context.Context, o ObjectLayer, bucket string, toD
 		}
 	}
 }
+
+// expireNoncurrentVersions deletes the noncurrent versions of an object
+// listed in fivs, noncurrent delete markers included. Versions under
+// retention or legal hold and quarantined versions are kept. It returns
+// the number of versions deleted and the number of versions kept.
+func expireNoncurrentVersions(ctx context.Context, o ObjectLayer, bucket, object string, fivs []FileInfo) (expired, skipped int) {
+	rcfg, _ := globalBucketObjectLockSys.Get(bucket)
+	vc, _ := globalBucketVersioningSys.Get(bucket)
+	versioned := vc.PrefixEnabled(object)
+
+	toDel := make([]ObjectToDelete, 0, len(fivs))
+	for _, fi := range fivs {
+		if fi.IsLatest || fi.TierFreeVersion() {
+			continue
+		}
+		if globalQuarantineSys.skip(ctx, quarantineSkipILM, bucket, object, fi.VersionID) ||
+			(rcfg.LockEnabled && enforceRetentionForDeletion(ctx, fi.ToObjectInfo(bucket, object, versioned))) {
+			skipped++
+			continue
+		}
+		versionID := fi.VersionID
+		if versionID == "" {
+			versionID = nullVersionID
+		}
+		toDel = append(toDel, ObjectToDelete{
+			ObjectV: ObjectV{
+				ObjectName: object,
+				VersionID:  versionID,
+			},
+		})
+	}
+
+	for len(toDel) > 0 {
+		batch := toDel
+		if len(batch) > maxDeleteList {
+			batch = batch[:maxDeleteList]
+		}
+		toDel = toDel[len(batch):]
+		deletedObjs, errs := o.DeleteObjects(ctx, bucket, batch, ObjectOptions{
+			PrefixEnabledFn:  vc.PrefixEnabled,
+			VersionSuspended: vc.Suspended(),
+		})
+		for i, err := range errs {
+			if err != nil {
+				logger.LogIf(ctx, err)
+				skipped++
+				continue
+			}
+			expired++
+			dobj := deletedObjs[i]
+			sendEvent(eventArgs{
+				EventName:  event.ObjectRemovedDelete,
+				BucketName: bucket,
+				Object: ObjectInfo{
+					Name:      dobj.ObjectName,
+					VersionID: dobj.VersionID,
+				},
+				Host: "Internal: [Admin-Expiry]",
+			})
+		}
+	}
+	return expired, skipped
+}
@@ -18,6 +18,8 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
 	"testing"
 )
 
@@ -51,3 +53,46 @@ func TestCanonicalizeETag(t *testing.T) {
 		}
 	}
 }
+
+func TestExpireNoncurrentVersions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket, object := "bucket", "object"
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{VersioningEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	var latest string
+	for i := 0; i < 3; i++ {
+		oi, err := obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader([]byte("abcd")), 4, "", ""), ObjectOptions{Versioned: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		latest = oi.VersionID
+	}
+
+	z := obj.(*erasureServerPools)
+	fivs, err := z.ListObjectVersionsRaw(ctx, bucket, object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expired, skipped := expireNoncurrentVersions(ctx, obj, bucket, object, fivs); expired != 2 || skipped != 0 {
+		t.Fatalf("expected 2 expired and 0 skipped versions, got %d and %d", expired, skipped)
+	}
+
+	fivs, err = z.ListObjectVersionsRaw(ctx, bucket, object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fivs) != 1 || fivs[0].VersionID != latest {
+		t.Fatalf("expected only the current version %s to be kept, got %d versions", latest, len(fivs))
+	}
+}
@@ -326,6 +326,7 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
+	opts.NoDecompression = isNoDecompressionRequested(r.Header)
 
 	// Check for auth type to return S3 compatible error.
 	// type to return the correct error (NoSuchKey vs AccessDenied)
@@ -366,7 +367,7 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 	}
 
 	getObjectNInfo := objectAPI.GetObjectNInfo
-	if api.CacheAPI() != nil {
+	if api.CacheAPI() != nil && !opts.NoDecompression {
 		getObjectNInfo = api.CacheAPI().GetObjectNInfo
 	}
 
@@ -401,6 +402,10 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 		return checkPreconditions(ctx, w, r, oi, opts)
 	}
 
+	// Canceled when the stream is aborted with the admin API.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	var proxy proxyResult
 	gr, err := getObjectNInfo(ctx, bucket, object, rs, r.Header, readLock, opts)
 	if err != nil {
@@ -423,6 +428,24 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 				gr = reader
 			}
 		}
+		if (reader == nil || !proxy.Proxy) && proxy.Err == nil {
+			var loi ObjectInfo
+			if gr != nil {
+				loi = gr.ObjInfo
+			}
+			// serve objects not yet copied locally from the read-through remote, if configured.
+			reader, proxy, perr = proxyGetToReadThroughRemote(ctx, bucket, object, rs, r.Header, loi, err, opts)
+			if perr != nil {
+				proxyGetErr := ErrorRespToObjectError(perr, bucket, object)
+				if !isErrBucketNotFound(proxyGetErr) && !isErrObjectNotFound(proxyGetErr) && !isErrVersionNotFound(proxyGetErr) &&
+					!isErrPreconditionFailed(proxyGetErr) && !isErrInvalidRange(proxyGetErr) {
+					logger.LogIf(ctx, fmt.Errorf("Proxying request (read-through) failed for %s/%s(%s) - %w", bucket, object, opts.VersionID, perr))
+				}
+			}
+			if reader != nil && proxy.Proxy && perr == nil {
+				gr = reader
+			}
+		}
 		if reader == nil || !proxy.Proxy {
 			// validate if the request indeed was authorized, if it wasn't we need to return "ErrAccessDenied"
 			// instead of any namespace related error.
@@ -535,8 +558,11 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 		w.WriteHeader(http.StatusPartialContent)
 	}
 
+	stream, done := globalObjectReadStreams.add(ctx, bucket, object, gr, cancel)
+	defer done()
+
 	// Write object content to response body
-	if _, err = xioutil.Copy(httpWriter, gr); err != nil {
+	if _, err = xioutil.Copy(httpWriter, stream); err != nil {
 		if !httpWriter.HasWritten() && !statusCodeWritten {
 			// write error response only if no data or headers has been written to client yet
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -569,6 +595,8 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
+
+	maybeExtendActiveRetention(objectAPI, bucket, objInfo)
 }
 
 // GetObjectHandler - GET Object
@@ -620,6 +648,7 @@ func (api objectAPIHandlers) headObjectHandler(ctx context.Context, objectAPI Ob
 		writeErrorResponseHeadersOnly(w, toAPIError(ctx, err))
 		return
 	}
+	opts.NoDecompression = isNoDecompressionRequested(r.Header)
 
 	// Check for auth type to return S3 compatible error.
 	// type to return the correct error (NoSuchKey vs AccessDenied)
@@ -682,6 +711,21 @@ func (api objectAPIHandlers) headObjectHandler(ctx context.Context, objectAPI Ob
 				return
 			}
 		}
+		// proxy HEAD to the read-through remote if the object was not yet copied locally
+		if !proxy.Proxy {
+			if rangeHeader != "" && rs == nil {
+				rs, _ = parseRequestRangeSpec(rangeHeader)
+			}
+			var oi ObjectInfo
+			oi, proxy = proxyHeadToReadThroughRemote(ctx, bucket, object, rs, objInfo, err, opts)
+			if proxy.Proxy {
+				objInfo = oi
+			}
+			if proxy.Err != nil {
+				writeErrorResponse(ctx, w, toAPIError(ctx, proxy.Err), r.URL)
+				return
+			}
+		}
 	}
 
 	if objInfo.UserTags != "" {
@@ -834,6 +878,8 @@ func (api objectAPIHandlers) headObjectHandler(ctx context.Context, objectAPI Ob
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
+
+	maybeExtendActiveRetention(objectAPI, bucket, objInfo)
 }
 
 // HeadObjectHandler - HEAD Object
@@ -864,6 +910,39 @@ func (api objectAPIHandlers) HeadObjectHandler(w http.ResponseWriter, r *http.Re
 	}
 }
 
+// isSameEncryption returns true if the object with the metadata is
+// encrypted with SSE-S3 or SSE-KMS as requested by the SSE headers, such
+// that it can be copied onto itself without re-encrypting the data.
+func isSameEncryption(ctx context.Context, metadata map[string]string, h http.Header) bool {
+	switch {
+	case crypto.SSEC.IsRequested(h):
+		return false
+	case crypto.S3.IsRequested(h):
+		return crypto.S3.IsEncrypted(metadata)
+	case crypto.S3KMS.IsRequested(h):
+		if !crypto.S3KMS.IsEncrypted(metadata) {
+			return false
+		}
+		keyID, kmsCtx, err := crypto.S3KMS.ParseHTTP(h)
+		if err != nil || len(kmsCtx) > 0 {
+			return false
+		}
+		if keyID == "" {
+			if GlobalKMS == nil {
+				return false
+			}
+			stat, err := GlobalKMS.Stat(ctx)
+			if err != nil {
+				return false
+			}
+			keyID = stat.DefaultKey
+		}
+		return keyID == metadata[crypto.MetaKeyID]
+	default:
+		return false
+	}
+}
+
 // Extract metadata relevant for an CopyObject operation based on conditional
 // header values specified in X-Amz-Metadata-Directive.
 func getCpObjMetadataFromHeader(ctx context.Context, r *http.Request, userMeta map[string]string) (map[string]string, error) {
@@ -999,6 +1078,16 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if s3Error := checkBucketState(ctx, objectAPI, r, dstBucket, dstObject, "", bucketStateOpWrite); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	if err = checkObjectNameDepth(dstBucket, dstObject); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
 	// Read escaped copy source path to check for parameters.
 	cpSrcPath := r.Header.Get(xhttp.AmzCopySource)
 	var vid string
@@ -1051,7 +1140,9 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// Check if bucket encryption is enabled
+	// Check if bucket encryption is enabled, the destination
+	// default applies unless the client sent SSE headers.
+	explicitEncryption := crypto.Requested(r.Header)
 	sseConfig, _ := globalBucketSSEConfigSys.Get(dstBucket)
 	sseConfig.Apply(r.Header, sse.ApplyOptions{
 		AutoEncrypt: globalAutoEncryption,
@@ -1151,6 +1242,24 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		srcInfo.metadataOnly = false
 	} // no changes in storage-class expected so its a metadataonly operation.
 
+	// Copying an object onto itself in a bucket whose default encryption
+	// matches the one of the object keeps the data and its sealed keys.
+	var srcInternalMetadata map[string]string
+	sameEncryption := cpSrcDstSame && !explicitEncryption && !chStorageClass &&
+		isSameEncryption(ctx, srcInfo.UserDefined, r.Header)
+	if sameEncryption {
+		srcInternalMetadata = make(map[string]string)
+		for k, v := range srcInfo.UserDefined {
+			switch {
+			case strings.HasPrefix(k, ReservedMetadataPrefix+"Server-Side-Encryption-"),
+				k == crypto.MetaMultipart,
+				k == ReservedMetadataPrefix+"compression",
+				k == ReservedMetadataPrefix+"actual-size":
+				srcInternalMetadata[k] = v
+			}
+		}
+	}
+
 	var reader io.Reader = gr
 
 	// Set the actual size to the compressed/decrypted size if encrypted.
@@ -1171,6 +1280,9 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 	// Check if either the source is encrypted or the destination will be encrypted.
 	objectEncryption := crypto.Requested(r.Header)
 	objectEncryption = objectEncryption || crypto.IsSourceEncrypted(srcInfo.UserDefined)
+	if sameEncryption {
+		objectEncryption = false
+	}
 
 	var compressMetadata map[string]string
 	// No need to compress for remote etcd calls
@@ -1252,7 +1364,13 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 	// - the object is encrypted using SSE-S3 and the SSE-S3 header is present
 	// - the object storage class is not changing
 	// then execute a key rotation.
-	if cpSrcDstSame && (sseCopyC && sseC) && !chStorageClass {
+	if sameEncryption {
+		// Nothing to re-encrypt, preserve the encryption
+		// metadata even if the metadata is replaced.
+		for k, v := range srcInternalMetadata {
+			encMetadata[k] = v
+		}
+	} else if cpSrcDstSame && (sseCopyC && sseC) && !chStorageClass {
 		oldKey, err = ParseSSECopyCustomerRequest(r.Header, srcInfo.UserDefined)
 		if err != nil {
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -1602,6 +1720,11 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if err = checkObjectNameDepth(bucket, object); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
 	// Validate storage class metadata if present
 	if sc := r.Header.Get(xhttp.AmzStorageClass); sc != "" {
 		if !storageclass.IsValid(sc) {
@@ -1697,6 +1820,11 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		}
 	}
 
+	if s3Err = checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpWrite); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
 	if err := enforceBucketQuotaHard(ctx, bucket, size); err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
@@ -1717,9 +1845,20 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		AutoEncrypt: globalAutoEncryption,
 	})
 
+	// MinIO extension, append the body to the object as a new segment.
+	var appendCfg BucketAppendConfig
+	appendObj := r.Header.Get(xhttp.MinIOAppend) == "true"
+	if appendObj {
+		appendCfg = bucketAppendConfig(ctx, bucket)
+		if err := checkAppendObjectAllowed(ctx, r, bucket, appendCfg); err != nil {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErrWithErr(ErrObjectNotAppendable, err), r.URL)
+			return
+		}
+	}
+
 	actualSize := size
 	var idxCb func() []byte
-	if isCompressible(r.Header, object) && size > minCompressibleSize {
+	if !appendObj && isCompressible(r.Header, object) && size > minCompressibleSize {
 		// Storing the compression metadata.
 		metadata[ReservedMetadataPrefix+"compression"] = compressionAlgorithmV2
 		metadata[ReservedMetadataPrefix+"actual-size"] = strconv.FormatInt(size, 10)
@@ -1765,6 +1904,12 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 	opts.IndexCB = idxCb
+	if appendObj {
+		opts.Append = AppendOptions{
+			Enabled:     true,
+			MaxSegments: appendCfg.maxSegments(),
+		}
+	}
 
 	if (!opts.MTime.IsZero() && opts.PreserveETag != "") ||
 		r.Header.Get(xhttp.IfMatch) != "" ||
@@ -1778,7 +1923,7 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		}
 	}
 
-	if api.CacheAPI() != nil {
+	if api.CacheAPI() != nil && !appendObj {
 		putObject = api.CacheAPI().PutObject
 	}
 
@@ -1802,6 +1947,24 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
 		return
 	}
+
+	// Skip writing if the bucket opted in and the latest version is
+	// identical, SSE-C and object lock updates are never skipped.
+	var (
+		skippedOverwrite bool
+		skippedETag      string
+	)
+	if !appendObj && opts.VersionID == "" && !retentionMode.Valid() && !legalHold.Status.Valid() &&
+		!crypto.SSEC.IsRequested(r.Header) && r.Header.Get(xMinIOExtract) != "true" &&
+		r.Header.Get(xhttp.AmzBucketReplicationStatus) != replication.Replica.String() &&
+		skipIdenticalOverwriteEnabled(ctx, bucket) {
+		wantMD5 := clientETag.String()
+		opts.SkipIdenticalFn = func(oi ObjectInfo) bool {
+			skippedETag, skippedOverwrite = isIdenticalOverwrite(ctx, oi, r.Header, metadata, actualSize, wantMD5, opts.WantChecksum)
+			return skippedOverwrite
+		}
+	}
+
 	if dsc := mustReplicate(ctx, bucket, object, getMustReplicateOptions(ObjectInfo{
 		UserDefined: metadata,
 	}, replication.ObjectReplicationType, opts)); dsc.ReplicateAny() {
@@ -1868,6 +2031,28 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if skippedOverwrite {
+		// Nothing was written, reply with the latest version as is.
+		globalBucketConnStats.incSkippedOverwrites(bucket, actualSize)
+		if kind, encrypted := crypto.IsEncrypted(objInfo.UserDefined); encrypted {
+			switch kind {
+			case crypto.S3:
+				w.Header().Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionAES)
+			case crypto.S3KMS:
+				w.Header().Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionKMS)
+				w.Header().Set(xhttp.AmzServerSideEncryptionKmsID, objInfo.KMSKeyID())
+				if kmsCtx, ok := objInfo.UserDefined[crypto.MetaContext]; ok {
+					w.Header().Set(xhttp.AmzServerSideEncryptionKmsContext, kmsCtx)
+				}
+			}
+		}
+		objInfo.ETag = skippedETag
+		w.Header().Set(xhttp.MinIOIdenticalOverwriteSkipped, "true")
+		setPutObjHeaders(w, objInfo, false)
+		writeSuccessResponseHeadersOnly(w)
+		return
+	}
+
 	if r.Header.Get(xMinIOExtract) == "true" && HasSuffix(object, archiveExt) {
 		opts := ObjectOptions{VersionID: objInfo.VersionID, MTime: objInfo.ModTime}
 		if _, err := updateObjectMetadataWithZipInfo(ctx, objectAPI, bucket, object, opts); err != nil {
@@ -2051,6 +2236,13 @@ func (api objectAPIHandlers) PutObjectExtractHandler(w http.ResponseWriter, r *h
 		}
 	}
 
+	// The objects of the archive are checked against write-once as
+	// they are extracted.
+	if s3Err = checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpUpdate); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
 	hreader, err := hash.NewReader(reader, size, md5hex, sha256hex, size)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -2085,6 +2277,10 @@ func (api objectAPIHandlers) PutObjectExtractHandler(w http.ResponseWriter, r *h
 	}
 
 	putObjectTar := func(reader io.Reader, info os.FileInfo, object string) error {
+		if err := checkObjectNameDepth(bucket, object); err != nil {
+			return err
+		}
+
 		size := info.Size()
 		metadata := map[string]string{
 			xhttp.AmzStorageClass: sc,
@@ -2119,6 +2315,11 @@ func (api objectAPIHandlers) PutObjectExtractHandler(w http.ResponseWriter, r *h
 		rawReader := hashReader
 		pReader := NewPutObjReader(rawReader)
 
+		if s3err := checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpWrite); s3err != ErrNone {
+			s3Err = s3err
+			return errBucketStateRejected
+		}
+
 		if r.Header.Get(xhttp.AmzBucketReplicationStatus) == replication.Replica.String() {
 			if s3Err = isPutActionAllowed(ctx, getRequestAuthType(r), bucket, object, r, iampolicy.ReplicateObjectAction); s3Err != ErrNone {
 				return err
@@ -2269,6 +2470,11 @@ func (api objectAPIHandlers) DeleteObjectHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if s3Error := checkBucketState(ctx, objectAPI, r, bucket, object, r.Form.Get(xhttp.VersionID), bucketStateOpDelete); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
 	if globalDNSConfig != nil {
 		_, err := globalDNSConfig.Get(bucket)
 		if err != nil && err != dns.ErrNotImplemented {
@@ -2360,8 +2566,10 @@ func (api objectAPIHandlers) DeleteObjectHandler(w http.ResponseWriter, r *http.
 	// http://docs.aws.amazon.com/AmazonS3/latest/API/RESTObjectDELETE.html
 	objInfo, err := deleteObject(ctx, bucket, object, opts)
 	if err != nil {
-		if _, ok := err.(BucketNotFound); ok {
-			// When bucket doesn't exist specially handle it.
+		switch err.(type) {
+		case BucketNotFound, PreConditionFailed:
+			// When bucket doesn't exist or a newer version
+			// replaced the requested version specially handle it.
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 			return
 		}
@@ -2446,6 +2654,11 @@ func (api objectAPIHandlers) PutObjectLegalHoldHandler(w http.ResponseWriter, r
 		return
 	}
 
+	if s3Err := checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpUpdate); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
 	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
@@ -2606,6 +2819,11 @@ func (api objectAPIHandlers) PutObjectRetentionHandler(w http.ResponseWriter, r
 		return
 	}
 
+	if s3Err := checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpUpdate); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
 	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
@@ -2850,6 +3068,11 @@ func (api objectAPIHandlers) PutObjectTaggingHandler(w http.ResponseWriter, r *h
 		return
 	}
 
+	if s3Error := checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpUpdate); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
 	opts, err := getOpts(ctx, r, bucket, object)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -2943,6 +3166,11 @@ func (api objectAPIHandlers) DeleteObjectTaggingHandler(w http.ResponseWriter, r
 		return
 	}
 
+	if s3Error := checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpUpdate); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
 	dsc := mustReplicate(ctx, bucket, object, getMustReplicateOptions(oi, replication.MetadataReplicationType, opts))
 	if dsc.ReplicateAny() {
 		opts.UserDefined = make(map[string]string)
@@ -3007,6 +3235,11 @@ func (api objectAPIHandlers) PostRestoreObjectHandler(w http.ResponseWriter, r *
 		return
 	}
 
+	if s3Error := checkBucketState(ctx, objectAPI, r, bucket, object, "", bucketStateOpUpdate); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
 	if r.ContentLength <= 0 {
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrEmptyRequestBody), r.URL)
 		return
@@ -41,9 +41,11 @@ import (
 
 	"github.com/dustin/go-humanize"
 	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/hash/sha256"
 	xhttp "github.com/minio/minio/internal/http"
 	ioutilx "github.com/minio/minio/internal/ioutil"
+	"github.com/minio/minio/internal/kms"
 )
 
 // Type to capture different modifications to API request to simulate failure cases.
@@ -831,6 +833,252 @@ func testAPIGetObjectWithMPHandler(obj ObjectLayer, instanceType, bucketName str
 	ExecObjectLayerAPINilTest(t, nilBucket, nilObject, instanceType, apiRouter, nilReq)
 }
 
+// Wrapper for calling ranged GetObject API handler tests on compressed objects,
+// with and without SSE-S3, for both Erasure multiple disks and FS single drive setup.
+func TestAPIGetCompressedObjectRangeHandler(t *testing.T) {
+	globalPolicySys = NewPolicySys()
+	defer func() { globalPolicySys = nil }()
+
+	defer DetectTestLeak(t)()
+	defer resetCompressEncryption()
+
+	for _, encrypt := range []bool{false, true} {
+		t.Run(fmt.Sprintf("encrypted=%v", encrypt), func(t *testing.T) {
+			resetCompressEncryption()
+			enableCompression(t, encrypt)
+			ExecObjectLayerAPITest(t, testAPIGetCompressedObjectRangeHandler, []string{"NewMultipart", "PutObjectPart", "CompleteMultipart", "GetObject", "PutObject"})
+		})
+	}
+}
+
+func testAPIGetCompressedObjectRangeHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	var oneMiB int64 = 1024 * 1024
+
+	// Parts above compMinIndexSize are stored with a compression index.
+	objectInputs := []struct {
+		objectName  string
+		partLengths []int64
+	}{
+		{"single", []int64{20 * oneMiB}},
+		{"mp", []int64{12 * oneMiB, 10*oneMiB + 13, 7}},
+	}
+
+	for _, input := range objectInputs {
+		uploadTestObject(t, apiRouter, credentials, bucketName, input.objectName, input.partLengths, nil, false)
+
+		oinfo, err := obj.GetObjectInfo(context.Background(), bucketName, input.objectName, ObjectOptions{})
+		if err != nil {
+			t.Fatalf("%s: Object: %s: Unexpected err: %v", instanceType, input.objectName, err)
+		}
+		if !oinfo.IsCompressed() {
+			t.Fatalf("%s: Object: %s: expected object to be compressed", instanceType, input.objectName)
+		}
+		if _, encrypted := crypto.IsEncrypted(oinfo.UserDefined); encrypted != globalAutoEncryption {
+			t.Fatalf("%s: Object: %s: expected encrypted %v, got %v", instanceType, input.objectName, globalAutoEncryption, encrypted)
+		}
+		for i, part := range oinfo.Parts {
+			if wantIdx := input.partLengths[i] > compMinIndexSize; wantIdx != (len(part.Index) > 0) {
+				t.Fatalf("%s: Object: %s: part %d: expected index %v, got %d bytes", instanceType, input.objectName, part.Number, wantIdx, len(part.Index))
+			}
+		}
+
+		var objLen int64
+		for _, l := range input.partLengths {
+			objLen += l
+		}
+		rangeHdrs := []string{
+			// Read the tail of the object
+			fmt.Sprintf("bytes=-%d", 1),
+			fmt.Sprintf("bytes=-%d", 100*humanize.KiByte),
+			// Read from the middle of the object to the end
+			fmt.Sprintf("bytes=%d-", objLen/2+5),
+			// Read across the part boundary
+			fmt.Sprintf("bytes=%d-%d", 12*oneMiB-17, 12*oneMiB+17),
+			// Read a small range deep into the object
+			fmt.Sprintf("bytes=%d-%d", objLen-3*oneMiB+1, objLen-3*oneMiB+4096),
+		}
+		for _, rangeHdr := range rangeHdrs {
+			req, err := newTestSignedRequestV4(http.MethodGet, getGetObjectURL("", bucketName, input.objectName),
+				0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+			if err != nil {
+				t.Fatalf("Object: %s ByteRange: %s: Failed to create HTTP request for Get Object: <ERROR> %v",
+					input.objectName, rangeHdr, err)
+			}
+			req.Header.Set("Range", rangeHdr)
+
+			rec := httptest.NewRecorder()
+			apiRouter.ServeHTTP(rec, req)
+			if rec.Code != http.StatusPartialContent {
+				bd, err1 := io.ReadAll(rec.Body)
+				t.Fatalf("%s Object: %s ByteRange: %s: Got response status `%d` and body: %s,%v",
+					instanceType, input.objectName, rangeHdr, rec.Code, string(bd), err1)
+			}
+
+			rs, err := parseRequestRangeSpec(rangeHdr)
+			if err != nil {
+				t.Fatalf("Object: %s ByteRange: %s: Unexpected err: %v", input.objectName, rangeHdr, err)
+			}
+			off, length, err := rs.GetOffsetLength(objLen)
+			if err != nil {
+				t.Fatalf("Object: %s ByteRange: %s: Unexpected err: %v", input.objectName, rangeHdr, err)
+			}
+
+			readers := []io.Reader{}
+			cumulativeSum := int64(0)
+			for _, p := range input.partLengths {
+				readers = append(readers, NewDummyDataGen(p, cumulativeSum))
+				cumulativeSum += p
+			}
+			refReader := io.LimitReader(ioutilx.NewSkipReader(io.MultiReader(readers...), off), length)
+			if ok, msg := cmpReaders(refReader, rec.Body); !ok {
+				t.Fatalf("(%s) Object: %s ByteRange: %s --> data mismatch! (msg: %s)", instanceType, input.objectName, rangeHdr, msg)
+			}
+		}
+	}
+}
+
+// Wrapper for calling CopyObject API handler tests between buckets
+// with different default encryption, for both Erasure multiple disks
+// and FS single drive setup.
+func TestAPICopyObjectBucketEncryptionHandler(t *testing.T) {
+	globalPolicySys = NewPolicySys()
+	defer func() { globalPolicySys = nil }()
+
+	defer DetectTestLeak(t)()
+	defer resetCompressEncryption()
+
+	var err error
+	GlobalKMS, err = kms.Parse("my-minio-key:5lF+0pJM0OWwlQrvK2S/I7W9mO4a6rJJI7wzj7v09cw=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ExecObjectLayerAPITest(t, testAPICopyObjectBucketEncryptionHandler, []string{"CopyObject", "PutObject", "GetObject"})
+}
+
+func testAPICopyObjectBucketEncryptionHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	ctx := context.Background()
+	sseConfigs := []struct {
+		name   string
+		config string
+		kind   crypto.Type
+	}{
+		{name: "none"},
+		{
+			name:   "sse-s3",
+			config: `<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault></Rule></ServerSideEncryptionConfiguration>`,
+			kind:   crypto.S3,
+		},
+		{
+			name:   "sse-kms",
+			config: `<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>aws:kms</SSEAlgorithm><KMSMasterKeyID>my-minio-key</KMSMasterKeyID></ApplyServerSideEncryptionByDefault></Rule></ServerSideEncryptionConfiguration>`,
+			kind:   crypto.S3KMS,
+		},
+	}
+	for _, c := range sseConfigs {
+		bucket := bucketName + "-" + c.name
+		if err := obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+			t.Fatalf("%s: unable to create bucket %s: %v", instanceType, bucket, err)
+		}
+		if c.config == "" {
+			continue
+		}
+		if _, err := globalBucketMetadataSys.Update(ctx, bucket, bucketSSEConfig, []byte(c.config)); err != nil {
+			t.Fatalf("%s: unable to set encryption of bucket %s: %v", instanceType, bucket, err)
+		}
+	}
+
+	checkKind := func(bucket, object string, kind crypto.Type) ObjectInfo {
+		t.Helper()
+		oi, err := obj.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+		if err != nil {
+			t.Fatalf("%s: %s/%s: %v", instanceType, bucket, object, err)
+		}
+		got, encrypted := crypto.IsEncrypted(oi.UserDefined)
+		if encrypted != (kind != nil) || (kind != nil && got != kind) {
+			t.Fatalf("%s: %s/%s: expected encryption %v, got %v", instanceType, bucket, object, kind, got)
+		}
+		return oi
+	}
+	checkData := func(bucket, object string, data []byte) {
+		t.Helper()
+		req, err := newTestSignedRequestV4(http.MethodGet, getGetObjectURL("", bucket, object),
+			0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: GET %s/%s: unexpected status %d: %s", instanceType, bucket, object, rec.Code, rec.Body.String())
+		}
+		if !bytes.Equal(rec.Body.Bytes(), data) {
+			t.Fatalf("%s: GET %s/%s: data mismatch", instanceType, bucket, object)
+		}
+	}
+	copyObject := func(srcBucket, dstBucket, object string, headers map[string]string) {
+		t.Helper()
+		req, err := newTestSignedRequestV4(http.MethodPut, getCopyObjectURL("", dstBucket, object),
+			0, nil, credentials.AccessKey, credentials.SecretKey, headers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(xhttp.AmzCopySource, url.QueryEscape(SlashSeparator+srcBucket+SlashSeparator+object))
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: copy %s -> %s: unexpected status %d: %s", instanceType, srcBucket, dstBucket, rec.Code, rec.Body.String())
+		}
+	}
+
+	data := bytes.Repeat([]byte("abcdefgh"), 16*humanize.KiByte)
+	for _, src := range sseConfigs {
+		srcBucket := bucketName + "-" + src.name
+		for _, dst := range sseConfigs {
+			object := "object-" + src.name + "-" + dst.name
+			req, err := newTestSignedRequestV4(http.MethodPut, getPutObjectURL("", srcBucket, object),
+				int64(len(data)), bytes.NewReader(data), credentials.AccessKey, credentials.SecretKey, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rec := httptest.NewRecorder()
+			apiRouter.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("%s: PUT %s/%s: unexpected status %d: %s", instanceType, srcBucket, object, rec.Code, rec.Body.String())
+			}
+			checkKind(srcBucket, object, src.kind)
+
+			// Copies to other buckets use their default encryption.
+			dstBucket := bucketName + "-" + dst.name
+			copyObject(srcBucket, dstBucket, object, nil)
+			checkKind(dstBucket, object, dst.kind)
+			checkData(dstBucket, object, data)
+		}
+
+		// Replacing the metadata of an object keeps its data and keys
+		// when the encryption does not change.
+		object := "object-" + src.name + "-" + src.name
+		before := checkKind(srcBucket, object, src.kind)
+		copyObject(srcBucket, srcBucket, object, map[string]string{
+			xhttp.AmzMetadataDirective: "REPLACE",
+			"X-Amz-Meta-Replaced":      "true",
+		})
+		after := checkKind(srcBucket, object, src.kind)
+		if after.UserDefined["X-Amz-Meta-Replaced"] != "true" {
+			t.Fatalf("%s: %s/%s: expected metadata to be replaced, got %v", instanceType, srcBucket, object, after.UserDefined)
+		}
+		for _, k := range []string{crypto.MetaSealedKeyS3, crypto.MetaSealedKeyKMS, crypto.MetaDataEncryptionKey} {
+			if before.UserDefined[k] != after.UserDefined[k] {
+				t.Fatalf("%s: %s/%s: expected %s to be kept", instanceType, srcBucket, object, k)
+			}
+		}
+		checkData(srcBucket, object, data)
+	}
+}
+
 // Wrapper for calling GetObject API handler tests for both Erasure multiple disks and FS single drive setup.
 func TestAPIGetObjectWithPartNumberHandler(t *testing.T) {
 	globalPolicySys = NewPolicySys()
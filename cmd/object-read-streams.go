@@ -0,0 +1,140 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio/internal/logger"
+)
+
+var errNoSuchObjectStream = errors.New("no such object stream")
+
+// ObjectReadStream is a GetObject response being streamed by a node.
+type ObjectReadStream struct {
+	Node       string        `json:"node"`
+	ID         string        `json:"id"`
+	Bucket     string        `json:"bucket"`
+	Object     string        `json:"object"`
+	VersionID  string        `json:"versionId,omitempty"`
+	RemoteHost string        `json:"remoteHost,omitempty"`
+	AccessKey  string        `json:"accessKey,omitempty"`
+	BytesSent  int64         `json:"bytesSent"`
+	Started    time.Time     `json:"started"`
+	Duration   time.Duration `json:"duration"`
+}
+
+type objectReadStream struct {
+	ObjectReadStream
+	sent   int64
+	r      io.Reader
+	cancel func()
+}
+
+// Read reads the object, counting the bytes handed to the client.
+func (s *objectReadStream) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	atomic.AddInt64(&s.sent, int64(n))
+	return n, err
+}
+
+// objectReadStreams tracks the GetObject responses being streamed by
+// this node, by request ID.
+type objectReadStreams struct {
+	mu      sync.Mutex
+	streams map[string]*objectReadStream
+}
+
+var globalObjectReadStreams = &objectReadStreams{streams: make(map[string]*objectReadStream)}
+
+// add records the response streaming gr to the client of the request in
+// ctx, cancel aborts it. The response must be read from the returned
+// stream, and the returned function called once it is sent.
+func (s *objectReadStreams) add(ctx context.Context, bucket, object string, gr *GetObjectReader, cancel context.CancelFunc) (*objectReadStream, func()) {
+	st := &objectReadStream{
+		ObjectReadStream: ObjectReadStream{
+			ID:        mustGetUUID(),
+			Bucket:    bucket,
+			Object:    object,
+			VersionID: gr.ObjInfo.VersionID,
+			Started:   UTCNow(),
+		},
+		r: gr,
+		// Closing the reader unblocks the stream waiting on the
+		// drives and releases the read lock of the object.
+		cancel: func() {
+			cancel()
+			gr.Close()
+		},
+	}
+	if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+		if reqInfo.RequestID != "" {
+			st.ID = reqInfo.RequestID
+		}
+		st.RemoteHost = reqInfo.RemoteHost
+		st.AccessKey = reqInfo.Cred.AccessKey
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.streams[st.ID] = st
+	return st, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.streams, st.ID)
+	}
+}
+
+// list returns the responses being streamed, oldest first.
+func (s *objectReadStreams) list() []ObjectReadStream {
+	now := UTCNow()
+
+	s.mu.Lock()
+	streams := make([]ObjectReadStream, 0, len(s.streams))
+	for _, st := range s.streams {
+		rs := st.ObjectReadStream
+		rs.Node = globalLocalNodeName
+		rs.BytesSent = atomic.LoadInt64(&st.sent)
+		rs.Duration = now.Sub(rs.Started)
+		streams = append(streams, rs)
+	}
+	s.mu.Unlock()
+
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].Started.Before(streams[j].Started)
+	})
+	return streams
+}
+
+// cancel aborts the response being streamed with the ID, it returns
+// false if no such response is streamed by this node.
+func (s *objectReadStreams) cancel(id string) bool {
+	s.mu.Lock()
+	st, ok := s.streams[id]
+	s.mu.Unlock()
+	if ok {
+		st.cancel()
+	}
+	return ok
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/minio/minio/internal/logger"
+)
+
+func TestObjectReadStreams(t *testing.T) {
+	s := &objectReadStreams{streams: make(map[string]*objectReadStream)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = logger.SetReqInfo(ctx, &logger.ReqInfo{RequestID: "req-1", RemoteHost: "127.0.0.1"})
+
+	pr, pw := io.Pipe()
+	cleanedUp := false
+	gr := &GetObjectReader{
+		Reader:     pr,
+		ObjInfo:    ObjectInfo{VersionID: "v1"},
+		cleanUpFns: []func(){func() { pr.Close() }, func() { cleanedUp = true }},
+	}
+	stream, done := s.add(ctx, "bucket", "object", gr, cancel)
+	defer done()
+
+	go pw.Write([]byte("data"))
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(stream, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	streams := s.list()
+	if len(streams) != 1 {
+		t.Fatalf("expected 1 stream, got %d", len(streams))
+	}
+	st := streams[0]
+	if st.ID != "req-1" || st.Bucket != "bucket" || st.Object != "object" || st.VersionID != "v1" || st.BytesSent != 4 || st.RemoteHost != "127.0.0.1" {
+		t.Fatalf("unexpected stream %+v", st)
+	}
+
+	if s.cancel("req-2") {
+		t.Fatal("expected an unknown stream not to be canceled")
+	}
+	if !s.cancel("req-1") {
+		t.Fatal("expected the stream to be canceled")
+	}
+	if ctx.Err() == nil || !cleanedUp {
+		t.Fatal("expected the request to be canceled and the reader closed")
+	}
+	if _, err := stream.Read(buf); err == nil {
+		t.Fatal("expected reading a canceled stream to fail")
+	}
+
+	done()
+	if streams = s.list(); len(streams) != 0 {
+		t.Fatalf("expected no streams, got %+v", streams)
+	}
+}
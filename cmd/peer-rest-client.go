@@ -356,6 +356,19 @@ func (client *peerRESTClient) LoadPolicyMapping(userOrGroup string, userType IAM
 	return nil
 }
 
+// LoadElevatedPolicy - reload the policy elevation of a specific user
+func (client *peerRESTClient) LoadElevatedPolicy(user string) error {
+	values := make(url.Values)
+	values.Set(peerRESTUser, user)
+
+	respBody, err := client.call(peerRESTMethodLoadElevatedPolicy, values, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(respBody)
+	return nil
+}
+
 // DeleteUser - delete a specific user.
 func (client *peerRESTClient) DeleteUser(accessKey string) (err error) {
 	values := make(url.Values)
@@ -559,6 +572,60 @@ func (client *peerRESTClient) StopRebalance(ctx context.Context) error {
 	return nil
 }
 
+// RebuildDataUsage - asks the remote node to rebuild the data usage cache,
+// if it is running the data scanner.
+func (client *peerRESTClient) RebuildDataUsage(ctx context.Context) error {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodRebuildDataUsage, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(respBody)
+	return nil
+}
+
+// GetScannerStatus - fetch the scanner state of the drives of a remote node.
+func (client *peerRESTClient) GetScannerStatus(ctx context.Context) (status ScannerNodeStatus, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetScannerStatus, nil, nil, -1)
+	if err != nil {
+		return status, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&status)
+	return status, err
+}
+
+// GetExpiryStatus - fetch the state of the ILM expiry workers of a remote node.
+func (client *peerRESTClient) GetExpiryStatus(ctx context.Context) (status ExpiryStatus, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetExpiryStatus, nil, nil, -1)
+	if err != nil {
+		return status, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&status)
+	return status, err
+}
+
+// LoadQuarantine - asks a remote node to reload the quarantine list.
+func (client *peerRESTClient) LoadQuarantine(ctx context.Context) error {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodLoadQuarantine, nil, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(respBody)
+	return nil
+}
+
+// FormatCheck - fetch the placement of the local drives of a remote node.
+func (client *peerRESTClient) FormatCheck(ctx context.Context) (status FormatCheckNodeStatus, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodFormatCheck, nil, nil, -1)
+	if err != nil {
+		return status, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&status)
+	return status, err
+}
+
 func (client *peerRESTClient) LoadRebalanceMeta(ctx context.Context, startRebalance bool) error {
 	values := url.Values{}
 	values.Set(peerRESTStartRebalance, strconv.FormatBool(startRebalance))
@@ -924,6 +991,165 @@ func (client *peerRESTClient) GetLastDayTierStats(ctx context.Context) (DailyAll
 	return DailyAllTierStats(result), nil
 }
 
+// GetClusterJobs - fetch the cluster jobs controlled by a remote node.
+func (client *peerRESTClient) GetClusterJobs(ctx context.Context, history bool) (jobs []ClusterJobInfo, err error) {
+	values := make(url.Values)
+	values.Set(peerRESTHistory, strconv.FormatBool(history))
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetClusterJobs, values, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&jobs)
+	return jobs, err
+}
+
+// GetMRFEntries - fetch the partial operations queued for healing on a remote node.
+func (client *peerRESTClient) GetMRFEntries(ctx context.Context) (entries []MRFEntry, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetMRFEntries, nil, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&entries)
+	return entries, err
+}
+
+// GetRequestTimings - fetch the timing breakdown of the recent sampled
+// requests of a remote node.
+func (client *peerRESTClient) GetRequestTimings(ctx context.Context) (timings []RequestTiming, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetRequestTimings, nil, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&timings)
+	return timings, err
+}
+
+// GetClock - fetch the current time of a remote node.
+func (client *peerRESTClient) GetClock(ctx context.Context) (t time.Time, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetClock, nil, nil, -1)
+	if err != nil {
+		return t, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&t)
+	return t, err
+}
+
+// Drain - starts or stops draining a remote node, returns its drain state.
+func (client *peerRESTClient) Drain(ctx context.Context, drain bool) (status NodeDrainStatus, err error) {
+	values := url.Values{}
+	values.Set(peerRESTDrain, strconv.FormatBool(drain))
+	respBody, err := client.callWithContext(ctx, peerRESTMethodDrain, values, nil, -1)
+	if err != nil {
+		return status, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&status)
+	return status, err
+}
+
+// GetLockHolders - fetch the holders of the lock on a resource taken by a remote node.
+func (client *peerRESTClient) GetLockHolders(ctx context.Context, resource string) (holders []LockHolder, err error) {
+	values := url.Values{}
+	values.Set(peerRESTResource, resource)
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetLockHolders, values, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&holders)
+	return holders, err
+}
+
+// GetObjectReadStreams - fetch the GetObject responses being streamed by a remote node.
+func (client *peerRESTClient) GetObjectReadStreams(ctx context.Context) (streams []ObjectReadStream, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetObjectReadStreams, nil, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&streams)
+	return streams, err
+}
+
+// CancelObjectReadStream - abort a GetObject response being streamed by a remote node.
+func (client *peerRESTClient) CancelObjectReadStream(ctx context.Context, id string) (canceled bool, err error) {
+	values := url.Values{}
+	values.Set(peerRESTStreamID, id)
+	respBody, err := client.callWithContext(ctx, peerRESTMethodCancelObjectReadStream, values, nil, -1)
+	if err != nil {
+		return false, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&canceled)
+	return canceled, err
+}
+
+// GetGuardrailStatus - fetch the load shedding state of a remote node.
+func (client *peerRESTClient) GetGuardrailStatus(ctx context.Context) (status GuardrailStatus, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetGuardrailStatus, nil, nil, -1)
+	if err != nil {
+		return status, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&status)
+	return status, err
+}
+
+// GetServerFeatures - fetch the features enabled on a remote node.
+func (client *peerRESTClient) GetServerFeatures(ctx context.Context) (features ServerFeatures, err error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetServerFeatures, nil, nil, -1)
+	if err != nil {
+		return features, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&features)
+	return features, err
+}
+
+// GetBootstrapTrace - fetch the startup events recorded by a remote node.
+func (client *peerRESTClient) GetBootstrapTrace(ctx context.Context, clear bool) (events []madmin.TraceInfo, err error) {
+	values := url.Values{}
+	values.Set(peerRESTClear, strconv.FormatBool(clear))
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetBootstrapTrace, values, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&events)
+	return events, err
+}
+
+// GetHealSequences - fetch the heal sequences registered on a remote node,
+// ended sequences are included if inclEnded is set.
+func (client *peerRESTClient) GetHealSequences(ctx context.Context, inclEnded bool) (seqs []HealSequenceSummary, err error) {
+	values := url.Values{}
+	values.Set(peerRESTInclEnded, strconv.FormatBool(inclEnded))
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetHealSequences, values, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&seqs)
+	return seqs, err
+}
+
+// StopHealSequence - stop the heal sequence with the client token on a remote node.
+func (client *peerRESTClient) StopHealSequence(ctx context.Context, token string) (stopped bool, err error) {
+	values := url.Values{}
+	values.Set(peerRESTClientToken, token)
+	respBody, err := client.callWithContext(ctx, peerRESTMethodStopHealSequence, values, nil, -1)
+	if err != nil {
+		return false, err
+	}
+	defer xhttp.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&stopped)
+	return stopped, err
+}
+
 // DevNull - Used by netperf to pump data to peer
 func (client *peerRESTClient) DevNull(ctx context.Context, r io.Reader) error {
 	respBody, err := client.callWithContext(ctx, peerRESTMethodDevNull, nil, r, -1)
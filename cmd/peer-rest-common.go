@@ -18,7 +18,7 @@
 package cmd
 
 const (
-	peerRESTVersion = "v30" // Removed bloom filter
+	peerRESTVersion = "v47" // Added server features
 
 	peerRESTVersionPrefix = SlashSeparator + peerRESTVersion
 	peerRESTPrefix        = minioReservedBucketPath + "/peer"
@@ -52,6 +52,7 @@ const (
 	peerRESTMethodDeleteServiceAccount        = "/deleteserviceaccount"
 	peerRESTMethodLoadPolicy                  = "/loadpolicy"
 	peerRESTMethodLoadPolicyMapping           = "/loadpolicymapping"
+	peerRESTMethodLoadElevatedPolicy          = "/loadelevatedpolicy"
 	peerRESTMethodDeletePolicy                = "/deletepolicy"
 	peerRESTMethodLoadGroup                   = "/loadgroup"
 	peerRESTMethodStartProfiling              = "/startprofiling"
@@ -76,6 +77,24 @@ const (
 	peerRESTMethodDevNull                     = "/devnull"
 	peerRESTMethodNetperf                     = "/netperf"
 	peerRESTMethodMetrics                     = "/metrics"
+	peerRESTMethodGetClusterJobs              = "/clusterjobs"
+	peerRESTMethodGetMRFEntries               = "/mrfentries"
+	peerRESTMethodRebuildDataUsage            = "/rebuilddatausage"
+	peerRESTMethodGetScannerStatus            = "/scannerstatus"
+	peerRESTMethodFormatCheck                 = "/formatcheck"
+	peerRESTMethodGetHealSequences            = "/healsequences"
+	peerRESTMethodStopHealSequence            = "/stophealsequence"
+	peerRESTMethodGetExpiryStatus             = "/expirystatus"
+	peerRESTMethodLoadQuarantine              = "/loadquarantine"
+	peerRESTMethodGetRequestTimings           = "/requesttimings"
+	peerRESTMethodGetClock                    = "/clock"
+	peerRESTMethodDrain                       = "/drain"
+	peerRESTMethodGetLockHolders              = "/lockholders"
+	peerRESTMethodGetObjectReadStreams        = "/objectreadstreams"
+	peerRESTMethodCancelObjectReadStream      = "/cancelobjectreadstream"
+	peerRESTMethodGetGuardrailStatus          = "/guardrailstatus"
+	peerRESTMethodGetBootstrapTrace           = "/bootstraptrace"
+	peerRESTMethodGetServerFeatures           = "/serverfeatures"
 )
 
 const (
@@ -98,8 +117,15 @@ const (
 	peerRESTMetricsTypes   = "types"
 	peerRESTDisk           = "disk"
 	peerRESTJobID          = "job-id"
+	peerRESTHistory        = "history"
 	peerRESTDepID          = "depID"
 	peerRESTStartRebalance = "start-rebalance"
+	peerRESTDrain          = "drain"
+	peerRESTResource       = "resource"
+	peerRESTStreamID       = "stream-id"
+	peerRESTClear          = "clear"
+	peerRESTInclEnded      = "incl-ended"
+	peerRESTClientToken    = "client-token"
 
 	peerRESTListenBucket = "bucket"
 	peerRESTListenPrefix = "prefix"
@@ -138,6 +138,26 @@ func (s *peerRESTServer) LoadPolicyMappingHandler(w http.ResponseWriter, r *http
 	}
 }
 
+// LoadElevatedPolicyHandler - reloads the policy elevation of a user on
+// the server.
+func (s *peerRESTServer) LoadElevatedPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("Invalid request"))
+		return
+	}
+
+	user := r.Form.Get(peerRESTUser)
+	if user == "" {
+		s.writeErrorResponse(w, errors.New("user is missing"))
+		return
+	}
+
+	if err := globalIAMSys.LoadElevatedPolicy(r.Context(), user); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+}
+
 // DeleteServiceAccountHandler - deletes a service account on the server.
 func (s *peerRESTServer) DeleteServiceAccountHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -1061,6 +1081,187 @@ func (s *peerRESTServer) StopRebalanceHandler(w http.ResponseWriter, r *http.Req
 	pools.StopRebalance()
 }
 
+// RebuildDataUsageHandler - asks the data scanner on this node, if running,
+// to discard the data usage cache and rebuild it.
+func (s *peerRESTServer) RebuildDataUsageHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	globalDataUsageRebuild.request()
+}
+
+// GetScannerStatusHandler - returns the scanner state of the drives of this node.
+func (s *peerRESTServer) GetScannerStatusHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetScannerStatus")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalScannerDrives.status()))
+}
+
+// GetExpiryStatusHandler - returns the state of the ILM expiry workers of this node.
+func (s *peerRESTServer) GetExpiryStatusHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetExpiryStatus")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalExpiryState.status()))
+}
+
+// GetRequestTimingsHandler - returns the timing breakdown of the recent
+// sampled requests of this node.
+func (s *peerRESTServer) GetRequestTimingsHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetRequestTimings")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalRequestTimings.recent(0)))
+}
+
+// GetClockHandler - returns the current time of this node.
+func (s *peerRESTServer) GetClockHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetClock")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(UTCNow()))
+}
+
+// DrainHandler - starts or stops draining this node.
+func (s *peerRESTServer) DrainHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	drain, err := strconv.ParseBool(r.Form.Get(peerRESTDrain))
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	ctx := newContext(r, w, "Drain")
+	globalNodeDrain.set(drain)
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalNodeDrain.status()))
+}
+
+// GetLockHoldersHandler - returns the holders of the lock on a resource
+// taken by this node.
+func (s *peerRESTServer) GetLockHoldersHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetLockHolders")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalLockHolders.get(r.Form.Get(peerRESTResource))))
+}
+
+// GetObjectReadStreamsHandler - returns the GetObject responses being
+// streamed by this node.
+func (s *peerRESTServer) GetObjectReadStreamsHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetObjectReadStreams")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalObjectReadStreams.list()))
+}
+
+// CancelObjectReadStreamHandler - aborts a GetObject response being
+// streamed by this node.
+func (s *peerRESTServer) CancelObjectReadStreamHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "CancelObjectReadStream")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalObjectReadStreams.cancel(r.Form.Get(peerRESTStreamID))))
+}
+
+// GetGuardrailStatusHandler - returns the load shedding state of this node.
+func (s *peerRESTServer) GetGuardrailStatusHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetGuardrailStatus")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalGuardrail.status()))
+}
+
+// GetBootstrapTraceHandler - returns the startup events recorded by this node.
+func (s *peerRESTServer) GetBootstrapTraceHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetBootstrapTrace")
+	clear := r.Form.Get(peerRESTClear) == "true"
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalBootstrapTracer.Timeline(clear)))
+}
+
+// GetServerFeaturesHandler - returns the features enabled on this node.
+func (s *peerRESTServer) GetServerFeaturesHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetServerFeatures")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(localServerFeatures()))
+}
+
+// LoadQuarantineHandler - reloads the quarantine list of this node.
+func (s *peerRESTServer) LoadQuarantineHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	if err := globalQuarantineSys.Load(r.Context(), objAPI); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+}
+
+// FormatCheckHandler - returns the placement of the local drives of this
+// node against their format.
+func (s *peerRESTServer) FormatCheckHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	ctx := newContext(r, w, "FormatCheck")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(localFormatCheck(objAPI)))
+}
+
 func (s *peerRESTServer) LoadRebalanceMetaHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
 		s.writeErrorResponse(w, errors.New("invalid request"))
@@ -1272,6 +1473,73 @@ func (s *peerRESTServer) GetLastDayTierStatsHandler(w http.ResponseWriter, r *ht
 	logger.LogIf(ctx, gob.NewEncoder(w).Encode(result))
 }
 
+// GetClusterJobsHandler - returns the cluster jobs controlled by this node.
+func (s *peerRESTServer) GetClusterJobsHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetClusterJobs")
+	if objAPI := newObjectLayerFn(); objAPI == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	history := r.Form.Get(peerRESTHistory) == "true"
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(localClusterJobs(history)))
+}
+
+// GetMRFEntriesHandler - returns the partial operations queued for healing on this node.
+func (s *peerRESTServer) GetMRFEntriesHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetMRFEntries")
+	if objAPI := newObjectLayerFn(); objAPI == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalMRFState.listPendingOps()))
+}
+
+// GetHealSequencesHandler - returns the heal sequences registered on this node.
+func (s *peerRESTServer) GetHealSequencesHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetHealSequences")
+	if objAPI := newObjectLayerFn(); objAPI == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	inclEnded := r.Form.Get(peerRESTInclEnded) == "true"
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalAllHealState.getHealSequences(inclEnded)))
+}
+
+// StopHealSequenceHandler - stops the heal sequence with the client token
+// on this node.
+func (s *peerRESTServer) StopHealSequenceHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "StopHealSequence")
+	if objAPI := newObjectLayerFn(); objAPI == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalAllHealState.stopHealSequenceByToken(r.Form.Get(peerRESTClientToken))))
+}
+
 func (s *peerRESTServer) DriveSpeedTestHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
 		s.writeErrorResponse(w, errors.New("invalid request"))
@@ -1382,6 +1650,7 @@ func registerPeerRESTHandlers(router *mux.Router) {
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDeletePolicy).HandlerFunc(httpTraceAll(server.DeletePolicyHandler)).Queries(restQueries(peerRESTPolicy)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadPolicy).HandlerFunc(httpTraceAll(server.LoadPolicyHandler)).Queries(restQueries(peerRESTPolicy)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadPolicyMapping).HandlerFunc(httpTraceAll(server.LoadPolicyMappingHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadElevatedPolicy).HandlerFunc(httpTraceAll(server.LoadElevatedPolicyHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDeleteUser).HandlerFunc(httpTraceAll(server.DeleteUserHandler)).Queries(restQueries(peerRESTUser)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDeleteServiceAccount).HandlerFunc(httpTraceAll(server.DeleteServiceAccountHandler)).Queries(restQueries(peerRESTUser)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadUser).HandlerFunc(httpTraceAll(server.LoadUserHandler)).Queries(restQueries(peerRESTUser, peerRESTUserTemp)...)
@@ -1409,4 +1678,22 @@ func registerPeerRESTHandlers(router *mux.Router) {
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadRebalanceMeta).HandlerFunc(httpTraceHdrs(server.LoadRebalanceMetaHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodStopRebalance).HandlerFunc(httpTraceHdrs(server.StopRebalanceHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetLastDayTierStats).HandlerFunc(httpTraceHdrs(server.GetLastDayTierStatsHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetClusterJobs).HandlerFunc(httpTraceHdrs(server.GetClusterJobsHandler)).Queries(restQueries(peerRESTHistory)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetMRFEntries).HandlerFunc(httpTraceHdrs(server.GetMRFEntriesHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetHealSequences).HandlerFunc(httpTraceHdrs(server.GetHealSequencesHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodStopHealSequence).HandlerFunc(httpTraceHdrs(server.StopHealSequenceHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodRebuildDataUsage).HandlerFunc(httpTraceHdrs(server.RebuildDataUsageHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetScannerStatus).HandlerFunc(httpTraceHdrs(server.GetScannerStatusHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetExpiryStatus).HandlerFunc(httpTraceHdrs(server.GetExpiryStatusHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadQuarantine).HandlerFunc(httpTraceHdrs(server.LoadQuarantineHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetRequestTimings).HandlerFunc(httpTraceHdrs(server.GetRequestTimingsHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetClock).HandlerFunc(httpTraceHdrs(server.GetClockHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDrain).HandlerFunc(httpTraceHdrs(server.DrainHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetLockHolders).HandlerFunc(httpTraceHdrs(server.GetLockHoldersHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetObjectReadStreams).HandlerFunc(httpTraceHdrs(server.GetObjectReadStreamsHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodCancelObjectReadStream).HandlerFunc(httpTraceHdrs(server.CancelObjectReadStreamHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetGuardrailStatus).HandlerFunc(httpTraceHdrs(server.GetGuardrailStatusHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetBootstrapTrace).HandlerFunc(httpTraceHdrs(server.GetBootstrapTraceHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetServerFeatures).HandlerFunc(httpTraceHdrs(server.GetServerFeaturesHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodFormatCheck).HandlerFunc(httpTraceHdrs(server.FormatCheckHandler))
 }
@@ -0,0 +1,284 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio/internal/logger"
+)
+
+const (
+	quarantineConfigFile = "quarantine.json"
+
+	// Maximum number of object versions in quarantine.
+	maxQuarantineEntries = 1000
+)
+
+// quarantineConfigPath refers to the object holding the quarantine list.
+var quarantineConfigPath = path.Join(minioConfigPrefix, quarantineConfigFile)
+
+var (
+	errQuarantineReasonRequired = errors.New("a reason is required to quarantine an object")
+	errQuarantineFull           = fmt.Errorf("no more than %d object versions can be quarantined", maxQuarantineEntries)
+	errQuarantineNotFound       = errors.New("object version is not quarantined")
+)
+
+// Background operations skipping quarantined object versions.
+const (
+	quarantineSkipHeal = iota
+	quarantineSkipDangling
+	quarantineSkipMRF
+	quarantineSkipILM
+	quarantineSkipOps
+)
+
+var quarantineSkipOpNames = [quarantineSkipOps]string{
+	quarantineSkipHeal:     "heal",
+	quarantineSkipDangling: "dangling",
+	quarantineSkipMRF:      "mrf",
+	quarantineSkipILM:      "ilm",
+}
+
+// QuarantineRequest is the body of a quarantine request.
+type QuarantineRequest struct {
+	Reason string `json:"reason"`
+}
+
+// QuarantineEntry is an object version which background operations,
+// healing, dangling object removal and lifecycle, must not modify. An
+// empty VersionID quarantines all the versions of the object.
+type QuarantineEntry struct {
+	Bucket        string    `json:"bucket"`
+	Object        string    `json:"object"`
+	VersionID     string    `json:"versionId,omitempty"`
+	Reason        string    `json:"reason"`
+	QuarantinedBy string    `json:"quarantinedBy"`
+	QuarantinedAt time.Time `json:"quarantinedAt"`
+}
+
+// matches returns true if the entry covers the version of the object,
+// an empty versionID refers to any version of the object.
+func (e QuarantineEntry) matches(bucket, object, versionID string) bool {
+	if e.Bucket != bucket || e.Object != object {
+		return false
+	}
+	return e.VersionID == "" || versionID == "" || e.VersionID == versionID
+}
+
+// QuarantineStatus lists the quarantined object versions and the number
+// of background operations skipped by the node serving the request.
+type QuarantineStatus struct {
+	Entries []QuarantineEntry `json:"entries"`
+	Skipped map[string]uint64 `json:"skipped"`
+}
+
+type quarantineList struct {
+	Version int               `json:"version"`
+	Entries []QuarantineEntry `json:"entries"`
+}
+
+const quarantineListVersion = 1
+
+// quarantineSys caches the quarantine list persisted in the meta bucket.
+type quarantineSys struct {
+	// number of entries, allows checks to skip the lock when empty.
+	count int64
+
+	mu      sync.RWMutex
+	entries []QuarantineEntry
+
+	skipped [quarantineSkipOps]uint64
+}
+
+var globalQuarantineSys = &quarantineSys{}
+
+func loadQuarantineList(ctx context.Context, objAPI ObjectLayer) (quarantineList, error) {
+	var ql quarantineList
+	data, err := readConfig(ctx, objAPI, quarantineConfigPath)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return quarantineList{Version: quarantineListVersion}, nil
+		}
+		return ql, err
+	}
+	if err = json.Unmarshal(data, &ql); err != nil {
+		return ql, err
+	}
+	if ql.Version != quarantineListVersion {
+		return ql, fmt.Errorf("unknown quarantine list version %d", ql.Version)
+	}
+	return ql, nil
+}
+
+func (sys *quarantineSys) set(entries []QuarantineEntry) {
+	sys.mu.Lock()
+	defer sys.mu.Unlock()
+	sys.entries = entries
+	atomic.StoreInt64(&sys.count, int64(len(entries)))
+}
+
+// Load reloads the quarantine list from the backend.
+func (sys *quarantineSys) Load(ctx context.Context, objAPI ObjectLayer) error {
+	ql, err := loadQuarantineList(ctx, objAPI)
+	if err != nil {
+		return err
+	}
+	sys.set(ql.Entries)
+	return nil
+}
+
+// update applies fn to the quarantine list under a cluster wide lock,
+// saves it and notifies the peers to reload it.
+func (sys *quarantineSys) update(ctx context.Context, objAPI ObjectLayer, fn func(ql *quarantineList) error) error {
+	lk := objAPI.NewNSLock(minioMetaBucket, quarantineConfigPath)
+	lkctx, err := lk.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return err
+	}
+	ctx = lkctx.Context()
+	defer lk.Unlock(lkctx)
+
+	ql, err := loadQuarantineList(ctx, objAPI)
+	if err != nil {
+		return err
+	}
+	if err = fn(&ql); err != nil {
+		return err
+	}
+	data, err := json.Marshal(ql)
+	if err != nil {
+		return err
+	}
+	if err = saveConfig(ctx, objAPI, quarantineConfigPath, data); err != nil {
+		return err
+	}
+	sys.set(ql.Entries)
+	if globalNotificationSys != nil {
+		globalNotificationSys.LoadQuarantine(ctx)
+	}
+	return nil
+}
+
+// Add quarantines an object version, replacing any previous entry for
+// the same version.
+func (sys *quarantineSys) Add(ctx context.Context, objAPI ObjectLayer, e QuarantineEntry) error {
+	if e.Reason == "" {
+		return errQuarantineReasonRequired
+	}
+	return sys.update(ctx, objAPI, func(ql *quarantineList) error {
+		entries := ql.Entries[:0]
+		for _, qe := range ql.Entries {
+			if qe.Bucket == e.Bucket && qe.Object == e.Object && qe.VersionID == e.VersionID {
+				continue
+			}
+			entries = append(entries, qe)
+		}
+		if len(entries) >= maxQuarantineEntries {
+			return errQuarantineFull
+		}
+		ql.Entries = append(entries, e)
+		return nil
+	})
+}
+
+// Remove releases an object version from quarantine and returns the
+// released entry.
+func (sys *quarantineSys) Remove(ctx context.Context, objAPI ObjectLayer, bucket, object, versionID string) (released QuarantineEntry, err error) {
+	err = sys.update(ctx, objAPI, func(ql *quarantineList) error {
+		for i, qe := range ql.Entries {
+			if qe.Bucket == bucket && qe.Object == object && qe.VersionID == versionID {
+				released = qe
+				ql.Entries = append(ql.Entries[:i], ql.Entries[i+1:]...)
+				return nil
+			}
+		}
+		return errQuarantineNotFound
+	})
+	return released, err
+}
+
+// Status returns the quarantined object versions, most recent first.
+func (sys *quarantineSys) Status() QuarantineStatus {
+	sys.mu.RLock()
+	entries := make([]QuarantineEntry, len(sys.entries))
+	copy(entries, sys.entries)
+	sys.mu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].QuarantinedAt.After(entries[j].QuarantinedAt)
+	})
+	return QuarantineStatus{
+		Entries: entries,
+		Skipped: sys.Skipped(),
+	}
+}
+
+// Skipped returns the number of skipped background operations by operation.
+func (sys *quarantineSys) Skipped() map[string]uint64 {
+	skipped := make(map[string]uint64, quarantineSkipOps)
+	for op, name := range quarantineSkipOpNames {
+		skipped[name] = atomic.LoadUint64(&sys.skipped[op])
+	}
+	return skipped
+}
+
+// Count returns the number of quarantined object versions.
+func (sys *quarantineSys) Count() int {
+	return int(atomic.LoadInt64(&sys.count))
+}
+
+// IsQuarantined returns true if the version of the object is quarantined.
+func (sys *quarantineSys) IsQuarantined(bucket, object, versionID string) bool {
+	if sys == nil || atomic.LoadInt64(&sys.count) == 0 {
+		return false
+	}
+	if versionID == nullVersionID {
+		versionID = ""
+	}
+
+	sys.mu.RLock()
+	defer sys.mu.RUnlock()
+	for _, e := range sys.entries {
+		if e.matches(bucket, object, versionID) {
+			return true
+		}
+	}
+	return false
+}
+
+// skip returns true if op must not be applied to the version of the
+// object since it is quarantined, counting and logging the skip.
+func (sys *quarantineSys) skip(ctx context.Context, op int, bucket, object, versionID string) bool {
+	if !sys.IsQuarantined(bucket, object, versionID) {
+		return false
+	}
+	atomic.AddUint64(&sys.skipped[op], 1)
+	logger.LogOnceIf(ctx, fmt.Errorf("skipping %s of quarantined object %s/%s (%s)",
+		quarantineSkipOpNames[op], bucket, object, versionID),
+		"quarantine-"+quarantineSkipOpNames[op]+"-"+pathJoin(bucket, object, versionID))
+	return true
+}
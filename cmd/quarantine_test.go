@@ -0,0 +1,115 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+)
+
+func TestQuarantine(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	sys := &quarantineSys{}
+	if sys.IsQuarantined("bucket", "object", "") {
+		t.Fatal("expected an empty quarantine list")
+	}
+
+	vid := mustGetUUID()
+	entry := QuarantineEntry{
+		Bucket:        "bucket",
+		Object:        "object",
+		VersionID:     vid,
+		QuarantinedBy: "admin",
+		QuarantinedAt: UTCNow(),
+	}
+	if err = sys.Add(ctx, obj, entry); err != errQuarantineReasonRequired {
+		t.Fatalf("expected %v, got %v", errQuarantineReasonRequired, err)
+	}
+	entry.Reason = "incident 42"
+	if err = sys.Add(ctx, obj, entry); err != nil {
+		t.Fatal(err)
+	}
+	// Quarantining the same version again replaces the entry.
+	if err = sys.Add(ctx, obj, entry); err != nil {
+		t.Fatal(err)
+	}
+	if n := sys.Count(); n != 1 {
+		t.Fatalf("expected 1 quarantined version, got %d", n)
+	}
+
+	testCases := []struct {
+		bucket, object, versionID string
+		quarantined               bool
+	}{
+		{"bucket", "object", vid, true},
+		// Requests for the latest version may touch the quarantined one.
+		{"bucket", "object", "", true},
+		{"bucket", "object", nullVersionID, true},
+		{"bucket", "object", mustGetUUID(), false},
+		{"bucket", "other", vid, false},
+		{"other", "object", vid, false},
+	}
+	for i, tc := range testCases {
+		if got := sys.IsQuarantined(tc.bucket, tc.object, tc.versionID); got != tc.quarantined {
+			t.Errorf("case %d: expected quarantined %v, got %v", i+1, tc.quarantined, got)
+		}
+	}
+
+	if !sys.skip(ctx, quarantineSkipHeal, "bucket", "object", vid) {
+		t.Fatal("expected heal of the quarantined version to be skipped")
+	}
+	if sys.skip(ctx, quarantineSkipILM, "bucket", "other", vid) {
+		t.Fatal("expected lifecycle of another object not to be skipped")
+	}
+	if skipped := sys.Skipped(); skipped["heal"] != 1 || skipped["ilm"] != 0 {
+		t.Fatalf("unexpected skip counts %v", skipped)
+	}
+
+	// The list is persisted and shared by all the nodes.
+	other := &quarantineSys{}
+	if err = other.Load(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	st := other.Status()
+	if len(st.Entries) != 1 || st.Entries[0].Reason != entry.Reason || st.Entries[0].QuarantinedBy != "admin" {
+		t.Fatalf("unexpected quarantine list %+v", st.Entries)
+	}
+
+	if _, err = sys.Remove(ctx, obj, "bucket", "object", ""); err != errQuarantineNotFound {
+		t.Fatalf("expected %v, got %v", errQuarantineNotFound, err)
+	}
+	released, err := sys.Remove(ctx, obj, "bucket", "object", vid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released.VersionID != vid {
+		t.Fatalf("unexpected released entry %+v", released)
+	}
+	if sys.IsQuarantined("bucket", "object", vid) {
+		t.Fatal("expected the version to be released")
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Phases of a request measured for sampled S3 requests.
+const (
+	requestPhaseAuth = iota
+	requestPhaseLock
+	requestPhaseMetadata
+	requestPhaseData
+	requestPhases
+)
+
+var requestPhaseNames = [requestPhases]string{
+	requestPhaseAuth:     "auth",
+	requestPhaseLock:     "lock",
+	requestPhaseMetadata: "metadata",
+	requestPhaseData:     "data",
+}
+
+const (
+	// One S3 request in requestTimingSampleInterval is sampled.
+	requestTimingSampleInterval = 100
+
+	// Number of most recent samples kept by a node.
+	maxRequestTimingSamples = 1000
+)
+
+// RequestTiming is the phase by phase timing breakdown of a sampled
+// S3 request.
+type RequestTiming struct {
+	Node       string                   `json:"node"`
+	API        string                   `json:"api"`
+	Path       string                   `json:"path"`
+	Time       time.Time                `json:"time"`
+	StatusCode int                      `json:"statusCode"`
+	Duration   time.Duration            `json:"duration"`
+	Phases     map[string]time.Duration `json:"phases"`
+	// Other is the part of the duration not spent in any phase.
+	Other time.Duration `json:"other"`
+}
+
+type requestTimingKey struct{}
+
+// requestTiming accumulates the time spent in each phase by a sampled
+// request, phases may be entered more than once and concurrently.
+type requestTiming struct {
+	phases [requestPhases]int64
+}
+
+func noopRequestPhaseDone() {}
+
+// requestTimingPhase starts timing a phase of the request in ctx, the
+// returned function must be called when the phase ends.
+func requestTimingPhase(ctx context.Context, phase int) func() {
+	t, ok := ctx.Value(requestTimingKey{}).(*requestTiming)
+	if !ok {
+		return noopRequestPhaseDone
+	}
+	start := time.Now()
+	return func() {
+		atomic.AddInt64(&t.phases[phase], int64(time.Since(start)))
+	}
+}
+
+type requestTimings struct {
+	requests uint64
+
+	mu      sync.Mutex
+	samples []RequestTiming
+	next    int
+}
+
+var globalRequestTimings = &requestTimings{}
+
+// sample returns a request with a context recording the time spent in
+// each phase if the request is sampled, nil otherwise.
+func (rt *requestTimings) sample(r *http.Request) (*http.Request, *requestTiming) {
+	if atomic.AddUint64(&rt.requests, 1)%requestTimingSampleInterval != 0 {
+		return r, nil
+	}
+	t := &requestTiming{}
+	return r.WithContext(context.WithValue(r.Context(), requestTimingKey{}, t)), t
+}
+
+// add records the timing breakdown of a finished sampled request.
+func (rt *requestTimings) add(api string, r *http.Request, statusCode int, start time.Time, t *requestTiming) {
+	sample := RequestTiming{
+		Node:       globalLocalNodeName,
+		API:        api,
+		Path:       r.URL.Path,
+		Time:       start,
+		StatusCode: statusCode,
+		Duration:   time.Since(start),
+		Phases:     make(map[string]time.Duration, requestPhases),
+	}
+	sample.Other = sample.Duration
+	for phase, name := range requestPhaseNames {
+		d := time.Duration(atomic.LoadInt64(&t.phases[phase]))
+		sample.Phases[name] = d
+		sample.Other -= d
+	}
+	if sample.Other < 0 {
+		// Phases overlapped, as a GET decoding while the client reads.
+		sample.Other = 0
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if len(rt.samples) < maxRequestTimingSamples {
+		rt.samples = append(rt.samples, sample)
+		return
+	}
+	rt.samples[rt.next] = sample
+	rt.next = (rt.next + 1) % maxRequestTimingSamples
+}
+
+// recent returns up to n of the most recent samples, newest first.
+func (rt *requestTimings) recent(n int) []RequestTiming {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if n <= 0 || n > len(rt.samples) {
+		n = len(rt.samples)
+	}
+	samples := make([]RequestTiming, 0, n)
+	// The newest sample is just before rt.next.
+	for i := 1; i <= n; i++ {
+		idx := (rt.next - i + len(rt.samples)) % len(rt.samples)
+		samples = append(samples, rt.samples[idx])
+	}
+	return samples
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestTimings(t *testing.T) {
+	rt := &requestTimings{}
+
+	var sampled int
+	for i := 0; i < requestTimingSampleInterval*(maxRequestTimingSamples+10); i++ {
+		r, timing := rt.sample(httptest.NewRequest(http.MethodGet, "/bucket/object", nil))
+		if timing == nil {
+			// Phases of requests not sampled are not recorded.
+			requestTimingPhase(r.Context(), requestPhaseLock)()
+			continue
+		}
+		sampled++
+
+		ctx := r.Context()
+		done := requestTimingPhase(ctx, requestPhaseMetadata)
+		time.Sleep(time.Microsecond)
+		done()
+		rt.add("getobject", r, http.StatusOK, time.Now().Add(-time.Second), timing)
+	}
+	if sampled != maxRequestTimingSamples+10 {
+		t.Fatalf("expected %d sampled requests, got %d", maxRequestTimingSamples+10, sampled)
+	}
+
+	samples := rt.recent(0)
+	if len(samples) != maxRequestTimingSamples {
+		t.Fatalf("expected %d samples, got %d", maxRequestTimingSamples, len(samples))
+	}
+	for i := 1; i < len(samples); i++ {
+		if samples[i].Time.After(samples[i-1].Time) {
+			t.Fatal("expected the most recent samples first")
+		}
+	}
+
+	s := rt.recent(1)[0]
+	if s.API != "getobject" || s.Path != "/bucket/object" || s.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected sample %+v", s)
+	}
+	if s.Phases["metadata"] <= 0 || s.Phases["lock"] != 0 {
+		t.Fatalf("unexpected phases %v", s.Phases)
+	}
+	if s.Other+s.Phases["metadata"] != s.Duration {
+		t.Fatalf("expected the phases and other to add up to the duration, got %+v", s)
+	}
+
+	if done := requestTimingPhase(context.Background(), requestPhaseAuth); done == nil {
+		t.Fatal("expected a phase end function")
+	}
+}
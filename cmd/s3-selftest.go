@@ -0,0 +1,570 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+)
+
+// s3SelfTestTimeout is the maximum duration of a single S3 self-test.
+const s3SelfTestTimeout = time.Minute
+
+// errS3SelfTestSkipped wraps the reason an S3 self-test does not apply to
+// the deployment configuration.
+var errS3SelfTestSkipped = errors.New("not supported by the deployment configuration")
+
+// S3SelfTestResult - outcome of a single S3 conformance self-test.
+type S3SelfTestResult struct {
+	Name     string        `json:"name"`
+	Passed   bool          `json:"passed"`
+	Skipped  bool          `json:"skipped,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// s3SelfTest is a single S3 conformance check, run against a temporary
+// bucket owned by the self-test.
+type s3SelfTest struct {
+	name string
+	// skip returns why the check does not apply to the server
+	// configuration, nil if it does.
+	skip func() error
+	run  func(ctx context.Context, c minio.Core, bucket string) error
+}
+
+// s3SelfTests is the battery of S3 conformance checks, the versioning
+// checks enable versioning on the bucket and hence run last.
+var s3SelfTests = []s3SelfTest{
+	{"bucket-operations", nil, s3SelfTestBucket},
+	{"object-put-get-delete", nil, s3SelfTestObject},
+	{"multipart-zero-byte-part", nil, s3SelfTestMultipartZeroByte},
+	{"multipart-max-parts", nil, s3SelfTestMultipartMaxParts},
+	{"multipart-part-too-small", nil, s3SelfTestMultipartTooSmall},
+	{"conditional-requests", nil, s3SelfTestConditional},
+	{"presigned-url", nil, s3SelfTestPresigned},
+	{"object-tagging", nil, s3SelfTestTagging},
+	{"copy-object", nil, s3SelfTestCopy},
+	{"list-pagination", s3SelfTestListingSkip, s3SelfTestListing},
+	{"versioning", nil, s3SelfTestVersioning},
+}
+
+// runS3SelfTests runs all S3 self-tests in a temporary bucket through the
+// loopback client and sends the result of each test on the returned
+// channel. The bucket is created and removed with the S3 API like any
+// other, so that peers, site replication and notifications see both.
+func runS3SelfTests(ctx context.Context) (<-chan S3SelfTestResult, error) {
+	if globalMinioClient == nil {
+		return nil, errServerNotInitialized
+	}
+
+	c := minio.Core{Client: globalMinioClient}
+	bucket := "minio-selftest-" + mustGetUUID()
+	if err := c.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}); err != nil {
+		// The bucket may exist on some nodes or sites only.
+		s3SelfTestRemoveBucket(c, bucket)
+		return nil, err
+	}
+
+	resultsCh := make(chan S3SelfTestResult)
+	go func() {
+		defer close(resultsCh)
+		defer s3SelfTestRemoveBucket(c, bucket)
+
+		for _, test := range s3SelfTests {
+			select {
+			case resultsCh <- runS3SelfTest(ctx, c, bucket, test):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return resultsCh, nil
+}
+
+// runS3SelfTest runs a single S3 self-test unless it is skipped by the
+// server configuration.
+func runS3SelfTest(ctx context.Context, c minio.Core, bucket string, test s3SelfTest) S3SelfTestResult {
+	start := time.Now()
+	var err error
+	if test.skip != nil {
+		err = test.skip()
+	}
+	if err == nil {
+		tctx, cancel := context.WithTimeout(ctx, s3SelfTestTimeout)
+		err = test.run(tctx, c, bucket)
+		cancel()
+	}
+
+	result := S3SelfTestResult{
+		Name:     test.name,
+		Passed:   err == nil,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		result.Error = err.Error()
+		result.Skipped = errors.Is(err, errS3SelfTestSkipped)
+	}
+	return result
+}
+
+// s3SelfTestRemoveBucket force deletes the self-test bucket with the S3
+// API, regardless of the request which started the self-test.
+func s3SelfTestRemoveBucket(c minio.Core, bucket string) {
+	ctx, cancel := context.WithTimeout(context.Background(), s3SelfTestTimeout)
+	defer cancel()
+	err := c.RemoveBucketWithOptions(ctx, bucket, minio.RemoveBucketOptions{ForceDelete: true})
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchBucket" {
+		logger.LogIf(ctx, fmt.Errorf("unable to remove the S3 self-test bucket %s: %w", bucket, err))
+	}
+}
+
+// s3SelfTestExpectCode returns an error unless err is an S3 error with
+// the given code.
+func s3SelfTestExpectCode(err error, code string) error {
+	if err == nil {
+		return fmt.Errorf("expected %s, request succeeded", code)
+	}
+	if got := minio.ToErrorResponse(err).Code; got != code {
+		return fmt.Errorf("expected %s, got %w", code, err)
+	}
+	return nil
+}
+
+// s3SelfTestGet reads an object and compares it to the expected content.
+func s3SelfTestGet(ctx context.Context, c minio.Core, bucket, object string, opts minio.GetObjectOptions, want []byte) error {
+	rc, _, _, err := c.GetObject(ctx, bucket, object, opts)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	got, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("%s: content mismatch, got %d bytes, expected %d bytes", object, len(got), len(want))
+	}
+	return nil
+}
+
+func s3SelfTestPut(ctx context.Context, c minio.Core, bucket, object string, data []byte) (minio.UploadInfo, error) {
+	return c.Client.PutObject(ctx, bucket, object, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{})
+}
+
+func s3SelfTestBucket(ctx context.Context, c minio.Core, bucket string) error {
+	found, err := c.BucketExists(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("bucket %s not found", bucket)
+	}
+	buckets, err := c.ListBuckets(ctx)
+	if err != nil {
+		return err
+	}
+	listed := false
+	for _, b := range buckets {
+		listed = listed || b.Name == bucket
+	}
+	if !listed {
+		return fmt.Errorf("bucket %s not listed", bucket)
+	}
+	_, err = c.BucketExists(ctx, bucket+"-missing")
+	if err != nil {
+		return err
+	}
+	if err = c.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}); err == nil {
+		return errors.New("creating an existing bucket succeeded")
+	}
+	return nil
+}
+
+func s3SelfTestObject(ctx context.Context, c minio.Core, bucket string) error {
+	const object = "object"
+	data := []byte("minio s3 self-test object")
+	info, err := s3SelfTestPut(ctx, c, bucket, object, data)
+	if err != nil {
+		return err
+	}
+	oi, err := c.StatObject(ctx, bucket, object, minio.StatObjectOptions{})
+	if err != nil {
+		return err
+	}
+	if oi.Size != int64(len(data)) || oi.ETag != info.ETag {
+		return fmt.Errorf("stat returned size %d and etag %s, expected %d and %s", oi.Size, oi.ETag, len(data), info.ETag)
+	}
+	if err = s3SelfTestGet(ctx, c, bucket, object, minio.GetObjectOptions{}, data); err != nil {
+		return err
+	}
+	var opts minio.GetObjectOptions
+	if err = opts.SetRange(6, 7); err != nil {
+		return err
+	}
+	if err = s3SelfTestGet(ctx, c, bucket, object, opts, data[6:8]); err != nil {
+		return err
+	}
+	if err = c.RemoveObject(ctx, bucket, object, minio.RemoveObjectOptions{}); err != nil {
+		return err
+	}
+	_, err = c.StatObject(ctx, bucket, object, minio.StatObjectOptions{})
+	return s3SelfTestExpectCode(err, "NoSuchKey")
+}
+
+func s3SelfTestMultipartZeroByte(ctx context.Context, c minio.Core, bucket string) error {
+	const object = "multipart-zero-byte"
+	uploadID, err := c.NewMultipartUpload(ctx, bucket, object, minio.PutObjectOptions{})
+	if err != nil {
+		return err
+	}
+	part, err := c.PutObjectPart(ctx, bucket, object, uploadID, 1, bytes.NewReader(nil), 0, "", "", nil)
+	if err != nil {
+		c.AbortMultipartUpload(ctx, bucket, object, uploadID)
+		return err
+	}
+	if _, err = c.CompleteMultipartUpload(ctx, bucket, object, uploadID, []minio.CompletePart{
+		{PartNumber: part.PartNumber, ETag: part.ETag},
+	}, minio.PutObjectOptions{}); err != nil {
+		c.AbortMultipartUpload(ctx, bucket, object, uploadID)
+		return err
+	}
+	oi, err := c.StatObject(ctx, bucket, object, minio.StatObjectOptions{})
+	if err != nil {
+		return err
+	}
+	if oi.Size != 0 {
+		return fmt.Errorf("expected an empty object, got %d bytes", oi.Size)
+	}
+	return nil
+}
+
+func s3SelfTestMultipartMaxParts(ctx context.Context, c minio.Core, bucket string) error {
+	const object = "multipart-max-parts"
+	uploadID, err := c.NewMultipartUpload(ctx, bucket, object, minio.PutObjectOptions{})
+	if err != nil {
+		return err
+	}
+	defer c.AbortMultipartUpload(ctx, bucket, object, uploadID)
+
+	data := []byte("last part")
+	if _, err = c.PutObjectPart(ctx, bucket, object, uploadID, globalMaxPartID+1, bytes.NewReader(data), int64(len(data)), "", "", nil); err == nil {
+		return fmt.Errorf("part number %d was accepted", globalMaxPartID+1)
+	}
+	part, err := c.PutObjectPart(ctx, bucket, object, uploadID, globalMaxPartID, bytes.NewReader(data), int64(len(data)), "", "", nil)
+	if err != nil {
+		return err
+	}
+	if _, err = c.CompleteMultipartUpload(ctx, bucket, object, uploadID, []minio.CompletePart{
+		{PartNumber: part.PartNumber, ETag: part.ETag},
+	}, minio.PutObjectOptions{}); err != nil {
+		return err
+	}
+	return s3SelfTestGet(ctx, c, bucket, object, minio.GetObjectOptions{}, data)
+}
+
+func s3SelfTestMultipartTooSmall(ctx context.Context, c minio.Core, bucket string) error {
+	const object = "multipart-too-small"
+	uploadID, err := c.NewMultipartUpload(ctx, bucket, object, minio.PutObjectOptions{})
+	if err != nil {
+		return err
+	}
+	defer c.AbortMultipartUpload(ctx, bucket, object, uploadID)
+
+	var parts []minio.CompletePart
+	for partID := 1; partID <= 2; partID++ {
+		part, err := c.PutObjectPart(ctx, bucket, object, uploadID, partID, bytes.NewReader([]byte("a")), 1, "", "", nil)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+	_, err = c.CompleteMultipartUpload(ctx, bucket, object, uploadID, parts, minio.PutObjectOptions{})
+	return s3SelfTestExpectCode(err, "EntityTooSmall")
+}
+
+func s3SelfTestConditional(ctx context.Context, c minio.Core, bucket string) error {
+	const object = "conditional"
+	data := []byte("conditional")
+	info, err := s3SelfTestPut(ctx, c, bucket, object, data)
+	if err != nil {
+		return err
+	}
+
+	var opts minio.GetObjectOptions
+	if err = opts.SetMatchETag(info.ETag); err != nil {
+		return err
+	}
+	if err = s3SelfTestGet(ctx, c, bucket, object, opts, data); err != nil {
+		return err
+	}
+
+	opts = minio.GetObjectOptions{}
+	if err = opts.SetMatchETag("00000000000000000000000000000000"); err != nil {
+		return err
+	}
+	if _, _, _, err = c.GetObject(ctx, bucket, object, opts); err == nil {
+		return errors.New("If-Match with a wrong etag succeeded")
+	}
+	if status := minio.ToErrorResponse(err).StatusCode; status != http.StatusPreconditionFailed {
+		return fmt.Errorf("If-Match with a wrong etag: expected status %d, got %d", http.StatusPreconditionFailed, status)
+	}
+
+	opts = minio.GetObjectOptions{}
+	if err = opts.SetMatchETagExcept(info.ETag); err != nil {
+		return err
+	}
+	if _, _, _, err = c.GetObject(ctx, bucket, object, opts); err == nil {
+		return errors.New("If-None-Match with the object etag succeeded")
+	}
+	if status := minio.ToErrorResponse(err).StatusCode; status != http.StatusNotModified {
+		return fmt.Errorf("If-None-Match with the object etag: expected status %d, got %d", http.StatusNotModified, status)
+	}
+
+	opts = minio.GetObjectOptions{}
+	if err = opts.SetUnmodified(info.LastModified.Add(-time.Hour)); err != nil {
+		return err
+	}
+	if _, _, _, err = c.GetObject(ctx, bucket, object, opts); err == nil {
+		return errors.New("If-Unmodified-Since before the last modification succeeded")
+	}
+	return nil
+}
+
+func s3SelfTestPresigned(ctx context.Context, c minio.Core, bucket string) error {
+	const object = "presigned"
+	data := []byte("presigned")
+	client := &http.Client{Transport: globalProxyTransport}
+
+	u, err := c.PresignedPutObject(ctx, bucket, object, time.Minute)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	xhttp.DrainBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("presigned PUT returned %s", resp.Status)
+	}
+
+	u, err = c.PresignedGetObject(ctx, bucket, object, time.Minute, nil)
+	if err != nil {
+		return err
+	}
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err = client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("presigned GET returned %s", resp.Status)
+	}
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(got, data) {
+		return errors.New("presigned GET returned unexpected content")
+	}
+	return nil
+}
+
+func s3SelfTestTagging(ctx context.Context, c minio.Core, bucket string) error {
+	const object = "tagging"
+	if _, err := s3SelfTestPut(ctx, c, bucket, object, []byte("tagging")); err != nil {
+		return err
+	}
+	want, err := tags.NewTags(map[string]string{"selftest": "tagging"}, true)
+	if err != nil {
+		return err
+	}
+	if err = c.PutObjectTagging(ctx, bucket, object, want, minio.PutObjectTaggingOptions{}); err != nil {
+		return err
+	}
+	got, err := c.GetObjectTagging(ctx, bucket, object, minio.GetObjectTaggingOptions{})
+	if err != nil {
+		return err
+	}
+	if got.String() != want.String() {
+		return fmt.Errorf("expected tags %s, got %s", want, got)
+	}
+	if err = c.RemoveObjectTagging(ctx, bucket, object, minio.RemoveObjectTaggingOptions{}); err != nil {
+		return err
+	}
+	got, err = c.GetObjectTagging(ctx, bucket, object, minio.GetObjectTaggingOptions{})
+	if err != nil {
+		return err
+	}
+	if len(got.ToMap()) != 0 {
+		return fmt.Errorf("expected no tags, got %s", got)
+	}
+	return nil
+}
+
+func s3SelfTestCopy(ctx context.Context, c minio.Core, bucket string) error {
+	const src, dst = "copy-source", "copy-destination"
+	data := []byte("copy")
+	if _, err := s3SelfTestPut(ctx, c, bucket, src, data); err != nil {
+		return err
+	}
+	if _, err := c.Client.CopyObject(ctx, minio.CopyDestOptions{
+		Bucket: bucket,
+		Object: dst,
+	}, minio.CopySrcOptions{
+		Bucket: bucket,
+		Object: src,
+	}); err != nil {
+		return err
+	}
+	return s3SelfTestGet(ctx, c, bucket, dst, minio.GetObjectOptions{}, data)
+}
+
+// Parameters of the listing self-test.
+const (
+	s3SelfTestListPrefix  = "list/"
+	s3SelfTestListCount   = 5
+	s3SelfTestListMaxKeys = 2
+)
+
+// s3SelfTestListingSkip skips the listing self-test when the api config
+// doesn't allow its keys or caps the listing below a page.
+func s3SelfTestListingSkip() error {
+	if depth := globalAPIConfig.getMaxObjectKeyDepth(); depth > 0 && depth < 2 {
+		return fmt.Errorf("%w: max_object_key_depth is %d", errS3SelfTestSkipped, depth)
+	}
+	if maxScanned, _ := globalAPIConfig.getListLimits(); maxScanned > 0 && maxScanned <= s3SelfTestListCount {
+		return fmt.Errorf("%w: list_max_scanned is %d", errS3SelfTestSkipped, maxScanned)
+	}
+	return nil
+}
+
+func s3SelfTestListing(ctx context.Context, c minio.Core, bucket string) error {
+	const prefix, count, maxKeys = s3SelfTestListPrefix, s3SelfTestListCount, s3SelfTestListMaxKeys
+	for i := 0; i < count; i++ {
+		if _, err := s3SelfTestPut(ctx, c, bucket, fmt.Sprintf("%sobject-%d", prefix, i), nil); err != nil {
+			return err
+		}
+	}
+
+	var keys []string
+	var pages int
+	var token string
+	for {
+		res, err := c.ListObjectsV2(bucket, prefix, "", token, "", maxKeys)
+		if err != nil {
+			return err
+		}
+		pages++
+		for _, obj := range res.Contents {
+			keys = append(keys, obj.Key)
+		}
+		if !res.IsTruncated {
+			break
+		}
+		if res.NextContinuationToken == "" {
+			return errors.New("truncated listing without a continuation token")
+		}
+		token = res.NextContinuationToken
+	}
+	if len(keys) != count || pages != (count+maxKeys-1)/maxKeys {
+		return fmt.Errorf("expected %d objects in %d pages, got %d objects in %d pages", count, (count+maxKeys-1)/maxKeys, len(keys), pages)
+	}
+	for i, key := range keys {
+		if want := fmt.Sprintf("%sobject-%d", prefix, i); key != want {
+			return fmt.Errorf("expected %s at position %d, got %s", want, i, key)
+		}
+	}
+
+	res, err := c.ListObjectsV2(bucket, "", "", "", SlashSeparator, maxKeys)
+	if err != nil {
+		return err
+	}
+	for _, p := range res.CommonPrefixes {
+		if p.Prefix == prefix {
+			return nil
+		}
+	}
+	return fmt.Errorf("common prefix %s not listed", prefix)
+}
+
+func s3SelfTestVersioning(ctx context.Context, c minio.Core, bucket string) error {
+	err := c.EnableVersioning(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	const object = "versioned"
+	v1, err := s3SelfTestPut(ctx, c, bucket, object, []byte("version 1"))
+	if err != nil {
+		return err
+	}
+	v2, err := s3SelfTestPut(ctx, c, bucket, object, []byte("version 2"))
+	if err != nil {
+		return err
+	}
+	if v1.VersionID == "" || v1.VersionID == v2.VersionID {
+		return fmt.Errorf("expected distinct version ids, got %q and %q", v1.VersionID, v2.VersionID)
+	}
+	if err = s3SelfTestGet(ctx, c, bucket, object, minio.GetObjectOptions{VersionID: v1.VersionID}, []byte("version 1")); err != nil {
+		return err
+	}
+
+	// Deleting without a version adds a delete marker.
+	if err = c.RemoveObject(ctx, bucket, object, minio.RemoveObjectOptions{}); err != nil {
+		return err
+	}
+	if _, err = c.StatObject(ctx, bucket, object, minio.StatObjectOptions{}); err == nil {
+		return errors.New("object is visible after adding a delete marker")
+	}
+	if err = s3SelfTestGet(ctx, c, bucket, object, minio.GetObjectOptions{VersionID: v2.VersionID}, []byte("version 2")); err != nil {
+		return err
+	}
+
+	// Deleting a version removes it permanently.
+	if err = c.RemoveObject(ctx, bucket, object, minio.RemoveObjectOptions{VersionID: v1.VersionID}); err != nil {
+		return err
+	}
+	_, err = c.StatObject(ctx, bucket, object, minio.StatObjectOptions{VersionID: v1.VersionID})
+	if err == nil {
+		return errors.New("deleted version is still visible")
+	}
+	if status := minio.ToErrorResponse(err).StatusCode; status != http.StatusNotFound {
+		return fmt.Errorf("deleted version: expected status %d, got %d", http.StatusNotFound, status)
+	}
+	return nil
+}
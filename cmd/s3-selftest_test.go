@@ -0,0 +1,110 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestRunS3SelfTest(t *testing.T) {
+	errFailed := errors.New("failed")
+	skipped := func() error { return fmt.Errorf("%w: reason", errS3SelfTestSkipped) }
+	testCases := []struct {
+		test    s3SelfTest
+		ran     bool
+		passed  bool
+		skipped bool
+		err     string
+	}{
+		{s3SelfTest{"pass", nil, func(context.Context, minio.Core, string) error { return nil }}, true, true, false, ""},
+		{s3SelfTest{"fail", nil, func(context.Context, minio.Core, string) error { return errFailed }}, true, false, false, "failed"},
+		{s3SelfTest{"applies", func() error { return nil }, func(context.Context, minio.Core, string) error { return nil }}, true, true, false, ""},
+		{s3SelfTest{"skip", skipped, func(context.Context, minio.Core, string) error { return nil }}, false, false, true, skipped().Error()},
+	}
+	for _, tc := range testCases {
+		var ran bool
+		run := tc.test.run
+		tc.test.run = func(ctx context.Context, c minio.Core, bucket string) error {
+			ran = true
+			if bucket != "bucket" {
+				t.Fatalf("%s: expected the bucket of the self-test, got %s", tc.test.name, bucket)
+			}
+			if _, ok := ctx.Deadline(); !ok {
+				t.Fatalf("%s: expected a deadline", tc.test.name)
+			}
+			return run(ctx, c, bucket)
+		}
+		result := runS3SelfTest(context.Background(), minio.Core{}, "bucket", tc.test)
+		if ran != tc.ran {
+			t.Fatalf("%s: expected the test to run %v, got %v", tc.test.name, tc.ran, ran)
+		}
+		if result.Name != tc.test.name || result.Passed != tc.passed || result.Skipped != tc.skipped || result.Error != tc.err {
+			t.Fatalf("%s: unexpected result %+v", tc.test.name, result)
+		}
+	}
+}
+
+func TestS3SelfTestListingSkip(t *testing.T) {
+	defer func(depth int, maxScanned int64) {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.maxObjectKeyDepth = depth
+		globalAPIConfig.listMaxScanned = maxScanned
+		globalAPIConfig.mu.Unlock()
+	}(globalAPIConfig.getMaxObjectKeyDepth(), globalAPIConfig.listMaxScanned)
+
+	testCases := []struct {
+		depth      int
+		maxScanned int64
+		skipped    bool
+	}{
+		{0, 0, false},
+		{100, 10000000, false},
+		{2, s3SelfTestListCount + 1, false},
+		{1, 0, true},
+		{0, s3SelfTestListCount, true},
+	}
+	for i, tc := range testCases {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.maxObjectKeyDepth = tc.depth
+		globalAPIConfig.listMaxScanned = tc.maxScanned
+		globalAPIConfig.mu.Unlock()
+
+		err := s3SelfTestListingSkip()
+		if skipped := errors.Is(err, errS3SelfTestSkipped); skipped != tc.skipped {
+			t.Fatalf("case %d: expected skipped %v, got %v", i+1, tc.skipped, err)
+		}
+	}
+}
+
+func TestS3SelfTestsUnique(t *testing.T) {
+	names := make(map[string]struct{}, len(s3SelfTests))
+	for _, test := range s3SelfTests {
+		if _, ok := names[test.name]; ok {
+			t.Fatalf("duplicate S3 self-test %s", test.name)
+		}
+		names[test.name] = struct{}{}
+	}
+	if last := s3SelfTests[len(s3SelfTests)-1]; last.name != "versioning" {
+		t.Fatalf("expected the versioning self-test to run last, got %s", last.name)
+	}
+}
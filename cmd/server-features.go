@@ -0,0 +1,90 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/minio/internal/config/api"
+	"github.com/minio/pkg/env"
+)
+
+// ServerFeatures is the set of features enabled on a node, along with
+// the deprecated features still in use and the warnings logged at
+// startup.
+type ServerFeatures struct {
+	Node       string          `json:"node"`
+	Toggles    map[string]bool `json:"toggles"`
+	Deprecated []string        `json:"deprecated,omitempty"`
+	Warnings   []string        `json:"warnings,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// localServerFeatures returns the features of this node.
+func localServerFeatures() ServerFeatures {
+	globalCompressConfigMu.Lock()
+	compression := globalCompressConfig.Enabled
+	globalCompressConfigMu.Unlock()
+
+	f := ServerFeatures{
+		Node: globalLocalNodeName,
+		Toggles: map[string]bool{
+			"browser":           globalBrowserEnabled,
+			"bucket_federation": globalBucketFederation,
+			"compression":       compression,
+			"drive_caching":     globalCacheConfig.Enabled,
+			"guardrail":         globalGuardrail.status().Enabled,
+			"inplace_update":    !globalInplaceUpdateDisabled,
+			"kms":               GlobalKMS != nil,
+			"site_replication":  globalSiteReplicationSys.isEnabled(),
+			"strict_s3_compat":  globalCLIContext.StrictS3Compat,
+		},
+	}
+
+	if globalCacheConfig.Enabled {
+		f.Deprecated = append(f.Deprecated, "drive caching")
+	}
+	if !env.IsSet(config.EnvRootUser) && env.IsSet(config.EnvAccessKey) && env.IsSet(config.EnvSecretKey) {
+		f.Deprecated = append(f.Deprecated, fmt.Sprintf("%s and %s, use %s and %s instead",
+			config.EnvAccessKey, config.EnvSecretKey, config.EnvRootUser, config.EnvRootPassword))
+	}
+	if env.IsSet(api.EnvDeleteCleanupInterval) {
+		f.Deprecated = append(f.Deprecated, fmt.Sprintf("%s, use %s instead",
+			api.EnvDeleteCleanupInterval, api.EnvAPIDeleteCleanupInterval))
+	}
+
+	if !globalCLIContext.StrictS3Compat {
+		f.Warnings = append(f.Warnings, "strict AWS S3 compatible incoming PUT, POST content payload validation is turned off")
+	}
+	if globalActiveCred.Equal(auth.DefaultCredentials) {
+		f.Warnings = append(f.Warnings, "default credentials are used")
+	}
+	if oldLinux() {
+		f.Warnings = append(f.Warnings, "Linux kernel version older than 4.0.0")
+	}
+	if maxProcs, cpuProcs := runtime.GOMAXPROCS(0), runtime.NumCPU(); maxProcs < cpuProcs {
+		f.Warnings = append(f.Warnings, fmt.Sprintf("GOMAXPROCS(%d) < NumCPU(%d)", maxProcs, cpuProcs))
+	}
+	if globalIsErasure && globalStorageClass.GetParityForSC("") == 0 {
+		f.Warnings = append(f.Warnings, "the standard parity is set to 0")
+	}
+	return f
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/minio/internal/config/api"
+)
+
+func TestLocalServerFeatures(t *testing.T) {
+	if _, ok := os.LookupEnv(config.EnvRootUser); ok {
+		t.Skipf("%s is set", config.EnvRootUser)
+	}
+	t.Setenv(config.EnvAccessKey, "minio")
+	t.Setenv(config.EnvSecretKey, "minio123")
+	t.Setenv(api.EnvDeleteCleanupInterval, "5m")
+
+	defer func(cred auth.Credentials) { globalActiveCred = cred }(globalActiveCred)
+	globalActiveCred = auth.DefaultCredentials
+
+	globalCompressConfigMu.Lock()
+	compression := globalCompressConfig.Enabled
+	globalCompressConfig.Enabled = true
+	globalCompressConfigMu.Unlock()
+	defer func() {
+		globalCompressConfigMu.Lock()
+		globalCompressConfig.Enabled = compression
+		globalCompressConfigMu.Unlock()
+	}()
+
+	f := localServerFeatures()
+	if !f.Toggles["compression"] {
+		t.Error("expected compression to be enabled")
+	}
+	if f.Toggles["kms"] != (GlobalKMS != nil) {
+		t.Errorf("expected the kms toggle to be %v", GlobalKMS != nil)
+	}
+
+	contains := func(list []string, s string) bool {
+		for _, v := range list {
+			if v == s {
+				return true
+			}
+		}
+		return false
+	}
+	for _, deprecated := range []string{
+		config.EnvAccessKey + " and " + config.EnvSecretKey + ", use " + config.EnvRootUser + " and " + config.EnvRootPassword + " instead",
+		api.EnvDeleteCleanupInterval + ", use " + api.EnvAPIDeleteCleanupInterval + " instead",
+	} {
+		if !contains(f.Deprecated, deprecated) {
+			t.Errorf("expected %q to be deprecated, got %v", deprecated, f.Deprecated)
+		}
+	}
+	if !contains(f.Warnings, "default credentials are used") {
+		t.Errorf("expected a warning for the default credentials, got %v", f.Warnings)
+	}
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/minio/minio/internal/bucket/bandwidth"
 	"github.com/minio/minio/internal/color"
 	"github.com/minio/minio/internal/config"
+	"github.com/minio/minio/internal/config/api"
 	"github.com/minio/minio/internal/hash/sha256"
 	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
@@ -240,7 +241,7 @@ func serverHandleCmdArgs(ctx *cli.Context) {
 	// allow transport to be HTTP/1.1 for proxying.
 	globalProxyTransport = NewCustomHTTPProxyTransport()()
 	globalProxyEndpoints = GetProxyEndpoints(globalEndpoints)
-	globalInternodeTransport = NewInternodeHTTPTransport()()
+	globalInternodeTransport = internodeConnSettings(api.Config{}).NewInternodeConnPool()
 	globalRemoteTargetTransport = NewRemoteTargetHTTPTransport()()
 
 	// On macOS, if a process already listens on LOCALIPADDR:PORT, net.Listen() falls back
@@ -553,12 +554,12 @@ func serverMain(ctx *cli.Context) {
 	}
 
 	// Check for updates in non-blocking manner.
-	go func() {
-		if !globalCLIContext.Quiet && !globalInplaceUpdateDisabled {
-			// Check for new updates from dl.min.io.
-			checkUpdate(getMinioMode())
-		}
-	}()
+	if !globalInplaceUpdateDisabled {
+		go globalUpdateChecker.run(GlobalContext, getMinioMode())
+	}
+
+	// Shed load when the resources used exceed the guardrail thresholds.
+	go globalGuardrail.run(GlobalContext)
 
 	// Set system resources to maximum.
 	setMaxResources()
@@ -669,6 +670,15 @@ func serverMain(ctx *cli.Context) {
 		}
 	}()
 
+	// Load the quarantine list before the scanner starts.
+	if err := globalQuarantineSys.Load(GlobalContext, newObject); err != nil {
+		logger.LogIf(GlobalContext, fmt.Errorf("Unable to load the quarantine list: %w", err))
+	}
+
+	if err := recordNodeRestart(GlobalContext, newObject); err != nil {
+		logger.LogIf(GlobalContext, fmt.Errorf("Unable to record the restart of the node: %w", err))
+	}
+
 	// Background all other operations such as initializing bucket metadata etc.
 	go func() {
 		// Initialize data scanner.
@@ -700,6 +710,7 @@ func serverMain(ctx *cli.Context) {
 
 		// Initialize bucket notification system first before loading bucket metadata.
 		logger.LogIf(GlobalContext, globalEventNotifier.InitBucketTargets(GlobalContext, newObject))
+		initEventJournal(GlobalContext, newObject)
 
 		// initialize the new disk cache objects.
 		if globalCacheConfig.Enabled {
@@ -121,6 +121,7 @@ func runAllTests(suite *TestSuiteCommon, c *check) {
 	suite.TestObjectMultipartListError(c)
 	suite.TestObjectValidMD5(c)
 	suite.TestObjectMultipart(c)
+	suite.TestObjectLockVersioning(c)
 	suite.TearDownSuite(c)
 }
 
@@ -2751,3 +2752,101 @@ func (s *TestSuiteCommon) TestObjectMultipart(c *check) {
 	etag := getCompleteMultipartMD5(parts)
 	c.Assert(canonicalizeETag(response.Header.Get(xhttp.ETag)), etag)
 }
+
+// newSignedRequest returns a request signed with the signer of the
+// suite, headers are set before signing.
+func (s *TestSuiteCommon) newSignedRequest(c *check, method, urlStr string, body []byte, headers map[string]string) *http.Request {
+	c.Helper()
+	var (
+		request *http.Request
+		err     error
+	)
+	if s.signer == signerV2 {
+		request, err = newTestSignedRequestV2(method, urlStr, int64(len(body)), bytes.NewReader(body), s.accessKey, s.secretKey, headers)
+	} else {
+		request, err = newTestSignedRequestV4(method, urlStr, int64(len(body)), bytes.NewReader(body), s.accessKey, s.secretKey, headers)
+	}
+	c.Assert(err, nil)
+	return request
+}
+
+// TestObjectLockVersioning - validates versioning, object lock default
+// retention, legal hold and lifecycle on a bucket created with object
+// lock enabled, the semantics must be the same on all backends.
+func (s *TestSuiteCommon) TestObjectLockVersioning(c *check) {
+	do := func(request *http.Request, statusCode int) *http.Response {
+		c.Helper()
+		response, err := s.client.Do(request)
+		c.Assert(err, nil)
+		c.Assert(response.StatusCode, statusCode)
+		return response
+	}
+	withMD5 := func(body []byte) map[string]string {
+		return map[string]string{xhttp.ContentMD5: getMD5HashBase64(body)}
+	}
+	versionURL := func(bucketName, objectName, versionID string, vals url.Values) string {
+		if vals == nil {
+			vals = make(url.Values)
+		}
+		vals.Set("versionId", versionID)
+		return makeTestTargetURL(s.endPoint, bucketName, objectName, vals)
+	}
+
+	bucketName := getRandomBucketName()
+	do(s.newSignedRequest(c, http.MethodPut, getMakeBucketURL(s.endPoint, bucketName), nil,
+		map[string]string{xhttp.AmzObjectLockEnabled: "true"}), http.StatusOK)
+
+	// Versioning is enabled with object lock and can't be suspended.
+	response := do(s.newSignedRequest(c, http.MethodGet, getBucketVersioningConfigURL(s.endPoint, bucketName), nil, nil), http.StatusOK)
+	data, err := io.ReadAll(response.Body)
+	c.Assert(err, nil)
+	c.Assert(strings.Contains(string(data), "<Status>Enabled</Status>"), true)
+
+	suspend := []byte(`<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Suspended</Status></VersioningConfiguration>`)
+	do(s.newSignedRequest(c, http.MethodPut, getBucketVersioningConfigURL(s.endPoint, bucketName), suspend, nil), http.StatusBadRequest)
+
+	// Default retention applies to new objects.
+	lockConfig := []byte(`<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>1</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+	do(s.newSignedRequest(c, http.MethodPut, makeTestTargetURL(s.endPoint, bucketName, "", url.Values{"object-lock": {""}}), lockConfig, withMD5(lockConfig)), http.StatusOK)
+
+	objectName := "locked"
+	content := []byte("hello world")
+	response = do(s.newSignedRequest(c, http.MethodPut, getPutObjectURL(s.endPoint, bucketName, objectName), content, withMD5(content)), http.StatusOK)
+	versionID := response.Header.Get(xhttp.AmzVersionID)
+	c.Assert(versionID != "", true)
+
+	response = do(s.newSignedRequest(c, http.MethodHead, getHeadObjectURL(s.endPoint, bucketName, objectName), nil, nil), http.StatusOK)
+	c.Assert(response.Header.Get(xhttp.AmzObjectLockMode), "GOVERNANCE")
+	c.Assert(response.Header.Get(xhttp.AmzObjectLockRetainUntilDate) != "", true)
+
+	// Deleting without a version adds a delete marker.
+	response = do(s.newSignedRequest(c, http.MethodDelete, getDeleteObjectURL(s.endPoint, bucketName, objectName), nil, nil), http.StatusNoContent)
+	c.Assert(response.Header.Get(xhttp.AmzDeleteMarker), "true")
+	deleteMarkerID := response.Header.Get(xhttp.AmzVersionID)
+	c.Assert(deleteMarkerID != "" && deleteMarkerID != versionID, true)
+
+	do(s.newSignedRequest(c, http.MethodHead, getHeadObjectURL(s.endPoint, bucketName, objectName), nil, nil), http.StatusNotFound)
+	do(s.newSignedRequest(c, http.MethodHead, versionURL(bucketName, objectName, versionID, nil), nil, nil), http.StatusOK)
+
+	// The retained version can't be deleted.
+	do(s.newSignedRequest(c, http.MethodDelete, versionURL(bucketName, objectName, versionID, nil), nil, nil), http.StatusBadRequest)
+
+	// A legal hold protects the version even when governance is bypassed.
+	bypass := map[string]string{xhttp.AmzObjectLockBypassGovernance: "true"}
+	legalHoldOn := []byte(`<LegalHold xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>ON</Status></LegalHold>`)
+	do(s.newSignedRequest(c, http.MethodPut, versionURL(bucketName, objectName, versionID, url.Values{"legal-hold": {""}}), legalHoldOn, withMD5(legalHoldOn)), http.StatusOK)
+	do(s.newSignedRequest(c, http.MethodDelete, versionURL(bucketName, objectName, versionID, nil), nil, bypass), http.StatusBadRequest)
+
+	legalHoldOff := []byte(`<LegalHold xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>OFF</Status></LegalHold>`)
+	do(s.newSignedRequest(c, http.MethodPut, versionURL(bucketName, objectName, versionID, url.Values{"legal-hold": {""}}), legalHoldOff, withMD5(legalHoldOff)), http.StatusOK)
+	do(s.newSignedRequest(c, http.MethodDelete, versionURL(bucketName, objectName, versionID, nil), nil, bypass), http.StatusNoContent)
+
+	// Removing the delete marker leaves no versions.
+	do(s.newSignedRequest(c, http.MethodDelete, versionURL(bucketName, objectName, deleteMarkerID, nil), nil, nil), http.StatusNoContent)
+	do(s.newSignedRequest(c, http.MethodGet, getGetObjectURL(s.endPoint, bucketName, objectName), nil, nil), http.StatusNotFound)
+
+	// Lifecycle rules for noncurrent versions are accepted.
+	lcConfig := []byte(`<LifecycleConfiguration><Rule><ID>noncurrent</ID><Status>Enabled</Status><Filter><Prefix></Prefix></Filter><NoncurrentVersionExpiration><NoncurrentDays>1</NoncurrentDays></NoncurrentVersionExpiration></Rule></LifecycleConfiguration>`)
+	do(s.newSignedRequest(c, http.MethodPut, getBucketLifecycleURL(s.endPoint, bucketName), lcConfig, withMD5(lcConfig)), http.StatusOK)
+	do(s.newSignedRequest(c, http.MethodGet, getBucketLifecycleURL(s.endPoint, bucketName), nil, nil), http.StatusOK)
+}
@@ -205,13 +205,27 @@ func (sm *siteResyncMetrics) updateState(s SiteResyncStatus) {
 	case ResyncCompleted, ResyncCanceled, ResyncFailed:
 		st, ok := sm.resyncStatus[s.ResyncID]
 		if ok {
+			prev := st.Status
 			st.LastUpdate = s.LastUpdate
 			st.Status = s.Status
+			sm.recordFinished(s.ResyncID, prev, st)
 		}
 		sm.resyncStatus[s.ResyncID] = st
 	}
 }
 
+// recordFinished adds the resync to the history of finished cluster
+// jobs when its status moved from prev to a final status.
+func (sm *siteResyncMetrics) recordFinished(resyncID string, prev ResyncStatusType, st SiteResyncStatus) {
+	if prev == st.Status {
+		return
+	}
+	switch st.Status {
+	case ResyncCompleted, ResyncCanceled, ResyncFailed:
+		go globalClusterJobsHistory.record(st.clusterJob(resyncID))
+	}
+}
+
 // increment SyncedBuckets count
 func (sm *siteResyncMetrics) incBucket(o resyncOpts, bktStatus ResyncStatusType) {
 	if !globalSiteReplicationSys.isEnabled() {
@@ -224,6 +238,7 @@ func (sm *siteResyncMetrics) incBucket(o resyncOpts, bktStatus ResyncStatusType)
 		if st.BucketStatuses == nil {
 			st.BucketStatuses = map[string]ResyncStatusType{}
 		}
+		prev := st.Status
 		switch bktStatus {
 		case ResyncCompleted:
 			st.BucketStatuses[o.bucket] = ResyncCompleted
@@ -236,6 +251,7 @@ func (sm *siteResyncMetrics) incBucket(o resyncOpts, bktStatus ResyncStatusType)
 			st.LastUpdate = UTCNow()
 			sm.resyncStatus[o.resyncID] = st
 		}
+		sm.recordFinished(o.resyncID, prev, st)
 	}
 }
 
@@ -1293,6 +1293,39 @@ func (c *SiteReplicationSys) PeerPolicyMappingHandler(ctx context.Context, mappi
 	return nil
 }
 
+// PeerElevatedPolicyHandler - copies a policy elevation, including its
+// expiry, to local. An empty elevation causes the elevation of the user
+// to be revoked.
+func (c *SiteReplicationSys) PeerElevatedPolicyHandler(ctx context.Context, user string, elevation []byte, updatedAt time.Time) error {
+	if user == "" {
+		return errSRInvalidRequest(errInvalidArgument)
+	}
+	// skip overwrite of local update if peer sent stale info
+	if !updatedAt.IsZero() {
+		mp, ok := globalIAMSys.store.GetElevatedPolicy(user)
+		if ok && mp.UpdatedAt.After(updatedAt) {
+			return nil
+		}
+	}
+
+	if len(elevation) == 0 {
+		_, err := globalIAMSys.RevokeElevatedPolicy(ctx, user, "", true)
+		if err != nil && !errors.Is(err, errNoSuchPolicy) {
+			return wrapSRErr(err)
+		}
+		return nil
+	}
+
+	var mp MappedPolicy
+	if err := json.Unmarshal(elevation, &mp); err != nil {
+		return errSRInvalidRequest(err)
+	}
+	if _, err := globalIAMSys.ElevatePolicy(ctx, user, mp, true); err != nil {
+		return wrapSRErr(err)
+	}
+	return nil
+}
+
 // PeerSTSAccHandler - replicates STS credential locally.
 func (c *SiteReplicationSys) PeerSTSAccHandler(ctx context.Context, stsCred *madmin.SRSTSCredential, updatedAt time.Time) error {
 	if stsCred == nil {
@@ -1541,6 +1574,30 @@ func (c *SiteReplicationSys) PeerBucketQuotaConfigHandler(ctx context.Context, b
 	return nil
 }
 
+// Type of the bucket metadata change replicating the administrative
+// state of a bucket, SRBucketMeta has no field for it and carries the
+// JSON configuration in Policy.
+const srBucketMetaTypeBucketState = "bucket-state"
+
+// PeerBucketStateHandler - updates the bucket state config to local cluster.
+func (c *SiteReplicationSys) PeerBucketStateHandler(ctx context.Context, bucket string, stateData []byte, updatedAt time.Time) error {
+	// skip overwrite if local update is newer than peer update.
+	if !updatedAt.IsZero() {
+		if _, updateTm, err := globalBucketMetadataSys.GetBucketStateConfig(ctx, bucket); err == nil && updateTm.After(updatedAt) {
+			return nil
+		}
+	}
+
+	if _, err := parseBucketStateConfig(stateData); err != nil {
+		return errSRInvalidRequest(err)
+	}
+
+	if _, err := globalBucketMetadataSys.Update(ctx, bucket, bucketStateConfigFile, stateData); err != nil {
+		return wrapSRErr(err)
+	}
+	return nil
+}
+
 // getAdminClient - NOTE: ensure to take at least a read lock on SiteReplicationSys
 // before calling this.
 func (c *SiteReplicationSys) getAdminClient(ctx context.Context, deploymentID string) (*madmin.AdminClient, error) {
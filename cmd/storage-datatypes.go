@@ -232,6 +232,10 @@ type FileInfo struct {
 
 	// Combined checksum when object was uploaded.
 	Checksum []byte `msg:"cs,allownil"`
+
+	// Durable indicates the commit of this FileInfo must be synced
+	// to stable storage before returning.
+	Durable bool `msg:"dur"`
 }
 
 // WriteQuorum returns expected write quorum for this FileInfo
@@ -220,3 +220,31 @@ func BenchmarkEncodeFileInfoGOB(b *testing.B) {
 		}
 	}
 }
+
+func TestFileInfoDurableMsgp(t *testing.T) {
+	v := FileInfo{Volume: "testbucket", Name: "object", Checksum: []byte("crc"), Durable: true}
+
+	bts, err := v.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got FileInfo
+	if _, err = got.UnmarshalMsg(bts); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Durable || !bytes.Equal(got.Checksum, v.Checksum) {
+		t.Fatalf("expected durable %v and checksum %q, got %v and %q", v.Durable, v.Checksum, got.Durable, got.Checksum)
+	}
+
+	var buf bytes.Buffer
+	if err = msgp.Encode(&buf, &v); err != nil {
+		t.Fatal(err)
+	}
+	got = FileInfo{}
+	if err = msgp.Decode(&buf, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Durable {
+		t.Fatal("expected durable to survive encode/decode")
+	}
+}
@@ -54,6 +54,9 @@ var errFaultyRemoteDisk = StorageErr("remote drive is faulty")
 // errFaultyDisk - disk is faulty.
 var errFaultyDisk = StorageErr("drive is faulty")
 
+// errDriveHung - drive was taken offline as its operations time out.
+var errDriveHung = StorageErr("drive is faulty (hung)")
+
 // errDiskAccessDenied - we don't have write permissions on disk.
 var errDiskAccessDenied = StorageErr("drive access denied")
 
@@ -128,6 +131,7 @@ var baseErrs = []error{
 	errDiskNotFound,
 	errFaultyDisk,
 	errFaultyRemoteDisk,
+	errDriveHung,
 }
 
 var baseIgnoredErrs = baseErrs
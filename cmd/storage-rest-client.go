@@ -79,6 +79,8 @@ func toStorageErr(err error) error {
 	switch err.Error() {
 	case errFaultyDisk.Error():
 		return errFaultyDisk
+	case errDriveHung.Error():
+		return errDriveHung
 	case errFileCorrupt.Error():
 		return errFileCorrupt
 	case errUnexpected.Error():
@@ -163,6 +163,7 @@ func newSignV4ChunkedReader(req *http.Request) (io.ReadCloser, APIErrorCode) {
 	if errCode != ErrNone {
 		return nil, errCode
 	}
+	globalIAMSys.RecordAccessKeyUsed(cred)
 
 	return &s3ChunkedReader{
 		reader:            bufio.NewReader(req.Body),
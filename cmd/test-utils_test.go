@@ -195,6 +195,8 @@ func prepareFS(ctx context.Context) (ObjectLayer, string, error) {
 	if err != nil {
 		return nil, "", err
 	}
+	// Same as a single drive server.
+	globalIsErasure, globalIsErasureSD = false, true
 
 	initAllSubsystems(ctx)
 
@@ -216,6 +218,7 @@ func prepareErasure(ctx context.Context, nDisks int) (ObjectLayer, []string, err
 		removeRoots(fsDirs)
 		return nil, nil, err
 	}
+	globalIsErasureSD = false
 
 	return obj, fsDirs, nil
 }
@@ -441,6 +444,7 @@ func resetGlobalEndpoints() {
 
 func resetGlobalIsErasure() {
 	globalIsErasure = false
+	globalIsErasureSD = false
 }
 
 // reset global heal state
@@ -1994,6 +1998,12 @@ func registerBucketLevelFunc(bucket *mux.Router, api objectAPIHandlers, apiFunct
 			bucket.Methods(http.MethodPut).HandlerFunc(api.PutBucketLifecycleHandler).Queries("lifecycle", "")
 		case "DeleteBucketLifecycle":
 			bucket.Methods(http.MethodDelete).HandlerFunc(api.DeleteBucketLifecycleHandler).Queries("lifecycle", "")
+		case "GetBucketCors":
+			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketCorsHandler).Queries("cors", "")
+		case "PutBucketCors":
+			bucket.Methods(http.MethodPut).HandlerFunc(api.PutBucketCorsHandler).Queries("cors", "")
+		case "DeleteBucketCors":
+			bucket.Methods(http.MethodDelete).HandlerFunc(api.DeleteBucketCorsHandler).Queries("cors", "")
 		case "GetBucketLocation":
 			// Register GetBucketLocation handler.
 			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketLocationHandler).Queries("location", "")
@@ -18,6 +18,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -278,3 +279,52 @@ func (api adminAPIHandlers) TierStatsHandler(w http.ResponseWriter, r *http.Requ
 	}
 	writeSuccessResponseJSON(w, data)
 }
+
+// freeVersionsCompactor is implemented by object layers able to compact
+// free-versions left behind by tiered objects.
+type freeVersionsCompactor interface {
+	CompactFreeVersions(ctx context.Context, bucket string, dryRun bool) (FreeVersionsCompaction, error)
+}
+
+// CompactFreeVersionsHandler - POST /minio/admin/v3/compact-free-versions?bucket={bucket}&dry-run={bool}
+// ----------
+// Removes free-versions of the bucket whose tiered content no longer exists
+// on the remote tier. Free-versions with tiered content still present are
+// left to the scanner, which removes the tiered content first.
+func (api adminAPIHandlers) CompactFreeVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "CompactFreeVersions")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objAPI, _ := validateAdminReq(ctx, w, r, iampolicy.SetTierAction)
+	if objAPI == nil || globalNotificationSys == nil || globalTierConfigMgr == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	compactor, ok := objAPI.(freeVersionsCompactor)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	bucket := r.Form.Get("bucket")
+	if _, err := objAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	res, err := compactor.CompactFreeVersions(ctx, bucket, r.Form.Get("dry-run") == "true")
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -28,6 +28,7 @@ import (
 	"sync"
 	"time"
 
+	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
 	"github.com/tinylib/msgp/msgp"
 )
@@ -159,6 +160,29 @@ func deleteObjectFromRemoteTier(ctx context.Context, objName, rvID, tierName str
 	return nil
 }
 
+// remoteTierObjectExists returns true if the tiered content objName with
+// version rvID is still present on tierName.
+func remoteTierObjectExists(ctx context.Context, objName, rvID, tierName string) (bool, error) {
+	w, err := globalTierConfigMgr.getDriver(tierName)
+	if err != nil {
+		return false, err
+	}
+	r, err := w.Get(ctx, objName, remoteVersionID(rvID), WarmBackendGetOpts{startOffset: 0, length: 1})
+	if err == nil {
+		xhttp.DrainBody(r)
+		return true, nil
+	}
+	var invalidRange InvalidRange
+	switch {
+	case isErrObjectNotFound(err), isErrVersionNotFound(err):
+		return false, nil
+	case errors.As(err, &invalidRange):
+		// zero sized tiered content
+		return true, nil
+	}
+	return false, err
+}
+
 func (jd *tierDiskJournal) deletePending(ctx context.Context) {
 	ticker := time.NewTicker(30 * time.Minute)
 	defer ticker.Stop()
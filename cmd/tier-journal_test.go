@@ -19,6 +19,9 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"io"
 	"testing"
 
 	"github.com/tinylib/msgp/msgp"
@@ -119,3 +122,60 @@ func TestJEntryWriteNewToOldMix1(t *testing.T) {
 		}
 	}
 }
+
+type memWarmBackend map[string][]byte
+
+func (m memWarmBackend) Put(ctx context.Context, object string, r io.Reader, length int64) (remoteVersionID, error) {
+	b, err := io.ReadAll(r)
+	m[object] = b
+	return "", err
+}
+
+func (m memWarmBackend) Get(ctx context.Context, object string, rv remoteVersionID, opts WarmBackendGetOpts) (io.ReadCloser, error) {
+	b, ok := m[object]
+	if !ok {
+		return nil, ObjectNotFound{Object: object}
+	}
+	if opts.startOffset >= int64(len(b)) {
+		return nil, InvalidRange{OffsetBegin: opts.startOffset, ResourceSize: int64(len(b))}
+	}
+	return io.NopCloser(bytes.NewReader(b[opts.startOffset:])), nil
+}
+
+func (m memWarmBackend) Remove(ctx context.Context, object string, rv remoteVersionID) error {
+	delete(m, object)
+	return nil
+}
+
+func (m memWarmBackend) InUse(ctx context.Context) (bool, error) {
+	return len(m) > 0, nil
+}
+
+func TestRemoteTierObjectExists(t *testing.T) {
+	defer func(mgr *TierConfigMgr) { globalTierConfigMgr = mgr }(globalTierConfigMgr)
+	globalTierConfigMgr = NewTierConfigMgr()
+	globalTierConfigMgr.drivercache["WARM-1"] = memWarmBackend{
+		"obj":   []byte("data"),
+		"empty": nil,
+	}
+
+	testCases := []struct {
+		objName, tier string
+		exists        bool
+		err           error
+	}{
+		{"obj", "WARM-1", true, nil},
+		{"empty", "WARM-1", true, nil},
+		{"removed", "WARM-1", false, nil},
+		{"obj", "WARM-2", false, errTierNotFound},
+	}
+	for i, tc := range testCases {
+		exists, err := remoteTierObjectExists(context.Background(), tc.objName, "", tc.tier)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("case %d: expected error %v, got %v", i+1, tc.err, err)
+		}
+		if exists != tc.exists {
+			t.Fatalf("case %d: expected exists %v, got %v", i+1, tc.exists, exists)
+		}
+	}
+}
@@ -18,17 +18,135 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"math"
+	"net/url"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cheggaaa/pb"
 	humanize "github.com/dustin/go-humanize"
 	"github.com/minio/minio/internal/color"
+	"github.com/minio/minio/internal/logger"
 )
 
+const (
+	// Interval between background checks for a newer release.
+	updateCheckInterval = 24 * time.Hour
+
+	// Timeout of a single background check.
+	updateCheckTimeout = 10 * time.Second
+)
+
+// updateCheckResult is the result of the last successful
+// check for a newer release.
+type updateCheckResult struct {
+	UpdateAvailable bool       `json:"updateAvailable"`
+	LatestVersion   string     `json:"latestVersion,omitempty"`
+	CheckedAt       *time.Time `json:"checkedAt,omitempty"`
+}
+
+// updateChecker periodically checks for a newer release
+// and caches the result of the last successful check.
+type updateChecker struct {
+	mu      sync.RWMutex
+	result  updateCheckResult
+	failing bool
+
+	// Release info URL set by the update config, empty
+	// for the official release info.
+	url string
+}
+
+var globalUpdateChecker = &updateChecker{}
+
+// Result returns the result of the last successful check.
+func (c *updateChecker) Result() updateCheckResult {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.result
+}
+
+func (c *updateChecker) infoURL() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.url
+}
+
+func (c *updateChecker) setInfoURL(u string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.url = u
+}
+
+// check fetches the latest release info from u and caches the result.
+// Failures are only logged once until a check succeeds again.
+func (c *updateChecker) check(ctx context.Context, u *url.URL, crTime time.Time, mode string) (lrTime time.Time, err error) {
+	_, lrTime, err = getLatestReleaseTime(u, updateCheckTimeout, mode)
+
+	c.mu.Lock()
+	logErr := err != nil && !c.failing
+	c.failing = err != nil
+	if err == nil {
+		now := UTCNow()
+		c.result = updateCheckResult{
+			UpdateAvailable: lrTime.After(crTime),
+			LatestVersion:   lrTime.Format(minioReleaseTagTimeLayout),
+			CheckedAt:       &now,
+		}
+	}
+	c.mu.Unlock()
+
+	if logErr {
+		logger.LogIf(ctx, fmt.Errorf("Unable to check for updates at %s: %w", u, err))
+	}
+	return lrTime, err
+}
+
+// run checks for a newer release at startup, printing a notification
+// unless quiet, and then every updateCheckInterval until ctx is canceled.
+// The release info URL is read at every check as the update config
+// may change it.
+func (c *updateChecker) run(ctx context.Context, mode string) {
+	crTime, err := GetCurrentReleaseTime()
+	if err != nil {
+		return
+	}
+
+	checkURL := func() (time.Time, error) {
+		u, err := url.Parse(getUpdateInfoURL())
+		if err != nil {
+			return time.Time{}, err
+		}
+		return c.check(ctx, u, crTime, mode)
+	}
+
+	if lrTime, err := checkURL(); err == nil && !globalCLIContext.Quiet {
+		if updateMsg := prepareUpdateMessage("Run `mc admin update`", lrTime.Sub(crTime)); updateMsg != "" {
+			logger.Info(updateMsg)
+		}
+	}
+
+	t := time.NewTimer(updateCheckInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			checkURL()
+			t.Reset(updateCheckInterval)
+		}
+	}
+}
+
 // prepareUpdateMessage - prepares the update message, only if a
 // newer version is available.
 func prepareUpdateMessage(downloadURL string, older time.Duration) string {
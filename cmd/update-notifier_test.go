@@ -18,8 +18,13 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -91,3 +96,69 @@ func TestPrepareUpdateMessage(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateCheckerCachesResult(t *testing.T) {
+	online := int32(1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&online) == 0 {
+			http.Error(w, "", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "fbe246edbd382902db9a4035df7dce8cb441357d minio.RELEASE.2016-10-07T01-16-39Z")
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &updateChecker{}
+	if c.Result().CheckedAt != nil {
+		t.Fatal("expected no result before the first check")
+	}
+
+	crTime := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err = c.check(context.Background(), u, crTime, ""); err != nil {
+		t.Fatal(err)
+	}
+	result := c.Result()
+	if !result.UpdateAvailable || result.LatestVersion != "2016-10-07T01-16-39Z" || result.CheckedAt == nil {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	// A failed check keeps the last successful result.
+	atomic.StoreInt32(&online, 0)
+	if _, err = c.check(context.Background(), u, crTime, ""); err == nil {
+		t.Fatal("expected the check to fail")
+	}
+	if !c.failing {
+		t.Fatal("expected the checker to be failing")
+	}
+	if got := c.Result(); got.LatestVersion != result.LatestVersion || !got.CheckedAt.Equal(*result.CheckedAt) {
+		t.Fatalf("expected %+v, got %+v", result, got)
+	}
+
+	atomic.StoreInt32(&online, 1)
+	if _, err = c.check(context.Background(), u, crTime.AddDate(1, 0, 0), ""); err != nil {
+		t.Fatal(err)
+	}
+	if c.failing || c.Result().UpdateAvailable {
+		t.Fatalf("unexpected state after recovery: failing %v, result %+v", c.failing, c.Result())
+	}
+}
+
+func TestUpdateCheckerInfoURL(t *testing.T) {
+	defer globalUpdateChecker.setInfoURL(globalUpdateChecker.infoURL())
+
+	globalUpdateChecker.setInfoURL("")
+	if u := getUpdateInfoURL(); u != minioReleaseInfoURL && u != minioReleaseWindowsInfoURL {
+		t.Fatalf("expected the official release info, got %s", u)
+	}
+
+	mirror := "https://mirror.example.com/minio/minio.sha256sum"
+	globalUpdateChecker.setInfoURL(mirror)
+	if u := getUpdateInfoURL(); u != mirror {
+		t.Fatalf("expected %s, got %s", mirror, u)
+	}
+}
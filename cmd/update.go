@@ -56,6 +56,18 @@ const (
 // For windows our files have .exe additionally.
 var minioReleaseWindowsInfoURL = minioReleaseURL + "minio.exe.sha256sum"
 
+// getUpdateInfoURL - returns the URL of the release info used to check
+// for updates, the update config may point it to an internal mirror.
+func getUpdateInfoURL() string {
+	if updateURL := globalUpdateChecker.infoURL(); updateURL != "" {
+		return updateURL
+	}
+	if runtime.GOOS == globalWindowsOSName {
+		return minioReleaseWindowsInfoURL
+	}
+	return minioReleaseInfoURL
+}
+
 // minioVersionToReleaseTime - parses a standard official release
 // MinIO version string.
 //
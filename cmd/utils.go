@@ -577,17 +577,32 @@ func GetDefaultConnSettings() xhttp.ConnSettings {
 	}
 }
 
+// internodeConnSettings returns the settings for internode MinIO
+// connections, using the connection pool settings of the api config
+// where set.
+func internodeConnSettings(cfg api.Config) xhttp.ConnSettings {
+	dialTimeout := cfg.InternodeDialTimeout
+	if dialTimeout <= 0 {
+		dialTimeout = rest.DefaultTimeout
+	}
+	return xhttp.ConnSettings{
+		DNSCache:            globalDNSCache,
+		DialTimeout:         dialTimeout,
+		RootCAs:             globalRootCAs,
+		CipherSuites:        fips.TLSCiphers(),
+		CurvePreferences:    fips.TLSCurveIDs(),
+		EnableHTTP2:         false,
+		MaxIdleConnsPerHost: cfg.InternodeMaxIdleConnsPerHost,
+		MaxConnsPerHost:     cfg.InternodeMaxConnsPerHost,
+		IdleConnTimeout:     cfg.InternodeIdleConnTimeout,
+		TLSHandshakeTimeout: cfg.InternodeTLSHandshakeTimeout,
+	}
+}
+
 // NewInternodeHTTPTransport returns a transport for internode MinIO
 // connections.
 func NewInternodeHTTPTransport() func() http.RoundTripper {
-	return xhttp.ConnSettings{
-		DNSCache:         globalDNSCache,
-		DialTimeout:      rest.DefaultTimeout,
-		RootCAs:          globalRootCAs,
-		CipherSuites:     fips.TLSCiphers(),
-		CurvePreferences: fips.TLSCurveIDs(),
-		EnableHTTP2:      false,
-	}.NewInternodeHTTPTransport()
+	return internodeConnSettings(api.Config{}).NewInternodeHTTPTransport()
 }
 
 // NewCustomHTTPProxyTransport is used only for proxied requests, specifically
@@ -604,8 +619,9 @@ func NewCustomHTTPProxyTransport() func() *http.Transport {
 }
 
 // NewHTTPTransportWithClientCerts returns a new http configuration
-// used while communicating with the cloud backends.
-func NewHTTPTransportWithClientCerts(clientCert, clientKey string) *http.Transport {
+// used while communicating with the cloud backends. The client cert,
+// key and certificate authority are either file paths or inline PEM.
+func NewHTTPTransportWithClientCerts(clientCert, clientKey, certAuthority string) *http.Transport {
 	s := xhttp.ConnSettings{
 		DNSCache:    globalDNSCache,
 		DialTimeout: defaultDialTimeout,
@@ -613,9 +629,30 @@ func NewHTTPTransportWithClientCerts(clientCert, clientKey string) *http.Transpo
 		EnableHTTP2: false,
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if certAuthority != "" {
+		rootCAs, err := config.LoadCertPool(certAuthority)
+		if err != nil {
+			logger.LogIf(ctx, fmt.Errorf("failed to load certificate authority, please check your endpoint configuration: %w", err))
+		} else {
+			s.RootCAs = rootCAs
+		}
+	}
+
 	if clientCert != "" && clientKey != "" {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-		defer cancel()
+		if config.IsPEM(clientCert) || config.IsPEM(clientKey) {
+			// Inline certificates are not reloaded.
+			transport := s.NewHTTPTransportWithTimeout(1 * time.Minute)
+			cert, err := config.LoadClientCertificate(clientCert, clientKey)
+			if err != nil {
+				logger.LogIf(ctx, fmt.Errorf("failed to load client key and cert, please check your endpoint configuration: %w", err))
+			} else {
+				transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+			}
+			return transport
+		}
 		transport, err := s.NewHTTPTransportWithClientCerts(ctx, clientCert, clientKey)
 		if err != nil {
 			logger.LogIf(ctx, fmt.Errorf("failed to load client key and cert, please check your endpoint configuration: %s",
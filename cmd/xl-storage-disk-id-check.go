@@ -81,6 +81,7 @@ type xlStorageDiskIDCheck struct {
 	diskID       string
 	storage      *xlStorage
 	health       *diskHealthTracker
+	workers      *driveWorkers
 	metricsCache timedValue
 }
 
@@ -130,10 +131,26 @@ func (e *lockedLastMinuteLatency) total() AccElem {
 	return e.lastMinuteLatency.getTotal()
 }
 
+// lastMinuteLatency returns the average latency of the given
+// operations in the last minute.
+func (p *xlStorageDiskIDCheck) lastMinuteLatency(metrics ...storageMetric) time.Duration {
+	var acc AccElem
+	for _, m := range metrics {
+		t := p.apiLatencies[m].total()
+		acc.Total += t.Total
+		acc.N += t.N
+	}
+	if acc.N == 0 {
+		return 0
+	}
+	return time.Duration(acc.Total / acc.N)
+}
+
 func newXLStorageDiskIDCheck(storage *xlStorage) *xlStorageDiskIDCheck {
 	xl := xlStorageDiskIDCheck{
 		storage: storage,
 		health:  newDiskHealthTracker(),
+		workers: newDriveWorkers(),
 	}
 	for i := range xl.apiLatencies[:] {
 		xl.apiLatencies[i] = &lockedLastMinuteLatency{}
@@ -230,7 +247,25 @@ func (p *xlStorageDiskIDCheck) DiskInfo(ctx context.Context) (info DiskInfo, err
 	si := p.updateStorageMetrics(storageMetricDiskInfo)
 	defer si(&err)
 
-	info, err = p.storage.DiskInfo(ctx)
+	if p.health.isHung() {
+		// Do not wait on the drive again, report it as hung.
+		return DiskInfo{
+			Endpoint:  p.storage.Endpoint().String(),
+			MountPath: p.storage.diskPath,
+			ID:        p.diskID,
+			Metrics:   p.getMetrics(),
+		}, errDriveHung
+	}
+
+	var dinfo DiskInfo
+	completed, err := p.runWithTimeout(ctx, storageMetricDiskInfo, driveMetadataOp, 0, func() (err error) {
+		dinfo, err = p.storage.DiskInfo(ctx)
+		return err
+	})
+	if !completed {
+		return info, err
+	}
+	info = dinfo
 	if err != nil {
 		return info, err
 	}
@@ -259,7 +294,9 @@ func (p *xlStorageDiskIDCheck) MakeVolBulk(ctx context.Context, volumes ...strin
 	}
 	defer done(&err)
 
-	return p.storage.MakeVolBulk(ctx, volumes...)
+	return p.withTimeout(ctx, storageMetricMakeVolBulk, driveMetadataOp, 0, func() error {
+		return p.storage.MakeVolBulk(ctx, volumes...)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) MakeVol(ctx context.Context, volume string) (err error) {
@@ -275,7 +312,9 @@ func (p *xlStorageDiskIDCheck) MakeVol(ctx context.Context, volume string) (err
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
-	return p.storage.MakeVol(ctx, volume)
+	return p.withTimeout(ctx, storageMetricMakeVol, driveMetadataOp, 0, func() error {
+		return p.storage.MakeVol(ctx, volume)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) (vi []VolInfo, err error) {
@@ -285,7 +324,15 @@ func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) (vi []VolInfo, err
 	}
 	defer done(&err)
 
-	return p.storage.ListVols(ctx)
+	var vols []VolInfo
+	completed, err := p.runWithTimeout(ctx, storageMetricListVols, driveMetadataOp, 0, func() (err error) {
+		vols, err = p.storage.ListVols(ctx)
+		return err
+	})
+	if !completed {
+		return nil, err
+	}
+	return vols, err
 }
 
 func (p *xlStorageDiskIDCheck) StatVol(ctx context.Context, volume string) (vol VolInfo, err error) {
@@ -295,7 +342,15 @@ func (p *xlStorageDiskIDCheck) StatVol(ctx context.Context, volume string) (vol
 	}
 	defer done(&err)
 
-	return p.storage.StatVol(ctx, volume)
+	var vi VolInfo
+	completed, err := p.runWithTimeout(ctx, storageMetricStatVol, driveMetadataOp, 0, func() (err error) {
+		vi, err = p.storage.StatVol(ctx, volume)
+		return err
+	})
+	if !completed {
+		return VolInfo{}, err
+	}
+	return vi, err
 }
 
 func (p *xlStorageDiskIDCheck) DeleteVol(ctx context.Context, volume string, forceDelete bool) (err error) {
@@ -305,7 +360,9 @@ func (p *xlStorageDiskIDCheck) DeleteVol(ctx context.Context, volume string, for
 	}
 	defer done(&err)
 
-	return p.storage.DeleteVol(ctx, volume, forceDelete)
+	return p.withTimeout(ctx, storageMetricDeleteVol, driveLongOp, 0, func() error {
+		return p.storage.DeleteVol(ctx, volume, forceDelete)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath string, count int) (s []string, err error) {
@@ -315,7 +372,15 @@ func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath stri
 	}
 	defer done(&err)
 
-	return p.storage.ListDir(ctx, volume, dirPath, count)
+	var entries []string
+	completed, err := p.runWithTimeout(ctx, storageMetricListDir, driveMetadataOp, 0, func() (err error) {
+		entries, err = p.storage.ListDir(ctx, volume, dirPath, count)
+		return err
+	})
+	if !completed {
+		return nil, err
+	}
+	return entries, err
 }
 
 func (p *xlStorageDiskIDCheck) ReadFile(ctx context.Context, volume string, path string, offset int64, buf []byte, verifier *BitrotVerifier) (n int64, err error) {
@@ -325,7 +390,19 @@ func (p *xlStorageDiskIDCheck) ReadFile(ctx context.Context, volume string, path
 	}
 	defer done(&err)
 
-	return p.storage.ReadFile(ctx, volume, path, offset, buf, verifier)
+	// Read into a private buffer, a timed out read still completes later.
+	rbuf := getDriveBuf(len(buf))
+	var rn int64
+	completed, err := p.runWithTimeout(ctx, storageMetricReadFile, driveDataOp, int64(len(buf)), func() (err error) {
+		rn, err = p.storage.ReadFile(ctx, volume, path, offset, *rbuf, verifier)
+		return err
+	})
+	if !completed {
+		return 0, err
+	}
+	copy(buf, (*rbuf)[:rn])
+	putDriveBuf(rbuf)
+	return rn, err
 }
 
 func (p *xlStorageDiskIDCheck) AppendFile(ctx context.Context, volume string, path string, buf []byte) (err error) {
@@ -335,7 +412,17 @@ func (p *xlStorageDiskIDCheck) AppendFile(ctx context.Context, volume string, pa
 	}
 	defer done(&err)
 
-	return p.storage.AppendFile(ctx, volume, path, buf)
+	// Write from a private buffer, buf is reused by the caller once
+	// a timed out write returns.
+	wbuf := getDriveBuf(len(buf))
+	copy(*wbuf, buf)
+	completed, err := p.runWithTimeout(ctx, storageMetricAppendFile, driveDataOp, int64(len(buf)), func() error {
+		return p.storage.AppendFile(ctx, volume, path, *wbuf)
+	})
+	if completed {
+		putDriveBuf(wbuf)
+	}
+	return err
 }
 
 func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path string, size int64, reader io.Reader) (err error) {
@@ -355,7 +442,36 @@ func (p *xlStorageDiskIDCheck) ReadFileStream(ctx context.Context, volume, path
 	}
 	defer done(&err)
 
-	return p.storage.ReadFileStream(ctx, volume, path, offset, length)
+	var (
+		mu        sync.Mutex
+		rc        io.ReadCloser
+		abandoned bool
+	)
+	completed, err := p.runWithTimeout(ctx, storageMetricReadFileStream, driveMetadataOp, 0, func() error {
+		r, err := p.storage.ReadFileStream(ctx, volume, path, offset, length)
+		mu.Lock()
+		defer mu.Unlock()
+		if abandoned && r != nil {
+			// Opened after the timeout, nobody reads the stream.
+			r.Close()
+			return err
+		}
+		rc = r
+		return err
+	})
+	if !completed {
+		mu.Lock()
+		abandoned = true
+		if rc != nil {
+			rc.Close()
+		}
+		mu.Unlock()
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &timedReadCloser{p: p, ctx: ctx, rc: rc}, nil
 }
 
 func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) (err error) {
@@ -365,7 +481,9 @@ func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPat
 	}
 	defer done(&err)
 
-	return p.storage.RenameFile(ctx, srcVolume, srcPath, dstVolume, dstPath)
+	return p.withTimeout(ctx, storageMetricRenameFile, driveMetadataOp, 0, func() error {
+		return p.storage.RenameFile(ctx, srcVolume, srcPath, dstVolume, dstPath)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (sign uint64, err error) {
@@ -375,7 +493,15 @@ func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPat
 	}
 	defer done(&err)
 
-	return p.storage.RenameData(ctx, srcVolume, srcPath, fi, dstVolume, dstPath)
+	var rsign uint64
+	completed, err := p.runWithTimeout(ctx, storageMetricRenameData, driveMetadataOp, 0, func() (err error) {
+		rsign, err = p.storage.RenameData(ctx, srcVolume, srcPath, fi, dstVolume, dstPath)
+		return err
+	})
+	if !completed {
+		return 0, err
+	}
+	return rsign, err
 }
 
 func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (err error) {
@@ -385,7 +511,9 @@ func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, pa
 	}
 	defer done(&err)
 
-	return p.storage.CheckParts(ctx, volume, path, fi)
+	return p.withTimeout(ctx, storageMetricCheckParts, driveMetadataOp, 0, func() error {
+		return p.storage.CheckParts(ctx, volume, path, fi)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (err error) {
@@ -395,7 +523,13 @@ func (p *xlStorageDiskIDCheck) Delete(ctx context.Context, volume string, path s
 	}
 	defer done(&err)
 
-	return p.storage.Delete(ctx, volume, path, deleteOpts)
+	class := driveMetadataOp
+	if deleteOpts.Recursive {
+		class = driveLongOp
+	}
+	return p.withTimeout(ctx, storageMetricDelete, class, 0, func() error {
+		return p.storage.Delete(ctx, volume, path, deleteOpts)
+	})
 }
 
 // DeleteVersions deletes slice of versions, it can be same object
@@ -433,7 +567,9 @@ func (p *xlStorageDiskIDCheck) VerifyFile(ctx context.Context, volume, path stri
 	}
 	defer done(&err)
 
-	return p.storage.VerifyFile(ctx, volume, path, fi)
+	return p.withTimeout(ctx, storageMetricVerifyFile, driveDataOp, fi.Size, func() error {
+		return p.storage.VerifyFile(ctx, volume, path, fi)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) WriteAll(ctx context.Context, volume string, path string, b []byte) (err error) {
@@ -443,7 +579,17 @@ func (p *xlStorageDiskIDCheck) WriteAll(ctx context.Context, volume string, path
 	}
 	defer done(&err)
 
-	return p.storage.WriteAll(ctx, volume, path, b)
+	// Write from a private buffer, b is reused by the caller once
+	// a timed out write returns.
+	wbuf := getDriveBuf(len(b))
+	copy(*wbuf, b)
+	completed, err := p.runWithTimeout(ctx, storageMetricWriteAll, driveMetadataOp, 0, func() error {
+		return p.storage.WriteAll(ctx, volume, path, *wbuf)
+	})
+	if completed {
+		putDriveBuf(wbuf)
+	}
+	return err
 }
 
 func (p *xlStorageDiskIDCheck) DeleteVersion(ctx context.Context, volume, path string, fi FileInfo, forceDelMarker bool) (err error) {
@@ -453,7 +599,9 @@ func (p *xlStorageDiskIDCheck) DeleteVersion(ctx context.Context, volume, path s
 	}
 	defer done(&err)
 
-	return p.storage.DeleteVersion(ctx, volume, path, fi, forceDelMarker)
+	return p.withTimeout(ctx, storageMetricDeleteVersion, driveMetadataOp, 0, func() error {
+		return p.storage.DeleteVersion(ctx, volume, path, fi, forceDelMarker)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) UpdateMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
@@ -463,7 +611,9 @@ func (p *xlStorageDiskIDCheck) UpdateMetadata(ctx context.Context, volume, path
 	}
 	defer done(&err)
 
-	return p.storage.UpdateMetadata(ctx, volume, path, fi)
+	return p.withTimeout(ctx, storageMetricUpdateMetadata, driveMetadataOp, 0, func() error {
+		return p.storage.UpdateMetadata(ctx, volume, path, fi)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
@@ -473,7 +623,9 @@ func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path s
 	}
 	defer done(&err)
 
-	return p.storage.WriteMetadata(ctx, volume, path, fi)
+	return p.withTimeout(ctx, storageMetricWriteMetadata, driveMetadataOp, 0, func() error {
+		return p.storage.WriteMetadata(ctx, volume, path, fi)
+	})
 }
 
 func (p *xlStorageDiskIDCheck) ReadVersion(ctx context.Context, volume, path, versionID string, readData bool) (fi FileInfo, err error) {
@@ -483,7 +635,15 @@ func (p *xlStorageDiskIDCheck) ReadVersion(ctx context.Context, volume, path, ve
 	}
 	defer done(&err)
 
-	return p.storage.ReadVersion(ctx, volume, path, versionID, readData)
+	var rfi FileInfo
+	completed, err := p.runWithTimeout(ctx, storageMetricReadVersion, driveMetadataOp, 0, func() (err error) {
+		rfi, err = p.storage.ReadVersion(ctx, volume, path, versionID, readData)
+		return err
+	})
+	if !completed {
+		return FileInfo{}, err
+	}
+	return rfi, err
 }
 
 func (p *xlStorageDiskIDCheck) ReadAll(ctx context.Context, volume string, path string) (buf []byte, err error) {
@@ -493,7 +653,15 @@ func (p *xlStorageDiskIDCheck) ReadAll(ctx context.Context, volume string, path
 	}
 	defer done(&err)
 
-	return p.storage.ReadAll(ctx, volume, path)
+	var data []byte
+	completed, err := p.runWithTimeout(ctx, storageMetricReadAll, driveMetadataOp, 0, func() (err error) {
+		data, err = p.storage.ReadAll(ctx, volume, path)
+		return err
+	})
+	if !completed {
+		return nil, err
+	}
+	return data, err
 }
 
 func (p *xlStorageDiskIDCheck) ReadXL(ctx context.Context, volume string, path string, readData bool) (rf RawFileInfo, err error) {
@@ -503,7 +671,15 @@ func (p *xlStorageDiskIDCheck) ReadXL(ctx context.Context, volume string, path s
 	}
 	defer done(&err)
 
-	return p.storage.ReadXL(ctx, volume, path, readData)
+	var raw RawFileInfo
+	completed, err := p.runWithTimeout(ctx, storageMetricReadXL, driveMetadataOp, 0, func() (err error) {
+		raw, err = p.storage.ReadXL(ctx, volume, path, readData)
+		return err
+	})
+	if !completed {
+		return RawFileInfo{}, err
+	}
+	return raw, err
 }
 
 func (p *xlStorageDiskIDCheck) StatInfoFile(ctx context.Context, volume, path string, glob bool) (stat []StatInfo, err error) {
@@ -513,7 +689,15 @@ func (p *xlStorageDiskIDCheck) StatInfoFile(ctx context.Context, volume, path st
 	}
 	defer done(&err)
 
-	return p.storage.StatInfoFile(ctx, volume, path, glob)
+	var stats []StatInfo
+	completed, err := p.runWithTimeout(ctx, storageMetricStatInfoFile, driveMetadataOp, 0, func() (err error) {
+		stats, err = p.storage.StatInfoFile(ctx, volume, path, glob)
+		return err
+	})
+	if !completed {
+		return nil, err
+	}
+	return stats, err
 }
 
 // ReadMultiple will read multiple files and send each back as response.
@@ -540,7 +724,9 @@ func (p *xlStorageDiskIDCheck) CleanAbandonedData(ctx context.Context, volume st
 	}
 	defer done(&err)
 
-	return p.storage.CleanAbandonedData(ctx, volume, path)
+	return p.withTimeout(ctx, storageMetricDeleteAbandonedParts, driveLongOp, 0, func() error {
+		return p.storage.CleanAbandonedData(ctx, volume, path)
+	})
 }
 
 func storageTrace(s storageMetric, startTime time.Time, duration time.Duration, path string, err string) madmin.TraceInfo {
@@ -591,8 +777,17 @@ func (p *xlStorageDiskIDCheck) updateStorageMetrics(s storageMetric, paths ...st
 const (
 	diskHealthOK = iota
 	diskHealthFaulty
+	// Drive took too many operations in a row past their timeout.
+	diskHealthHung
+	diskHealthStatuses
 )
 
+var diskHealthStatusNames = [diskHealthStatuses]string{
+	diskHealthOK:     "ok",
+	diskHealthFaulty: "faulty",
+	diskHealthHung:   "hung",
+}
+
 // diskMaxConcurrent is the maximum number of running concurrent operations
 // for local and (incoming) remote disk ops respectively.
 var diskMaxConcurrent = 512
@@ -619,6 +814,13 @@ type diskHealthTracker struct {
 	// Atomic number of requests blocking for a token.
 	blocked int32
 
+	// Atomic number of operations timed out, in total and in a row.
+	timeouts            uint64
+	consecutiveTimeouts int32
+
+	// Atomic number of transitions to each status.
+	transitions [diskHealthStatuses]uint64
+
 	// Concurrency tokens.
 	tokens chan struct{}
 }
@@ -646,6 +848,32 @@ func (d *diskHealthTracker) isFaulty() bool {
 	return atomic.LoadInt32(&d.status) == diskHealthFaulty
 }
 
+func (d *diskHealthTracker) isHung() bool {
+	return atomic.LoadInt32(&d.status) == diskHealthHung
+}
+
+// isOffline returns true if the drive was taken offline.
+func (d *diskHealthTracker) isOffline() bool {
+	return atomic.LoadInt32(&d.status) != diskHealthOK
+}
+
+// setStatus changes the status from one status to another, returns
+// false if the status was not from.
+func (d *diskHealthTracker) setStatus(from, to int32) bool {
+	if !atomic.CompareAndSwapInt32(&d.status, from, to) {
+		return false
+	}
+	atomic.AddUint64(&d.transitions[to], 1)
+	return true
+}
+
+// opCompleted resets the number of operations timed out in a row.
+func (d *diskHealthTracker) opCompleted() {
+	if atomic.LoadInt32(&d.consecutiveTimeouts) != 0 {
+		atomic.StoreInt32(&d.consecutiveTimeouts, 0)
+	}
+}
+
 type (
 	healthDiskCtxKey   struct{}
 	healthDiskCtxValue struct {
@@ -675,8 +903,8 @@ func (p *xlStorageDiskIDCheck) TrackDiskHealth(ctx context.Context, s storageMet
 		return ctx, done, ctx.Err()
 	}
 
-	// Return early if disk is faulty already.
-	if atomic.LoadInt32(&p.health.status) == diskHealthFaulty {
+	// Return early if disk is faulty or hung already.
+	if p.health.isOffline() {
 		return ctx, done, errFaultyDisk
 	}
 
@@ -756,7 +984,7 @@ func (p *xlStorageDiskIDCheck) waitForToken(ctx context.Context) (err error) {
 // checkHealth should only be called when tokens have run out.
 // This will check if disk should be taken offline.
 func (p *xlStorageDiskIDCheck) checkHealth(ctx context.Context) (err error) {
-	if atomic.LoadInt32(&p.health.status) == diskHealthFaulty {
+	if p.health.isOffline() {
 		return errFaultyDisk
 	}
 	// Check if there are tokens.
@@ -778,7 +1006,7 @@ func (p *xlStorageDiskIDCheck) checkHealth(ctx context.Context) (err error) {
 	// If also more than 15 seconds since last success, take disk offline.
 	t = time.Since(time.Unix(0, atomic.LoadInt64(&p.health.lastSuccess)))
 	if t > maxTimeSinceLastSuccess {
-		if atomic.CompareAndSwapInt32(&p.health.status, diskHealthOK, diskHealthFaulty) {
+		if p.health.setStatus(diskHealthOK, diskHealthFaulty) {
 			logger.LogAlwaysIf(ctx, fmt.Errorf("taking drive %s offline, time since last response %v", p.storage.String(), t.Round(time.Millisecond)))
 			go p.monitorDiskStatus()
 		}
@@ -813,7 +1041,7 @@ func (p *xlStorageDiskIDCheck) monitorDiskStatus() {
 		if err == nil {
 			logger.Info("Able to read+write+delete, bringing drive %s online. Drive was offline for %s.", p.storage.String(),
 				time.Since(time.Unix(0, atomic.LoadInt64(&p.health.lastSuccess))))
-			atomic.StoreInt32(&p.health.status, diskHealthOK)
+			p.health.setStatus(diskHealthFaulty, diskHealthOK)
 			return
 		}
 	}
@@ -0,0 +1,363 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio/internal/config/drive"
+	"github.com/minio/minio/internal/logger"
+	uatomic "go.uber.org/atomic"
+)
+
+// Classes of storage operations sharing a timeout.
+const (
+	// Operations on metadata, volumes and directories.
+	driveMetadataOp = iota
+	// Reads and writes of object data, the timeout scales with the size.
+	driveDataOp
+	// Operations whose duration depends on the amount of data on the
+	// drive, such as recursive deletes.
+	driveLongOp
+)
+
+// driveDataTimeoutSize is the size of data a data operation is allowed
+// the data timeout for, larger operations are allowed proportionally more.
+const driveDataTimeoutSize = 64 << 20
+
+// driveTimeoutConfig holds the drive timeouts, set by the drive config
+// sub-system. A timeout of 0 disables it.
+type driveTimeoutConfig struct {
+	metadata uatomic.Duration
+	data     uatomic.Duration
+	long     uatomic.Duration
+
+	// Number of consecutive timed out operations after which a drive
+	// is considered hung and taken offline until it responds again.
+	maxTimeouts uatomic.Int32
+}
+
+var driveTimeouts = newDriveTimeoutConfig()
+
+func newDriveTimeoutConfig() *driveTimeoutConfig {
+	var c driveTimeoutConfig
+	c.metadata.Store(10 * time.Second)
+	c.data.Store(30 * time.Second)
+	c.long.Store(5 * time.Minute)
+	c.maxTimeouts.Store(3)
+	return &c
+}
+
+func (c *driveTimeoutConfig) update(cfg drive.Config) {
+	c.metadata.Store(cfg.MetadataTimeout)
+	c.data.Store(cfg.DataTimeout)
+	c.long.Store(cfg.LongTimeout)
+	c.maxTimeouts.Store(int32(cfg.MaxTimeouts))
+}
+
+// driveOpTimeout returns the timeout of an operation of class on size
+// bytes of data, 0 if the operation must not time out.
+func driveOpTimeout(class int, size int64) time.Duration {
+	switch class {
+	case driveMetadataOp:
+		return driveTimeouts.metadata.Load()
+	case driveLongOp:
+		return driveTimeouts.long.Load()
+	}
+	if size < 0 {
+		size = 0
+	}
+	return driveTimeouts.data.Load() * time.Duration(1+size/driveDataTimeoutSize)
+}
+
+// driveOp is a storage operation run by a drive worker. Operations are
+// pooled along with their timer, an operation that timed out is never
+// returned to the pool since its worker may still use it.
+type driveOp struct {
+	fn    func() error
+	errCh chan error
+	timer *time.Timer
+}
+
+var driveOpPool = sync.Pool{
+	New: func() interface{} {
+		t := time.NewTimer(time.Hour)
+		t.Stop()
+		return &driveOp{
+			errCh: make(chan error, 1),
+			timer: t,
+		}
+	},
+}
+
+// driveWorkerIdleTimeout is the time after which an idle drive worker exits.
+const driveWorkerIdleTimeout = time.Minute
+
+// driveWorkers runs the operations of a drive on long lived goroutines,
+// more are started when all are busy, for instance running operations
+// which do not complete.
+type driveWorkers struct {
+	ops chan *driveOp
+}
+
+func newDriveWorkers() *driveWorkers {
+	return &driveWorkers{ops: make(chan *driveOp)}
+}
+
+func (w *driveWorkers) run(op *driveOp) {
+	select {
+	case w.ops <- op:
+	default:
+		go w.worker(op)
+	}
+}
+
+func (w *driveWorkers) worker(op *driveOp) {
+	idle := time.NewTimer(driveWorkerIdleTimeout)
+	defer idle.Stop()
+	for {
+		// op must not be used once its result is sent.
+		op.errCh <- op.fn()
+
+		if !idle.Stop() {
+			select {
+			case <-idle.C:
+			default:
+			}
+		}
+		idle.Reset(driveWorkerIdleTimeout)
+		select {
+		case op = <-w.ops:
+		case <-idle.C:
+			return
+		}
+	}
+}
+
+// withTimeout runs fn, returning errFaultyDisk if it does not complete
+// within the timeout of the operation.
+func (p *xlStorageDiskIDCheck) withTimeout(ctx context.Context, s storageMetric, class int, size int64, fn func() error) error {
+	_, err := p.runWithTimeout(ctx, s, class, size, fn)
+	return err
+}
+
+// isDriveWriteOp returns true for the storage operations which change
+// the content of a drive.
+func isDriveWriteOp(s storageMetric) bool {
+	switch s {
+	case storageMetricMakeVolBulk, storageMetricMakeVol, storageMetricDeleteVol,
+		storageMetricAppendFile, storageMetricCreateFile, storageMetricRenameFile,
+		storageMetricRenameData, storageMetricDelete, storageMetricDeleteVersions,
+		storageMetricWriteAll, storageMetricDeleteVersion, storageMetricWriteMetadata,
+		storageMetricUpdateMetadata, storageMetricDeleteAbandonedParts:
+		return true
+	}
+	return false
+}
+
+// runWithTimeout runs fn, returning errFaultyDisk if it does not complete
+// within the timeout of the operation. As IO cannot be interrupted fn
+// keeps running when it times out or ctx is canceled, completed is then
+// false and the results of fn must not be read, nor the buffers it uses
+// be reused.
+//
+// A write which times out may still be applied, failing it would report
+// a change the drive ends up with as not made. Writes hence wait for fn
+// to complete and return its result, their timeout only counts towards
+// taking a hung drive offline, which fails the following operations.
+func (p *xlStorageDiskIDCheck) runWithTimeout(ctx context.Context, s storageMetric, class int, size int64, fn func() error) (completed bool, err error) {
+	timeout := driveOpTimeout(class, size)
+	if timeout <= 0 {
+		return true, fn()
+	}
+
+	op := driveOpPool.Get().(*driveOp)
+	op.fn = fn
+	op.timer.Reset(timeout)
+	p.workers.run(op)
+
+	write := isDriveWriteOp(s)
+	doneCh := ctx.Done()
+	if write {
+		doneCh = nil
+	}
+	timedOut := false
+	for {
+		select {
+		case err = <-op.errCh:
+			if !op.timer.Stop() {
+				select {
+				case <-op.timer.C:
+				default:
+				}
+			}
+			op.fn = nil
+			driveOpPool.Put(op)
+			if !timedOut {
+				p.health.opCompleted()
+			}
+			return true, err
+		case <-op.timer.C:
+			p.opTimedOut(s, timeout)
+			if !write {
+				return false, errFaultyDisk
+			}
+			timedOut = true
+		case <-doneCh:
+			op.timer.Stop()
+			return false, ctx.Err()
+		}
+	}
+}
+
+// driveBufPool holds the private buffers of timed data operations, a
+// buffer of an operation that timed out is left to the garbage collector.
+var driveBufPool = sync.Pool{
+	New: func() interface{} {
+		return new([]byte)
+	},
+}
+
+func getDriveBuf(size int) *[]byte {
+	b := driveBufPool.Get().(*[]byte)
+	if cap(*b) < size {
+		*b = make([]byte, size)
+	}
+	*b = (*b)[:size]
+	return b
+}
+
+func putDriveBuf(b *[]byte) {
+	driveBufPool.Put(b)
+}
+
+// opTimedOut records a timed out operation, taking the drive offline
+// once too many operations timed out in a row.
+func (p *xlStorageDiskIDCheck) opTimedOut(s storageMetric, timeout time.Duration) {
+	atomic.AddUint64(&p.health.timeouts, 1)
+	maxTimeouts := driveTimeouts.maxTimeouts.Load()
+	if atomic.AddInt32(&p.health.consecutiveTimeouts, 1) < maxTimeouts {
+		return
+	}
+	if p.health.setStatus(diskHealthOK, diskHealthHung) {
+		logger.LogAlwaysIf(GlobalContext, fmt.Errorf("taking drive %s offline, %d operations in a row timed out, last %s after %v",
+			p.storage.String(), maxTimeouts, s, timeout))
+		go p.monitorHungDisk()
+	}
+}
+
+// monitorHungDisk probes a hung drive until it responds in time again,
+// and then brings it back online.
+func (p *xlStorageDiskIDCheck) monitorHungDisk() {
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
+
+	fn := mustGetUUID()
+	var probing int32
+	for range t.C {
+		// A probe may itself hang, never have more than one running.
+		if !atomic.CompareAndSwapInt32(&probing, 0, 1) {
+			continue
+		}
+		errCh := make(chan error, 1)
+		go func() {
+			defer atomic.StoreInt32(&probing, 0)
+			errCh <- p.probe(fn)
+		}()
+
+		var timeoutCh <-chan time.Time
+		if timeout := driveTimeouts.metadata.Load(); timeout > 0 {
+			timeoutCh = time.After(timeout)
+		}
+		var err error
+		select {
+		case err = <-errCh:
+		case <-timeoutCh:
+			err = errFaultyDisk
+		}
+		if err != nil {
+			continue
+		}
+		atomic.StoreInt32(&p.health.consecutiveTimeouts, 0)
+		if p.health.setStatus(diskHealthHung, diskHealthOK) {
+			logger.Info("Drive %s responds in time again, bringing it online.", p.storage.String())
+		}
+		return
+	}
+}
+
+// probe writes, reads and deletes a small file on the drive.
+func (p *xlStorageDiskIDCheck) probe(fn string) error {
+	ctx := context.Background()
+	if err := p.storage.WriteAll(ctx, minioMetaTmpBucket, fn, []byte{10000: 42}); err != nil {
+		return err
+	}
+	b, err := p.storage.ReadAll(ctx, minioMetaTmpBucket, fn)
+	if err != nil {
+		return err
+	}
+	if len(b) != 10001 {
+		return errFileCorrupt
+	}
+	return p.storage.Delete(ctx, minioMetaTmpBucket, fn, DeleteOptions{})
+}
+
+// timedReadCloser fails reads of a drive stream which do not complete
+// within the data operation timeout. Data is read into a private buffer
+// since a timed out read still completes later.
+type timedReadCloser struct {
+	p   *xlStorageDiskIDCheck
+	ctx context.Context
+	rc  io.ReadCloser
+	buf []byte
+
+	// Set once a read timed out, the stream is then unusable.
+	err error
+}
+
+func (t *timedReadCloser) Read(b []byte) (n int, err error) {
+	if t.err != nil {
+		return 0, t.err
+	}
+	if len(t.buf) < len(b) {
+		t.buf = make([]byte, len(b))
+	}
+	buf := t.buf[:len(b)]
+	var rn int
+	completed, err := t.p.runWithTimeout(t.ctx, storageMetricReadFileStream, driveDataOp, int64(len(b)), func() (err error) {
+		rn, err = t.rc.Read(buf)
+		return err
+	})
+	if !completed {
+		// The read may still complete, its buffer cannot be reused.
+		t.buf = nil
+		t.err = err
+		return 0, err
+	}
+	n = copy(b, buf[:rn])
+	return n, err
+}
+
+func (t *timedReadCloser) Close() error {
+	return t.rc.Close()
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/minio/internal/config/drive"
+)
+
+func TestDriveOpTimeout(t *testing.T) {
+	testCases := []struct {
+		class   int
+		size    int64
+		timeout time.Duration
+	}{
+		{driveMetadataOp, 0, 10 * time.Second},
+		{driveMetadataOp, 1 << 30, 10 * time.Second},
+		{driveDataOp, -1, 30 * time.Second},
+		{driveDataOp, driveDataTimeoutSize - 1, 30 * time.Second},
+		{driveDataOp, driveDataTimeoutSize, 60 * time.Second},
+		{driveDataOp, 10 * driveDataTimeoutSize, 330 * time.Second},
+		{driveLongOp, 0, 5 * time.Minute},
+		{driveLongOp, 1 << 30, 5 * time.Minute},
+	}
+	for i, tc := range testCases {
+		if timeout := driveOpTimeout(tc.class, tc.size); timeout != tc.timeout {
+			t.Errorf("case %d: expected timeout %v, got %v", i+1, tc.timeout, timeout)
+		}
+	}
+}
+
+func TestDriveHungOnTimeouts(t *testing.T) {
+	defer func(timeout time.Duration) {
+		driveTimeouts.metadata.Store(timeout)
+	}(driveTimeouts.metadata.Load())
+	driveTimeouts.metadata.Store(10 * time.Millisecond)
+
+	storage, err := newLocalXLStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := newXLStorageDiskIDCheck(storage)
+	defer p.Close()
+
+	ctx := context.Background()
+	block := make(chan struct{})
+	defer close(block)
+	hang := func() error {
+		<-block
+		return nil
+	}
+
+	maxTimeouts := int(driveTimeouts.maxTimeouts.Load())
+	for i := 1; i <= maxTimeouts; i++ {
+		if p.health.isHung() {
+			t.Fatalf("expected drive online after %d timeouts", i-1)
+		}
+		if err = p.withTimeout(ctx, storageMetricStatInfoFile, driveMetadataOp, 0, hang); err != errFaultyDisk {
+			t.Fatalf("expected %v, got %v", errFaultyDisk, err)
+		}
+	}
+	if !p.health.isHung() {
+		t.Fatalf("expected drive hung after %d timeouts", maxTimeouts)
+	}
+	if _, err = p.DiskInfo(ctx); err != errDriveHung {
+		t.Fatalf("expected %v, got %v", errDriveHung, err)
+	}
+	if p.health.timeouts != uint64(maxTimeouts) || p.health.transitions[diskHealthHung] != 1 {
+		t.Fatalf("unexpected timeouts %d and transitions %v", p.health.timeouts, p.health.transitions)
+	}
+
+	// The drive responds to the probe, it comes back online.
+	deadline := time.Now().Add(time.Minute)
+	for p.health.isHung() {
+		if time.Now().After(deadline) {
+			t.Fatal("expected drive back online")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err = p.withTimeout(ctx, storageMetricStatInfoFile, driveMetadataOp, 0, func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDriveTimeoutsUpdate(t *testing.T) {
+	defer func(c *driveTimeoutConfig) { driveTimeouts = c }(driveTimeouts)
+	driveTimeouts = newDriveTimeoutConfig()
+
+	cfg, err := drive.LookupConfig(config.KVS{
+		config.KV{Key: drive.MetadataTimeout, Value: "2s"},
+		config.KV{Key: drive.DataTimeout, Value: "0"},
+		config.KV{Key: drive.LongTimeout, Value: "1h"},
+		config.KV{Key: drive.MaxTimeouts, Value: "5"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	driveTimeouts.update(cfg)
+
+	if timeout := driveOpTimeout(driveMetadataOp, 0); timeout != 2*time.Second {
+		t.Errorf("expected metadata timeout 2s, got %v", timeout)
+	}
+	if timeout := driveOpTimeout(driveDataOp, 1<<30); timeout != 0 {
+		t.Errorf("expected data timeout disabled, got %v", timeout)
+	}
+	if timeout := driveOpTimeout(driveLongOp, 0); timeout != time.Hour {
+		t.Errorf("expected long timeout 1h, got %v", timeout)
+	}
+	if n := driveTimeouts.maxTimeouts.Load(); n != 5 {
+		t.Errorf("expected 5 max timeouts, got %d", n)
+	}
+}
+
+func TestDriveWriteTimeout(t *testing.T) {
+	defer func(timeout time.Duration) {
+		driveTimeouts.metadata.Store(timeout)
+	}(driveTimeouts.metadata.Load())
+	driveTimeouts.metadata.Store(10 * time.Millisecond)
+
+	dir := t.TempDir()
+	storage, err := newLocalXLStorage(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := newXLStorageDiskIDCheck(storage)
+	defer p.Close()
+
+	// The drive hangs after writing the first half of the file.
+	filePath := filepath.Join(dir, "file")
+	data := []byte("0123456789")
+	block := make(chan struct{})
+	hangingWrite := func() error {
+		f, err := os.Create(filePath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if _, err = f.Write(data[:len(data)/2]); err != nil {
+			return err
+		}
+		<-block
+		_, err = f.Write(data[len(data)/2:])
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- p.withTimeout(context.Background(), storageMetricWriteAll, driveMetadataOp, 0, hangingWrite)
+	}()
+
+	// The timeout is counted, the write is not reported failed while
+	// it may still complete.
+	deadline := time.Now().Add(time.Minute)
+	for atomic.LoadUint64(&p.health.timeouts) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the write to time out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err = <-errCh:
+		t.Fatalf("expected the timed out write to wait for the drive, got %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(block)
+	if err = <-errCh; err != nil {
+		t.Fatalf("expected the outcome of the write, got %v", err)
+	}
+	got, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("expected %q, got %q", data, got)
+	}
+
+	// Reads which time out still fail right away.
+	hang := make(chan struct{})
+	defer close(hang)
+	if err = p.withTimeout(context.Background(), storageMetricReadAll, driveMetadataOp, 0, func() error {
+		<-hang
+		return nil
+	}); err != errFaultyDisk {
+		t.Fatalf("expected %v, got %v", errFaultyDisk, err)
+	}
+}
@@ -1443,6 +1443,14 @@ type xlMetaDataDirDecoder struct {
 // update all metadata freshly before calling this function
 // in-case you wish to clear existing metadata.
 func (x *xlMetaV2) UpdateObjectVersion(fi FileInfo) error {
+	_, err := x.updateObjectVersion(fi)
+	return err
+}
+
+// updateObjectVersion is like UpdateObjectVersion, only the
+// serialized version being updated is re-encoded, it returns
+// false if the version already had the metadata and modTime.
+func (x *xlMetaV2) updateObjectVersion(fi FileInfo) (updated bool, err error) {
 	if fi.VersionID == "" {
 		// this means versioning is not yet
 		// enabled or suspend i.e all versions
@@ -1451,11 +1459,10 @@ func (x *xlMetaV2) UpdateObjectVersion(fi FileInfo) error {
 	}
 
 	var uv uuid.UUID
-	var err error
 	if fi.VersionID != "" && fi.VersionID != nullVersionID {
 		uv, err = uuid.Parse(fi.VersionID)
 		if err != nil {
-			return err
+			return false, err
 		}
 	}
 
@@ -1463,30 +1470,41 @@ func (x *xlMetaV2) UpdateObjectVersion(fi FileInfo) error {
 		switch version.header.Type {
 		case LegacyType, DeleteType:
 			if version.header.VersionID == uv {
-				return errMethodNotAllowed
+				return false, errMethodNotAllowed
 			}
 		case ObjectType:
 			if version.header.VersionID == uv {
 				ver, err := x.getIdx(i)
 				if err != nil {
-					return err
+					return false, err
 				}
 				for k, v := range fi.Metadata {
 					if len(k) > len(ReservedMetadataPrefixLower) && strings.EqualFold(k[:len(ReservedMetadataPrefixLower)], ReservedMetadataPrefixLower) {
+						if cur, ok := ver.ObjectV2.MetaSys[k]; ok && string(cur) == v {
+							continue
+						}
 						ver.ObjectV2.MetaSys[k] = []byte(v)
 					} else {
+						if cur, ok := ver.ObjectV2.MetaUser[k]; ok && cur == v {
+							continue
+						}
 						ver.ObjectV2.MetaUser[k] = v
 					}
+					updated = true
 				}
-				if !fi.ModTime.IsZero() {
+				if !fi.ModTime.IsZero() && ver.ObjectV2.ModTime != fi.ModTime.UnixNano() {
 					ver.ObjectV2.ModTime = fi.ModTime.UnixNano()
+					updated = true
+				}
+				if !updated {
+					return false, nil
 				}
-				return x.setIdx(i, *ver)
+				return true, x.setIdx(i, *ver)
 			}
 		}
 	}
 
-	return errFileVersionNotFound
+	return false, errFileVersionNotFound
 }
 
 // AddVersion adds a new version
@@ -403,6 +403,103 @@ func TestDeleteVersionWithSharedDataDir(t *testing.T) {
 	}
 }
 
+func TestUpdateObjectVersionInPlace(t *testing.T) {
+	const versions = 500
+	fi := FileInfo{
+		Size:    1 << 20,
+		ModTime: time.Now(),
+		Erasure: ErasureInfo{
+			Algorithm:    ReedSolomon.String(),
+			DataBlocks:   4,
+			ParityBlocks: 2,
+			BlockSize:    blockSizeV2,
+			Index:        1,
+			Distribution: []int{1, 2, 3, 4, 5, 6},
+		},
+		Parts:    []ObjectPartInfo{{Number: 1, Size: 1 << 20, ActualSize: 1 << 20}},
+		Metadata: map[string]string{xhttp.AmzObjectTagging: "a=b"},
+	}
+
+	var xl xlMetaV2
+	ids := make([]string, versions)
+	for i := range ids {
+		fi.VersionID = mustGetUUID()
+		fi.DataDir = mustGetUUID()
+		fi.ModTime = fi.ModTime.Add(-time.Second)
+		ids[i] = fi.VersionID
+		if err := xl.AddVersion(fi); err != nil {
+			t.Fatal(err)
+		}
+	}
+	enc, err := xl.AppendTo(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var orig xlMetaV2
+	if err = orig.Load(append([]byte(nil), enc...)); err != nil {
+		t.Fatal(err)
+	}
+
+	update := func(tags string) (bool, []byte) {
+		t.Helper()
+		var xl xlMetaV2
+		if err := xl.Load(append([]byte(nil), enc...)); err != nil {
+			t.Fatal(err)
+		}
+		updated, err := xl.updateObjectVersion(FileInfo{
+			VersionID: ids[versions/2],
+			Metadata:  map[string]string{xhttp.AmzObjectTagging: tags},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Only the updated version must be re-encoded.
+		changed := 0
+		for i := range xl.versions {
+			if !bytes.Equal(xl.versions[i].meta, orig.versions[i].meta) {
+				changed++
+			}
+		}
+		want := 0
+		if updated {
+			want = 1
+		}
+		if changed != want {
+			t.Fatalf("expected %d versions to change, got %d", want, changed)
+		}
+		if !updated {
+			return false, nil
+		}
+		buf, err := xl.AppendTo(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return true, buf
+	}
+
+	if updated, _ := update("a=b"); updated {
+		t.Fatal("expected setting the same tags to not update the version")
+	}
+	updated, buf := update("a=c")
+	if !updated {
+		t.Fatal("expected the version to be updated")
+	}
+	t.Logf("xl.meta with %d versions: %d bytes, version updated: %d bytes", versions, len(buf), len(orig.versions[versions/2].meta))
+
+	var got xlMetaV2
+	if err = got.Load(buf); err != nil {
+		t.Fatal(err)
+	}
+	gfi, err := got.ToFileInfo("volume", "object", ids[versions/2], false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gfi.Metadata[xhttp.AmzObjectTagging] != "a=c" {
+		t.Fatalf("expected updated tags, got %q", gfi.Metadata[xhttp.AmzObjectTagging])
+	}
+}
+
 func Benchmark_mergeXLV2Versions(b *testing.B) {
 	data, err := os.ReadFile("testdata/xl.meta-v1.2.zst")
 	if err != nil {
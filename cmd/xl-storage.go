@@ -41,6 +41,7 @@ import (
 	"github.com/klauspost/filepathx"
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio/internal/bucket/lifecycle"
+	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/disk"
 	xioutil "github.com/minio/minio/internal/ioutil"
 	"github.com/minio/minio/internal/logger"
@@ -463,6 +464,7 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache, updates
 	}
 
 	vcfg, _ := globalBucketVersioningSys.Get(cache.Info.Name)
+	lockCfg, _ := globalBucketObjectLockSys.Get(cache.Info.Name)
 
 	// return initialized object layer
 	objAPI := newObjectLayerFn()
@@ -512,6 +514,9 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache, updates
 		if noTiers = globalTierConfigMgr.Empty(); !noTiers {
 			sizeS.tiers = make(map[string]tierStats)
 		}
+		if lockCfg.LockEnabled {
+			sizeS.objLock = newObjectLockStats()
+		}
 
 		done := globalScannerMetrics.time(scannerMetricApplyAll)
 		fivs.Versions, err = item.applyVersionActions(ctx, objAPI, fivs.Versions)
@@ -533,6 +538,52 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache, updates
 				sizeS.versions++
 			}
 			sizeS.totalSize += sz
+			if oi.IsLatest && !oi.DeleteMarker && sz == oi.Size {
+				sizeS.modTime = oi.ModTime
+			}
+
+			// Account inlining and storage classes of versions still
+			// present after applying actions, transitioned versions
+			// have their data on the tier.
+			if !oi.DeleteMarker && oi.TransitionedObject.Status == "" && sz == oi.Size {
+				if version.InlineData() {
+					sizeS.inlined++
+				} else {
+					sizeS.notInlined++
+				}
+				if sizeS.storageClasses == nil {
+					sizeS.storageClasses = make(map[string]tierStats)
+				}
+				sizeS.storageClasses[oi.StorageClass] = sizeS.storageClasses[oi.StorageClass].add(oi.tierStats())
+			}
+
+			// Account current and noncurrent versions still present
+			// after applying actions.
+			if !oi.DeleteMarker && sz == oi.Size {
+				if oi.IsLatest {
+					sizeS.current++
+				} else {
+					sizeS.noncurrent++
+				}
+			}
+
+			// Account encryption of the objects, i.e. latest versions,
+			// still present after applying actions.
+			if oi.IsLatest && !oi.DeleteMarker && sz == oi.Size {
+				switch {
+				case crypto.S3KMS.IsEncrypted(oi.UserDefined):
+					sizeS.sseKMS++
+				case crypto.S3.IsEncrypted(oi.UserDefined):
+					sizeS.sseS3++
+				case crypto.SSEC.IsEncrypted(oi.UserDefined):
+					sizeS.sseC++
+				}
+			}
+
+			// Account retention of versions still present after applying actions.
+			if sizeS.objLock != nil && !oi.DeleteMarker && sz == oi.Size {
+				sizeS.objLock.add(oi.UserDefined, UTCNow())
+			}
 
 			// Skip tier accounting if,
 			// 1. no tiers configured
@@ -1161,7 +1212,7 @@ func (s *xlStorage) DeleteVersion(ctx context.Context, volume, path string, fi F
 
 // Updates only metadata for a given version.
 func (s *xlStorage) UpdateMetadata(ctx context.Context, volume, path string, fi FileInfo) error {
-	if len(fi.Metadata) == 0 {
+	if len(fi.Metadata) == 0 && fi.ModTime.IsZero() {
 		return errInvalidArgument
 	}
 
@@ -1186,9 +1237,15 @@ func (s *xlStorage) UpdateMetadata(ctx context.Context, volume, path string, fi
 		return err
 	}
 
-	if err = xlMeta.UpdateObjectVersion(fi); err != nil {
+	updated, err := xlMeta.updateObjectVersion(fi)
+	if err != nil {
 		return err
 	}
+	if !updated {
+		// Already up to date, e.g. a retried update or the
+		// same tags set again, skip rewriting xl.meta.
+		return nil
+	}
 
 	wbuf, err := xlMeta.AppendTo(metaDataPoolGet())
 	if err != nil {
@@ -2450,6 +2507,25 @@ func (s *xlStorage) RenameData(ctx context.Context, srcVolume, srcPath string, f
 		}
 	}
 
+	if fi.Durable {
+		// Only the files of this object and the directories they were
+		// renamed into are synced, not the whole drive.
+		durablePaths := []string{dstFilePath, pathutil.Dir(dstFilePath), pathutil.Dir(pathutil.Dir(dstFilePath))}
+		if srcDataPath != "" && len(fi.Data) == 0 && fi.Size > 0 {
+			entries, err := readDir(dstDataPath)
+			if err != nil {
+				return 0, osErrToFileErr(err)
+			}
+			for _, entry := range entries {
+				durablePaths = append(durablePaths, pathJoin(dstDataPath, entry))
+			}
+			durablePaths = append(durablePaths, dstDataPath)
+		}
+		if err = fdatasyncPaths(durablePaths...); err != nil {
+			return 0, osErrToFileErr(err)
+		}
+	}
+
 	// srcFilePath is always in minioMetaTmpBucket, an attempt to
 	// remove the temporary folder is enough since at this point
 	// ideally all transaction should be complete.
@@ -2458,6 +2534,30 @@ func (s *xlStorage) RenameData(ctx context.Context, srcVolume, srcPath string, f
 	return sign, nil
 }
 
+// fdatasyncPaths flushes the files at paths to stable storage, synced
+// directories persist the entries created or renamed into them.
+func fdatasyncPaths(paths ...string) error {
+	for _, p := range paths {
+		f, err := OpenFile(p, os.O_RDONLY, 0)
+		if err != nil {
+			return err
+		}
+		if runtime.GOOS == globalWindowsOSName {
+			// Directories can't be synced on windows.
+			if st, serr := f.Stat(); serr == nil && st.IsDir() {
+				f.Close()
+				continue
+			}
+		}
+		err = Fdatasync(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RenameFile - rename source path to destination path atomically.
 func (s *xlStorage) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) (err error) {
 	srcVolumeDir, err := s.getVolDir(srcVolume)
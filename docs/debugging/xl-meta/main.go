@@ -19,9 +19,13 @@ package main
 
 import (
 	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -30,9 +34,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/klauspost/compress/zip"
 	"github.com/klauspost/filepathx"
 	"github.com/minio/cli"
+	"github.com/minio/highwayhash"
+	"github.com/minio/madmin-go/v2/estream"
 	"github.com/tinylib/msgp/msgp"
 )
 
@@ -48,7 +55,9 @@ USAGE:
   {{.Name}} {{if .VisibleFlags}}[FLAGS]{{end}} METAFILES...
 
 Multiple files can be added. Files ending in '.zip' will be searched
-for 'xl.meta' files. Wildcards are accepted: 'testdir/*.txt' will compress
+for 'xl.meta' files. Files ending in '.enc' are inspect data encrypted
+with a public key, they are decrypted with '--private-key' and searched
+as zip files. Wildcards are accepted: 'testdir/*.txt' will compress
 all files in testdir ending with '.txt', directories can be wildcards
 as well. 'testdir/*/*.txt' will match 'testdir/subdir/b.txt', double stars
 means full recursive. 'testdir/**/xl.meta' will search for all xl.meta
@@ -74,11 +83,35 @@ FLAGS:
 			Usage: "export inline data",
 			Name:  "export",
 		},
+		cli.BoolFlag{
+			Usage: "print a single JSON object of all files, with a summary of the versions of each file",
+			Name:  "json",
+		},
+		cli.BoolFlag{
+			Usage: "verify inline data against its bitrot checksums, print the result of each version on stderr",
+			Name:  "verify",
+		},
+		cli.StringFlag{
+			Usage: "private key in PEM format to decrypt inspect data files ending in '.enc'",
+			Name:  "private-key",
+		},
 	}
 
 	app.Action = func(c *cli.Context) error {
 		ndjson := c.Bool("ndjson")
-		decode := func(r io.Reader, file string) ([]byte, error) {
+		verify := c.Bool("verify")
+		var privKey *rsa.PrivateKey
+		if path := c.String("private-key"); path != "" {
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if privKey, err = bytesToPrivateKey(b); err != nil {
+				return fmt.Errorf("decoding private key: %w", err)
+			}
+		}
+		var verifyFailed int
+		decode := func(r io.Reader, file string) (*xlMetaFile, error) {
 			b, err := io.ReadAll(r)
 			if err != nil {
 				return nil, err
@@ -90,6 +123,8 @@ FLAGS:
 
 			buf := bytes.NewBuffer(nil)
 			var data xlMetaInlineData
+			var summary *xlMetaSummary
+			var objects []*xlMetaV2Object
 			switch minor {
 			case 0:
 				_, err = msgp.CopyToJSON(buf, bytes.NewReader(b))
@@ -110,6 +145,17 @@ FLAGS:
 				if err != nil {
 					return nil, err
 				}
+				if verify {
+					var meta struct {
+						Versions []xlMetaV2Version
+					}
+					if err := json.Unmarshal(buf.Bytes(), &meta); err != nil {
+						return nil, err
+					}
+					for _, ver := range meta.Versions {
+						objects = append(objects, ver.object())
+					}
+				}
 				data = b
 			case 3:
 				v, b, err := msgp.ReadBytesZC(b)
@@ -131,11 +177,13 @@ FLAGS:
 					Metadata json.RawMessage
 				}
 				versions := make([]version, nVers)
+				summary = &xlMetaSummary{Versions: nVers}
 				err = decodeVersions(v, nVers, func(idx int, hdr, meta []byte) error {
 					var header xlMetaV2VersionHeaderV2
 					if _, err := header.UnmarshalMsg(hdr); err != nil {
 						return err
 					}
+					summary.add(header)
 					b, err := header.MarshalJSON()
 					if err != nil {
 						return err
@@ -144,6 +192,13 @@ FLAGS:
 					if _, err := msgp.UnmarshalAsJSON(&buf, meta); err != nil {
 						return err
 					}
+					if verify {
+						var ver xlMetaV2Version
+						if err := json.Unmarshal(buf.Bytes(), &ver); err != nil {
+							return err
+						}
+						objects = append(objects, ver.object())
+					}
 					versions[idx] = version{
 						Idx:      idx,
 						Header:   b,
@@ -164,6 +219,38 @@ FLAGS:
 			default:
 				return nil, fmt.Errorf("unknown metadata version %d", minor)
 			}
+			if summary != nil {
+				if err := data.files(func(name string, data []byte) {
+					summary.InlineDataSize += int64(len(data))
+				}); err != nil {
+					return nil, err
+				}
+			}
+
+			if verify {
+				inline := make(map[string][]byte)
+				if err := data.files(func(name string, data []byte) {
+					inline[name] = data
+				}); err != nil {
+					return nil, err
+				}
+				for _, obj := range objects {
+					if obj == nil {
+						continue
+					}
+					key := obj.key()
+					shards, ok := inline[key]
+					if !ok {
+						continue
+					}
+					if err := obj.verifyInline(shards); err != nil {
+						verifyFailed++
+						fmt.Fprintf(os.Stderr, "%s: version %s: FAIL: %v\n", file, key, err)
+						continue
+					}
+					fmt.Fprintf(os.Stderr, "%s: version %s: PASS\n", file, key)
+				}
+			}
 
 			if c.Bool("data") {
 				b, err := data.json()
@@ -197,22 +284,20 @@ FLAGS:
 					return nil, err
 				}
 			}
+			return &xlMetaFile{Metadata: bytes.TrimSpace(buf.Bytes()), Summary: summary}, nil
+		}
+		format := func(m *xlMetaFile) ([]byte, error) {
 			if ndjson {
-				return buf.Bytes(), nil
+				return m.Metadata, nil
 			}
 			var msi map[string]interface{}
-			dec := json.NewDecoder(buf)
+			dec := json.NewDecoder(bytes.NewReader(m.Metadata))
 			// Use number to preserve integers.
 			dec.UseNumber()
-			err = dec.Decode(&msi)
-			if err != nil {
-				return nil, err
-			}
-			b, err = json.MarshalIndent(msi, "", "  ")
-			if err != nil {
+			if err := dec.Decode(&msi); err != nil {
 				return nil, err
 			}
-			return b, nil
+			return json.MarshalIndent(msi, "", "  ")
 		}
 
 		args := c.Args()
@@ -239,7 +324,16 @@ FLAGS:
 		if len(files) == 0 {
 			return fmt.Errorf("no files found")
 		}
-		multiple := len(files) > 1 || strings.HasSuffix(files[0], ".zip")
+		if c.Bool("json") {
+			if err := printJSON(files, privKey, decode); err != nil {
+				return err
+			}
+			if verifyFailed > 0 {
+				return fmt.Errorf("%d versions failed verification", verifyFailed)
+			}
+			return nil
+		}
+		multiple := len(files) > 1 || isArchive(files[0])
 		if multiple {
 			ndjson = true
 			fmt.Println("{")
@@ -263,8 +357,8 @@ FLAGS:
 				defer f.Close()
 				r = f
 			}
-			if strings.HasSuffix(file, ".zip") {
-				zr, err := zip.NewReader(r.(io.ReaderAt), sz)
+			if isArchive(file) {
+				zr, err := openArchive(file, r.(io.ReaderAt), sz, privKey)
 				if err != nil {
 					return err
 				}
@@ -281,10 +375,13 @@ FLAGS:
 						}
 						fmt.Printf("\t%s: ", string(b))
 
-						b, err = decode(r, file.Name)
+						m, err := decode(r, file.Name)
 						if err != nil {
 							return err
 						}
+						if b, err = format(m); err != nil {
+							return err
+						}
 						fmt.Print(string(b))
 						hasWritten = true
 					}
@@ -299,7 +396,11 @@ FLAGS:
 					fmt.Printf("\t%s: ", string(b))
 				}
 
-				b, err := decode(r, file)
+				m, err := decode(r, file)
+				if err != nil {
+					return err
+				}
+				b, err := format(m)
 				if err != nil {
 					return err
 				}
@@ -313,6 +414,9 @@ FLAGS:
 			fmt.Println("}")
 		}
 
+		if verifyFailed > 0 {
+			return fmt.Errorf("%d versions failed verification", verifyFailed)
+		}
 		return nil
 	}
 	err := app.Run(os.Args)
@@ -321,6 +425,250 @@ FLAGS:
 	}
 }
 
+// xlMetaFile is a decoded xl.meta file, Error is set instead if it
+// could not be decoded.
+type xlMetaFile struct {
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+	Summary  *xlMetaSummary  `json:"summary,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// xlMetaSummary summarizes the versions of an xl.meta file.
+type xlMetaSummary struct {
+	Versions       int       `json:"versions"`
+	LatestModTime  time.Time `json:"latestModTime"`
+	InlineDataSize int64     `json:"inlineDataSize"`
+	FreeVersion    bool      `json:"freeVersion"`
+}
+
+// Flag of the header of a version set for free versions.
+const xlFlagFreeVersion = 1 << 0
+
+func (s *xlMetaSummary) add(header xlMetaV2VersionHeaderV2) {
+	if modTime := time.Unix(0, header.ModTime); modTime.After(s.LatestModTime) {
+		s.LatestModTime = modTime
+	}
+	if header.Flags&xlFlagFreeVersion != 0 {
+		s.FreeVersion = true
+	}
+}
+
+// printJSON prints a single JSON object of the decoded files keyed by
+// their path, files which could not be decoded are printed with an error.
+func printJSON(files []string, privKey *rsa.PrivateKey, decode func(r io.Reader, file string) (*xlMetaFile, error)) error {
+	res := make(map[string]*xlMetaFile)
+	add := func(file string, r io.Reader) {
+		m, err := decode(r, file)
+		if err != nil {
+			m = &xlMetaFile{Error: err.Error()}
+		}
+		res[file] = m
+	}
+	for _, file := range files {
+		if file == "-" {
+			add(file, os.Stdin)
+			continue
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if !isArchive(file) {
+			add(file, f)
+			continue
+		}
+		st, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		zr, err := openArchive(file, f, st.Size(), privKey)
+		if err != nil {
+			return err
+		}
+		for _, zf := range zr.File {
+			if zf.FileInfo().IsDir() || !strings.HasSuffix(zf.Name, "xl.meta") {
+				continue
+			}
+			r, err := zf.Open()
+			if err != nil {
+				res[zf.Name] = &xlMetaFile{Error: err.Error()}
+				continue
+			}
+			add(zf.Name, r)
+			r.Close()
+		}
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// isArchive returns whether the file is a zip file, or inspect data
+// holding one.
+func isArchive(file string) bool {
+	return strings.HasSuffix(file, ".zip") || strings.HasSuffix(file, ".enc")
+}
+
+// openArchive returns a reader of the zip file, inspect data ending in
+// '.enc' is decrypted with the private key first.
+func openArchive(file string, r io.ReaderAt, sz int64, privKey *rsa.PrivateKey) (*zip.Reader, error) {
+	if !strings.HasSuffix(file, ".enc") {
+		return zip.NewReader(r, sz)
+	}
+	if privKey == nil {
+		return nil, fmt.Errorf("%s: --private-key is required to decrypt inspect data", file)
+	}
+	b, err := decryptInspect(io.NewSectionReader(r, 0, sz), privKey)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return zip.NewReader(bytes.NewReader(b), int64(len(b)))
+}
+
+// decryptInspect returns the zip file of inspect data encrypted with the
+// public key of privKey, as written by the inspect data admin API.
+func decryptInspect(r io.Reader, privKey *rsa.PrivateKey) ([]byte, error) {
+	sr, err := estream.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	sr.SetPrivateKey(privKey)
+	sr.ReturnNonDecryptable(true)
+	for {
+		stream, err := sr.NextStream()
+		if err != nil {
+			if err == io.EOF {
+				return nil, errors.New("no inspect data found on stream")
+			}
+			if errors.Is(err, estream.ErrNoKey) {
+				if stream.Name == "inspect.zip" {
+					return nil, errors.New("incorrect private key")
+				}
+				if err := stream.Skip(); err != nil {
+					return nil, fmt.Errorf("stream skip: %w", err)
+				}
+				continue
+			}
+			return nil, fmt.Errorf("next stream: %w", err)
+		}
+		if stream.Name == "inspect.zip" {
+			b, err := io.ReadAll(stream)
+			if err != nil {
+				return nil, fmt.Errorf("reading inspect stream: %w", err)
+			}
+			return b, nil
+		}
+		if err := stream.Skip(); err != nil {
+			return nil, fmt.Errorf("stream skip: %w", err)
+		}
+	}
+}
+
+// bytesToPrivateKey parses a PKCS #1 private key in PEM, base64 or raw
+// format.
+func bytesToPrivateKey(priv []byte) (*rsa.PrivateKey, error) {
+	if block, _ := pem.Decode(priv); block != nil {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	dst := make([]byte, base64.StdEncoding.DecodedLen(len(priv)))
+	if n, err := base64.StdEncoding.Decode(dst, priv); err == nil {
+		return x509.ParsePKCS1PrivateKey(dst[:n])
+	}
+	return x509.ParsePKCS1PrivateKey(priv)
+}
+
+// xlMetaV2Version is the part of a version of an xl.meta file decoded
+// to verify its inline data.
+type xlMetaV2Version struct {
+	Type  uint8
+	V2Obj *xlMetaV2Object
+}
+
+// Type of versions holding objects.
+const xlObjectType = 1
+
+func (v xlMetaV2Version) object() *xlMetaV2Object {
+	if v.Type != xlObjectType {
+		return nil
+	}
+	return v.V2Obj
+}
+
+// xlMetaV2Object holds the fields of an object version needed to verify
+// its inline data.
+type xlMetaV2Object struct {
+	ID       []byte
+	EcM      int
+	EcBSize  int64
+	CSumAlgo uint8
+	Size     int64
+}
+
+// Bitrot checksum algorithm of objects, the checksum of each shard is
+// written before it.
+const xlHighwayHash = 1
+
+var magicHighwayHash256Key = []byte("\x4b\xe7\x34\xfa\x8e\x23\x8a\xcd\x26\x3e\x83\xe6\xbb\x96\x85\x52\x04\x0f\x93\x5d\xa3\x9f\x44\x14\x97\xe0\x9d\x13\x22\xde\x36\xa0")
+
+// key returns the key of the inline data of the version.
+func (o xlMetaV2Object) key() string {
+	var id uuid.UUID
+	copy(id[:], o.ID)
+	if id == (uuid.UUID{}) {
+		return "null"
+	}
+	return id.String()
+}
+
+// verifyInline verifies the inline data of the version against the
+// checksums of its shards.
+func (o xlMetaV2Object) verifyInline(data []byte) error {
+	if o.CSumAlgo != xlHighwayHash {
+		return fmt.Errorf("unknown checksum algorithm %d", o.CSumAlgo)
+	}
+	if o.EcM <= 0 || o.EcBSize <= 0 {
+		return fmt.Errorf("invalid erasure coding of %d data blocks of %d bytes", o.EcM, o.EcBSize)
+	}
+	shardSize := ceilFrac(o.EcBSize, int64(o.EcM))
+	want := (o.Size/o.EcBSize)*shardSize + ceilFrac(o.Size%o.EcBSize, int64(o.EcM))
+
+	h, _ := highwayhash.New(magicHighwayHash256Key) // New will never return error since key is 256 bit
+	var got int64
+	for shard := 0; len(data) > 0; shard++ {
+		if len(data) < h.Size() {
+			return fmt.Errorf("shard %d: truncated checksum", shard)
+		}
+		sum := data[:h.Size()]
+		data = data[h.Size():]
+		n := shardSize
+		if int64(len(data)) < n {
+			n = int64(len(data))
+		}
+		h.Reset()
+		h.Write(data[:n])
+		if !bytes.Equal(h.Sum(nil), sum) {
+			return fmt.Errorf("shard %d: checksum mismatch", shard)
+		}
+		data = data[n:]
+		got += n
+	}
+	if got != want {
+		return fmt.Errorf("%d bytes of shard data, expected %d", got, want)
+	}
+	return nil
+}
+
+func ceilFrac(numerator, denominator int64) int64 {
+	if denominator == 0 {
+		return 0
+	}
+	return (numerator + denominator - 1) / denominator
+}
+
 var (
 	// XL header specifies the format
 	xlHeader = [4]byte{'X', 'L', '2', ' '}
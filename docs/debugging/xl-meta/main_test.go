@@ -0,0 +1,308 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/klauspost/compress/zip"
+	"github.com/minio/highwayhash"
+	"github.com/minio/madmin-go/v2/estream"
+)
+
+func TestXLMetaSummary(t *testing.T) {
+	now := time.Now()
+	var s xlMetaSummary
+	s.add(xlMetaV2VersionHeaderV2{ModTime: now.Add(-time.Hour).UnixNano()})
+	s.add(xlMetaV2VersionHeaderV2{ModTime: now.UnixNano()})
+	s.add(xlMetaV2VersionHeaderV2{ModTime: now.Add(-2 * time.Hour).UnixNano()})
+	if !s.LatestModTime.Equal(time.Unix(0, now.UnixNano())) {
+		t.Errorf("expected the latest modtime %v, got %v", now, s.LatestModTime)
+	}
+	if s.FreeVersion {
+		t.Error("expected no free version")
+	}
+	s.add(xlMetaV2VersionHeaderV2{ModTime: now.Add(-3 * time.Hour).UnixNano(), Flags: xlFlagFreeVersion})
+	if !s.FreeVersion {
+		t.Error("expected a free version")
+	}
+}
+
+// captureStdout returns what fn prints on stdout.
+func captureStdout(t *testing.T, fn func() error) []byte {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	out := make(chan []byte)
+	go func() {
+		b, _ := io.ReadAll(r)
+		out <- b
+	}()
+	err = fn()
+	os.Stdout = stdout
+	w.Close()
+	b := <-out
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestPrintJSON(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name string, data []byte) string {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	for name, data := range map[string]string{
+		"bucket/zipped/xl.meta": "ok",
+		"bucket/zipped/part.1":  "data",
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = io.WriteString(w, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	files := []string{
+		writeFile("good/xl.meta", []byte("ok")),
+		writeFile("bad/xl.meta", []byte("bad")),
+		writeFile("inspect.zip", zipped.Bytes()),
+	}
+	decode := func(r io.Reader, file string) (*xlMetaFile, error) {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		if string(b) != "ok" {
+			return nil, errors.New("unknown metadata")
+		}
+		return &xlMetaFile{Metadata: json.RawMessage(`{"Versions":[]}`), Summary: &xlMetaSummary{Versions: 1}}, nil
+	}
+
+	out := captureStdout(t, func() error {
+		return printJSON(files, nil, decode)
+	})
+	var res map[string]xlMetaFile
+	if err := json.Unmarshal(out, &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 3 {
+		t.Fatalf("expected 3 files, got %d: %s", len(res), out)
+	}
+	for _, file := range []string{files[0], "bucket/zipped/xl.meta"} {
+		var metadata bytes.Buffer
+		m := res[file]
+		if err := json.Compact(&metadata, m.Metadata); err != nil {
+			t.Fatal(err)
+		}
+		if m.Error != "" || m.Summary == nil || m.Summary.Versions != 1 || metadata.String() != `{"Versions":[]}` {
+			t.Errorf("unexpected result of %s: %+v", file, m)
+		}
+	}
+	if m := res[files[1]]; m.Error != "unknown metadata" || m.Metadata != nil {
+		t.Errorf("expected an error for %s, got %+v", files[1], m)
+	}
+}
+
+func TestVerifyInline(t *testing.T) {
+	id := uuid.New()
+	o := xlMetaV2Object{ID: id[:], EcM: 2, EcBSize: 8, CSumAlgo: xlHighwayHash, Size: 20}
+	if o.key() != id.String() {
+		t.Fatalf("expected the key %s, got %s", id, o.key())
+	}
+	if key := (xlMetaV2Object{}).key(); key != "null" {
+		t.Fatalf("expected the null version key, got %s", key)
+	}
+
+	// 2 full blocks of 4 bytes per shard and the last 4 bytes of the
+	// object in a shard of 2 bytes.
+	h, err := highwayhash.New(magicHighwayHash256Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data []byte
+	for _, shard := range [][]byte{[]byte("abcd"), []byte("efgh"), []byte("ij")} {
+		h.Reset()
+		h.Write(shard)
+		data = append(data, h.Sum(nil)...)
+		data = append(data, shard...)
+	}
+	if err = o.verifyInline(data); err != nil {
+		t.Fatal(err)
+	}
+
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	if err = o.verifyInline(corrupted); err == nil {
+		t.Fatal("expected a checksum mismatch")
+	}
+	if err = o.verifyInline(data[:2*(h.Size()+4)]); err == nil {
+		t.Fatal("expected missing shard data to fail")
+	}
+	if err = o.verifyInline(data[:2*(h.Size()+4)+10]); err == nil {
+		t.Fatal("expected a truncated checksum to fail")
+	}
+	o.CSumAlgo = 0
+	if err = o.verifyInline(data); err == nil {
+		t.Fatal("expected an unknown checksum algorithm to fail")
+	}
+
+	if obj := (xlMetaV2Version{Type: xlObjectType + 1, V2Obj: &o}).object(); obj != nil {
+		t.Fatal("expected no object for a delete marker")
+	}
+}
+
+// encryptInspect returns the zip file as inspect data encrypted with the
+// public key of privKey.
+func encryptInspect(t *testing.T, zipFile []byte, privKey *rsa.PrivateKey) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	sw := estream.NewWriter(&buf)
+	if err := sw.AddKeyEncrypted(&privKey.PublicKey); err != nil {
+		t.Fatal(err)
+	}
+	w, err := sw.AddEncryptedStream("inspect.zip", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write(zipFile); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = sw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecryptInspect(t *testing.T) {
+	privKey, err := rsa.GenerateKey(crand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(crand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	w, err := zw.Create("bucket/object/xl.meta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.WriteString(w, "ok"); err != nil {
+		t.Fatal(err)
+	}
+	if err = zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	enc := encryptInspect(t, zipped.Bytes(), privKey)
+
+	b, err := decryptInspect(bytes.NewReader(enc), privKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, zipped.Bytes()) {
+		t.Fatal("decrypted inspect data does not match")
+	}
+	if _, err = decryptInspect(bytes.NewReader(enc), otherKey); err == nil {
+		t.Fatal("expected decrypting with another key to fail")
+	}
+
+	if _, err = openArchive("inspect.enc", bytes.NewReader(enc), int64(len(enc)), nil); err == nil {
+		t.Fatal("expected a private key to be required")
+	}
+	zr, err := openArchive("inspect.enc", bytes.NewReader(enc), int64(len(enc)), privKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zr.File) != 1 || zr.File[0].Name != "bucket/object/xl.meta" {
+		t.Fatalf("unexpected files in the inspect data: %v", zr.File)
+	}
+
+	// Private keys are accepted in PEM, base64 and raw format.
+	der := x509.MarshalPKCS1PrivateKey(privKey)
+	for name, priv := range map[string][]byte{
+		"pem":    pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: der}),
+		"base64": []byte(base64.StdEncoding.EncodeToString(der)),
+		"raw":    der,
+	} {
+		key, err := bytesToPrivateKey(priv)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !key.Equal(privKey) {
+			t.Fatalf("%s: private key mismatch", name)
+		}
+	}
+
+	// Inspect data is searched for xl.meta files like zip files.
+	file := filepath.Join(t.TempDir(), "inspect.enc")
+	if err = os.WriteFile(file, enc, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	decode := func(r io.Reader, file string) (*xlMetaFile, error) {
+		return &xlMetaFile{Summary: &xlMetaSummary{Versions: 1}}, nil
+	}
+	out := captureStdout(t, func() error {
+		return printJSON([]string{file}, privKey, decode)
+	})
+	var res map[string]xlMetaFile
+	if err = json.Unmarshal(out, &res); err != nil {
+		t.Fatal(err)
+	}
+	if m, ok := res["bucket/object/xl.meta"]; !ok || m.Summary == nil || m.Summary.Versions != 1 {
+		t.Fatalf("unexpected result %s", out)
+	}
+}
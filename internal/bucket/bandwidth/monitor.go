@@ -57,16 +57,8 @@ func NewMonitor(ctx context.Context, numNodes uint64) *Monitor {
 func (m *Monitor) updateMeasurement(bucket, arn string, bytes uint64) {
 	m.mlock.Lock()
 	defer m.mlock.Unlock()
-	tm, ok := m.activeBuckets[bucket]
-	if !ok {
-		tm = make(map[string]*bucketMeasurement)
-	}
-	measurement, ok := tm[arn]
-	if !ok {
-		measurement = &bucketMeasurement{}
-	}
-	measurement.incrementBytes(bytes)
-	m.activeBuckets[bucket][arn] = measurement
+	// The bucket may have been deleted while the transfer was in flight.
+	m.getBucketMeasurement(bucket, arn, time.Now())[arn].incrementBytes(bytes)
 }
 
 // SelectionFunction for buckets
@@ -117,16 +109,24 @@ func (m *Monitor) getReport(selectBucket SelectionFunction) *BucketBandwidthRepo
 		}
 		m.tlock.RLock()
 		report.BucketStats[bucket] = make(map[string]Details)
-		if tgtThrottle, ok := m.bucketThrottle[bucket]; ok {
-			for arn, throttle := range tgtThrottle {
-				var currBw float64
-				if bucketMeasurement, ok := bucketMeasurementMap[arn]; ok {
-					currBw = bucketMeasurement.getExpMovingAvgBytesPerSecond()
-				}
-				report.BucketStats[bucket][arn] = Details{
-					LimitInBytesPerSecond:            throttle.NodeBandwidthPerSec * int64(m.NodeCount),
-					CurrentBandwidthInBytesPerSecond: currBw,
-				}
+		tgtThrottle := m.bucketThrottle[bucket]
+		for arn, throttle := range tgtThrottle {
+			var currBw float64
+			if bucketMeasurement, ok := bucketMeasurementMap[arn]; ok {
+				currBw = bucketMeasurement.getExpMovingAvgBytesPerSecond()
+			}
+			report.BucketStats[bucket][arn] = Details{
+				LimitInBytesPerSecond:            throttle.NodeBandwidthPerSec * int64(m.NodeCount),
+				CurrentBandwidthInBytesPerSecond: currBw,
+			}
+		}
+		// Targets without a limit report their bandwidth as well.
+		for arn, bucketMeasurement := range bucketMeasurementMap {
+			if _, ok := tgtThrottle[arn]; ok {
+				continue
+			}
+			report.BucketStats[bucket][arn] = Details{
+				CurrentBandwidthInBytesPerSecond: bucketMeasurement.getExpMovingAvgBytesPerSecond(),
 			}
 		}
 		m.tlock.RUnlock()
@@ -160,9 +160,11 @@ func (m *Monitor) getBucketMeasurement(bucket, arn string, initTime time.Time) m
 	bucketTracker, ok := m.activeBuckets[bucket]
 	if !ok {
 		bucketTracker = make(map[string]*bucketMeasurement)
-		bucketTracker[arn] = newBucketMeasurement(initTime)
 		m.activeBuckets[bucket] = bucketTracker
 	}
+	if _, ok = bucketTracker[arn]; !ok {
+		bucketTracker[arn] = newBucketMeasurement(initTime)
+	}
 	return bucketTracker
 }
 
@@ -186,10 +188,10 @@ func (m *Monitor) DeleteBucket(bucket string) {
 // DeleteBucketThrottle deletes monitoring for a bucket's target
 func (m *Monitor) DeleteBucketThrottle(bucket, arn string) {
 	m.tlock.Lock()
-	delete(m.bucketThrottle, bucket)
+	delete(m.bucketThrottle[bucket], arn)
 	m.tlock.Unlock()
 	m.mlock.Lock()
-	delete(m.activeBuckets, bucket)
+	delete(m.activeBuckets[bucket], arn)
 	m.mlock.Unlock()
 }
 
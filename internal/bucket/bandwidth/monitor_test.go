@@ -119,3 +119,29 @@ func TestMonitor_GetReport(t *testing.T) {
 		})
 	}
 }
+
+func TestMonitor_DeleteBucketThrottle(t *testing.T) {
+	m := &Monitor{
+		activeBuckets:  make(map[string]map[string]*bucketMeasurement),
+		bucketThrottle: make(map[string]map[string]*throttle),
+		NodeCount:      2,
+	}
+	m.SetBandwidthLimit("bucket", "arn1", 200*int64(oneMiB))
+	m.SetBandwidthLimit("bucket", "arn2", 400*int64(oneMiB))
+	m.track("bucket", "arn1")
+	m.track("bucket", "arn2")
+	m.track("bucket", "arn3")
+
+	m.DeleteBucketThrottle("bucket", "arn1")
+	if m.IsThrottled("bucket", "arn1") || !m.IsThrottled("bucket", "arn2") {
+		t.Fatal("expected only the throttle of arn1 to be deleted")
+	}
+
+	want := map[string]Details{
+		"arn2": {LimitInBytesPerSecond: 400 * int64(oneMiB)},
+		"arn3": {},
+	}
+	if got := m.GetReport(SelectBuckets("bucket")).BucketStats["bucket"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetReport() = %v, want %v", got, want)
+	}
+}
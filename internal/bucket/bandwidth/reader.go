@@ -25,12 +25,11 @@ import (
 
 // MonitoredReader represents a throttled reader subject to bandwidth monitoring
 type MonitoredReader struct {
-	r        io.Reader
-	throttle *throttle
-	ctx      context.Context // request context
-	lastErr  error           // last error reported, if this non-nil all reads will fail.
-	m        *Monitor
-	opts     *MonitorReaderOptions
+	r       io.Reader
+	ctx     context.Context // request context
+	lastErr error           // last error reported, if this non-nil all reads will fail.
+	m       *Monitor
+	opts    *MonitorReaderOptions
 }
 
 // MonitorReaderOptions provides configurable options for monitor reader implementation.
@@ -42,14 +41,22 @@ type MonitorReaderOptions struct {
 
 // Read implements a throttled read
 func (r *MonitoredReader) Read(buf []byte) (n int, err error) {
-	if r.throttle == nil {
-		return r.r.Read(buf)
+	// The throttle is looked up on every read so that limits set,
+	// changed or removed apply to transfers in flight, such as the
+	// long running transfers of a resync.
+	throttle := r.m.throttle(r.opts.Bucket, r.opts.TargetARN)
+	if throttle == nil {
+		n, err = r.r.Read(buf)
+		if n > 0 {
+			r.m.updateMeasurement(r.opts.Bucket, r.opts.TargetARN, uint64(n))
+		}
+		return
 	}
 	if r.lastErr != nil {
 		err = r.lastErr
 		return
 	}
-	b := r.throttle.Burst()  // maximum available tokens
+	b := throttle.Burst()    // maximum available tokens
 	need := len(buf)         // number of bytes requested by caller
 	hdr := r.opts.HeaderSize // remaining header bytes
 	var tokens int           // number of tokens to request
@@ -70,7 +77,7 @@ func (r *MonitoredReader) Read(buf []byte) (n int, err error) {
 		tokens = need
 	}
 
-	err = r.throttle.WaitN(r.ctx, tokens)
+	err = throttle.WaitN(r.ctx, tokens)
 	if err != nil {
 		return
 	}
@@ -88,11 +95,10 @@ func (r *MonitoredReader) Read(buf []byte) (n int, err error) {
 // bucket.
 func NewMonitoredReader(ctx context.Context, m *Monitor, r io.Reader, opts *MonitorReaderOptions) *MonitoredReader {
 	reader := MonitoredReader{
-		r:        r,
-		throttle: m.throttle(opts.Bucket, opts.TargetARN),
-		m:        m,
-		opts:     opts,
-		ctx:      ctx,
+		r:    r,
+		m:    m,
+		opts: opts,
+		ctx:  ctx,
 	}
 	reader.m.track(opts.Bucket, opts.TargetARN)
 	return &reader
@@ -0,0 +1,212 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cors
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	maxRules  = 100
+	maxIDSize = 255
+)
+
+var (
+	errNoRules        = Errorf("CORS configuration must contain at least one rule")
+	errTooManyRules   = Errorf("CORS configuration must not contain more than %d rules", maxRules)
+	errIDTooLong      = Errorf("ID must be less than %d characters", maxIDSize)
+	errNoMethods      = Errorf("CORS rule must contain at least one AllowedMethod")
+	errNoOrigins      = Errorf("CORS rule must contain at least one AllowedOrigin")
+	errMaxAgeNegative = Errorf("MaxAgeSeconds must not be negative")
+)
+
+// supportedMethods are the methods allowed in AllowedMethod.
+var supportedMethods = []string{
+	http.MethodGet,
+	http.MethodPut,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodDelete,
+}
+
+// Rule - a CORS rule of a bucket.
+type Rule struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+	MaxAgeSeconds  *int     `xml:"MaxAgeSeconds,omitempty"`
+}
+
+// Validate - validates the CORS rule
+func (r Rule) Validate() error {
+	if len(r.ID) > maxIDSize {
+		return errIDTooLong
+	}
+	if len(r.AllowedMethods) == 0 {
+		return errNoMethods
+	}
+	for _, m := range r.AllowedMethods {
+		var ok bool
+		for _, sm := range supportedMethods {
+			if m == sm {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return Errorf("Found unsupported HTTP method in CORS config. Unsupported method is %s", m)
+		}
+	}
+	if len(r.AllowedOrigins) == 0 {
+		return errNoOrigins
+	}
+	for _, o := range r.AllowedOrigins {
+		if strings.Count(o, "*") > 1 {
+			return Errorf("AllowedOrigin \"%s\" can not have more than one wildcard.", o)
+		}
+	}
+	for _, h := range r.AllowedHeaders {
+		if strings.Count(h, "*") > 1 {
+			return Errorf("AllowedHeader \"%s\" can not have more than one wildcard.", h)
+		}
+	}
+	for _, h := range r.ExposeHeaders {
+		if strings.Contains(h, "*") {
+			return Errorf("ExposeHeader \"%s\" contains wildcard. We currently do not support wildcard for ExposeHeader.", h)
+		}
+	}
+	if r.MaxAgeSeconds != nil && *r.MaxAgeSeconds < 0 {
+		return errMaxAgeNegative
+	}
+	return nil
+}
+
+// AllowsOrigin - returns true if origin matches one of the allowed origins.
+func (r Rule) AllowsOrigin(origin string) bool {
+	for _, o := range r.AllowedOrigins {
+		if match(o, origin, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsAnyOrigin - returns true if the rule allows all origins.
+func (r Rule) AllowsAnyOrigin() bool {
+	for _, o := range r.AllowedOrigins {
+		if o == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsMethod - returns true if method is one of the allowed methods.
+func (r Rule) AllowsMethod(method string) bool {
+	for _, m := range r.AllowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsHeaders - returns true if all headers match an allowed header,
+// headers are matched case-insensitively.
+func (r Rule) AllowsHeaders(headers []string) bool {
+	for _, h := range headers {
+		var ok bool
+		for _, ah := range r.AllowedHeaders {
+			if match(ah, h, true) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Config - CORS configuration of a bucket.
+type Config struct {
+	XMLNS   string   `xml:"xmlns,attr,omitempty"`
+	XMLName xml.Name `xml:"CORSConfiguration"`
+	Rules   []Rule   `xml:"CORSRule"`
+}
+
+// Validate - validates the CORS configuration
+func (c Config) Validate() error {
+	if len(c.Rules) == 0 {
+		return errNoRules
+	}
+	if len(c.Rules) > maxRules {
+		return errTooManyRules
+	}
+	for _, r := range c.Rules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match - returns the first rule allowing a request from origin with
+// method and headers, nil if no rule allows it. For preflight requests
+// method and headers are the values of Access-Control-Request-Method
+// and Access-Control-Request-Headers.
+func (c Config) Match(origin, method string, headers []string) *Rule {
+	for i, r := range c.Rules {
+		if r.AllowsOrigin(origin) && r.AllowsMethod(method) && r.AllowsHeaders(headers) {
+			return &c.Rules[i]
+		}
+	}
+	return nil
+}
+
+// ParseConfig - parses data in given reader to CORSConfiguration.
+func ParseConfig(reader io.Reader) (*Config, error) {
+	var c Config
+	if err := xml.NewDecoder(reader).Decode(&c); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// match matches s against pattern which may contain at most one '*'
+// matching any sequence of characters.
+func match(pattern, s string, fold bool) bool {
+	if fold {
+		pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+	}
+	i := strings.IndexByte(pattern, '*')
+	if i < 0 {
+		return pattern == s
+	}
+	prefix, suffix := pattern[:i], pattern[i+1:]
+	return len(s) >= len(prefix)+len(suffix) && strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cors
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseConfig(t *testing.T) {
+	testCases := []struct {
+		config  string
+		success bool
+	}{
+		{
+			config:  `<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`,
+			success: true,
+		},
+		{
+			config: `<CORSConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <CORSRule>
+    <ID>web</ID>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedMethod>POST</AllowedMethod>
+    <AllowedHeader>x-amz-*</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>3000</MaxAgeSeconds>
+  </CORSRule>
+</CORSConfiguration>`,
+			success: true,
+		},
+		// No rules
+		{config: `<CORSConfiguration></CORSConfiguration>`},
+		// Unsupported method
+		{config: `<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>PATCH</AllowedMethod></CORSRule></CORSConfiguration>`},
+		// Missing origin
+		{config: `<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`},
+		// Two wildcards in origin
+		{config: `<CORSConfiguration><CORSRule><AllowedOrigin>https://*.*.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`},
+		// Wildcard in expose header
+		{config: `<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>GET</AllowedMethod><ExposeHeader>x-amz-*</ExposeHeader></CORSRule></CORSConfiguration>`},
+		// Negative max age
+		{config: `<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>GET</AllowedMethod><MaxAgeSeconds>-1</MaxAgeSeconds></CORSRule></CORSConfiguration>`},
+	}
+	for i, tc := range testCases {
+		_, err := ParseConfig(strings.NewReader(tc.config))
+		if tc.success && err != nil {
+			t.Fatalf("Test %d: expected success, got %v", i+1, err)
+		}
+		if !tc.success && err == nil {
+			t.Fatalf("Test %d: expected failure", i+1)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	config := `<CORSConfiguration>
+  <CORSRule>
+    <ID>write</ID>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedHeader>Content-*</AllowedHeader>
+    <AllowedHeader>x-amz-date</AllowedHeader>
+  </CORSRule>
+  <CORSRule>
+    <ID>read</ID>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>PUT</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+	c, err := ParseConfig(strings.NewReader(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		origin  string
+		method  string
+		headers []string
+		id      string
+	}{
+		{origin: "https://www.example.com", method: "PUT", headers: []string{"content-type", "X-Amz-Date"}, id: "write"},
+		{origin: "https://www.example.com", method: "PUT", id: "write"},
+		// Header not allowed by the first rule, the second
+		// rule allows no headers.
+		{origin: "https://www.example.com", method: "PUT", headers: []string{"authorization"}},
+		{origin: "https://www.example.com", method: "GET", id: "read"},
+		{origin: "http://www.example.com", method: "PUT", id: "read"},
+		// Origin is matched case-sensitively.
+		{origin: "https://WWW.EXAMPLE.COM", method: "PUT", id: "read"},
+		{origin: "https://example.com", method: "DELETE"},
+		// Wildcard must match the whole suffix.
+		{origin: "https://www.example.co", method: "PUT", id: "read"},
+	}
+	for i, tc := range testCases {
+		r := c.Match(tc.origin, tc.method, tc.headers)
+		var id string
+		if r != nil {
+			id = r.ID
+		}
+		if id != tc.id {
+			t.Errorf("Test %d: expected rule %q, got %q", i+1, tc.id, id)
+		}
+	}
+}
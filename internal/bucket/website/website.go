@@ -0,0 +1,134 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package website
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+const maxRoutingRules = 50
+
+var (
+	errEmptyConfig         = Errorf("A value for IndexDocument Suffix must be provided if RedirectAllRequestsTo is empty")
+	errRedirectAllWithMore = Errorf("RedirectAllRequestsTo cannot be provided in conjunction with other Routing Rules.")
+	errInvalidSuffix       = Errorf("The IndexDocument Suffix is not well formed")
+	errTooManyRoutingRules = Errorf("The number of routing rules must not exceed %d", maxRoutingRules)
+	errRedirectHostMissing = Errorf("RedirectAllRequestsTo must contain a HostName")
+	errRedirectMissing     = Errorf("A RoutingRule must contain a Redirect")
+	errInvalidProtocol     = Errorf("Protocol must be http or https")
+)
+
+// Redirect - redirect of a routing rule.
+type Redirect struct {
+	HostName             string `xml:"HostName,omitempty"`
+	HTTPRedirectCode     string `xml:"HttpRedirectCode,omitempty"`
+	Protocol             string `xml:"Protocol,omitempty"`
+	ReplaceKeyPrefixWith string `xml:"ReplaceKeyPrefixWith,omitempty"`
+	ReplaceKeyWith       string `xml:"ReplaceKeyWith,omitempty"`
+}
+
+// Condition - condition of a routing rule.
+type Condition struct {
+	HTTPErrorCodeReturnedEquals string `xml:"HttpErrorCodeReturnedEquals,omitempty"`
+	KeyPrefixEquals             string `xml:"KeyPrefixEquals,omitempty"`
+}
+
+// RoutingRule - a routing rule of a website configuration.
+type RoutingRule struct {
+	Condition *Condition `xml:"Condition,omitempty"`
+	Redirect  *Redirect  `xml:"Redirect"`
+}
+
+// RedirectAllRequestsTo - redirects all requests of a website.
+type RedirectAllRequestsTo struct {
+	HostName string `xml:"HostName"`
+	Protocol string `xml:"Protocol,omitempty"`
+}
+
+// IndexDocument - index document of a website.
+type IndexDocument struct {
+	Suffix string `xml:"Suffix"`
+}
+
+// ErrorDocument - error document of a website.
+type ErrorDocument struct {
+	Key string `xml:"Key"`
+}
+
+// Config - website configuration of a bucket, it is stored and
+// returned but websites are not served.
+type Config struct {
+	XMLNS                 string                 `xml:"xmlns,attr,omitempty"`
+	XMLName               xml.Name               `xml:"WebsiteConfiguration"`
+	RedirectAllRequestsTo *RedirectAllRequestsTo `xml:"RedirectAllRequestsTo,omitempty"`
+	IndexDocument         *IndexDocument         `xml:"IndexDocument,omitempty"`
+	ErrorDocument         *ErrorDocument         `xml:"ErrorDocument,omitempty"`
+	RoutingRules          []RoutingRule          `xml:"RoutingRules>RoutingRule,omitempty"`
+}
+
+func validProtocol(p string) bool {
+	return p == "" || p == "http" || p == "https"
+}
+
+// Validate - validates the website configuration
+func (c Config) Validate() error {
+	if c.RedirectAllRequestsTo != nil {
+		if c.IndexDocument != nil || c.ErrorDocument != nil || len(c.RoutingRules) > 0 {
+			return errRedirectAllWithMore
+		}
+		if c.RedirectAllRequestsTo.HostName == "" {
+			return errRedirectHostMissing
+		}
+		if !validProtocol(c.RedirectAllRequestsTo.Protocol) {
+			return errInvalidProtocol
+		}
+		return nil
+	}
+	if c.IndexDocument == nil {
+		return errEmptyConfig
+	}
+	if c.IndexDocument.Suffix == "" || strings.Contains(c.IndexDocument.Suffix, "/") {
+		return errInvalidSuffix
+	}
+	if len(c.RoutingRules) > maxRoutingRules {
+		return errTooManyRoutingRules
+	}
+	for _, r := range c.RoutingRules {
+		if r.Redirect == nil {
+			return errRedirectMissing
+		}
+		if !validProtocol(r.Redirect.Protocol) {
+			return errInvalidProtocol
+		}
+	}
+	return nil
+}
+
+// ParseConfig - parses data in given reader to WebsiteConfiguration.
+func ParseConfig(reader io.Reader) (*Config, error) {
+	var c Config
+	if err := xml.NewDecoder(reader).Decode(&c); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/minio/minio/internal/config"
 	"github.com/minio/pkg/env"
 )
@@ -45,6 +46,22 @@ const (
 	apiDeleteCleanupInterval       = "delete_cleanup_interval"
 	apiDisableODirect              = "disable_odirect"
 	apiGzipObjects                 = "gzip_objects"
+	apiEncodeChunkSize             = "encode_chunk_size"
+	apiEncodeChunkWorkers          = "encode_chunk_workers"
+	apiMaxObjectKeyDepth           = "max_object_key_depth"
+	apiBufferMemoryWatermark       = "buffer_memory_watermark"
+	apiStrictBucketConfig          = "strict_bucket_config"
+	apiMultipartWeakETag           = "multipart_weak_etag"
+	apiDedupVersions               = "dedup_versions"
+	apiListMaxScanned              = "list_max_scanned"
+	apiListMaxDuration             = "list_max_duration"
+	apiTransitionReads             = "transition_reads"
+
+	apiInternodeMaxIdleConnsPerHost = "internode_max_idle_conns_per_host"
+	apiInternodeMaxConnsPerHost     = "internode_max_conns_per_host"
+	apiInternodeIdleConnTimeout     = "internode_idle_conn_timeout"
+	apiInternodeTLSHandshakeTimeout = "internode_tls_handshake_timeout"
+	apiInternodeDialTimeout         = "internode_dial_timeout"
 
 	EnvAPIRequestsMax             = "MINIO_API_REQUESTS_MAX"
 	EnvAPIRequestsDeadline        = "MINIO_API_REQUESTS_DEADLINE"
@@ -61,6 +78,22 @@ const (
 	EnvDeleteCleanupInterval          = "MINIO_DELETE_CLEANUP_INTERVAL"
 	EnvAPIDisableODirect              = "MINIO_API_DISABLE_ODIRECT"
 	EnvAPIGzipObjects                 = "MINIO_API_GZIP_OBJECTS"
+	EnvAPIEncodeChunkSize             = "MINIO_API_ENCODE_CHUNK_SIZE"
+	EnvAPIEncodeChunkWorkers          = "MINIO_API_ENCODE_CHUNK_WORKERS"
+	EnvAPIMaxObjectKeyDepth           = "MINIO_API_MAX_OBJECT_KEY_DEPTH"
+	EnvAPIBufferMemoryWatermark       = "MINIO_API_BUFFER_MEMORY_WATERMARK"
+	EnvAPIStrictBucketConfig          = "MINIO_API_STRICT_BUCKET_CONFIG"
+	EnvAPIMultipartWeakETag           = "MINIO_API_MULTIPART_WEAK_ETAG"
+	EnvAPIDedupVersions               = "MINIO_API_DEDUP_VERSIONS"
+	EnvAPIListMaxScanned              = "MINIO_API_LIST_MAX_SCANNED"
+	EnvAPIListMaxDuration             = "MINIO_API_LIST_MAX_DURATION"
+	EnvAPITransitionReads             = "MINIO_API_TRANSITION_READS"
+
+	EnvAPIInternodeMaxIdleConnsPerHost = "MINIO_API_INTERNODE_MAX_IDLE_CONNS_PER_HOST"
+	EnvAPIInternodeMaxConnsPerHost     = "MINIO_API_INTERNODE_MAX_CONNS_PER_HOST"
+	EnvAPIInternodeIdleConnTimeout     = "MINIO_API_INTERNODE_IDLE_CONN_TIMEOUT"
+	EnvAPIInternodeTLSHandshakeTimeout = "MINIO_API_INTERNODE_TLS_HANDSHAKE_TIMEOUT"
+	EnvAPIInternodeDialTimeout         = "MINIO_API_INTERNODE_DIAL_TIMEOUT"
 )
 
 // Deprecated key and ENVs
@@ -130,6 +163,66 @@ var (
 			Key:   apiGzipObjects,
 			Value: "off",
 		},
+		config.KV{
+			Key:   apiEncodeChunkSize,
+			Value: "0",
+		},
+		config.KV{
+			Key:   apiEncodeChunkWorkers,
+			Value: "4",
+		},
+		config.KV{
+			Key:   apiMaxObjectKeyDepth,
+			Value: "100",
+		},
+		config.KV{
+			Key:   apiBufferMemoryWatermark,
+			Value: "0",
+		},
+		config.KV{
+			Key:   apiStrictBucketConfig,
+			Value: "off",
+		},
+		config.KV{
+			Key:   apiMultipartWeakETag,
+			Value: "off",
+		},
+		config.KV{
+			Key:   apiDedupVersions,
+			Value: "off",
+		},
+		config.KV{
+			Key:   apiListMaxScanned,
+			Value: "10000000",
+		},
+		config.KV{
+			Key:   apiListMaxDuration,
+			Value: "10m",
+		},
+		config.KV{
+			Key:   apiTransitionReads,
+			Value: "wait",
+		},
+		config.KV{
+			Key:   apiInternodeMaxIdleConnsPerHost,
+			Value: "1024",
+		},
+		config.KV{
+			Key:   apiInternodeMaxConnsPerHost,
+			Value: "0",
+		},
+		config.KV{
+			Key:   apiInternodeIdleConnTimeout,
+			Value: "15s",
+		},
+		config.KV{
+			Key:   apiInternodeTLSHandshakeTimeout,
+			Value: "15s",
+		},
+		config.KV{
+			Key:   apiInternodeDialTimeout,
+			Value: "10s",
+		},
 	}
 )
 
@@ -148,6 +241,22 @@ type Config struct {
 	DeleteCleanupInterval       time.Duration `json:"delete_cleanup_interval"`
 	DisableODirect              bool          `json:"disable_odirect"`
 	GzipObjects                 bool          `json:"gzip_objects"`
+	EncodeChunkSize             uint64        `json:"encode_chunk_size"`
+	EncodeChunkWorkers          int           `json:"encode_chunk_workers"`
+	MaxObjectKeyDepth           int           `json:"max_object_key_depth"`
+	BufferMemoryWatermark       uint64        `json:"buffer_memory_watermark"`
+	StrictBucketConfig          bool          `json:"strict_bucket_config"`
+	MultipartWeakETag           bool          `json:"multipart_weak_etag"`
+	DedupVersions               bool          `json:"dedup_versions"`
+	ListMaxScanned              int64         `json:"list_max_scanned"`
+	ListMaxDuration             time.Duration `json:"list_max_duration"`
+	TransitionReads             string        `json:"transition_reads"`
+
+	InternodeMaxIdleConnsPerHost int           `json:"internode_max_idle_conns_per_host"`
+	InternodeMaxConnsPerHost     int           `json:"internode_max_conns_per_host"`
+	InternodeIdleConnTimeout     time.Duration `json:"internode_idle_conn_timeout"`
+	InternodeTLSHandshakeTimeout time.Duration `json:"internode_tls_handshake_timeout"`
+	InternodeDialTimeout         time.Duration `json:"internode_dial_timeout"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -248,6 +357,101 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 	disableODirect := env.Get(EnvAPIDisableODirect, kvs.Get(apiDisableODirect)) == config.EnableOn
 	gzipObjects := env.Get(EnvAPIGzipObjects, kvs.Get(apiGzipObjects)) == config.EnableOn
 
+	encodeChunkSize, err := humanize.ParseBytes(env.Get(EnvAPIEncodeChunkSize, kvs.GetWithDefault(apiEncodeChunkSize, DefaultKVS)))
+	if err != nil {
+		return cfg, fmt.Errorf("invalid value for encode_chunk_size: %w", err)
+	}
+
+	encodeChunkWorkers, err := strconv.Atoi(env.Get(EnvAPIEncodeChunkWorkers, kvs.GetWithDefault(apiEncodeChunkWorkers, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if encodeChunkWorkers <= 0 {
+		return cfg, fmt.Errorf("invalid value %v for encode_chunk_workers", encodeChunkWorkers)
+	}
+
+	maxObjectKeyDepth, err := strconv.Atoi(env.Get(EnvAPIMaxObjectKeyDepth, kvs.GetWithDefault(apiMaxObjectKeyDepth, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if maxObjectKeyDepth < 0 {
+		return cfg, fmt.Errorf("invalid value %v for max_object_key_depth", maxObjectKeyDepth)
+	}
+
+	bufferMemoryWatermark, err := humanize.ParseBytes(env.Get(EnvAPIBufferMemoryWatermark, kvs.GetWithDefault(apiBufferMemoryWatermark, DefaultKVS)))
+	if err != nil {
+		return cfg, fmt.Errorf("invalid value for buffer_memory_watermark: %w", err)
+	}
+
+	strictBucketConfig := env.Get(EnvAPIStrictBucketConfig, kvs.Get(apiStrictBucketConfig)) == config.EnableOn
+
+	multipartWeakETag := env.Get(EnvAPIMultipartWeakETag, kvs.Get(apiMultipartWeakETag)) == config.EnableOn
+
+	dedupVersions := env.Get(EnvAPIDedupVersions, kvs.Get(apiDedupVersions)) == config.EnableOn
+
+	listMaxScanned, err := strconv.ParseInt(env.Get(EnvAPIListMaxScanned, kvs.GetWithDefault(apiListMaxScanned, DefaultKVS)), 10, 64)
+	if err != nil {
+		return cfg, err
+	}
+	if listMaxScanned < 0 {
+		return cfg, fmt.Errorf("invalid value %v for list_max_scanned", listMaxScanned)
+	}
+
+	listMaxDuration, err := time.ParseDuration(env.Get(EnvAPIListMaxDuration, kvs.GetWithDefault(apiListMaxDuration, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if listMaxDuration < 0 {
+		return cfg, fmt.Errorf("invalid value %v for list_max_duration", listMaxDuration)
+	}
+
+	transitionReads := env.Get(EnvAPITransitionReads, kvs.GetWithDefault(apiTransitionReads, DefaultKVS))
+	switch transitionReads {
+	case "wait", "local":
+	default:
+		return cfg, fmt.Errorf("invalid value %v for transition_reads", transitionReads)
+	}
+
+	internodeMaxIdleConnsPerHost, err := strconv.Atoi(env.Get(EnvAPIInternodeMaxIdleConnsPerHost, kvs.GetWithDefault(apiInternodeMaxIdleConnsPerHost, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if internodeMaxIdleConnsPerHost <= 0 {
+		return cfg, fmt.Errorf("invalid value %v for internode_max_idle_conns_per_host", internodeMaxIdleConnsPerHost)
+	}
+
+	internodeMaxConnsPerHost, err := strconv.Atoi(env.Get(EnvAPIInternodeMaxConnsPerHost, kvs.GetWithDefault(apiInternodeMaxConnsPerHost, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if internodeMaxConnsPerHost < 0 {
+		return cfg, fmt.Errorf("invalid value %v for internode_max_conns_per_host", internodeMaxConnsPerHost)
+	}
+
+	internodeIdleConnTimeout, err := time.ParseDuration(env.Get(EnvAPIInternodeIdleConnTimeout, kvs.GetWithDefault(apiInternodeIdleConnTimeout, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if internodeIdleConnTimeout <= 0 {
+		return cfg, fmt.Errorf("invalid value %v for internode_idle_conn_timeout", internodeIdleConnTimeout)
+	}
+
+	internodeTLSHandshakeTimeout, err := time.ParseDuration(env.Get(EnvAPIInternodeTLSHandshakeTimeout, kvs.GetWithDefault(apiInternodeTLSHandshakeTimeout, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if internodeTLSHandshakeTimeout <= 0 {
+		return cfg, fmt.Errorf("invalid value %v for internode_tls_handshake_timeout", internodeTLSHandshakeTimeout)
+	}
+
+	internodeDialTimeout, err := time.ParseDuration(env.Get(EnvAPIInternodeDialTimeout, kvs.GetWithDefault(apiInternodeDialTimeout, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if internodeDialTimeout <= 0 {
+		return cfg, fmt.Errorf("invalid value %v for internode_dial_timeout", internodeDialTimeout)
+	}
+
 	return Config{
 		RequestsMax:                 requestsMax,
 		RequestsDeadline:            requestsDeadline,
@@ -262,5 +466,21 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		DeleteCleanupInterval:       deleteCleanupInterval,
 		DisableODirect:              disableODirect,
 		GzipObjects:                 gzipObjects,
+		EncodeChunkSize:             encodeChunkSize,
+		EncodeChunkWorkers:          encodeChunkWorkers,
+		MaxObjectKeyDepth:           maxObjectKeyDepth,
+		BufferMemoryWatermark:       bufferMemoryWatermark,
+		StrictBucketConfig:          strictBucketConfig,
+		MultipartWeakETag:           multipartWeakETag,
+		DedupVersions:               dedupVersions,
+		ListMaxScanned:              listMaxScanned,
+		ListMaxDuration:             listMaxDuration,
+		TransitionReads:             transitionReads,
+
+		InternodeMaxIdleConnsPerHost: internodeMaxIdleConnsPerHost,
+		InternodeMaxConnsPerHost:     internodeMaxConnsPerHost,
+		InternodeIdleConnTimeout:     internodeIdleConnTimeout,
+		InternodeTLSHandshakeTimeout: internodeTLSHandshakeTimeout,
+		InternodeDialTimeout:         internodeDialTimeout,
 	}, nil
 }
@@ -98,5 +98,95 @@ var (
 			Optional:    true,
 			Type:        "boolean",
 		},
+		config.HelpKV{
+			Key:         apiEncodeChunkSize,
+			Description: `set the size of the chunks large objects are split into to be erasure coded in parallel e.g. "128MiB", "0" disables` + defaultHelpPostfix(apiEncodeChunkSize),
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         apiEncodeChunkWorkers,
+			Description: `set the maximum number of chunks of an object erasure coded in parallel` + defaultHelpPostfix(apiEncodeChunkWorkers),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         apiMaxObjectKeyDepth,
+			Description: `set the maximum number of path components allowed in new object names, "0" disables the limit` + defaultHelpPostfix(apiMaxObjectKeyDepth),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         apiBufferMemoryWatermark,
+			Description: `set the memory held by in-flight object request buffers above which new GET and PUT object requests are rejected with 503 SlowDown e.g. "8GiB", "0" disables` + defaultHelpPostfix(apiBufferMemoryWatermark),
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         apiStrictBucketConfig,
+			Description: "set to return NotImplemented for bucket CORS, website and accelerate APIs and ignore bucket CORS configurations" + defaultHelpPostfix(apiStrictBucketConfig),
+			Optional:    true,
+			Type:        "boolean",
+		},
+		config.HelpKV{
+			Key:         apiMultipartWeakETag,
+			Description: "set to return a weak ETag for multipart objects in the X-Minio-Weak-Etag header, for caches assuming the ETag is the MD5 of the content" + defaultHelpPostfix(apiMultipartWeakETag),
+			Optional:    true,
+			Type:        "boolean",
+		},
+		config.HelpKV{
+			Key:         apiDedupVersions,
+			Description: "set to share the data of a new object version with the latest version when their content is identical" + defaultHelpPostfix(apiDedupVersions),
+			Optional:    true,
+			Type:        "boolean",
+		},
+		config.HelpKV{
+			Key:         apiListMaxScanned,
+			Description: `set the maximum number of entries a single listing request may scan, including the entries not returned, "0" disables the limit` + defaultHelpPostfix(apiListMaxScanned),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         apiListMaxDuration,
+			Description: `set the maximum duration of a single listing request, "0s" disables the limit` + defaultHelpPostfix(apiListMaxDuration),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         apiTransitionReads,
+			Description: `set how objects being transitioned to a remote tier are read, "wait" for the transition to complete or "local" to serve the local copy until then` + defaultHelpPostfix(apiTransitionReads),
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         apiInternodeMaxIdleConnsPerHost,
+			Description: `set the maximum number of idle internode connections kept per peer` + defaultHelpPostfix(apiInternodeMaxIdleConnsPerHost),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         apiInternodeMaxConnsPerHost,
+			Description: `set the maximum number of internode connections per peer, "0" means unlimited` + defaultHelpPostfix(apiInternodeMaxConnsPerHost),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         apiInternodeIdleConnTimeout,
+			Description: `set the duration after which idle internode connections are closed` + defaultHelpPostfix(apiInternodeIdleConnTimeout),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         apiInternodeTLSHandshakeTimeout,
+			Description: `set the timeout for TLS handshakes of internode connections` + defaultHelpPostfix(apiInternodeTLSHandshakeTimeout),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         apiInternodeDialTimeout,
+			Description: `set the timeout for dialing internode connections` + defaultHelpPostfix(apiInternodeDialTimeout),
+			Optional:    true,
+			Type:        "duration",
+		},
 	}
 )
@@ -23,7 +23,10 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/minio/pkg/env"
 )
@@ -79,6 +82,10 @@ func LoadX509KeyPair(certFile, keyFile string) (tls.Certificate, error) {
 	if err != nil {
 		return tls.Certificate{}, ErrTLSReadError(nil).Msg("Unable to read the private key: %s", err)
 	}
+	return parseX509KeyPair(certPEMBlock, keyPEMBlock)
+}
+
+func parseX509KeyPair(certPEMBlock, keyPEMBlock []byte) (tls.Certificate, error) {
 	key, rest := pem.Decode(keyPEMBlock)
 	if len(rest) > 0 {
 		return tls.Certificate{}, ErrTLSUnexpectedData(nil).Msg("The private key contains additional data")
@@ -104,11 +111,96 @@ func LoadX509KeyPair(certFile, keyFile string) (tls.Certificate, error) {
 	return cert, nil
 }
 
-// EnsureCertAndKey checks if both client certificate and key paths are provided
+// EnsureCertAndKey checks if both client certificate and key are provided
+// and that they load as a pair.
 func EnsureCertAndKey(clientCert, clientKey string) error {
 	if (clientCert != "" && clientKey == "") ||
 		(clientCert == "" && clientKey != "") {
 		return errors.New("cert and key must be specified as a pair")
 	}
+	if clientCert != "" {
+		if _, err := LoadClientCertificate(clientCert, clientKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsPEM returns true if the value holds inline PEM data instead of
+// the path of a PEM file.
+func IsPEM(value string) bool {
+	return strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN ")
+}
+
+// readPEM returns the inline PEM data of the value or reads it from
+// the file at the given path. Inline PEM may be given on a single line
+// with escaped newlines.
+func readPEM(value string) ([]byte, error) {
+	if IsPEM(value) {
+		value = strings.TrimSpace(value)
+		if !strings.Contains(value, "\n") {
+			value = strings.ReplaceAll(value, `\n`, "\n")
+		}
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// LoadClientCertificate - load a client certificate and its private key,
+// each given either as the path of a PEM file or as inline PEM. The
+// private key may be encrypted and is decrypted using the ENV_VAR:
+// MINIO_CERT_PASSWD.
+func LoadClientCertificate(clientCert, clientKey string) (tls.Certificate, error) {
+	certPEMBlock, err := readPEM(clientCert)
+	if err != nil {
+		return tls.Certificate{}, ErrTLSReadError(nil).Msg("Unable to read the client certificate: %s", err)
+	}
+	keyPEMBlock, err := readPEM(clientKey)
+	if err != nil {
+		return tls.Certificate{}, ErrTLSReadError(nil).Msg("Unable to read the client key: %s", err)
+	}
+	cert, err := parseX509KeyPair(certPEMBlock, keyPEMBlock)
+	if err != nil {
+		return cert, err
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return tls.Certificate{}, ErrTLSUnexpectedData(nil).Msg(err.Error())
+	}
+	return cert, nil
+}
+
+// LoadCertPool - load the CA certificates, given either as the path of
+// a PEM file or as inline PEM, into a new certificate pool.
+func LoadCertPool(certAuthority string) (*x509.CertPool, error) {
+	data, err := readPEM(certAuthority)
+	if err != nil {
+		return nil, ErrTLSReadError(nil).Msg("Unable to read the certificate authority: %s", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, ErrTLSUnexpectedData(nil).Msg("No PEM certificate found in the certificate authority")
+	}
+	return pool, nil
+}
+
+// Client certificates expiring within this duration are warned about.
+const clientCertExpiryWarning = 30 * 24 * time.Hour
+
+// CheckClientCertExpiry returns an error if the client certificate
+// has expired or expires soon.
+func CheckClientCertExpiry(clientCert, clientKey string) error {
+	if clientCert == "" || clientKey == "" {
+		return nil
+	}
+	cert, err := LoadClientCertificate(clientCert, clientKey)
+	if err != nil {
+		return err
+	}
+	if notAfter := cert.Leaf.NotAfter; time.Until(notAfter) < clientCertExpiryWarning {
+		if time.Now().After(notAfter) {
+			return fmt.Errorf("client certificate %s expired on %s", cert.Leaf.Subject, notAfter.Format(time.RFC3339))
+		}
+		return fmt.Errorf("client certificate %s expires on %s", cert.Leaf.Subject, notAfter.Format(time.RFC3339))
+	}
 	return nil
 }
@@ -19,6 +19,7 @@ package config
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -209,6 +210,26 @@ func TestLoadX509KeyPair(t *testing.T) {
 	}
 }
 
+func TestLoadClientCertificate(t *testing.T) {
+	for i, testCase := range loadX509KeyPairTests {
+		if testCase.password != "" {
+			t.Setenv(EnvCertPassword, testCase.password)
+		}
+		// Inline PEM, the certificate on a single line with escaped newlines.
+		certificate := strings.ReplaceAll(testCase.certificate, "\n", `\n`)
+		cert, err := LoadClientCertificate(certificate, testCase.privateKey)
+		if err != nil && !testCase.shouldFail {
+			t.Errorf("Test %d: test should succeed but it failed: %v", i, err)
+		}
+		if err == nil && testCase.shouldFail {
+			t.Errorf("Test %d: test should fail but it succeed", i)
+		}
+		if err == nil && cert.Leaf == nil {
+			t.Errorf("Test %d: expected the parsed certificate", i)
+		}
+	}
+}
+
 var loadX509KeyPairTests = []struct {
 	password                string
 	privateKey, certificate string
@@ -117,6 +117,10 @@ const (
 	CrawlerSubSys        = madmin.CrawlerSubSys
 	SubnetSubSys         = madmin.SubnetSubSys
 	CallhomeSubSys       = madmin.CallhomeSubSys
+	ILMSubSys            = "ilm"
+	DriveSubSys          = "drive"
+	UpdateSubSys         = "update"
+	GuardrailSubSys      = "guardrail"
 
 	// Add new constants here (similar to above) if you add new fields to config.
 )
@@ -169,7 +173,12 @@ var LoggerSubSystems = set.CreateStringSet(
 )
 
 // SubSystems - all supported sub-systems
-var SubSystems = madmin.SubSystems
+var SubSystems = madmin.SubSystems.Union(set.CreateStringSet(
+	ILMSubSys,
+	DriveSubSys,
+	UpdateSubSys,
+	GuardrailSubSys,
+))
 
 // SubSystemsDynamic - all sub-systems that have dynamic config.
 var SubSystemsDynamic = set.CreateStringSet(
@@ -183,6 +192,10 @@ var SubSystemsDynamic = set.CreateStringSet(
 	AuditWebhookSubSys,
 	AuditKafkaSubSys,
 	StorageClassSubSys,
+	ILMSubSys,
+	DriveSubSys,
+	UpdateSubSys,
+	GuardrailSubSys,
 )
 
 // SubSystemsSingleTargets - subsystems which only support single target.
@@ -203,6 +216,10 @@ var SubSystemsSingleTargets = set.CreateStringSet(
 	ScannerSubSys,
 	SubnetSubSys,
 	CallhomeSubSys,
+	ILMSubSys,
+	DriveSubSys,
+	UpdateSubSys,
+	GuardrailSubSys,
 )
 
 // Constant separators
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package drive
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/pkg/env"
+)
+
+// Drive timeout environment variables
+const (
+	MetadataTimeout    = "metadata_timeout"
+	EnvMetadataTimeout = "MINIO_DRIVE_METADATA_TIMEOUT"
+	DataTimeout        = "data_timeout"
+	EnvDataTimeout     = "MINIO_DRIVE_DATA_TIMEOUT"
+	LongTimeout        = "long_timeout"
+	EnvLongTimeout     = "MINIO_DRIVE_LONG_TIMEOUT"
+	MaxTimeouts        = "max_timeouts"
+	EnvMaxTimeouts     = "MINIO_DRIVE_MAX_TIMEOUTS"
+)
+
+// Config represents the drive timeout settings.
+type Config struct {
+	// MetadataTimeout is the timeout of operations on metadata,
+	// volumes and directories, 0 disables it.
+	MetadataTimeout time.Duration `json:"metadataTimeout"`
+	// DataTimeout is the timeout of reads and writes of up to 64MiB
+	// of object data, larger operations are allowed proportionally
+	// more, 0 disables it.
+	DataTimeout time.Duration `json:"dataTimeout"`
+	// LongTimeout is the timeout of operations whose duration depends
+	// on the amount of data on the drive, such as recursive deletes,
+	// 0 disables it.
+	LongTimeout time.Duration `json:"longTimeout"`
+	// MaxTimeouts is the number of operations timing out in a row
+	// after which a drive is taken offline until it responds again.
+	MaxTimeouts int `json:"maxTimeouts"`
+}
+
+// DefaultKVS - default KV config for drive settings
+var DefaultKVS = config.KVS{
+	config.KV{
+		Key:   MetadataTimeout,
+		Value: "10s",
+	},
+	config.KV{
+		Key:   DataTimeout,
+		Value: "30s",
+	},
+	config.KV{
+		Key:   LongTimeout,
+		Value: "5m",
+	},
+	config.KV{
+		Key:   MaxTimeouts,
+		Value: "3",
+	},
+}
+
+// LookupConfig - lookup config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.DriveSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	lookupDuration := func(key, envKey string) (time.Duration, error) {
+		s := env.Get(envKey, kvs.GetWithDefault(key, DefaultKVS))
+		d, err := time.ParseDuration(s)
+		if err != nil || d < 0 {
+			return 0, fmt.Errorf("invalid %s value '%s'", key, s)
+		}
+		return d, nil
+	}
+	if cfg.MetadataTimeout, err = lookupDuration(MetadataTimeout, EnvMetadataTimeout); err != nil {
+		return cfg, err
+	}
+	if cfg.DataTimeout, err = lookupDuration(DataTimeout, EnvDataTimeout); err != nil {
+		return cfg, err
+	}
+	if cfg.LongTimeout, err = lookupDuration(LongTimeout, EnvLongTimeout); err != nil {
+		return cfg, err
+	}
+	maxTimeouts := env.Get(EnvMaxTimeouts, kvs.GetWithDefault(MaxTimeouts, DefaultKVS))
+	cfg.MaxTimeouts, err = strconv.Atoi(maxTimeouts)
+	if err != nil || cfg.MaxTimeouts <= 0 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", MaxTimeouts, maxTimeouts)
+	}
+	return cfg, nil
+}
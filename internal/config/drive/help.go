@@ -0,0 +1,54 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package drive
+
+import "github.com/minio/minio/internal/config"
+
+var (
+	defaultHelpPostfix = func(key string) string {
+		return config.DefaultHelpPostfix(DefaultKVS, key)
+	}
+
+	// Help provides help for config values
+	Help = config.HelpKVS{
+		config.HelpKV{
+			Key:         MetadataTimeout,
+			Description: `timeout of drive operations on metadata, volumes and directories, 0 to disable` + defaultHelpPostfix(MetadataTimeout),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         DataTimeout,
+			Description: `timeout of drive reads and writes of up to 64MiB of object data, scaled with the size, 0 to disable` + defaultHelpPostfix(DataTimeout),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         LongTimeout,
+			Description: `timeout of long drive operations such as recursive deletes, 0 to disable` + defaultHelpPostfix(LongTimeout),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         MaxTimeouts,
+			Description: `number of drive operations timing out in a row after which a drive is taken offline until it responds again` + defaultHelpPostfix(MaxTimeouts),
+			Optional:    true,
+			Type:        "number",
+		},
+	}
+)
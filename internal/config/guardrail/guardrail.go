@@ -0,0 +1,124 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package guardrail
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/pkg/env"
+)
+
+// Resource guardrail environment variables
+const (
+	CPUThreshold          = "cpu_threshold"
+	EnvCPUThreshold       = "MINIO_GUARDRAIL_CPU_THRESHOLD"
+	MemoryThreshold       = "memory_threshold"
+	EnvMemoryThreshold    = "MINIO_GUARDRAIL_MEMORY_THRESHOLD"
+	GoroutineThreshold    = "goroutine_threshold"
+	EnvGoroutineThreshold = "MINIO_GUARDRAIL_GOROUTINE_THRESHOLD"
+	Sustain               = "sustain"
+	EnvSustain            = "MINIO_GUARDRAIL_SUSTAIN"
+	ShedFraction          = "shed_fraction"
+	EnvShedFraction       = "MINIO_GUARDRAIL_SHED_FRACTION"
+)
+
+// Config represents the resource guardrail settings.
+type Config struct {
+	// CPUThreshold is the percentage of the CPUs of the node used by
+	// the server process above which it is overloaded, 0 disables it.
+	CPUThreshold float64 `json:"cpuThreshold"`
+	// MemoryThreshold is the resident memory of the server process
+	// above which it is overloaded, 0 disables it.
+	MemoryThreshold uint64 `json:"memoryThreshold"`
+	// GoroutineThreshold is the number of goroutines above which the
+	// server is overloaded, 0 disables it.
+	GoroutineThreshold int `json:"goroutineThreshold"`
+	// Sustain is how long a threshold must stay exceeded, or not,
+	// before shedding escalates, or relaxes, by one level.
+	Sustain time.Duration `json:"sustain"`
+	// ShedFraction is the fraction of the new S3 requests rejected
+	// with SlowDown at the highest shedding level.
+	ShedFraction float64 `json:"shedFraction"`
+}
+
+// Enabled returns true if any threshold is set.
+func (c Config) Enabled() bool {
+	return c.CPUThreshold > 0 || c.MemoryThreshold > 0 || c.GoroutineThreshold > 0
+}
+
+// DefaultKVS - default KV config for resource guardrail settings
+var DefaultKVS = config.KVS{
+	config.KV{
+		Key:   CPUThreshold,
+		Value: "0",
+	},
+	config.KV{
+		Key:   MemoryThreshold,
+		Value: "0",
+	},
+	config.KV{
+		Key:   GoroutineThreshold,
+		Value: "0",
+	},
+	config.KV{
+		Key:   Sustain,
+		Value: "1m",
+	},
+	config.KV{
+		Key:   ShedFraction,
+		Value: "0.1",
+	},
+}
+
+// LookupConfig - lookup config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.GuardrailSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	cpu := env.Get(EnvCPUThreshold, kvs.GetWithDefault(CPUThreshold, DefaultKVS))
+	cfg.CPUThreshold, err = strconv.ParseFloat(cpu, 64)
+	if err != nil || cfg.CPUThreshold < 0 || cfg.CPUThreshold > 100 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", CPUThreshold, cpu)
+	}
+	memory := env.Get(EnvMemoryThreshold, kvs.GetWithDefault(MemoryThreshold, DefaultKVS))
+	cfg.MemoryThreshold, err = humanize.ParseBytes(memory)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid %s value '%s'", MemoryThreshold, memory)
+	}
+	goroutines := env.Get(EnvGoroutineThreshold, kvs.GetWithDefault(GoroutineThreshold, DefaultKVS))
+	cfg.GoroutineThreshold, err = strconv.Atoi(goroutines)
+	if err != nil || cfg.GoroutineThreshold < 0 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", GoroutineThreshold, goroutines)
+	}
+	sustain := env.Get(EnvSustain, kvs.GetWithDefault(Sustain, DefaultKVS))
+	cfg.Sustain, err = time.ParseDuration(sustain)
+	if err != nil || cfg.Sustain < 0 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", Sustain, sustain)
+	}
+	fraction := env.Get(EnvShedFraction, kvs.GetWithDefault(ShedFraction, DefaultKVS))
+	cfg.ShedFraction, err = strconv.ParseFloat(fraction, 64)
+	if err != nil || cfg.ShedFraction < 0 || cfg.ShedFraction > 1 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", ShedFraction, fraction)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package guardrail
+
+import "github.com/minio/minio/internal/config"
+
+var (
+	defaultHelpPostfix = func(key string) string {
+		return config.DefaultHelpPostfix(DefaultKVS, key)
+	}
+
+	// Help provides help for config values
+	Help = config.HelpKVS{
+		config.HelpKV{
+			Key:         CPUThreshold,
+			Description: `percentage of the node CPUs used by the server above which it sheds load, 0 to disable` + defaultHelpPostfix(CPUThreshold),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         MemoryThreshold,
+			Description: `resident memory of the server above which it sheds load e.g. "16GiB", 0 to disable` + defaultHelpPostfix(MemoryThreshold),
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         GoroutineThreshold,
+			Description: `number of goroutines above which the server sheds load, 0 to disable` + defaultHelpPostfix(GoroutineThreshold),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         Sustain,
+			Description: `how long a threshold must stay exceeded, or not, before shedding escalates, or relaxes, by one level` + defaultHelpPostfix(Sustain),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         ShedFraction,
+			Description: `fraction of the new S3 requests rejected with SlowDown at the highest shedding level` + defaultHelpPostfix(ShedFraction),
+			Optional:    true,
+			Type:        "number",
+		},
+	}
+)
@@ -0,0 +1,41 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ParseHeaders parses static HTTP headers given as comma separated
+// `key:value` pairs, e.g. `X-Tenant:acme,X-Source:minio`.
+func ParseHeaders(value string) (http.Header, error) {
+	headers := make(http.Header)
+	for _, kv := range strings.Split(value, ValueSeparator) {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, ":")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" || strings.ContainsAny(k, " \t\r\n") || strings.ContainsAny(v, "\r\n") {
+			return nil, Errorf("invalid header `%s`, expected `key:value`", kv)
+		}
+		headers.Add(k, strings.TrimSpace(v))
+	}
+	return headers, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestParseHeaders(t *testing.T) {
+	testCases := []struct {
+		value      string
+		headers    http.Header
+		shouldFail bool
+	}{
+		{"", http.Header{}, false},
+		{"x-tenant:acme", http.Header{"X-Tenant": {"acme"}}, false},
+		{"X-Tenant: acme , X-Source:minio:9000", http.Header{"X-Tenant": {"acme"}, "X-Source": {"minio:9000"}}, false},
+		{"X-Tenant", nil, true},
+		{":acme", nil, true},
+		{"X Tenant:acme", nil, true},
+	}
+	for i, testCase := range testCases {
+		headers, err := ParseHeaders(testCase.value)
+		if err != nil && !testCase.shouldFail {
+			t.Errorf("Test %d: test should succeed but it failed: %v", i+1, err)
+		}
+		if err == nil && testCase.shouldFail {
+			t.Errorf("Test %d: test should fail but it succeed", i+1)
+		}
+		if err == nil && !reflect.DeepEqual(headers, testCase.headers) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.headers, headers)
+		}
+	}
+}
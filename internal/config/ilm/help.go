@@ -0,0 +1,48 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ilm
+
+import "github.com/minio/minio/internal/config"
+
+var (
+	defaultHelpPostfix = func(key string) string {
+		return config.DefaultHelpPostfix(DefaultKVS, key)
+	}
+
+	// Help provides help for config values
+	Help = config.HelpKVS{
+		config.HelpKV{
+			Key:         ExpirationWorkers,
+			Description: `number of workers deleting expired objects on a node` + defaultHelpPostfix(ExpirationWorkers),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         ExpirationRate,
+			Description: `maximum number of expired object versions deleted per second on a node, 0 for no limit` + defaultHelpPostfix(ExpirationRate),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         ExpirationPendingLimit,
+			Description: `pending expiry tasks on a node above which the scanner defers expiring objects to its next cycle, 0 for no limit` + defaultHelpPostfix(ExpirationPendingLimit),
+			Optional:    true,
+			Type:        "number",
+		},
+	}
+)
@@ -0,0 +1,90 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ilm
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/pkg/env"
+)
+
+// ILM expiry environment variables
+const (
+	ExpirationWorkers         = "expiration_workers"
+	EnvExpirationWorkers      = "MINIO_ILM_EXPIRATION_WORKERS"
+	ExpirationRate            = "expiration_rate"
+	EnvExpirationRate         = "MINIO_ILM_EXPIRATION_RATE"
+	ExpirationPendingLimit    = "expiration_pending_limit"
+	EnvExpirationPendingLimit = "MINIO_ILM_EXPIRATION_PENDING_LIMIT"
+)
+
+// Config represents the ILM expiry settings.
+type Config struct {
+	// ExpirationWorkers is the number of workers deleting expired
+	// objects on a node.
+	ExpirationWorkers int `json:"expirationWorkers"`
+	// ExpirationRate is the maximum number of object versions deleted
+	// per second on a node by the expiry workers, 0 means no limit.
+	ExpirationRate int `json:"expirationRate"`
+	// ExpirationPendingLimit is the number of pending expiry tasks on
+	// a node above which the scanner stops queueing more expiry work
+	// until its next cycle, 0 means no limit.
+	ExpirationPendingLimit int `json:"expirationPendingLimit"`
+}
+
+// DefaultKVS - default KV config for ILM settings
+var DefaultKVS = config.KVS{
+	config.KV{
+		Key:   ExpirationWorkers,
+		Value: "1",
+	},
+	config.KV{
+		Key:   ExpirationRate,
+		Value: "0",
+	},
+	config.KV{
+		Key:   ExpirationPendingLimit,
+		Value: "0",
+	},
+}
+
+// LookupConfig - lookup config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.ILMSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	workers := env.Get(EnvExpirationWorkers, kvs.GetWithDefault(ExpirationWorkers, DefaultKVS))
+	cfg.ExpirationWorkers, err = strconv.Atoi(workers)
+	if err != nil || cfg.ExpirationWorkers <= 0 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", ExpirationWorkers, workers)
+	}
+	rate := env.Get(EnvExpirationRate, kvs.GetWithDefault(ExpirationRate, DefaultKVS))
+	cfg.ExpirationRate, err = strconv.Atoi(rate)
+	if err != nil || cfg.ExpirationRate < 0 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", ExpirationRate, rate)
+	}
+	limit := env.Get(EnvExpirationPendingLimit, kvs.GetWithDefault(ExpirationPendingLimit, DefaultKVS))
+	cfg.ExpirationPendingLimit, err = strconv.Atoi(limit)
+	if err != nil || cfg.ExpirationPendingLimit < 0 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", ExpirationPendingLimit, limit)
+	}
+	return cfg, nil
+}
@@ -64,18 +64,30 @@ var (
 		},
 		config.HelpKV{
 			Key:         target.WebhookClientCert,
-			Description: "client cert for Webhook mTLS auth",
+			Description: "client cert for Webhook mTLS auth, path or inline PEM",
 			Optional:    true,
 			Type:        "string",
 			Sensitive:   true,
 		},
 		config.HelpKV{
 			Key:         target.WebhookClientKey,
-			Description: "client cert key for Webhook mTLS auth",
+			Description: "client cert key for Webhook mTLS auth, path or inline PEM",
 			Optional:    true,
 			Type:        "string",
 			Sensitive:   true,
 		},
+		config.HelpKV{
+			Key:         target.WebhookCertAuthority,
+			Description: "CA certificates to verify the Webhook endpoint, path or inline PEM",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.WebhookHeaders,
+			Description: `static headers sent to the Webhook e.g. "X-Tenant:acme,X-Source:minio"`,
+			Optional:    true,
+			Type:        "csv",
+		},
 	}
 
 	HelpAMQP = config.HelpKVS{
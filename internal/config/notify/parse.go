@@ -1338,6 +1338,14 @@ var (
 			Key:   target.WebhookClientKey,
 			Value: "",
 		},
+		config.KV{
+			Key:   target.WebhookCertAuthority,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.WebhookHeaders,
+			Value: "",
+		},
 	}
 )
 
@@ -1392,15 +1400,31 @@ func GetNotifyWebhook(webhookKVS map[string]config.KVS, transport *http.Transpor
 			clientKeyEnv = clientKeyEnv + config.Default + k
 		}
 
+		certAuthorityEnv := target.EnvWebhookCertAuthority
+		if k != config.Default {
+			certAuthorityEnv = certAuthorityEnv + config.Default + k
+		}
+
+		headersEnv := target.EnvWebhookHeaders
+		if k != config.Default {
+			headersEnv = headersEnv + config.Default + k
+		}
+		headers, err := config.ParseHeaders(env.Get(headersEnv, kv.Get(target.WebhookHeaders)))
+		if err != nil {
+			return nil, err
+		}
+
 		webhookArgs := target.WebhookArgs{
-			Enable:     enabled,
-			Endpoint:   *url,
-			Transport:  transport,
-			AuthToken:  env.Get(authEnv, kv.Get(target.WebhookAuthToken)),
-			QueueDir:   env.Get(queueDirEnv, kv.Get(target.WebhookQueueDir)),
-			QueueLimit: uint64(queueLimit),
-			ClientCert: env.Get(clientCertEnv, kv.Get(target.WebhookClientCert)),
-			ClientKey:  env.Get(clientKeyEnv, kv.Get(target.WebhookClientKey)),
+			Enable:        enabled,
+			Endpoint:      *url,
+			Transport:     transport,
+			AuthToken:     env.Get(authEnv, kv.Get(target.WebhookAuthToken)),
+			QueueDir:      env.Get(queueDirEnv, kv.Get(target.WebhookQueueDir)),
+			QueueLimit:    uint64(queueLimit),
+			ClientCert:    env.Get(clientCertEnv, kv.Get(target.WebhookClientCert)),
+			ClientKey:     env.Get(clientKeyEnv, kv.Get(target.WebhookClientKey)),
+			CertAuthority: env.Get(certAuthorityEnv, kv.Get(target.WebhookCertAuthority)),
+			Headers:       headers,
 		}
 		if err = webhookArgs.Validate(); err != nil {
 			return nil, err
@@ -32,5 +32,17 @@ var (
 			Optional:    true,
 			Type:        "default|slowest|slow|fast|fastest",
 		},
+		config.HelpKV{
+			Key:         MaxDrivesInParallel,
+			Description: `maximum number of drives scanned in parallel on a node, 0 for no limit` + defaultHelpPostfix(MaxDrivesInParallel),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         DriveLatencyThreshold,
+			Description: `back off scanning drives with a last minute latency above this value, 0s to disable` + defaultHelpPostfix(DriveLatencyThreshold),
+			Optional:    true,
+			Type:        "duration",
+		},
 	}
 )
@@ -31,6 +31,11 @@ const (
 	Speed    = "speed"
 	EnvSpeed = "MINIO_SCANNER_SPEED"
 
+	MaxDrivesInParallel      = "max_drives_in_parallel"
+	EnvMaxDrivesInParallel   = "MINIO_SCANNER_MAX_DRIVES_IN_PARALLEL"
+	DriveLatencyThreshold    = "drive_latency_threshold"
+	EnvDriveLatencyThreshold = "MINIO_SCANNER_DRIVE_LATENCY_THRESHOLD"
+
 	// All below are deprecated in October 2022 and
 	// replaced them with a single speed parameter
 	Delay            = "delay"
@@ -51,6 +56,15 @@ type Config struct {
 	MaxWait time.Duration
 	// Cycle is the time.Duration between each scanner cycles
 	Cycle time.Duration
+	// Speed is the speed preset the delay, max wait and cycle
+	// come from, empty when they are set with the deprecated keys.
+	Speed string
+	// MaxDrivesInParallel is the maximum number of drives scanned
+	// in parallel on a node, 0 means no limit.
+	MaxDrivesInParallel int
+	// DriveLatencyThreshold is the drive latency above which scanning
+	// of a drive is backed off, 0 disables backing off.
+	DriveLatencyThreshold time.Duration
 }
 
 // DefaultKVS - default KV config for heal settings
@@ -59,6 +73,14 @@ var DefaultKVS = config.KVS{
 		Key:   Speed,
 		Value: "default",
 	},
+	config.KV{
+		Key:   MaxDrivesInParallel,
+		Value: "0",
+	},
+	config.KV{
+		Key:   DriveLatencyThreshold,
+		Value: "0s",
+	},
 	// Deprecated Oct 2022
 	config.KV{
 		Key:        Delay,
@@ -87,24 +109,39 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 
 	// Stick to loading deprecated config/env if they are already set
 	if kvs.Get(Delay) != "" && kvs.Get(MaxWait) != "" && kvs.Get(Cycle) != "" {
-		return lookupDeprecatedScannerConfig(kvs)
+		cfg, err = lookupDeprecatedScannerConfig(kvs)
+	} else {
+		switch speed := env.Get(EnvSpeed, kvs.GetWithDefault(Speed, DefaultKVS)); speed {
+		case "fastest":
+			cfg.Delay, cfg.MaxWait, cfg.Cycle = 0, 0, 0
+		case "fast":
+			cfg.Delay, cfg.MaxWait, cfg.Cycle = 1, 100*time.Millisecond, time.Minute
+		case "default":
+			cfg.Delay, cfg.MaxWait, cfg.Cycle = 2, 5*time.Second, time.Minute
+		case "slow":
+			cfg.Delay, cfg.MaxWait, cfg.Cycle = 10, 15*time.Second, time.Minute
+		case "slowest":
+			cfg.Delay, cfg.MaxWait, cfg.Cycle = 100, 15*time.Second, 30*time.Minute
+		default:
+			return cfg, fmt.Errorf("unknown '%s' value", speed)
+		}
+		cfg.Speed = speed
+	}
+	if err != nil {
+		return cfg, err
 	}
 
-	switch speed := env.Get(EnvSpeed, kvs.GetWithDefault(Speed, DefaultKVS)); speed {
-	case "fastest":
-		cfg.Delay, cfg.MaxWait, cfg.Cycle = 0, 0, 0
-	case "fast":
-		cfg.Delay, cfg.MaxWait, cfg.Cycle = 1, 100*time.Millisecond, time.Minute
-	case "default":
-		cfg.Delay, cfg.MaxWait, cfg.Cycle = 2, 5*time.Second, time.Minute
-	case "slow":
-		cfg.Delay, cfg.MaxWait, cfg.Cycle = 10, 15*time.Second, time.Minute
-	case "slowest":
-		cfg.Delay, cfg.MaxWait, cfg.Cycle = 100, 15*time.Second, 30*time.Minute
-	default:
-		return cfg, fmt.Errorf("unknown '%s' value", speed)
+	maxDrives := env.Get(EnvMaxDrivesInParallel, kvs.GetWithDefault(MaxDrivesInParallel, DefaultKVS))
+	cfg.MaxDrivesInParallel, err = strconv.Atoi(maxDrives)
+	if err != nil || cfg.MaxDrivesInParallel < 0 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", MaxDrivesInParallel, maxDrives)
 	}
-	return
+	threshold := env.Get(EnvDriveLatencyThreshold, kvs.GetWithDefault(DriveLatencyThreshold, DefaultKVS))
+	cfg.DriveLatencyThreshold, err = time.ParseDuration(threshold)
+	if err != nil || cfg.DriveLatencyThreshold < 0 {
+		return cfg, fmt.Errorf("invalid %s value '%s'", DriveLatencyThreshold, threshold)
+	}
+	return cfg, nil
 }
 
 func lookupDeprecatedScannerConfig(kvs config.KVS) (cfg Config, err error) {
@@ -38,6 +38,18 @@ var (
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         ClassStandardPools,
+			Description: `comma separated per pool parity overrides for standard storage class e.g. "EC:4,EC:2"`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         ClassRRSPools,
+			Description: `comma separated per pool parity overrides for reduced redundancy storage class e.g. "EC:2,EC:1"`,
+			Optional:    true,
+			Type:        "csv",
+		},
 		config.HelpKV{
 			Key:         config.Comment,
 			Description: config.DefaultComment,
@@ -38,13 +38,19 @@ const (
 
 // Standard constats for config info storage class
 const (
-	ClassStandard = "standard"
-	ClassRRS      = "rrs"
+	ClassStandard      = "standard"
+	ClassRRS           = "rrs"
+	ClassStandardPools = "standard_pools"
+	ClassRRSPools      = "rrs_pools"
 
 	// Reduced redundancy storage class environment variable
 	RRSEnv = "MINIO_STORAGE_CLASS_RRS"
 	// Standard storage class environment variable
 	StandardEnv = "MINIO_STORAGE_CLASS_STANDARD"
+	// Per pool reduced redundancy storage class environment variable
+	RRSPoolsEnv = "MINIO_STORAGE_CLASS_RRS_POOLS"
+	// Per pool standard storage class environment variable
+	StandardPoolsEnv = "MINIO_STORAGE_CLASS_STANDARD_POOLS"
 
 	// Supported storage class scheme is EC
 	schemePrefix = "EC"
@@ -67,6 +73,14 @@ var (
 			Key:   ClassRRS,
 			Value: "EC:1",
 		},
+		config.KV{
+			Key:   ClassStandardPools,
+			Value: "",
+		},
+		config.KV{
+			Key:   ClassRRSPools,
+			Value: "",
+		},
 	}
 )
 
@@ -82,6 +96,11 @@ var ConfigLock sync.RWMutex
 type Config struct {
 	Standard StorageClass `json:"standard"`
 	RRS      StorageClass `json:"rrs"`
+
+	// Per pool overrides keyed by pool index, pools
+	// without an override use the values above.
+	StandardPools map[int]StorageClass `json:"standardPools,omitempty"`
+	RRSPools      map[int]StorageClass `json:"rrsPools,omitempty"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -162,6 +181,31 @@ func parseStorageClass(storageClassEnv string) (sc StorageClass, err error) {
 	}, nil
 }
 
+// Parses the comma separated per pool storage classes, the position of
+// each entry is the pool index and empty entries keep the global value.
+func parsePoolStorageClasses(poolsEnv string, poolCount int) (map[int]StorageClass, error) {
+	if strings.TrimSpace(poolsEnv) == "" {
+		return nil, nil
+	}
+	entries := strings.Split(poolsEnv, ",")
+	if len(entries) > poolCount {
+		return nil, config.ErrStorageClassValue(nil).Msg(fmt.Sprintf("%d pool overrides provided for %d pools in %s", len(entries), poolCount, poolsEnv))
+	}
+	scs := make(map[int]StorageClass, len(entries))
+	for idx, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		sc, err := parseStorageClass(entry)
+		if err != nil {
+			return nil, err
+		}
+		scs[idx] = sc
+	}
+	return scs, nil
+}
+
 // ValidateParity validate standard storage class parity.
 func ValidateParity(ssParity, setDriveCount int) error {
 	// SS parity disks should be greater than or equal to minParityDisks.
@@ -236,12 +280,34 @@ func (sCfg Config) GetParityForSC(sc string) (parity int) {
 	}
 }
 
+// GetParityForPoolSC - Returns the parity drive count based on storage
+// class for the pool at poolIdx, per pool overrides take precedence
+// over the values returned by GetParityForSC.
+func (sCfg Config) GetParityForPoolSC(poolIdx int, sc string) (parity int) {
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	switch strings.TrimSpace(sc) {
+	case RRS:
+		if s, ok := sCfg.RRSPools[poolIdx]; ok {
+			return s.Parity
+		}
+		return sCfg.RRS.Parity
+	default:
+		if s, ok := sCfg.StandardPools[poolIdx]; ok {
+			return s.Parity
+		}
+		return sCfg.Standard.Parity
+	}
+}
+
 // Update update storage-class with new config
 func (sCfg *Config) Update(newCfg Config) {
 	ConfigLock.Lock()
 	defer ConfigLock.Unlock()
 	sCfg.RRS = newCfg.RRS
 	sCfg.Standard = newCfg.Standard
+	sCfg.RRSPools = newCfg.RRSPools
+	sCfg.StandardPools = newCfg.StandardPools
 }
 
 // Enabled returns if etcd is enabled.
@@ -269,6 +335,23 @@ func DefaultParityBlocks(drive int) int {
 
 // LookupConfig - lookup storage class config and override with valid environment settings if any.
 func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
+	cfg, err = lookupConfig(kvs, setDriveCount)
+	if err != nil {
+		return Config{}, err
+	}
+
+	// Validation is done after parsing both the storage classes. This is needed because we need one
+	// storage class value to deduce the correct value of the other storage class.
+	if err = validateParity(cfg.Standard.Parity, cfg.RRS.Parity, setDriveCount); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// lookupConfig - parses the storage class config without validating
+// the parity against setDriveCount.
+func lookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 	cfg = Config{}
 
 	kvs.Delete("dma")
@@ -301,11 +384,46 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 		}
 	}
 
-	// Validation is done after parsing both the storage classes. This is needed because we need one
-	// storage class value to deduce the correct value of the other storage class.
-	if err = validateParity(cfg.Standard.Parity, cfg.RRS.Parity, setDriveCount); err != nil {
+	return cfg, nil
+}
+
+// LookupPoolsConfig - lookup storage class config including the per pool
+// overrides, validating the effective parity of every pool against its
+// set drive count. setDriveCounts is indexed by pool.
+func LookupPoolsConfig(kvs config.KVS, setDriveCounts []int) (cfg Config, err error) {
+	if len(setDriveCounts) == 0 {
+		return Config{}, config.ErrStorageClassValue(nil).Msg("no pools provided")
+	}
+
+	// Defaults are derived from the last pool.
+	cfg, err = lookupConfig(kvs, setDriveCounts[len(setDriveCounts)-1])
+	if err != nil {
+		return Config{}, err
+	}
+
+	cfg.StandardPools, err = parsePoolStorageClasses(env.Get(StandardPoolsEnv, kvs.Get(ClassStandardPools)), len(setDriveCounts))
+	if err != nil {
 		return Config{}, err
 	}
+	cfg.RRSPools, err = parsePoolStorageClasses(env.Get(RRSPoolsEnv, kvs.Get(ClassRRSPools)), len(setDriveCounts))
+	if err != nil {
+		return Config{}, err
+	}
+
+	for poolIdx, setDriveCount := range setDriveCounts {
+		// Validate against the defaults this pool would derive on its own.
+		poolCfg, err := lookupConfig(kvs, setDriveCount)
+		if err != nil {
+			return Config{}, err
+		}
+		poolCfg.StandardPools = cfg.StandardPools
+		poolCfg.RRSPools = cfg.RRSPools
+		ssParity := poolCfg.GetParityForPoolSC(poolIdx, STANDARD)
+		rrsParity := poolCfg.GetParityForPoolSC(poolIdx, RRS)
+		if err = validateParity(ssParity, rrsParity, setDriveCount); err != nil {
+			return Config{}, fmt.Errorf("pool %d: %w", poolIdx, err)
+		}
+	}
 
 	return cfg, nil
 }
@@ -187,3 +187,48 @@ func TestIsValidStorageClassKind(t *testing.T) {
 		}
 	}
 }
+
+func TestLookupPoolsConfig(t *testing.T) {
+	tests := []struct {
+		standard       string
+		standardPools  string
+		rrsPools       string
+		setDriveCounts []int
+		success        bool
+		expectedSS     []int
+		expectedRRS    []int
+	}{
+		{"", "", "", []int{16, 16}, true, []int{4, 4}, []int{1, 1}},
+		{"EC:4", "EC:2", "", []int{6, 16}, true, []int{2, 4}, []int{1, 1}},
+		{"EC:4", ",EC:6", "EC:2", []int{16, 16}, true, []int{4, 6}, []int{2, 1}},
+		{"EC:4", "", "", []int{6, 16}, false, nil, nil},
+		{"", "EC:9", "", []int{16, 16}, false, nil, nil},
+		{"", "EC:2,EC:2,EC:2", "", []int{16, 16}, false, nil, nil},
+		{"", "EC:2", "", []int{}, false, nil, nil},
+		{"", "EC:4", "EC:6", []int{16}, false, nil, nil},
+		{"", "INVALID", "", []int{16}, false, nil, nil},
+	}
+	for i, tt := range tests {
+		kvs := DefaultKVS.Clone()
+		kvs.Set(ClassStandard, tt.standard)
+		kvs.Set(ClassStandardPools, tt.standardPools)
+		kvs.Set(ClassRRSPools, tt.rrsPools)
+		cfg, err := LookupPoolsConfig(kvs, tt.setDriveCounts)
+		if err != nil && tt.success {
+			t.Errorf("Test %d, Expected success, got %s", i+1, err)
+			continue
+		}
+		if err == nil && !tt.success {
+			t.Errorf("Test %d, Expected failure, got success", i+1)
+			continue
+		}
+		for poolIdx := range tt.expectedSS {
+			if got := cfg.GetParityForPoolSC(poolIdx, STANDARD); got != tt.expectedSS[poolIdx] {
+				t.Errorf("Test %d, pool %d, Expected standard parity %d, got %d", i+1, poolIdx, tt.expectedSS[poolIdx], got)
+			}
+			if got := cfg.GetParityForPoolSC(poolIdx, RRS); got != tt.expectedRRS[poolIdx] {
+				t.Errorf("Test %d, pool %d, Expected RRS parity %d, got %d", i+1, poolIdx, tt.expectedRRS[poolIdx], got)
+			}
+		}
+	}
+}
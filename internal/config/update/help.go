@@ -0,0 +1,30 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package update
+
+import "github.com/minio/minio/internal/config"
+
+// Help provides help for config values
+var Help = config.HelpKVS{
+	config.HelpKV{
+		Key:         URL,
+		Description: `release info URL used to check for updates, e.g. an internal mirror of "https://dl.min.io/server/minio/release/linux-amd64/minio.sha256sum"`,
+		Optional:    true,
+		Type:        "url",
+	},
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package update
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/pkg/env"
+)
+
+// Update checker environment variables
+const (
+	URL    = "url"
+	EnvURL = "MINIO_UPDATE_URL"
+)
+
+// Config represents the update checker settings.
+type Config struct {
+	// URL is the release info used to check for a newer release and
+	// by server updates without an explicit URL, empty for the
+	// official release info.
+	URL string `json:"url"`
+}
+
+// DefaultKVS - default KV config for update checker settings
+var DefaultKVS = config.KVS{
+	config.KV{
+		Key:   URL,
+		Value: "",
+	},
+}
+
+// LookupConfig - lookup config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.UpdateSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	cfg.URL = env.Get(EnvURL, kvs.GetWithDefault(URL, DefaultKVS))
+	if cfg.URL != "" {
+		u, err := url.Parse(cfg.URL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return cfg, fmt.Errorf("invalid %s value '%s'", URL, cfg.URL)
+		}
+	}
+	return cfg, nil
+}
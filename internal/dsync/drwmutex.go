@@ -25,6 +25,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/minio/minio/internal/mcontext"
@@ -75,6 +76,11 @@ const (
 	// dRWMutexRefreshInterval - default the interval between two refresh calls
 	drwMutexRefreshInterval = 10 * time.Second
 
+	// drwMutexRefreshMaxFailures - number of consecutive refreshes not
+	// acknowledged by a quorum of lockers after which the lock is
+	// considered lost, lockers expire locks not refreshed for a minute.
+	drwMutexRefreshMaxFailures = 3
+
 	drwMutexInfinite = 1<<63 - 1
 )
 
@@ -111,6 +117,7 @@ type DRWMutex struct {
 	clnt              *Dsync
 	cancelRefresh     context.CancelFunc
 	refreshInterval   time.Duration
+	refreshFailures   int
 	lockRetryInterval time.Duration
 }
 
@@ -139,6 +146,7 @@ func NewDRWMutex(clnt *Dsync, names ...string) *DRWMutex {
 		clnt:              clnt,
 		rng:               rand.New(&lockedRandSource{src: rand.NewSource(time.Now().UTC().UnixNano())}),
 		refreshInterval:   drwMutexRefreshInterval,
+		refreshFailures:   drwMutexRefreshMaxFailures,
 		lockRetryInterval: lockRetryInterval,
 	}
 }
@@ -273,16 +281,36 @@ func (dm *DRWMutex) startContinousLockRefresh(lockLossCallback func(), id, sourc
 	go func() {
 		defer cancel()
 
+		atomic.AddInt64(&globalRefreshStats.active, 1)
+		defer atomic.AddInt64(&globalRefreshStats.active, -1)
+
 		refreshTimer := time.NewTimer(dm.refreshInterval)
 		defer refreshTimer.Stop()
 
+		failures := 0
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-refreshTimer.C:
-				noQuorum, err := refreshLock(ctx, dm.clnt, id, source, quorum)
-				if err == nil && noQuorum {
+				refreshed, noQuorum, err := refreshLock(ctx, dm.clnt, id, source, quorum)
+				if err != nil {
+					// Refreshing is canceled by the unlock.
+					return
+				}
+				if refreshed {
+					atomic.AddUint64(&globalRefreshStats.successes, 1)
+					failures = 0
+				} else {
+					atomic.AddUint64(&globalRefreshStats.failures, 1)
+					failures++
+				}
+
+				// The lock is lost when quorum lockers don't hold it anymore,
+				// or when they are unreachable long enough to expire it.
+				if noQuorum || failures >= dm.refreshFailures {
+					atomic.AddUint64(&globalRefreshStats.lost, 1)
+					log("dsync: lock %#v lost after %d failed refreshes\n", dm.Names, failures)
 					// Clean the lock locally and in remote nodes
 					forceUnlock(ctx, dm.clnt, id)
 					// Execute the caller lock loss callback
@@ -325,9 +353,10 @@ type refreshResult struct {
 	refreshed bool
 }
 
-// Refresh the given lock in all nodes, return true to indicate if a lock
-// does not exist in enough quorum nodes.
-func refreshLock(ctx context.Context, ds *Dsync, id, source string, quorum int) (bool, error) {
+// Refresh the given lock in all nodes, return whether quorum nodes
+// refreshed the lock and whether the lock does not exist in enough
+// quorum nodes.
+func refreshLock(ctx context.Context, ds *Dsync, id, source string, quorum int) (refreshed, noQuorum bool, err error) {
 	restClnts, _ := ds.GetLockers()
 
 	// Create buffered channel of size equal to total number of nodes.
@@ -352,7 +381,11 @@ func refreshLock(ctx context.Context, ds *Dsync, id, source string, quorum int)
 			ctx, cancel := context.WithTimeout(ctx, ds.Timeouts.RefreshCall)
 			defer cancel()
 
+			start := time.Now()
 			refreshed, err := c.Refresh(ctx, args)
+			if err == nil {
+				globalRefreshStats.setLatency(c.String(), time.Since(start))
+			}
 			if err != nil {
 				ch <- refreshResult{offline: true}
 				log("dsync: Unable to call Refresh failed with %s for %#v at %s\n", err, args, c)
@@ -388,7 +421,7 @@ func refreshLock(ctx context.Context, ds *Dsync, id, source string, quorum int)
 			}
 		case <-ctx.Done():
 			// Refreshing is canceled
-			return false, ctx.Err()
+			return false, false, ctx.Err()
 		}
 		if done {
 			break
@@ -403,8 +436,7 @@ func refreshLock(ctx context.Context, ds *Dsync, id, source string, quorum int)
 		}
 	}()
 
-	noQuorum := lockNotFound > len(restClnts)-quorum
-	return noQuorum, nil
+	return lockRefreshed >= quorum, lockNotFound > len(restClnts)-quorum, nil
 }
 
 // lock tries to acquire the distributed lock, returning true or false.
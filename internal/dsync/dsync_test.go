@@ -270,6 +270,61 @@ func TestFailedRefreshLock(t *testing.T) {
 	dm.Unlock(context.Background())
 }
 
+// unackedRefreshLocker is a locker which stops acknowledging refreshes,
+// like a locker behind a network partition.
+type unackedRefreshLocker struct {
+	NetLocker
+}
+
+func (l *unackedRefreshLocker) Refresh(ctx context.Context, args LockArgs) (bool, error) {
+	return false, context.DeadlineExceeded
+}
+
+// Test canceling context while all lockers stop acknowledging refreshes
+func TestUnackedRefreshLock(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	clnts, _ := ds.GetLockers()
+	unacked := make([]NetLocker, len(clnts))
+	for i, c := range clnts {
+		unacked[i] = &unackedRefreshLocker{c}
+	}
+	partitioned := &Dsync{
+		GetLockers: func() ([]NetLocker, string) { return unacked, uuid.New().String() },
+		Timeouts:   ds.Timeouts,
+	}
+
+	before := GetRefreshStats()
+
+	dm := NewDRWMutex(partitioned, "aap")
+	dm.refreshInterval = testDrwMutexRefreshInterval
+	ctx, cancel := context.WithCancel(context.Background())
+	if !dm.GetLock(ctx, cancel, id, source, Options{Timeout: 5 * time.Minute}) {
+		t.Fatal("GetLock() should be successful")
+	}
+
+	timer := time.NewTimer(testDrwMutexRefreshInterval * time.Duration(drwMutexRefreshMaxFailures+5))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+		t.Fatal("Lock context should be canceled once refreshes permanently fail")
+	}
+
+	stats := GetRefreshStats()
+	if stats.Failures-before.Failures < drwMutexRefreshMaxFailures {
+		t.Fatalf("expected at least %d refresh failures, got %d", drwMutexRefreshMaxFailures, stats.Failures-before.Failures)
+	}
+	if stats.Lost-before.Lost != 1 {
+		t.Fatalf("expected 1 lost lock, got %d", stats.Lost-before.Lost)
+	}
+
+	// Should be safe operation in all cases
+	dm.Unlock(context.Background())
+}
+
 // Test Unlock should not timeout
 func TestUnlockShouldNotTimeout(t *testing.T) {
 	if testing.Short() {
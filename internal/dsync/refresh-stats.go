@@ -0,0 +1,80 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dsync
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RefreshStats is the health of the refresh of the locks held by this
+// node, the locks are lost once their refresh fails for too long.
+type RefreshStats struct {
+	// Active is the number of locks being refreshed.
+	Active int64
+	// Successes is the number of refreshes acknowledged by a quorum
+	// of lockers.
+	Successes uint64
+	// Failures is the number of refreshes not acknowledged by a quorum
+	// of lockers.
+	Failures uint64
+	// Lost is the number of locks lost after failed refreshes, the
+	// operations holding them were canceled.
+	Lost uint64
+	// Latency is the round trip of the last refresh call acknowledged
+	// by each locker endpoint.
+	Latency map[string]time.Duration
+}
+
+type refreshStats struct {
+	active    int64
+	successes uint64
+	failures  uint64
+	lost      uint64
+
+	mu      sync.Mutex
+	latency map[string]time.Duration
+}
+
+var globalRefreshStats = &refreshStats{latency: make(map[string]time.Duration)}
+
+func (s *refreshStats) setLatency(endpoint string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency[endpoint] = d
+}
+
+// GetRefreshStats returns the health of the refresh of the locks held
+// by this node since it started.
+func GetRefreshStats() RefreshStats {
+	s := globalRefreshStats
+	stats := RefreshStats{
+		Active:    atomic.LoadInt64(&s.active),
+		Successes: atomic.LoadUint64(&s.successes),
+		Failures:  atomic.LoadUint64(&s.failures),
+		Lost:      atomic.LoadUint64(&s.lost),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats.Latency = make(map[string]time.Duration, len(s.latency))
+	for endpoint, d := range s.latency {
+		stats.Latency[endpoint] = d
+	}
+	return stats
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package event
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DeliveryWindow is the rolling window over which the delivery
+	// success rate of a target is computed.
+	DeliveryWindow = 5 * time.Minute
+
+	deliverySlot  = time.Minute
+	deliverySlots = int(DeliveryWindow / deliverySlot)
+)
+
+type deliverySlotCounts struct {
+	start     int64 // start of the slot, in unix minutes
+	successes uint64
+	failures  uint64
+}
+
+// deliveryStats counts the delivery attempts of a target during the
+// last DeliveryWindow, in one minute slots.
+type deliveryStats struct {
+	slots [deliverySlots]deliverySlotCounts
+}
+
+func (d *deliveryStats) record(now time.Time, err error) {
+	start := now.Unix() / int64(deliverySlot/time.Second)
+	slot := &d.slots[start%int64(deliverySlots)]
+	if slot.start != start {
+		*slot = deliverySlotCounts{start: start}
+	}
+	if err != nil {
+		slot.failures++
+	} else {
+		slot.successes++
+	}
+}
+
+func (d *deliveryStats) successRate(now time.Time) float64 {
+	start := now.Unix() / int64(deliverySlot/time.Second)
+	var successes, failures uint64
+	for _, slot := range d.slots {
+		if start-slot.start < int64(deliverySlots) {
+			successes += slot.successes
+			failures += slot.failures
+		}
+	}
+	if successes+failures == 0 {
+		return 1
+	}
+	return float64(successes) / float64(successes+failures)
+}
+
+var globalDeliveryStats = struct {
+	sync.Mutex
+	targets map[TargetID]*deliveryStats
+}{targets: make(map[TargetID]*deliveryStats)}
+
+// RecordDelivery records the outcome of an attempt to deliver an event
+// to the target id, err is nil if the event was delivered.
+func RecordDelivery(id TargetID, err error) {
+	globalDeliveryStats.Lock()
+	defer globalDeliveryStats.Unlock()
+
+	d, ok := globalDeliveryStats.targets[id]
+	if !ok {
+		d = &deliveryStats{}
+		globalDeliveryStats.targets[id] = d
+	}
+	d.record(time.Now(), err)
+}
+
+// deliverySuccessRate returns the ratio of the attempts to deliver an
+// event to the target id which succeeded during the last DeliveryWindow,
+// 1 if no event was sent.
+func deliverySuccessRate(id TargetID) float64 {
+	globalDeliveryStats.Lock()
+	defer globalDeliveryStats.Unlock()
+
+	d, ok := globalDeliveryStats.targets[id]
+	if !ok {
+		return 1
+	}
+	return d.successRate(time.Now())
+}
+
+func removeDeliveryStats(id TargetID) {
+	globalDeliveryStats.Lock()
+	defer globalDeliveryStats.Unlock()
+
+	delete(globalDeliveryStats.targets, id)
+}
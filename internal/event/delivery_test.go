@@ -0,0 +1,52 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package event
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDeliveryStats(t *testing.T) {
+	d := &deliveryStats{}
+	now := time.Unix(1700000000, 0)
+	if rate := d.successRate(now); rate != 1 {
+		t.Fatalf("expected a success rate of 1 without deliveries, got %v", rate)
+	}
+
+	errSend := errors.New("send error")
+	d.record(now, nil)
+	d.record(now, errSend)
+	d.record(now.Add(time.Minute), nil)
+	d.record(now.Add(time.Minute), nil)
+	if rate := d.successRate(now.Add(time.Minute)); rate != 0.75 {
+		t.Fatalf("expected a success rate of 0.75, got %v", rate)
+	}
+
+	// The deliveries of the first minute left the window.
+	if rate := d.successRate(now.Add(DeliveryWindow)); rate != 1 {
+		t.Fatalf("expected a success rate of 1, got %v", rate)
+	}
+
+	// A slot is reused once its deliveries left the window.
+	d.record(now.Add(DeliveryWindow), errSend)
+	if rate := d.successRate(now.Add(DeliveryWindow)); rate != 2.0/3 {
+		t.Fatalf("expected a success rate of 2/3, got %v", rate)
+	}
+}
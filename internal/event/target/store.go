@@ -105,6 +105,7 @@ func sendEvents(target event.Target, eventKeyCh <-chan string, doneCh <-chan str
 	send := func(eventKey string) bool {
 		for {
 			err := target.Send(eventKey)
+			event.RecordDelivery(target.ID(), err)
 			if err == nil {
 				break
 			}
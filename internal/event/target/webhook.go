@@ -33,6 +33,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/minio/minio/internal/config"
 	"github.com/minio/minio/internal/event"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/pkg/certs"
@@ -48,6 +49,9 @@ const (
 	WebhookClientCert = "client_cert"
 	WebhookClientKey  = "client_key"
 
+	WebhookCertAuthority = "cert_authority"
+	WebhookHeaders       = "headers"
+
 	EnvWebhookEnable     = "MINIO_NOTIFY_WEBHOOK_ENABLE"
 	EnvWebhookEndpoint   = "MINIO_NOTIFY_WEBHOOK_ENDPOINT"
 	EnvWebhookAuthToken  = "MINIO_NOTIFY_WEBHOOK_AUTH_TOKEN"
@@ -55,18 +59,23 @@ const (
 	EnvWebhookQueueLimit = "MINIO_NOTIFY_WEBHOOK_QUEUE_LIMIT"
 	EnvWebhookClientCert = "MINIO_NOTIFY_WEBHOOK_CLIENT_CERT"
 	EnvWebhookClientKey  = "MINIO_NOTIFY_WEBHOOK_CLIENT_KEY"
+
+	EnvWebhookCertAuthority = "MINIO_NOTIFY_WEBHOOK_CERT_AUTHORITY"
+	EnvWebhookHeaders       = "MINIO_NOTIFY_WEBHOOK_HEADERS"
 )
 
 // WebhookArgs - Webhook target arguments.
 type WebhookArgs struct {
-	Enable     bool            `json:"enable"`
-	Endpoint   xnet.URL        `json:"endpoint"`
-	AuthToken  string          `json:"authToken"`
-	Transport  *http.Transport `json:"-"`
-	QueueDir   string          `json:"queueDir"`
-	QueueLimit uint64          `json:"queueLimit"`
-	ClientCert string          `json:"clientCert"`
-	ClientKey  string          `json:"clientKey"`
+	Enable        bool            `json:"enable"`
+	Endpoint      xnet.URL        `json:"endpoint"`
+	AuthToken     string          `json:"authToken"`
+	Transport     *http.Transport `json:"-"`
+	QueueDir      string          `json:"queueDir"`
+	QueueLimit    uint64          `json:"queueLimit"`
+	ClientCert    string          `json:"clientCert"`
+	ClientKey     string          `json:"clientKey"`
+	CertAuthority string          `json:"certAuthority"`
+	Headers       http.Header     `json:"headers"`
 }
 
 // Validate WebhookArgs fields
@@ -85,6 +94,16 @@ func (w WebhookArgs) Validate() error {
 	if w.ClientCert != "" && w.ClientKey == "" || w.ClientCert == "" && w.ClientKey != "" {
 		return errors.New("cert and key must be specified as a pair")
 	}
+	if w.ClientCert != "" {
+		if _, err := config.LoadClientCertificate(w.ClientCert, w.ClientKey); err != nil {
+			return err
+		}
+	}
+	if w.CertAuthority != "" {
+		if _, err := config.LoadCertPool(w.CertAuthority); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -131,13 +150,7 @@ func (target *WebhookTarget) isActive() (bool, error) {
 		}
 		return false, err
 	}
-	tokens := strings.Fields(target.args.AuthToken)
-	switch len(tokens) {
-	case 2:
-		req.Header.Set("Authorization", target.args.AuthToken)
-	case 1:
-		req.Header.Set("Authorization", "Bearer "+target.args.AuthToken)
-	}
+	target.setHeaders(req)
 
 	resp, err := target.httpClient.Do(req)
 	if err != nil {
@@ -171,6 +184,26 @@ func (target *WebhookTarget) Save(eventData event.Event) error {
 	return err
 }
 
+// setHeaders - sets the static headers of the config and the
+// authorization of a request to the webhook.
+func (target *WebhookTarget) setHeaders(req *http.Request) {
+	for k, v := range target.args.Headers {
+		req.Header[k] = v
+	}
+
+	// Verify if the authToken already contains
+	// <Key> <Token> like format, if this is
+	// already present we can blindly use the
+	// authToken as is instead of adding 'Bearer'
+	tokens := strings.Fields(target.args.AuthToken)
+	switch len(tokens) {
+	case 2:
+		req.Header.Set("Authorization", target.args.AuthToken)
+	case 1:
+		req.Header.Set("Authorization", "Bearer "+target.args.AuthToken)
+	}
+}
+
 // send - sends an event to the webhook.
 func (target *WebhookTarget) send(eventData event.Event) error {
 	objectName, err := url.QueryUnescape(eventData.S3.Object.Key)
@@ -189,18 +222,7 @@ func (target *WebhookTarget) send(eventData event.Event) error {
 		return err
 	}
 
-	// Verify if the authToken already contains
-	// <Key> <Token> like format, if this is
-	// already present we can blindly use the
-	// authToken as is instead of adding 'Bearer'
-	tokens := strings.Fields(target.args.AuthToken)
-	switch len(tokens) {
-	case 2:
-		req.Header.Set("Authorization", target.args.AuthToken)
-	case 1:
-		req.Header.Set("Authorization", "Bearer "+target.args.AuthToken)
-	}
-
+	target.setHeaders(req)
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := target.httpClient.Do(req)
@@ -259,13 +281,35 @@ func (target *WebhookTarget) initWebhook() error {
 	args := target.args
 	transport := target.transport
 
-	if args.ClientCert != "" && args.ClientKey != "" {
-		manager, err := certs.NewManager(context.Background(), args.ClientCert, args.ClientKey, tls.LoadX509KeyPair)
+	if args.CertAuthority != "" || args.ClientCert != "" && args.ClientKey != "" {
+		// The transport is shared by all targets.
+		transport = transport.Clone()
+	}
+	if args.CertAuthority != "" {
+		rootCAs, err := config.LoadCertPool(args.CertAuthority)
 		if err != nil {
 			return err
 		}
-		manager.ReloadOnSignal(syscall.SIGHUP) // allow reloads upon SIGHUP
-		transport.TLSClientConfig.GetClientCertificate = manager.GetClientCertificate
+		transport.TLSClientConfig.RootCAs = rootCAs
+	}
+	if args.ClientCert != "" && args.ClientKey != "" {
+		if err := config.CheckClientCertExpiry(args.ClientCert, args.ClientKey); err != nil {
+			target.loggerOnce(context.Background(), fmt.Errorf("WARNING: webhook target %s: %w", target.id, err), target.id.String())
+		}
+		if config.IsPEM(args.ClientCert) || config.IsPEM(args.ClientKey) {
+			cert, err := config.LoadClientCertificate(args.ClientCert, args.ClientKey)
+			if err != nil {
+				return err
+			}
+			transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+		} else {
+			manager, err := certs.NewManager(context.Background(), args.ClientCert, args.ClientKey, tls.LoadX509KeyPair)
+			if err != nil {
+				return err
+			}
+			manager.ReloadOnSignal(syscall.SIGHUP) // allow reloads upon SIGHUP
+			transport.TLSClientConfig.GetClientCertificate = manager.GetClientCertificate
+		}
 	}
 	target.httpClient = &http.Client{Transport: transport}
 
@@ -56,6 +56,9 @@ type TargetStats struct {
 type TargetStat struct {
 	ID           TargetID
 	CurrentQueue int // Populated if target has a store.
+	// SuccessRate is the ratio of the attempts to deliver an event to
+	// the target which succeeded during the last DeliveryWindow.
+	SuccessRate float64
 }
 
 // TargetList - holds list of targets indexed by target ID.
@@ -110,6 +113,7 @@ func (list *TargetList) Remove(targetIDSet TargetIDSet) {
 		if ok {
 			target.Close()
 			delete(list.targets, id)
+			removeDeliveryStats(id)
 		}
 	}
 }
@@ -178,11 +182,13 @@ func (list *TargetList) Send(event Event, targetIDset TargetIDSet, resCh chan<-
 					atomic.AddInt64(&list.currentSendCalls, 1)
 					defer atomic.AddInt64(&list.currentSendCalls, -1)
 					defer wg.Done()
-					tgtRes := TargetIDResult{ID: id}
-					if err := target.Save(event); err != nil {
-						tgtRes.Err = err
+					err := target.Save(event)
+					if target.Store() == nil {
+						// Targets with a store record the delivery
+						// when the event is sent from the store.
+						RecordDelivery(id, err)
 					}
-					resCh <- tgtRes
+					resCh <- TargetIDResult{ID: id, Err: err}
 				}(id, target)
 			} else {
 				resCh <- TargetIDResult{ID: id}
@@ -203,7 +209,7 @@ func (list *TargetList) Stats() TargetStats {
 	defer list.RUnlock()
 	t.TargetStats = make(map[string]TargetStat, len(list.targets))
 	for id, target := range list.targets {
-		ts := TargetStat{ID: id}
+		ts := TargetStat{ID: id, SuccessRate: deliverySuccessRate(id)}
 		if st := target.Store(); st != nil {
 			ts.CurrentQueue = st.Len()
 		}
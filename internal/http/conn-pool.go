@@ -0,0 +1,217 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// connPoolDrainInterval is the interval at which a replaced transport
+// closes the connections that became idle since.
+var connPoolDrainInterval = time.Second
+
+// ConnPoolStats are the connection counters of a host.
+type ConnPoolStats struct {
+	// Connections dialed and not yet closed.
+	Open int64
+	// Requests in flight, each holding a connection.
+	Active int64
+	// Total dials and failed dials.
+	Dials    uint64
+	DialErrs uint64
+}
+
+// Idle returns the number of open connections not serving a request.
+func (s ConnPoolStats) Idle() int64 {
+	if idle := s.Open - s.Active; idle > 0 {
+		return idle
+	}
+	return 0
+}
+
+type hostConnStats struct {
+	open     atomic.Int64
+	active   atomic.Int64
+	dials    atomic.Uint64
+	dialErrs atomic.Uint64
+}
+
+type poolTransport struct {
+	*http.Transport
+	inflight atomic.Int64
+}
+
+// ConnPool is a http.RoundTripper for internode connections whose
+// transport can be replaced at runtime and which counts the connections
+// and dials per host.
+type ConnPool struct {
+	tr atomic.Pointer[poolTransport]
+
+	mu    sync.Mutex
+	hosts map[string]*hostConnStats
+}
+
+// NewInternodeConnPool returns a ConnPool using an internode transport
+// with the given settings.
+func (s ConnSettings) NewInternodeConnPool() *ConnPool {
+	p := &ConnPool{hosts: make(map[string]*hostConnStats)}
+	p.tr.Store(p.newTransport(s))
+	return p
+}
+
+func (p *ConnPool) newTransport(s ConnSettings) *poolTransport {
+	tr := s.newInternodeTransport()
+	tr.DialContext = p.instrumentDialContext(tr.DialContext)
+	return &poolTransport{Transport: tr}
+}
+
+// Update replaces the transport by one with the given settings. New
+// requests use the new transport, the connections of the previous one
+// are closed as soon as they are idle.
+func (p *ConnPool) Update(s ConnSettings) {
+	old := p.tr.Swap(p.newTransport(s))
+	old.CloseIdleConnections()
+	interval := connPoolDrainInterval
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for old.inflight.Load() > 0 {
+			<-t.C
+			old.CloseIdleConnections()
+		}
+		old.CloseIdleConnections()
+	}()
+}
+
+// Transport returns the current transport.
+func (p *ConnPool) Transport() *http.Transport {
+	return p.tr.Load().Transport
+}
+
+// CloseIdleConnections closes the idle connections of the current transport.
+func (p *ConnPool) CloseIdleConnections() {
+	p.tr.Load().CloseIdleConnections()
+}
+
+// Stats returns the connection counters per host, keyed by host:port.
+func (p *ConnPool) Stats() map[string]ConnPoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make(map[string]ConnPoolStats, len(p.hosts))
+	for host, hs := range p.hosts {
+		stats[host] = ConnPoolStats{
+			Open:     hs.open.Load(),
+			Active:   hs.active.Load(),
+			Dials:    hs.dials.Load(),
+			DialErrs: hs.dialErrs.Load(),
+		}
+	}
+	return stats
+}
+
+func (p *ConnPool) host(addr string) *hostConnStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	hs, ok := p.hosts[addr]
+	if !ok {
+		hs = &hostConnStats{}
+		p.hosts[addr] = hs
+	}
+	return hs
+}
+
+// RoundTrip implements http.RoundTripper.
+func (p *ConnPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	tr := p.tr.Load()
+	hs := p.host(canonicalAddr(req.URL))
+
+	tr.inflight.Add(1)
+	hs.active.Add(1)
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		hs.active.Add(-1)
+		tr.inflight.Add(-1)
+		return nil, err
+	}
+	resp.Body = &connPoolBody{ReadCloser: resp.Body, done: func() {
+		hs.active.Add(-1)
+		tr.inflight.Add(-1)
+	}}
+	return resp, nil
+}
+
+func (p *ConnPool) instrumentDialContext(dial DialContext) DialContext {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		hs := p.host(addr)
+		hs.dials.Add(1)
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			hs.dialErrs.Add(1)
+			return nil, err
+		}
+		hs.open.Add(1)
+		return &connPoolConn{Conn: conn, stats: hs}, nil
+	}
+}
+
+// canonicalAddr returns the host:port the transport dials for u.
+func canonicalAddr(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+type connPoolBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+func (b *connPoolBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
+
+type connPoolConn struct {
+	net.Conn
+	once  sync.Once
+	stats *hostConnStats
+}
+
+func (c *connPoolConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(func() {
+		c.stats.open.Add(-1)
+	})
+	return err
+}
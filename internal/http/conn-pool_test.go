@@ -0,0 +1,115 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package http
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestConnPool(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-release
+		}
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host := u.Host
+
+	p := ConnSettings{DialTimeout: time.Second}.NewInternodeConnPool()
+	client := &http.Client{Transport: p}
+
+	get := func(path string) *http.Response {
+		t.Helper()
+		resp, err := client.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	resp := get("/")
+	if st := p.Stats()[host]; st.Active != 1 || st.Open != 1 || st.Dials != 1 {
+		t.Fatalf("unexpected stats with a request in flight: %+v", st)
+	}
+	DrainBody(resp.Body)
+	if st := p.Stats()[host]; st.Active != 0 || st.Idle() != 1 {
+		t.Fatalf("unexpected stats after the request: %+v", st)
+	}
+
+	// A replaced transport keeps serving the request in flight and
+	// closes its connections once idle.
+	connPoolDrainInterval = 10 * time.Millisecond
+	slow := get("/slow")
+	old := p.Transport()
+	p.Update(ConnSettings{DialTimeout: time.Second, MaxIdleConnsPerHost: 4, MaxConnsPerHost: 8})
+	if p.Transport() == old || p.Transport().MaxConnsPerHost != 8 || p.Transport().MaxIdleConnsPerHost != 4 {
+		t.Fatal("transport not replaced")
+	}
+	DrainBody(get("/").Body)
+	if st := p.Stats()[host]; st.Active != 1 || st.Open != 2 || st.Dials != 2 {
+		t.Fatalf("unexpected stats while draining: %+v", st)
+	}
+	close(release)
+	DrainBody(slow.Body)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for p.Stats()[host].Open != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("previous transport not drained: %+v", p.Stats()[host])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Dial failures are counted.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := l.Addr().String()
+	l.Close()
+	if _, err = client.Get("http://" + closed); err == nil {
+		t.Fatal("expected dial error")
+	}
+	if st := p.Stats()[closed]; st.Dials != 1 || st.DialErrs != 1 || st.Active != 0 {
+		t.Fatalf("unexpected stats after dial error: %+v", st)
+	}
+}
+
+func TestConnPoolStatsIdle(t *testing.T) {
+	if idle := (ConnPoolStats{Open: 3, Active: 1}).Idle(); idle != 2 {
+		t.Errorf("expected 2 idle connections, got %d", idle)
+	}
+	if idle := (ConnPoolStats{Open: 1, Active: 2}).Idle(); idle != 0 {
+		t.Errorf("expected 0 idle connections, got %d", idle)
+	}
+}
@@ -42,6 +42,20 @@ const (
 	Range              = "Range"
 )
 
+// CORS HTTP header constants
+const (
+	Origin                        = "Origin"
+	Vary                          = "Vary"
+	AccessControlRequestMethod    = "Access-Control-Request-Method"
+	AccessControlRequestHeaders   = "Access-Control-Request-Headers"
+	AccessControlAllowOrigin      = "Access-Control-Allow-Origin"
+	AccessControlAllowMethods     = "Access-Control-Allow-Methods"
+	AccessControlAllowHeaders     = "Access-Control-Allow-Headers"
+	AccessControlAllowCredentials = "Access-Control-Allow-Credentials"
+	AccessControlExposeHeaders    = "Access-Control-Expose-Headers"
+	AccessControlMaxAge           = "Access-Control-Max-Age"
+)
+
 // Non standard S3 HTTP response constants
 const (
 	XCache       = "X-Cache"
@@ -174,12 +188,24 @@ const (
 	// Header indicates if the etag should be preserved by client
 	MinIOSourceETag = "x-minio-source-etag"
 
+	// Header indicates the PUT appends its body to the object
+	MinIOAppend = "x-minio-append"
+
+	// Weak ETag of multipart objects, stored when api multipart_weak_etag is enabled
+	MinIOWeakETag = "x-minio-weak-etag"
+
 	// Writes expected write quorum
 	MinIOWriteQuorum = "x-minio-write-quorum"
 
 	// Reports number of drives currently healing
 	MinIOHealingDrives = "x-minio-healing-drives"
 
+	// Header indicates the PUT was not written since it was identical to the latest version
+	MinIOIdenticalOverwriteSkipped = "x-minio-identical-overwrite-skipped"
+
+	// Header indicates the version in a DELETE must still be the latest version
+	MinIODeleteIfLatest = "x-minio-delete-if-latest"
+
 	// Header indicates if the delete marker should be preserved by client
 	MinIOSourceDeleteMarker = "x-minio-source-deletemarker"
 
@@ -212,6 +238,48 @@ const (
 	// MinIOCompressed is returned when object is compressed
 	MinIOCompressed = "X-Minio-Compressed"
 
+	// MinIONoDecompression requests the stored compressed stream of a
+	// compressed object, without server side decompression.
+	MinIONoDecompression = "X-Minio-No-Decompression"
+
+	// MinIOActualSize is the original size of an object returned
+	// compressed.
+	MinIOActualSize = "X-Minio-Actual-Size"
+
+	// MinIODurable requests the object to be synced to stable storage
+	// before PutObject or CompleteMultipartUpload returns.
+	MinIODurable = "X-Minio-Durable"
+
+	// MinIOWriteAllOnline requests PutObject to write the object to all
+	// the online drives of its erasure set, instead of write quorum.
+	MinIOWriteAllOnline = "X-Minio-Write-All-Online"
+
+	// MinIOPreserveClientMetadata requests the metadata headers of a
+	// PutObject, CopyObject or NewMultipartUpload to be stored verbatim,
+	// separately from the metadata the server derives.
+	MinIOPreserveClientMetadata = "X-Minio-Preserve-Client-Metadata"
+
+	// MinIOClientMetadata returns the preserved client metadata of an
+	// object, as base64 encoded JSON.
+	MinIOClientMetadata = "X-Minio-Client-Metadata"
+
+	// MinIOContentHash is a content hash of an object computed by the
+	// client, as <algorithm>=<value>, stored on PutObject or
+	// NewMultipartUpload and returned verbatim on GET and HEAD.
+	MinIOContentHash = "X-Minio-Content-Hash"
+
+	// MinIOListConsistency set to "strong" requests a listing reflecting
+	// all writes completed before it, bypassing the listing caches.
+	MinIOListConsistency = "X-Minio-List-Consistency"
+
+	// MinIOListingNextMarker returns the marker to resume a listing
+	// stopped at its scan limit before finding entries to return.
+	MinIOListingNextMarker = "X-Minio-Listing-Next-Marker"
+
+	// MinIOBucketState returns the administrative state of a read-only
+	// or write-once bucket on HeadBucket.
+	MinIOBucketState = "X-Minio-Bucket-State"
+
 	// SUBNET related
 	SubnetAPIKey = "x-subnet-api-key"
 )
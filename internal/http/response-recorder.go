@@ -18,9 +18,12 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"time"
 )
@@ -126,6 +129,15 @@ func (lrw *ResponseRecorder) Flush() {
 	lrw.ResponseWriter.(http.Flusher).Flush()
 }
 
+// Hijack - Calls the underlying Hijack, for WebSocket upgrades.
+func (lrw *ResponseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := lrw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return hj.Hijack()
+}
+
 // Size - returns  the number of bytes written
 func (lrw *ResponseRecorder) Size() int {
 	return lrw.bytesWritten
@@ -18,7 +18,10 @@
 package stats
 
 import (
+	"bufio"
+	"errors"
 	"io"
+	"net"
 	"net/http"
 )
 
@@ -60,6 +63,15 @@ func (w *OutgoingTrafficMeter) Flush() {
 	w.ResponseWriter.(http.Flusher).Flush()
 }
 
+// Hijack calls the underlying Hijack, for WebSocket upgrades.
+func (w *OutgoingTrafficMeter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return hj.Hijack()
+}
+
 // BytesWritten returns the number of transferred bytes
 func (w *OutgoingTrafficMeter) BytesWritten() int64 {
 	return w.countBytes
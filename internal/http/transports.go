@@ -49,6 +49,12 @@ type ConnSettings struct {
 
 	// HTTP2
 	EnableHTTP2 bool
+
+	// Connection pool settings, defaults are used if zero.
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	TLSHandshakeTimeout time.Duration
 }
 
 func (s ConnSettings) getDefaultTransport() *http.Transport {
@@ -78,6 +84,7 @@ func (s ConnSettings) getDefaultTransport() *http.Transport {
 		ExpectContinueTimeout: 10 * time.Second,
 		TLSClientConfig:       &tlsClientConfig,
 		ForceAttemptHTTP2:     s.EnableHTTP2,
+		MaxConnsPerHost:       s.MaxConnsPerHost,
 		// Go net/http automatically unzip if content-type is
 		// gzip disable this feature, as we are always interested
 		// in raw stream.
@@ -105,16 +112,33 @@ func (s ConnSettings) getDefaultTransport() *http.Transport {
 	// 	}
 	// }
 
+	if s.MaxIdleConnsPerHost > 0 {
+		tr.MaxIdleConnsPerHost = s.MaxIdleConnsPerHost
+	}
+	if s.IdleConnTimeout > 0 {
+		tr.IdleConnTimeout = s.IdleConnTimeout
+	}
+	if s.TLSHandshakeTimeout > 0 {
+		tr.TLSHandshakeTimeout = s.TLSHandshakeTimeout
+	}
+
 	return tr
 }
 
-// NewInternodeHTTPTransport returns transport for internode MinIO connections.
-func (s ConnSettings) NewInternodeHTTPTransport() func() http.RoundTripper {
+func (s ConnSettings) newInternodeTransport() *http.Transport {
+	if s.TLSHandshakeTimeout == 0 {
+		s.TLSHandshakeTimeout = 15 * time.Second
+	}
 	tr := s.getDefaultTransport()
 
 	// Settings specific to internode requests.
-	tr.TLSHandshakeTimeout = 15 * time.Second
 	tr.ExpectContinueTimeout = 15 * time.Second
+	return tr
+}
+
+// NewInternodeHTTPTransport returns transport for internode MinIO connections.
+func (s ConnSettings) NewInternodeHTTPTransport() func() http.RoundTripper {
+	tr := s.newInternodeTransport()
 
 	return func() http.RoundTripper {
 		return tr
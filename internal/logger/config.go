@@ -45,6 +45,9 @@ const (
 	QueueSize  = "queue_size"
 	Proxy      = "proxy"
 
+	CertAuthority = "cert_authority"
+	Headers       = "headers"
+
 	KafkaBrokers       = "brokers"
 	KafkaTopic         = "topic"
 	KafkaTLS           = "tls"
@@ -66,6 +69,9 @@ const (
 	EnvLoggerWebhookProxy      = "MINIO_LOGGER_WEBHOOK_PROXY"
 	EnvLoggerWebhookQueueSize  = "MINIO_LOGGER_WEBHOOK_QUEUE_SIZE"
 
+	EnvLoggerWebhookCertAuthority = "MINIO_LOGGER_WEBHOOK_CERT_AUTHORITY"
+	EnvLoggerWebhookHeaders       = "MINIO_LOGGER_WEBHOOK_HEADERS"
+
 	EnvAuditWebhookEnable     = "MINIO_AUDIT_WEBHOOK_ENABLE"
 	EnvAuditWebhookEndpoint   = "MINIO_AUDIT_WEBHOOK_ENDPOINT"
 	EnvAuditWebhookAuthToken  = "MINIO_AUDIT_WEBHOOK_AUTH_TOKEN"
@@ -73,6 +79,9 @@ const (
 	EnvAuditWebhookClientKey  = "MINIO_AUDIT_WEBHOOK_CLIENT_KEY"
 	EnvAuditWebhookQueueSize  = "MINIO_AUDIT_WEBHOOK_QUEUE_SIZE"
 
+	EnvAuditWebhookCertAuthority = "MINIO_AUDIT_WEBHOOK_CERT_AUTHORITY"
+	EnvAuditWebhookHeaders       = "MINIO_AUDIT_WEBHOOK_HEADERS"
+
 	EnvKafkaEnable        = "MINIO_AUDIT_KAFKA_ENABLE"
 	EnvKafkaBrokers       = "MINIO_AUDIT_KAFKA_BROKERS"
 	EnvKafkaTopic         = "MINIO_AUDIT_KAFKA_TOPIC"
@@ -111,6 +120,14 @@ var (
 			Key:   ClientKey,
 			Value: "",
 		},
+		config.KV{
+			Key:   CertAuthority,
+			Value: "",
+		},
+		config.KV{
+			Key:   Headers,
+			Value: "",
+		},
 		config.KV{
 			Key:   Proxy,
 			Value: "",
@@ -142,6 +159,14 @@ var (
 			Key:   ClientKey,
 			Value: "",
 		},
+		config.KV{
+			Key:   CertAuthority,
+			Value: "",
+		},
+		config.KV{
+			Key:   Headers,
+			Value: "",
+		},
 		config.KV{
 			Key:   QueueSize,
 			Value: "100000",
@@ -288,6 +313,16 @@ func lookupLegacyConfigForSubSys(subSys string) Config {
 	return cfg
 }
 
+// ensureCertAuthority checks that the certificate authority of a
+// webhook target, if any, loads.
+func ensureCertAuthority(certAuthority string) error {
+	if certAuthority == "" {
+		return nil
+	}
+	_, err := config.LoadCertPool(certAuthority)
+	return err
+}
+
 func lookupAuditKafkaConfig(scfg config.Config, cfg Config) (Config, error) {
 	for k, kv := range config.Merge(scfg[config.AuditKafkaSubSys], EnvKafkaEnable, DefaultAuditKafkaKVS) {
 		enableEnv := EnvKafkaEnable
@@ -447,6 +482,21 @@ func lookupLoggerWebhookConfig(scfg config.Config, cfg Config) (Config, error) {
 		if err != nil {
 			return cfg, err
 		}
+		certAuthorityEnv := EnvLoggerWebhookCertAuthority
+		if target != config.Default {
+			certAuthorityEnv = EnvLoggerWebhookCertAuthority + config.Default + target
+		}
+		if err = ensureCertAuthority(env.Get(certAuthorityEnv, "")); err != nil {
+			return cfg, err
+		}
+		headersEnv := EnvLoggerWebhookHeaders
+		if target != config.Default {
+			headersEnv = EnvLoggerWebhookHeaders + config.Default + target
+		}
+		headers, err := config.ParseHeaders(env.Get(headersEnv, ""))
+		if err != nil {
+			return cfg, err
+		}
 		proxyEnv := EnvLoggerWebhookProxy
 		queueSizeEnv := EnvAuditWebhookQueueSize
 		if target != config.Default {
@@ -460,14 +510,16 @@ func lookupLoggerWebhookConfig(scfg config.Config, cfg Config) (Config, error) {
 			return cfg, errors.New("invalid queue_size value")
 		}
 		cfg.HTTP[target] = http.Config{
-			Enabled:    true,
-			Endpoint:   env.Get(endpointEnv, ""),
-			AuthToken:  env.Get(authTokenEnv, ""),
-			ClientCert: env.Get(clientCertEnv, ""),
-			ClientKey:  env.Get(clientKeyEnv, ""),
-			Proxy:      env.Get(proxyEnv, ""),
-			QueueSize:  queueSize,
-			Name:       target,
+			Enabled:       true,
+			Endpoint:      env.Get(endpointEnv, ""),
+			AuthToken:     env.Get(authTokenEnv, ""),
+			ClientCert:    env.Get(clientCertEnv, ""),
+			ClientKey:     env.Get(clientKeyEnv, ""),
+			CertAuthority: env.Get(certAuthorityEnv, ""),
+			Headers:       headers,
+			Proxy:         env.Get(proxyEnv, ""),
+			QueueSize:     queueSize,
+			Name:          target,
 		}
 	}
 
@@ -496,6 +548,13 @@ func lookupLoggerWebhookConfig(scfg config.Config, cfg Config) (Config, error) {
 		if err != nil {
 			return cfg, err
 		}
+		if err = ensureCertAuthority(kv.Get(CertAuthority)); err != nil {
+			return cfg, err
+		}
+		headers, err := config.ParseHeaders(kv.Get(Headers))
+		if err != nil {
+			return cfg, err
+		}
 		queueSize, err := strconv.Atoi(kv.Get(QueueSize))
 		if err != nil {
 			return cfg, err
@@ -504,14 +563,16 @@ func lookupLoggerWebhookConfig(scfg config.Config, cfg Config) (Config, error) {
 			return cfg, errors.New("invalid queue_size value")
 		}
 		cfg.HTTP[starget] = http.Config{
-			Enabled:    true,
-			Endpoint:   kv.Get(Endpoint),
-			AuthToken:  kv.Get(AuthToken),
-			ClientCert: kv.Get(ClientCert),
-			ClientKey:  kv.Get(ClientKey),
-			Proxy:      kv.Get(Proxy),
-			QueueSize:  queueSize,
-			Name:       starget,
+			Enabled:       true,
+			Endpoint:      kv.Get(Endpoint),
+			AuthToken:     kv.Get(AuthToken),
+			ClientCert:    kv.Get(ClientCert),
+			ClientKey:     kv.Get(ClientKey),
+			CertAuthority: kv.Get(CertAuthority),
+			Headers:       headers,
+			Proxy:         kv.Get(Proxy),
+			QueueSize:     queueSize,
+			Name:          starget,
 		}
 	}
 
@@ -563,6 +624,21 @@ func lookupAuditWebhookConfig(scfg config.Config, cfg Config) (Config, error) {
 		if err != nil {
 			return cfg, err
 		}
+		certAuthorityEnv := EnvAuditWebhookCertAuthority
+		if target != config.Default {
+			certAuthorityEnv = EnvAuditWebhookCertAuthority + config.Default + target
+		}
+		if err = ensureCertAuthority(env.Get(certAuthorityEnv, "")); err != nil {
+			return cfg, err
+		}
+		headersEnv := EnvAuditWebhookHeaders
+		if target != config.Default {
+			headersEnv = EnvAuditWebhookHeaders + config.Default + target
+		}
+		headers, err := config.ParseHeaders(env.Get(headersEnv, ""))
+		if err != nil {
+			return cfg, err
+		}
 		queueSizeEnv := EnvAuditWebhookQueueSize
 		if target != config.Default {
 			queueSizeEnv = EnvAuditWebhookQueueSize + config.Default + target
@@ -575,13 +651,15 @@ func lookupAuditWebhookConfig(scfg config.Config, cfg Config) (Config, error) {
 			return cfg, errors.New("invalid queue_size value")
 		}
 		cfg.AuditWebhook[target] = http.Config{
-			Enabled:    true,
-			Endpoint:   env.Get(endpointEnv, ""),
-			AuthToken:  env.Get(authTokenEnv, ""),
-			ClientCert: env.Get(clientCertEnv, ""),
-			ClientKey:  env.Get(clientKeyEnv, ""),
-			QueueSize:  queueSize,
-			Name:       target,
+			Enabled:       true,
+			Endpoint:      env.Get(endpointEnv, ""),
+			AuthToken:     env.Get(authTokenEnv, ""),
+			ClientCert:    env.Get(clientCertEnv, ""),
+			ClientKey:     env.Get(clientKeyEnv, ""),
+			CertAuthority: env.Get(certAuthorityEnv, ""),
+			Headers:       headers,
+			QueueSize:     queueSize,
+			Name:          target,
 		}
 	}
 
@@ -610,6 +688,13 @@ func lookupAuditWebhookConfig(scfg config.Config, cfg Config) (Config, error) {
 		if err != nil {
 			return cfg, err
 		}
+		if err = ensureCertAuthority(kv.Get(CertAuthority)); err != nil {
+			return cfg, err
+		}
+		headers, err := config.ParseHeaders(kv.Get(Headers))
+		if err != nil {
+			return cfg, err
+		}
 		queueSize, err := strconv.Atoi(kv.Get(QueueSize))
 		if err != nil {
 			return cfg, err
@@ -619,13 +704,15 @@ func lookupAuditWebhookConfig(scfg config.Config, cfg Config) (Config, error) {
 		}
 
 		cfg.AuditWebhook[starget] = http.Config{
-			Enabled:    true,
-			Endpoint:   kv.Get(Endpoint),
-			AuthToken:  kv.Get(AuthToken),
-			ClientCert: kv.Get(ClientCert),
-			ClientKey:  kv.Get(ClientKey),
-			QueueSize:  queueSize,
-			Name:       starget,
+			Enabled:       true,
+			Endpoint:      kv.Get(Endpoint),
+			AuthToken:     kv.Get(AuthToken),
+			ClientCert:    kv.Get(ClientCert),
+			ClientKey:     kv.Get(ClientKey),
+			CertAuthority: kv.Get(CertAuthority),
+			Headers:       headers,
+			QueueSize:     queueSize,
+			Name:          starget,
 		}
 	}
 
@@ -39,18 +39,30 @@ var (
 		},
 		config.HelpKV{
 			Key:         ClientCert,
-			Description: "mTLS certificate for Logger Webhook authentication",
+			Description: "mTLS certificate for Logger Webhook authentication, path or inline PEM",
 			Optional:    true,
 			Type:        "string",
 			Sensitive:   true,
 		},
 		config.HelpKV{
 			Key:         ClientKey,
-			Description: "mTLS certificate key for Logger Webhook authentication",
+			Description: "mTLS certificate key for Logger Webhook authentication, path or inline PEM",
 			Optional:    true,
 			Type:        "string",
 			Sensitive:   true,
 		},
+		config.HelpKV{
+			Key:         CertAuthority,
+			Description: "CA certificates to verify the Logger Webhook endpoint, path or inline PEM",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         Headers,
+			Description: `static headers sent to the Logger Webhook e.g. "X-Tenant:acme,X-Source:minio"`,
+			Optional:    true,
+			Type:        "csv",
+		},
 		config.HelpKV{
 			Key:         QueueSize,
 			Description: "configure channel queue size for Logger Webhook targets",
@@ -87,18 +99,30 @@ var (
 		},
 		config.HelpKV{
 			Key:         ClientCert,
-			Description: "mTLS certificate for Audit Webhook authentication",
+			Description: "mTLS certificate for Audit Webhook authentication, path or inline PEM",
 			Optional:    true,
 			Type:        "string",
 			Sensitive:   true,
 		},
 		config.HelpKV{
 			Key:         ClientKey,
-			Description: "mTLS certificate key for Audit Webhook authentication",
+			Description: "mTLS certificate key for Audit Webhook authentication, path or inline PEM",
 			Optional:    true,
 			Type:        "string",
 			Sensitive:   true,
 		},
+		config.HelpKV{
+			Key:         CertAuthority,
+			Description: "CA certificates to verify the Audit Webhook endpoint, path or inline PEM",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         Headers,
+			Description: `static headers sent to the Audit Webhook e.g. "X-Tenant:acme,X-Source:minio"`,
+			Optional:    true,
+			Type:        "csv",
+		},
 		config.HelpKV{
 			Key:         QueueSize,
 			Description: "configure channel queue size for Audit Webhook targets",
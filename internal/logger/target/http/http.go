@@ -51,16 +51,18 @@ const (
 
 // Config http logger target
 type Config struct {
-	Enabled    bool              `json:"enabled"`
-	Name       string            `json:"name"`
-	UserAgent  string            `json:"userAgent"`
-	Endpoint   string            `json:"endpoint"`
-	AuthToken  string            `json:"authToken"`
-	ClientCert string            `json:"clientCert"`
-	ClientKey  string            `json:"clientKey"`
-	QueueSize  int               `json:"queueSize"`
-	Proxy      string            `json:"string"`
-	Transport  http.RoundTripper `json:"-"`
+	Enabled       bool              `json:"enabled"`
+	Name          string            `json:"name"`
+	UserAgent     string            `json:"userAgent"`
+	Endpoint      string            `json:"endpoint"`
+	AuthToken     string            `json:"authToken"`
+	ClientCert    string            `json:"clientCert"`
+	ClientKey     string            `json:"clientKey"`
+	CertAuthority string            `json:"certAuthority"`
+	Headers       http.Header       `json:"headers"`
+	QueueSize     int               `json:"queueSize"`
+	Proxy         string            `json:"string"`
+	Transport     http.RoundTripper `json:"-"`
 
 	// Custom logger
 	LogOnce func(ctx context.Context, err error, id string, errKind ...interface{}) `json:"-"`
@@ -144,15 +146,7 @@ func (h *Target) Init() (err error) {
 			return err
 		}
 
-		req.Header.Set(xhttp.ContentType, "application/json")
-
-		// Set user-agent to indicate MinIO release
-		// version to the configured log endpoint
-		req.Header.Set("User-Agent", h.config.UserAgent)
-
-		if h.config.AuthToken != "" {
-			req.Header.Set("Authorization", h.config.AuthToken)
-		}
+		h.setHeaders(req)
 
 		resp, err := h.client.Do(req)
 		if err != nil {
@@ -209,6 +203,24 @@ func (h *Target) Init() (err error) {
 	return nil
 }
 
+// setHeaders sets the headers of a request to the endpoint, the static
+// headers of the config first so that they can't override ours.
+func (h *Target) setHeaders(req *http.Request) {
+	for k, v := range h.config.Headers {
+		req.Header[k] = v
+	}
+
+	req.Header.Set(xhttp.ContentType, "application/json")
+
+	// Set user-agent to indicate MinIO release
+	// version to the configured log endpoint
+	req.Header.Set("User-Agent", h.config.UserAgent)
+
+	if h.config.AuthToken != "" {
+		req.Header.Set("Authorization", h.config.AuthToken)
+	}
+}
+
 // Accepted HTTP Status Codes
 var acceptedStatusCodeMap = map[int]bool{http.StatusOK: true, http.StatusCreated: true, http.StatusAccepted: true, http.StatusNoContent: true}
 
@@ -247,18 +259,10 @@ func (h *Target) logEntry(entry interface{}) {
 			atomic.AddInt64(&h.failedMessages, 1)
 			continue
 		}
-		req.Header.Set(xhttp.ContentType, "application/json")
+		h.setHeaders(req)
 		req.Header.Set(xhttp.MinIOVersion, xhttp.GlobalMinIOVersion)
 		req.Header.Set(xhttp.MinioDeploymentID, xhttp.GlobalDeploymentID)
 
-		// Set user-agent to indicate MinIO release
-		// version to the configured log endpoint
-		req.Header.Set("User-Agent", h.config.UserAgent)
-
-		if h.config.AuthToken != "" {
-			req.Header.Set("Authorization", h.config.AuthToken)
-		}
-
 		resp, err := h.client.Do(req)
 		if err != nil {
 			atomic.AddInt64(&h.failedMessages, 1)
@@ -392,6 +396,34 @@ func (h *Target) Cancel() {
 	h.wg.Wait()
 }
 
+// Transfer - cancels the target and moves its queued messages to the
+// target replacing it, so that reloading the config doesn't drop them.
+// Messages are dropped if the queue of the new target is full.
+func (h *Target) Transfer(next *Target) {
+	atomic.StoreInt32(&h.status, statusClosed)
+
+	h.logChMu.Lock()
+	logCh := h.logCh
+	h.logCh = nil
+	h.logChMu.Unlock()
+
+	if logCh == nil {
+		return
+	}
+
+	// Running workers keep sending the messages they took.
+	for {
+		select {
+		case entry := <-logCh:
+			next.Send(entry)
+		default:
+			close(logCh)
+			h.wg.Wait()
+			return
+		}
+	}
+}
+
 // Type - returns type of the target
 func (h *Target) Type() types.TargetType {
 	return types.TargetHTTP
@@ -199,6 +199,24 @@ func cancelTargets(targets []Target) {
 	}
 }
 
+// replaceTargets cancels the replaced targets, the queued messages of
+// http targets are moved to the new target with the same name, if any.
+func replaceTargets(oldTargets, newTargets []Target) {
+	next := make(map[string]*http.Target, len(newTargets))
+	for _, target := range newTargets {
+		if t, ok := target.(*http.Target); ok {
+			next[t.String()] = t
+		}
+	}
+	for _, target := range oldTargets {
+		if t, ok := target.(*http.Target); ok && next[t.String()] != nil {
+			t.Transfer(next[t.String()])
+			continue
+		}
+		target.Cancel()
+	}
+}
+
 // UpdateSystemTargets swaps targets with newly loaded ones from the cfg
 func UpdateSystemTargets(cfg Config) []error {
 	newTgts, errs := initSystemTargets(cfg.HTTP)
@@ -209,7 +227,7 @@ func UpdateSystemTargets(cfg Config) []error {
 	systemTargets = newTgts
 	swapSystemMuRW.Unlock()
 
-	cancelTargets(otherTargets) // cancel running targets
+	replaceTargets(otherTargets, newTgts) // cancel running targets
 	return errs
 }
 
@@ -224,7 +242,7 @@ func UpdateAuditWebhookTargets(cfg Config) []error {
 	auditTargets = newWebhookTgts
 	swapAuditMuRW.Unlock()
 
-	cancelTargets(oldWebhookTgts) // cancel running targets
+	replaceTargets(oldWebhookTgts, newWebhookTgts) // cancel running targets
 	return errs
 }
 